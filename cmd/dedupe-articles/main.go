@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mysql"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/pinecone"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	_ = godotenv.Load()
+	ctx := context.Background()
+
+	// Setup logger
+	logLevel := slog.LevelInfo
+	if lvl := os.Getenv("LOG_LEVEL"); lvl != "" {
+		if err := logLevel.UnmarshalText([]byte(lvl)); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid LOG_LEVEL: %s\n", lvl)
+			os.Exit(1)
+		}
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevel,
+	}))
+	slog.SetDefault(logger)
+	ctx = domain.ContextWithLogger(ctx, logger)
+
+	if err := run(ctx); err != nil {
+		logger.ErrorContext(ctx, "duplicate article detection failed", "error", err)
+		os.Exit(1)
+	}
+
+	logger.InfoContext(ctx, "duplicate article detection completed successfully")
+}
+
+func run(ctx context.Context) error {
+	// Connect to MySQL
+	mysqlURI := os.Getenv("MYSQL_URI")
+	if mysqlURI == "" {
+		return fmt.Errorf("MYSQL_URI environment variable is required")
+	}
+
+	db, err := mysql.Connect(ctx, mysqlURI)
+	if err != nil {
+		return fmt.Errorf("connecting to MySQL: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	dataset := mysql.New(db)
+
+	pineconeAPIKey := os.Getenv("PINECONE_API_KEY")
+	pineconeIndexName := os.Getenv("PINECONE_INDEX_NAME")
+	if pineconeAPIKey == "" || pineconeIndexName == "" {
+		return fmt.Errorf("PINECONE_API_KEY and PINECONE_INDEX_NAME environment variables are required")
+	}
+
+	similarity, err := pinecone.NewClient(ctx, pineconeAPIKey, pineconeIndexName)
+	if err != nil {
+		return fmt.Errorf("connecting to Pinecone: %w", err)
+	}
+
+	dedupeCmd := command.NewDetectDuplicateArticles(
+		dataset,
+		dataset,
+		similarity,
+		dataset,
+		dataset,
+		domain.DefaultDuplicateConfig(),
+	)
+
+	_, err = dedupeCmd.Execute(ctx, command.DetectDuplicateArticlesRequest{})
+	return err
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mysql"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	_ = godotenv.Load()
+	ctx := context.Background()
+
+	// Setup logger; stdout is reserved for the report
+	logLevel := slog.LevelInfo
+	if lvl := os.Getenv("LOG_LEVEL"); lvl != "" {
+		if err := logLevel.UnmarshalText([]byte(lvl)); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid LOG_LEVEL: %s\n", lvl)
+			os.Exit(1)
+		}
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: logLevel,
+	}))
+	slog.SetDefault(logger)
+	ctx = domain.ContextWithLogger(ctx, logger)
+
+	days := flag.Int("days", 30, "only count recommendations shown in the last this many days")
+	flag.Parse()
+
+	if err := run(ctx, *days); err != nil {
+		logger.ErrorContext(ctx, "experiment report failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, days int) error {
+	// Connect to MySQL
+	mysqlURI := os.Getenv("MYSQL_URI")
+	if mysqlURI == "" {
+		return fmt.Errorf("MYSQL_URI environment variable is required")
+	}
+
+	db, err := mysql.Connect(ctx, mysqlURI)
+	if err != nil {
+		return fmt.Errorf("connecting to MySQL: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	dataset := mysql.New(db)
+
+	since := time.Now().AddDate(0, 0, -days)
+	reports, err := dataset.ReportExperimentArms(ctx, since)
+	if err != nil {
+		return fmt.Errorf("reporting experiment arms: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ARM\tUSERS\tSHOWN\tREAD\tLIKED\tREAD RATE\tLIKE RATE")
+	for _, r := range reports {
+		arm := r.Arm
+		if arm == "" {
+			arm = "(none)"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.2f%%\t%.2f%%\n",
+			arm, r.Users, r.Shown, r.Read, r.Liked, 100*r.ReadRate, 100*r.LikeRate)
+	}
+	return w.Flush()
+}
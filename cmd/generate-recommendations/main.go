@@ -84,9 +84,8 @@ func run(ctx context.Context) error {
 	generateCmd := command.NewGenerateRecommendations(
 		pineconeClient,
 		dataset,
-		dataset,
-		dataset,
 		app.DefaultGenerateRecommendationsConfig(),
+		app.DefaultRecommendationExperiment(),
 	)
 
 	// Create the background job runner
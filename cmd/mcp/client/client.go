@@ -33,6 +33,51 @@ type Article struct {
 	HaveRead   *bool `json:"have_read,omitempty"`
 	ThumbsUp   *bool `json:"thumbs_up,omitempty"`
 	ThumbsDown *bool `json:"thumbs_down,omitempty"`
+
+	Notes []Note `json:"notes,omitempty"`
+}
+
+// Note represents a private note or highlight on an article.
+type Note struct {
+	ID            string    `json:"id"`
+	ArticleHashID string    `json:"article_hash_id"`
+	Body          string    `json:"body"`
+	Quote         string    `json:"quote,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// NotesResponse represents the response for listing notes.
+type NotesResponse struct {
+	Data []Note `json:"data"`
+}
+
+// TagCount represents one of the user's tags and how many articles have it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
+
+// TagsResponse represents the response for listing the user's tags.
+type TagsResponse struct {
+	Data []TagCount `json:"data"`
+}
+
+// Follow represents an author, source or category the user follows.
+type Follow struct {
+	Type      string    `json:"type"`
+	Target    string    `json:"target"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FollowsResponse represents the response for listing the user's follows.
+type FollowsResponse struct {
+	Data []Follow `json:"data"`
+}
+
+// ArticleTagsResponse represents the response for listing an article's tags.
+type ArticleTagsResponse struct {
+	Data []string `json:"data"`
 }
 
 // ArticlesResponse represents the paginated response for article lists.
@@ -41,6 +86,40 @@ type ArticlesResponse struct {
 	Metadata struct{}  `json:"metadata"`
 }
 
+// SavedSearch represents one of the user's saved searches.
+type SavedSearch struct {
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Kind         string         `json:"kind"`
+	Filters      map[string]any `json:"filters,omitempty"`
+	QueryText    string         `json:"query_text,omitempty"`
+	LastViewedAt *time.Time     `json:"last_viewed_at,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	FeedURL      string         `json:"feed_url"`
+}
+
+// SavedSearchesResponse represents the response for listing saved searches.
+type SavedSearchesResponse struct {
+	Data []SavedSearch `json:"data"`
+}
+
+// Collection represents one of the user's article collections.
+type Collection struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	IsPublic     bool      `json:"is_public"`
+	ArticleCount int       `json:"article_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	ShareURL     string    `json:"share_url,omitempty"`
+	FeedURL      string    `json:"feed_url,omitempty"`
+}
+
+// CollectionsResponse represents the response for listing collections.
+type CollectionsResponse struct {
+	Data []Collection `json:"data"`
+}
+
 // SearchFilters contains search parameters for listing articles.
 type SearchFilters struct {
 	Query           string
@@ -271,6 +350,33 @@ func (c *Client) GetRecommendations(ctx context.Context, limit int) ([]Article,
 	return result.Data, nil
 }
 
+// GetNewRecommendations retrieves personalized recommendations from articles published
+// in the last days days.
+func (c *Client) GetNewRecommendations(ctx context.Context, days int) ([]Article, error) {
+	params := url.Values{}
+	if days > 0 {
+		params.Set("days", strconv.Itoa(days))
+	}
+
+	path := "/v1/articles/recommended/new"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result ArticlesResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
 // RateArticle sets the thumbs up or thumbs down rating for an article.
 func (c *Client) RateArticle(ctx context.Context, articleID string, thumbsUp, thumbsDown bool) error {
 	upPath := fmt.Sprintf("/v1/articles/%s/thumbs_up/%t", url.PathEscape(articleID), thumbsUp)
@@ -307,9 +413,53 @@ func (c *Client) MarkRead(ctx context.Context, articleID string, read bool) erro
 	return c.handleResponse(resp, nil)
 }
 
+// DismissArticle dismisses an article so it is never recommended, or undoes a dismissal.
+func (c *Client) DismissArticle(ctx context.Context, articleID string, dismissed bool) error {
+	path := fmt.Sprintf("/v1/articles/%s/dismissed/%t", url.PathEscape(articleID), dismissed)
+	resp, err := c.doRequest(ctx, http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// SnoozeArticle leaves an article out of recommendations until a time.
+// The zero time clears the snooze.
+func (c *Client) SnoozeArticle(ctx context.Context, articleID string, until time.Time) error {
+	path := "/v1/articles/" + url.PathEscape(articleID) + "/snooze"
+	if until.IsZero() {
+		resp, err := c.doRequest(ctx, http.MethodDelete, path)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		return c.handleResponse(resp, nil)
+	}
+
+	jsonBody, err := json.Marshal(struct {
+		Until time.Time `json:"until"`
+	}{Until: until})
+	if err != nil {
+		return fmt.Errorf("marshalling request: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, path, bytes.NewReader(jsonBody))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
 // listArticlesByPath retrieves a paginated list of articles from the given path.
-func (c *Client) listArticlesByPath(ctx context.Context, path string, page, pageSize int) ([]Article, error) {
+func (c *Client) listArticlesByPath(
+	ctx context.Context, path string, tags []string, page, pageSize int,
+) ([]Article, error) {
 	params := url.Values{}
+	if len(tags) > 0 {
+		params.Set("filter_tags", strings.Join(tags, ","))
+	}
 	if page > 0 {
 		params.Set("page", strconv.Itoa(page))
 	}
@@ -336,16 +486,355 @@ func (c *Client) listArticlesByPath(ctx context.Context, path string, page, page
 }
 
 // ListLiked retrieves articles the user has liked (thumbs up).
-func (c *Client) ListLiked(ctx context.Context, page, pageSize int) ([]Article, error) {
-	return c.listArticlesByPath(ctx, "/v1/articles/liked", page, pageSize)
+// If tags are given, only articles with all of those tags are returned.
+func (c *Client) ListLiked(ctx context.Context, tags []string, page, pageSize int) ([]Article, error) {
+	return c.listArticlesByPath(ctx, "/v1/articles/liked", tags, page, pageSize)
 }
 
 // ListDisliked retrieves articles the user has disliked (thumbs down).
-func (c *Client) ListDisliked(ctx context.Context, page, pageSize int) ([]Article, error) {
-	return c.listArticlesByPath(ctx, "/v1/articles/disliked", page, pageSize)
+// If tags are given, only articles with all of those tags are returned.
+func (c *Client) ListDisliked(ctx context.Context, tags []string, page, pageSize int) ([]Article, error) {
+	return c.listArticlesByPath(ctx, "/v1/articles/disliked", tags, page, pageSize)
 }
 
 // ListUnreviewed retrieves articles the user hasn't reviewed yet.
-func (c *Client) ListUnreviewed(ctx context.Context, page, pageSize int) ([]Article, error) {
-	return c.listArticlesByPath(ctx, "/v1/articles/unreviewed", page, pageSize)
+// If tags are given, only articles with all of those tags are returned.
+func (c *Client) ListUnreviewed(ctx context.Context, tags []string, page, pageSize int) ([]Article, error) {
+	return c.listArticlesByPath(ctx, "/v1/articles/unreviewed", tags, page, pageSize)
+}
+
+// ListSavedSearches retrieves the user's saved searches.
+func (c *Client) ListSavedSearches(ctx context.Context) ([]SavedSearch, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/saved-searches")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result SavedSearchesResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// RunSavedSearch retrieves results for a saved search. If newOnly is set, only articles
+// published since the search was last viewed are returned, and it is marked as viewed.
+func (c *Client) RunSavedSearch(
+	ctx context.Context, searchID string, newOnly bool, page, pageSize int,
+) ([]Article, error) {
+	path := "/v1/saved-searches/" + url.PathEscape(searchID) + "/articles"
+	if newOnly {
+		path = "/v1/saved-searches/" + url.PathEscape(searchID) + "/new"
+	}
+	return c.listArticlesByPath(ctx, path, nil, page, pageSize)
+}
+
+// ListCollections retrieves the user's collections.
+func (c *Client) ListCollections(ctx context.Context) ([]Collection, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/collections")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result CollectionsResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// CreateCollection creates a new, empty collection.
+func (c *Client) CreateCollection(ctx context.Context, name string, isPublic bool) (*Collection, error) {
+	reqBody := struct {
+		Name     string `json:"name"`
+		IsPublic bool   `json:"is_public"`
+	}{
+		Name:     name,
+		IsPublic: isPublic,
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, "/v1/collections", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var collection Collection
+	if err := c.handleResponse(resp, &collection); err != nil {
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+// GetCollectionArticles retrieves the articles in a collection, in order.
+func (c *Client) GetCollectionArticles(ctx context.Context, collectionID string) ([]Article, error) {
+	return c.listArticlesByPath(ctx, "/v1/collections/"+url.PathEscape(collectionID)+"/articles", nil, 0, 0)
+}
+
+// AddToCollection appends an article to a collection.
+func (c *Client) AddToCollection(ctx context.Context, collectionID, articleID string) error {
+	path := "/v1/collections/" + url.PathEscape(collectionID) + "/articles/" + url.PathEscape(articleID)
+	resp, err := c.doRequest(ctx, http.MethodPut, path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// RemoveFromCollection removes an article from a collection.
+func (c *Client) RemoveFromCollection(ctx context.Context, collectionID, articleID string) error {
+	path := "/v1/collections/" + url.PathEscape(collectionID) + "/articles/" + url.PathEscape(articleID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// GetCollectionSimilar finds articles similar to a collection as a whole.
+func (c *Client) GetCollectionSimilar(ctx context.Context, collectionID string, limit int) ([]Article, error) {
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/v1/collections/" + url.PathEscape(collectionID) + "/similar"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result ArticlesResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// ListNotes retrieves the user's notes. If articleID is set, only notes on that article are
+// returned; otherwise notes across all articles are listed, or searched if query is set.
+func (c *Client) ListNotes(ctx context.Context, articleID, query string, page, pageSize int) ([]Note, error) {
+	params := url.Values{}
+	path := "/v1/notes"
+	if articleID != "" {
+		path = "/v1/articles/" + url.PathEscape(articleID) + "/notes"
+	} else {
+		if query != "" {
+			params.Set("q", query)
+		}
+		if page > 0 {
+			params.Set("page", strconv.Itoa(page))
+		}
+		if pageSize > 0 {
+			params.Set("page_size", strconv.Itoa(pageSize))
+		}
+	}
+
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result NotesResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// AddNote adds a note to an article. A non-empty quote makes it a highlight of that passage.
+func (c *Client) AddNote(ctx context.Context, articleID, body, quote string) (*Note, error) {
+	reqBody := struct {
+		Body  string `json:"body"`
+		Quote string `json:"quote,omitempty"`
+	}{
+		Body:  body,
+		Quote: quote,
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	path := "/v1/articles/" + url.PathEscape(articleID) + "/notes"
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, path, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var note Note
+	if err := c.handleResponse(resp, &note); err != nil {
+		return nil, err
+	}
+
+	return &note, nil
+}
+
+// UpdateNote edits a note. Nil fields are left unchanged.
+func (c *Client) UpdateNote(ctx context.Context, noteID string, body, quote *string) (*Note, error) {
+	reqBody := struct {
+		Body  *string `json:"body,omitempty"`
+		Quote *string `json:"quote,omitempty"`
+	}{
+		Body:  body,
+		Quote: quote,
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	path := "/v1/notes/" + url.PathEscape(noteID)
+	resp, err := c.doRequestWithBody(ctx, http.MethodPatch, path, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var note Note
+	if err := c.handleResponse(resp, &note); err != nil {
+		return nil, err
+	}
+
+	return &note, nil
+}
+
+// DeleteNote deletes a note.
+func (c *Client) DeleteNote(ctx context.Context, noteID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/v1/notes/"+url.PathEscape(noteID))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// ListTags retrieves the user's tags with how many articles have each.
+func (c *Client) ListTags(ctx context.Context) ([]TagCount, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/tags")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result TagsResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// ListArticleTags retrieves the tags the user has given an article.
+func (c *Client) ListArticleTags(ctx context.Context, articleID string) ([]string, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/articles/"+url.PathEscape(articleID)+"/tags")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result ArticleTagsResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// ListTaggedArticles retrieves the articles the user has given a tag.
+func (c *Client) ListTaggedArticles(ctx context.Context, tag string, page, pageSize int) ([]Article, error) {
+	return c.listArticlesByPath(ctx, "/v1/tags/"+url.PathEscape(tag)+"/articles", nil, page, pageSize)
+}
+
+// TagArticle adds a tag to an article.
+func (c *Client) TagArticle(ctx context.Context, articleID, tag string) error {
+	path := "/v1/articles/" + url.PathEscape(articleID) + "/tags/" + url.PathEscape(tag)
+	resp, err := c.doRequest(ctx, http.MethodPut, path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// UntagArticle removes a tag from an article.
+func (c *Client) UntagArticle(ctx context.Context, articleID, tag string) error {
+	path := "/v1/articles/" + url.PathEscape(articleID) + "/tags/" + url.PathEscape(tag)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// ListFollows retrieves the authors, sources and categories the user follows.
+func (c *Client) ListFollows(ctx context.Context) ([]Follow, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/follows")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result FollowsResponse
+	if err := c.handleResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// Follow follows an author (by author ID), source or category.
+func (c *Client) Follow(ctx context.Context, followType, target string) error {
+	path := "/v1/follows/" + url.PathEscape(followType) + "/" + url.PathEscape(target)
+	resp, err := c.doRequest(ctx, http.MethodPut, path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// Unfollow stops following an author, source or category.
+func (c *Client) Unfollow(ctx context.Context, followType, target string) error {
+	path := "/v1/follows/" + url.PathEscape(followType) + "/" + url.PathEscape(target)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return c.handleResponse(resp, nil)
+}
+
+// ListFollowing retrieves the latest articles by the authors, or in the sources and categories,
+// the user follows.
+func (c *Client) ListFollowing(ctx context.Context, page, pageSize int) ([]Article, error) {
+	return c.listArticlesByPath(ctx, "/v1/articles/following", nil, page, pageSize)
 }
@@ -122,6 +122,15 @@ func (s *Server) registerTools() {
 		),
 	), s.handleGetRecommendations)
 
+	s.mcpServer.AddTool(mcp.NewTool("whats_new_for_me",
+		mcp.WithDescription(
+			"Get personalized recommendations from recently published articles only. "+
+				"Requires authentication."),
+		mcp.WithNumber("days",
+			mcp.Description("Only recommend articles published in this many days, up to 90 (default: 7)"),
+		),
+	), s.handleWhatsNewForMe)
+
 	s.mcpServer.AddTool(mcp.NewTool("rate_article",
 		mcp.WithDescription("Rate an article with thumbs up or thumbs down. This affects your personalized recommendations."),
 		mcp.WithString("article_id",
@@ -146,11 +155,37 @@ func (s *Server) registerTools() {
 		),
 	), s.handleMarkRead)
 
+	s.mcpServer.AddTool(mcp.NewTool("dismiss_article",
+		mcp.WithDescription("Dismiss an article so it is never recommended again, or undo a dismissal."),
+		mcp.WithString("article_id",
+			mcp.Required(),
+			mcp.Description("The hash_id of the article"),
+		),
+		mcp.WithBoolean("dismissed",
+			mcp.Required(),
+			mcp.Description("Whether to dismiss (true) or undismiss (false)"),
+		),
+	), s.handleDismissArticle)
+
+	s.mcpServer.AddTool(mcp.NewTool("snooze_article",
+		mcp.WithDescription("Leave an article out of recommendations for a number of days."),
+		mcp.WithString("article_id",
+			mcp.Required(),
+			mcp.Description("The hash_id of the article"),
+		),
+		mcp.WithNumber("days",
+			mcp.Description("Number of days to snooze for (default: 7); 0 clears an existing snooze"),
+		),
+	), s.handleSnoozeArticle)
+
 	s.mcpServer.AddTool(mcp.NewTool("list_liked",
 		mcp.WithDescription("List articles you have marked as liked (thumbs up). Requires authentication."),
 		mcp.WithNumber("page",
 			mcp.Description("Page number (1-indexed, default: 1)"),
 		),
+		mcp.WithString("tags",
+			mcp.Description("Comma-separated tags; only articles with all of these tags are listed"),
+		),
 		mcp.WithNumber("page_size",
 			mcp.Description("Number of articles per page (default: 50, max: 200)"),
 		),
@@ -161,6 +196,9 @@ func (s *Server) registerTools() {
 		mcp.WithNumber("page",
 			mcp.Description("Page number (1-indexed, default: 1)"),
 		),
+		mcp.WithString("tags",
+			mcp.Description("Comma-separated tags; only articles with all of these tags are listed"),
+		),
 		mcp.WithNumber("page_size",
 			mcp.Description("Number of articles per page (default: 50, max: 200)"),
 		),
@@ -171,8 +209,243 @@ func (s *Server) registerTools() {
 		mcp.WithNumber("page",
 			mcp.Description("Page number (1-indexed, default: 1)"),
 		),
+		mcp.WithString("tags",
+			mcp.Description("Comma-separated tags; only articles with all of these tags are listed"),
+		),
 		mcp.WithNumber("page_size",
 			mcp.Description("Number of articles per page (default: 50, max: 200)"),
 		),
 	), s.handleListUnreviewed)
+
+	s.mcpServer.AddTool(mcp.NewTool("list_saved_searches",
+		mcp.WithDescription(
+			"List your saved searches, including their filters or semantic query text "+
+				"and when each was last viewed. Requires authentication."),
+	), s.handleListSavedSearches)
+
+	s.mcpServer.AddTool(mcp.NewTool("run_saved_search",
+		mcp.WithDescription(
+			"Run one of your saved searches. With new_only, returns only articles "+
+				"published since the search was last viewed and marks it as viewed. Requires authentication."),
+		mcp.WithString("saved_search_id",
+			mcp.Required(),
+			mcp.Description("The id of the saved search, as returned by list_saved_searches"),
+		),
+		mcp.WithBoolean("new_only",
+			mcp.Description("Only return articles published since the search was last viewed (default: false)"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Page number (1-indexed, default: 1)"),
+		),
+		mcp.WithNumber("page_size",
+			mcp.Description("Number of articles per page (default: 50, max: 200)"),
+		),
+	), s.handleRunSavedSearch)
+
+	s.mcpServer.AddTool(mcp.NewTool("list_collections",
+		mcp.WithDescription(
+			"List your article collections with their article counts and, for public collections, "+
+				"share links. Requires authentication."),
+	), s.handleListCollections)
+
+	s.mcpServer.AddTool(mcp.NewTool("create_collection",
+		mcp.WithDescription("Create a new, empty article collection. Requires authentication."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the collection"),
+		),
+		mcp.WithBoolean("public",
+			mcp.Description("Whether the collection can be viewed by anyone with its share link (default: false)"),
+		),
+	), s.handleCreateCollection)
+
+	s.mcpServer.AddTool(mcp.NewTool("get_collection_articles",
+		mcp.WithDescription("List the articles in one of your collections, in order. Requires authentication."),
+		mcp.WithString("collection_id",
+			mcp.Required(),
+			mcp.Description("The id of the collection, as returned by list_collections"),
+		),
+	), s.handleGetCollectionArticles)
+
+	s.mcpServer.AddTool(mcp.NewTool("add_to_collection",
+		mcp.WithDescription("Add an article to the end of one of your collections. Requires authentication."),
+		mcp.WithString("collection_id",
+			mcp.Required(),
+			mcp.Description("The id of the collection"),
+		),
+		mcp.WithString("article_id",
+			mcp.Required(),
+			mcp.Description("The hash_id of the article to add"),
+		),
+	), s.handleAddToCollection)
+
+	s.mcpServer.AddTool(mcp.NewTool("remove_from_collection",
+		mcp.WithDescription("Remove an article from one of your collections. Requires authentication."),
+		mcp.WithString("collection_id",
+			mcp.Required(),
+			mcp.Description("The id of the collection"),
+		),
+		mcp.WithString("article_id",
+			mcp.Required(),
+			mcp.Description("The hash_id of the article to remove"),
+		),
+	), s.handleRemoveFromCollection)
+
+	s.mcpServer.AddTool(mcp.NewTool("get_collection_similar",
+		mcp.WithDescription(
+			"Find articles similar to a collection as a whole, using all of its articles as the seed set. "+
+				"Useful for extending a reading list. Requires authentication."),
+		mcp.WithString("collection_id",
+			mcp.Required(),
+			mcp.Description("The id of the collection"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of similar articles to return (default: 10)"),
+		),
+	), s.handleGetCollectionSimilar)
+
+	s.mcpServer.AddTool(mcp.NewTool("list_notes",
+		mcp.WithDescription(
+			"List your private notes and highlights. Give article_id for the notes on one article, "+
+				"or query to full-text search across all your notes. Requires authentication."),
+		mcp.WithString("article_id",
+			mcp.Description("Only list notes on this article (hash_id)"),
+		),
+		mcp.WithString("query",
+			mcp.Description("Full-text search over note bodies and highlighted quotes"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Page number (1-indexed, default: 1)"),
+		),
+		mcp.WithNumber("page_size",
+			mcp.Description("Number of notes per page (default: 50, max: 200)"),
+		),
+	), s.handleListNotes)
+
+	s.mcpServer.AddTool(mcp.NewTool("add_note",
+		mcp.WithDescription(
+			"Add a private markdown note to an article. Include quote to highlight a passage "+
+				"from the article. Requires authentication."),
+		mcp.WithString("article_id",
+			mcp.Required(),
+			mcp.Description("The hash_id of the article"),
+		),
+		mcp.WithString("body",
+			mcp.Description("Markdown note text"),
+		),
+		mcp.WithString("quote",
+			mcp.Description("Passage from the article to highlight"),
+		),
+	), s.handleAddNote)
+
+	s.mcpServer.AddTool(mcp.NewTool("update_note",
+		mcp.WithDescription("Edit one of your notes. Omitted fields are left unchanged. Requires authentication."),
+		mcp.WithString("note_id",
+			mcp.Required(),
+			mcp.Description("The id of the note"),
+		),
+		mcp.WithString("body",
+			mcp.Description("New markdown note text"),
+		),
+		mcp.WithString("quote",
+			mcp.Description("New highlighted passage; empty string removes the highlight"),
+		),
+	), s.handleUpdateNote)
+
+	s.mcpServer.AddTool(mcp.NewTool("delete_note",
+		mcp.WithDescription("Delete one of your notes. Requires authentication."),
+		mcp.WithString("note_id",
+			mcp.Required(),
+			mcp.Description("The id of the note"),
+		),
+	), s.handleDeleteNote)
+
+	s.mcpServer.AddTool(mcp.NewTool("list_tags",
+		mcp.WithDescription(
+			"List your tags with how many articles have each, or the tags on one article if "+
+				"article_id is given. Requires authentication."),
+		mcp.WithString("article_id",
+			mcp.Description("Only list the tags on this article (hash_id)"),
+		),
+	), s.handleListTags)
+
+	s.mcpServer.AddTool(mcp.NewTool("tag_article",
+		mcp.WithDescription("Add one of your own free-form tags to an article. Requires authentication."),
+		mcp.WithString("article_id",
+			mcp.Required(),
+			mcp.Description("The hash_id of the article"),
+		),
+		mcp.WithString("tag",
+			mcp.Required(),
+			mcp.Description("The tag, up to 64 characters; case and extra whitespace are ignored"),
+		),
+	), s.handleTagArticle)
+
+	s.mcpServer.AddTool(mcp.NewTool("untag_article",
+		mcp.WithDescription("Remove a tag from an article. Requires authentication."),
+		mcp.WithString("article_id",
+			mcp.Required(),
+			mcp.Description("The hash_id of the article"),
+		),
+		mcp.WithString("tag",
+			mcp.Required(),
+			mcp.Description("The tag to remove"),
+		),
+	), s.handleUntagArticle)
+
+	s.mcpServer.AddTool(mcp.NewTool("list_tagged_articles",
+		mcp.WithDescription("List articles you have given a tag, most recently tagged first. Requires authentication."),
+		mcp.WithString("tag",
+			mcp.Required(),
+			mcp.Description("The tag"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Page number (1-indexed, default: 1)"),
+		),
+		mcp.WithNumber("page_size",
+			mcp.Description("Number of articles per page (default: 50, max: 200)"),
+		),
+	), s.handleListTaggedArticles)
+
+	s.mcpServer.AddTool(mcp.NewTool("list_follows",
+		mcp.WithDescription("List the authors, sources and categories you follow. Requires authentication."),
+	), s.handleListFollows)
+
+	s.mcpServer.AddTool(mcp.NewTool("follow",
+		mcp.WithDescription(
+			"Follow an author, source or category, to see everything from it in your following feed. "+
+				"Requires authentication."),
+		mcp.WithString("type",
+			mcp.Required(),
+			mcp.Description("What to follow: author, source or category"),
+		),
+		mcp.WithString("target",
+			mcp.Required(),
+			mcp.Description("The author ID (e.g. neel-nanda), source (e.g. arxiv) or category"),
+		),
+	), s.handleFollow)
+
+	s.mcpServer.AddTool(mcp.NewTool("unfollow",
+		mcp.WithDescription("Stop following an author, source or category. Requires authentication."),
+		mcp.WithString("type",
+			mcp.Required(),
+			mcp.Description("What to unfollow: author, source or category"),
+		),
+		mcp.WithString("target",
+			mcp.Required(),
+			mcp.Description("The author ID, source or category"),
+		),
+	), s.handleUnfollow)
+
+	s.mcpServer.AddTool(mcp.NewTool("list_following",
+		mcp.WithDescription(
+			"List the latest articles by the authors, or in the sources and categories, you follow. "+
+				"Requires authentication."),
+		mcp.WithNumber("page",
+			mcp.Description("Page number (1-indexed, default: 1)"),
+		),
+		mcp.WithNumber("page_size",
+			mcp.Description("Number of articles per page (default: 50, max: 200)"),
+		),
+	), s.handleListFollowing)
 }
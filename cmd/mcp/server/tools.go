@@ -182,6 +182,26 @@ func (s *Server) handleGetRecommendations(
 	return formatArticlesResult(articles)
 }
 
+func (s *Server) handleWhatsNewForMe(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	days := 7 // Default window
+	if d, ok := args["days"].(float64); ok && d > 0 {
+		days = int(d)
+	}
+
+	articles, err := s.client.GetNewRecommendations(ctx, days)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to get new recommendations: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	return formatArticlesResult(articles)
+}
+
 func (s *Server) handleRateArticle(
 	ctx context.Context,
 	request mcp.CallToolRequest,
@@ -251,13 +271,81 @@ func (s *Server) handleMarkRead(
 	return mcp.NewToolResultText(msg), nil
 }
 
+func (s *Server) handleDismissArticle(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	articleID, ok := args["article_id"].(string)
+	if !ok || articleID == "" {
+		return mcp.NewToolResultError("article_id is required"), nil
+	}
+
+	dismissed, ok := args["dismissed"].(bool)
+	if !ok {
+		return mcp.NewToolResultError("dismissed is required (true or false)"), nil
+	}
+
+	err := s.client.DismissArticle(ctx, articleID, dismissed)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to dismiss article: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	status := "dismissed"
+	if !dismissed {
+		status = "undismissed"
+	}
+	msg := fmt.Sprintf("Successfully %s article %s", status, articleID)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleSnoozeArticle(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	articleID, ok := args["article_id"].(string)
+	if !ok || articleID == "" {
+		return mcp.NewToolResultError("article_id is required"), nil
+	}
+
+	days := 7.0
+	if d, ok := args["days"].(float64); ok {
+		days = d
+	}
+	if days < 0 {
+		return mcp.NewToolResultError("days must not be negative"), nil
+	}
+
+	var until time.Time
+	if days > 0 {
+		until = time.Now().Add(time.Duration(days * float64(24*time.Hour)))
+	}
+
+	err := s.client.SnoozeArticle(ctx, articleID, until)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to snooze article: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	if until.IsZero() {
+		return mcp.NewToolResultText(fmt.Sprintf("Successfully cleared snooze on article %s", articleID)), nil
+	}
+	msg := fmt.Sprintf("Successfully snoozed article %s until %s", articleID, until.Format(time.RFC3339))
+	return mcp.NewToolResultText(msg), nil
+}
+
 func (s *Server) handleListLiked(
 	ctx context.Context,
 	request mcp.CallToolRequest,
 ) (*mcp.CallToolResult, error) {
 	page, pageSize := parsePagination(request.Params.Arguments)
+	tags := parseTags(request.Params.Arguments)
 
-	articles, err := s.client.ListLiked(ctx, page, pageSize)
+	articles, err := s.client.ListLiked(ctx, tags, page, pageSize)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to list liked articles: %v", err)
 		return mcp.NewToolResultError(errMsg), nil
@@ -271,8 +359,9 @@ func (s *Server) handleListDisliked(
 	request mcp.CallToolRequest,
 ) (*mcp.CallToolResult, error) {
 	page, pageSize := parsePagination(request.Params.Arguments)
+	tags := parseTags(request.Params.Arguments)
 
-	articles, err := s.client.ListDisliked(ctx, page, pageSize)
+	articles, err := s.client.ListDisliked(ctx, tags, page, pageSize)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to list disliked articles: %v", err)
 		return mcp.NewToolResultError(errMsg), nil
@@ -286,8 +375,9 @@ func (s *Server) handleListUnreviewed(
 	request mcp.CallToolRequest,
 ) (*mcp.CallToolResult, error) {
 	page, pageSize := parsePagination(request.Params.Arguments)
+	tags := parseTags(request.Params.Arguments)
 
-	articles, err := s.client.ListUnreviewed(ctx, page, pageSize)
+	articles, err := s.client.ListUnreviewed(ctx, tags, page, pageSize)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to list unreviewed articles: %v", err)
 		return mcp.NewToolResultError(errMsg), nil
@@ -296,6 +386,299 @@ func (s *Server) handleListUnreviewed(
 	return formatArticlesResult(articles)
 }
 
+func (s *Server) handleListSavedSearches(
+	ctx context.Context,
+	_ mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	searches, err := s.client.ListSavedSearches(ctx)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list saved searches: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	if len(searches) == 0 {
+		return mcp.NewToolResultText("No saved searches found."), nil
+	}
+
+	data, err := json.MarshalIndent(searches, "", "  ")
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to format saved searches: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Found %d saved search(es):\n\n%s", len(searches), string(data))
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleRunSavedSearch(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	searchID, ok := args["saved_search_id"].(string)
+	if !ok || searchID == "" {
+		return mcp.NewToolResultError("saved_search_id is required"), nil
+	}
+
+	newOnly, _ := args["new_only"].(bool)
+	page, pageSize := parsePagination(args)
+
+	articles, err := s.client.RunSavedSearch(ctx, searchID, newOnly, page, pageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to run saved search: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	return formatArticlesResult(articles)
+}
+
+func (s *Server) handleListCollections(
+	ctx context.Context,
+	_ mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	collections, err := s.client.ListCollections(ctx)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list collections: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	if len(collections) == 0 {
+		return mcp.NewToolResultText("No collections found."), nil
+	}
+
+	data, err := json.MarshalIndent(collections, "", "  ")
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to format collections: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Found %d collection(s):\n\n%s", len(collections), string(data))
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleCreateCollection(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("name is required"), nil
+	}
+
+	isPublic, _ := args["public"].(bool)
+
+	collection, err := s.client.CreateCollection(ctx, name, isPublic)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to create collection: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Created collection '%s' with id %s", collection.Name, collection.ID)
+	if collection.ShareURL != "" {
+		msg += fmt.Sprintf(" (share link: %s)", collection.ShareURL)
+	}
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleGetCollectionArticles(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	collectionID, ok := request.Params.Arguments["collection_id"].(string)
+	if !ok || collectionID == "" {
+		return mcp.NewToolResultError("collection_id is required"), nil
+	}
+
+	articles, err := s.client.GetCollectionArticles(ctx, collectionID)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to get collection articles: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	return formatArticlesResult(articles)
+}
+
+func (s *Server) handleAddToCollection(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	collectionID, articleID, errResult := collectionArticleArgs(request.Params.Arguments)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	if err := s.client.AddToCollection(ctx, collectionID, articleID); err != nil {
+		errMsg := fmt.Sprintf("failed to add article to collection: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Successfully added article %s to collection %s", articleID, collectionID)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleRemoveFromCollection(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	collectionID, articleID, errResult := collectionArticleArgs(request.Params.Arguments)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	if err := s.client.RemoveFromCollection(ctx, collectionID, articleID); err != nil {
+		errMsg := fmt.Sprintf("failed to remove article from collection: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Successfully removed article %s from collection %s", articleID, collectionID)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleGetCollectionSimilar(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	collectionID, ok := args["collection_id"].(string)
+	if !ok || collectionID == "" {
+		return mcp.NewToolResultError("collection_id is required"), nil
+	}
+
+	limit := 10 // Default limit
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	articles, err := s.client.GetCollectionSimilar(ctx, collectionID, limit)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to get similar articles: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	return formatArticlesResult(articles)
+}
+
+func collectionArticleArgs(args map[string]any) (collectionID, articleID string, errResult *mcp.CallToolResult) {
+	collectionID, ok := args["collection_id"].(string)
+	if !ok || collectionID == "" {
+		return "", "", mcp.NewToolResultError("collection_id is required")
+	}
+
+	articleID, ok = args["article_id"].(string)
+	if !ok || articleID == "" {
+		return "", "", mcp.NewToolResultError("article_id is required")
+	}
+
+	return collectionID, articleID, nil
+}
+
+func (s *Server) handleListNotes(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	articleID, _ := args["article_id"].(string)
+	query, _ := args["query"].(string)
+	page, pageSize := parsePagination(args)
+
+	notes, err := s.client.ListNotes(ctx, articleID, query, page, pageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list notes: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	if len(notes) == 0 {
+		return mcp.NewToolResultText("No notes found."), nil
+	}
+
+	data, err := json.MarshalIndent(notes, "", "  ")
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to format notes: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Found %d note(s):\n\n%s", len(notes), string(data))
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleAddNote(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	articleID, ok := args["article_id"].(string)
+	if !ok || articleID == "" {
+		return mcp.NewToolResultError("article_id is required"), nil
+	}
+
+	body, _ := args["body"].(string)
+	quote, _ := args["quote"].(string)
+	if body == "" && quote == "" {
+		return mcp.NewToolResultError("body or quote is required"), nil
+	}
+
+	note, err := s.client.AddNote(ctx, articleID, body, quote)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to add note: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Added note %s to article %s", note.ID, articleID)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleUpdateNote(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	noteID, ok := args["note_id"].(string)
+	if !ok || noteID == "" {
+		return mcp.NewToolResultError("note_id is required"), nil
+	}
+
+	var body, quote *string
+	if b, ok := args["body"].(string); ok {
+		body = &b
+	}
+	if q, ok := args["quote"].(string); ok {
+		quote = &q
+	}
+
+	if _, err := s.client.UpdateNote(ctx, noteID, body, quote); err != nil {
+		errMsg := fmt.Sprintf("failed to update note: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Successfully updated note %s", noteID)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleDeleteNote(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	noteID, ok := request.Params.Arguments["note_id"].(string)
+	if !ok || noteID == "" {
+		return mcp.NewToolResultError("note_id is required"), nil
+	}
+
+	if err := s.client.DeleteNote(ctx, noteID); err != nil {
+		errMsg := fmt.Sprintf("failed to delete note: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Successfully deleted note %s", noteID)
+	return mcp.NewToolResultText(msg), nil
+}
+
 func parsePagination(args map[string]any) (page, pageSize int) {
 	page = 1
 	pageSize = 50
@@ -309,6 +692,21 @@ func parsePagination(args map[string]any) (page, pageSize int) {
 	return page, pageSize
 }
 
+func parseTags(args map[string]any) []string {
+	tagsStr, _ := args["tags"].(string)
+	if tagsStr == "" {
+		return nil
+	}
+
+	var tags []string
+	for _, tag := range splitAndTrim(tagsStr) {
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
 func formatArticlesResult(articles []client.Article) (*mcp.CallToolResult, error) {
 	if len(articles) == 0 {
 		return mcp.NewToolResultText("No articles found."), nil
@@ -333,3 +731,199 @@ func formatArticleResult(article *client.Article) (*mcp.CallToolResult, error) {
 
 	return mcp.NewToolResultText(string(data)), nil
 }
+
+func (s *Server) handleListTags(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	if articleID, _ := request.Params.Arguments["article_id"].(string); articleID != "" {
+		tags, err := s.client.ListArticleTags(ctx, articleID)
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to list article tags: %v", err)
+			return mcp.NewToolResultError(errMsg), nil
+		}
+		if len(tags) == 0 {
+			return mcp.NewToolResultText("No tags found."), nil
+		}
+		return mcp.NewToolResultText("Tags: " + strings.Join(tags, ", ")), nil
+	}
+
+	tags, err := s.client.ListTags(ctx)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list tags: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	if len(tags) == 0 {
+		return mcp.NewToolResultText("No tags found."), nil
+	}
+
+	data, err := json.MarshalIndent(tags, "", "  ")
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to format tags: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Found %d tag(s):\n\n%s", len(tags), string(data))
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleTagArticle(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	articleID, tag, errResult := articleTagArgs(request.Params.Arguments)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	if err := s.client.TagArticle(ctx, articleID, tag); err != nil {
+		errMsg := fmt.Sprintf("failed to tag article: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Successfully tagged article %s with %q", articleID, tag)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleUntagArticle(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	articleID, tag, errResult := articleTagArgs(request.Params.Arguments)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	if err := s.client.UntagArticle(ctx, articleID, tag); err != nil {
+		errMsg := fmt.Sprintf("failed to untag article: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Successfully removed tag %q from article %s", tag, articleID)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleListTaggedArticles(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	args := request.Params.Arguments
+
+	tag, ok := args["tag"].(string)
+	if !ok || tag == "" {
+		return mcp.NewToolResultError("tag is required"), nil
+	}
+	page, pageSize := parsePagination(args)
+
+	articles, err := s.client.ListTaggedArticles(ctx, tag, page, pageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list tagged articles: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	return formatArticlesResult(articles)
+}
+
+func articleTagArgs(args map[string]any) (articleID, tag string, errResult *mcp.CallToolResult) {
+	articleID, ok := args["article_id"].(string)
+	if !ok || articleID == "" {
+		return "", "", mcp.NewToolResultError("article_id is required")
+	}
+
+	tag, ok = args["tag"].(string)
+	if !ok || tag == "" {
+		return "", "", mcp.NewToolResultError("tag is required")
+	}
+
+	return articleID, tag, nil
+}
+
+func (s *Server) handleListFollows(
+	ctx context.Context,
+	_ mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	follows, err := s.client.ListFollows(ctx)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list follows: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	if len(follows) == 0 {
+		return mcp.NewToolResultText("You don't follow anything yet."), nil
+	}
+
+	data, err := json.MarshalIndent(follows, "", "  ")
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to format follows: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Following %d item(s):\n\n%s", len(follows), string(data))
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleFollow(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	followType, target, errResult := followArgs(request.Params.Arguments)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	if err := s.client.Follow(ctx, followType, target); err != nil {
+		errMsg := fmt.Sprintf("failed to follow: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("Now following %s %q", followType, target)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleUnfollow(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	followType, target, errResult := followArgs(request.Params.Arguments)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	if err := s.client.Unfollow(ctx, followType, target); err != nil {
+		errMsg := fmt.Sprintf("failed to unfollow: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	msg := fmt.Sprintf("No longer following %s %q", followType, target)
+	return mcp.NewToolResultText(msg), nil
+}
+
+func (s *Server) handleListFollowing(
+	ctx context.Context,
+	request mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	page, pageSize := parsePagination(request.Params.Arguments)
+
+	articles, err := s.client.ListFollowing(ctx, page, pageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list followed articles: %v", err)
+		return mcp.NewToolResultError(errMsg), nil
+	}
+
+	return formatArticlesResult(articles)
+}
+
+func followArgs(args map[string]any) (followType, target string, errResult *mcp.CallToolResult) {
+	followType, ok := args["type"].(string)
+	if !ok || followType == "" {
+		return "", "", mcp.NewToolResultError("type is required")
+	}
+
+	target, ok = args["target"].(string)
+	if !ok || target == "" {
+		return "", "", mcp.NewToolResultError("target is required")
+	}
+
+	return followType, target, nil
+}
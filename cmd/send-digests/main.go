@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jbeshir/alignment-research-feed/internal/app"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mysql"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/pinecone"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	_ = godotenv.Load()
+	ctx := context.Background()
+
+	// Setup logger
+	logLevel := slog.LevelInfo
+	if lvl := os.Getenv("LOG_LEVEL"); lvl != "" {
+		if err := logLevel.UnmarshalText([]byte(lvl)); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid LOG_LEVEL: %s\n", lvl)
+			os.Exit(1)
+		}
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevel,
+	}))
+	slog.SetDefault(logger)
+	ctx = domain.ContextWithLogger(ctx, logger)
+
+	if err := run(ctx); err != nil {
+		logger.ErrorContext(ctx, "digest sending failed", "error", err)
+		os.Exit(1)
+	}
+
+	logger.InfoContext(ctx, "digest sending completed successfully")
+}
+
+func run(ctx context.Context) error {
+	// Connect to MySQL
+	mysqlURI := os.Getenv("MYSQL_URI")
+	if mysqlURI == "" {
+		return fmt.Errorf("MYSQL_URI environment variable is required")
+	}
+
+	db, err := mysql.Connect(ctx, mysqlURI)
+	if err != nil {
+		return fmt.Errorf("connecting to MySQL: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	dataset := mysql.New(db)
+
+	unsubscribeBaseURL := os.Getenv("DIGEST_UNSUBSCRIBE_BASE_URL")
+	if unsubscribeBaseURL == "" {
+		return fmt.Errorf("DIGEST_UNSUBSCRIBE_BASE_URL environment variable is required")
+	}
+
+	// Pinecone is only needed to generate recommendations on demand for users
+	// without fresh precomputed recommendations, so it is optional when testing offline.
+	var similarity datasources.SimilarityRepository = datasources.NullSimilarityRepository{}
+	pineconeAPIKey := os.Getenv("PINECONE_API_KEY")
+	pineconeIndexName := os.Getenv("PINECONE_INDEX_NAME")
+	if pineconeAPIKey != "" && pineconeIndexName != "" {
+		similarity, err = pinecone.NewClient(ctx, pineconeAPIKey, pineconeIndexName)
+		if err != nil {
+			return fmt.Errorf("connecting to Pinecone: %w", err)
+		}
+	}
+
+	mailer, err := app.SetupMailer(ctx)
+	if err != nil {
+		return fmt.Errorf("setting up mailer: %w", err)
+	}
+
+	generateCmd := command.NewGenerateRecommendations(
+		similarity,
+		dataset,
+		app.DefaultGenerateRecommendationsConfig(),
+		app.DefaultRecommendationExperiment(),
+	)
+
+	recommendCmd := command.NewRecommendArticles(
+		generateCmd,
+		dataset,
+		dataset,
+		dataset,
+		dataset,
+		dataset,
+		dataset,
+		command.NewListFollowedArticleIDs(dataset, dataset),
+		dataset,
+		app.DefaultRecommendArticlesConfig(),
+	)
+
+	buildCmd := command.NewBuildDigest(
+		recommendCmd,
+		dataset,
+		dataset,
+		dataset,
+		app.DefaultBuildDigestConfig(unsubscribeBaseURL),
+	)
+
+	sendCmd := command.NewSendDigests(buildCmd, dataset, dataset, mailer)
+
+	// Execute
+	_, err = sendCmd.Execute(ctx, command.SendDigestsRequest{})
+	return err
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mysql"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/pinecone"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage:
+  user-data export <user_id>      Write all data stored about a user to stdout as JSON
+  user-data delete -yes <user_id> Permanently delete all data stored about a user
+  user-data import -format <format> [-like] <user_id> <file>
+                                  Mark articles in an exported reading history as read,
+                                  writing a JSON report of matched items to stdout.
+                                  Formats: bibtex, urls, opml, pocket, arxiv_csv
+`
+
+func main() {
+	_ = godotenv.Load()
+	ctx := context.Background()
+
+	// Setup logger; stdout is reserved for exported data
+	logLevel := slog.LevelInfo
+	if lvl := os.Getenv("LOG_LEVEL"); lvl != "" {
+		if err := logLevel.UnmarshalText([]byte(lvl)); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid LOG_LEVEL: %s\n", lvl)
+			os.Exit(1)
+		}
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: logLevel,
+	}))
+	slog.SetDefault(logger)
+	ctx = domain.ContextWithLogger(ctx, logger)
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := run(ctx, os.Args[1], os.Args[2:]); err != nil {
+		logger.ErrorContext(ctx, "user data command failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, subcommand string, args []string) error {
+	flags := flag.NewFlagSet(subcommand, flag.ExitOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	confirmed := flags.Bool("yes", false, "confirm permanent deletion")
+	format := flags.String("format", "", "format of the file to import")
+	like := flags.Bool("like", false, "give imported articles a thumbs up as well as marking them read")
+	_ = flags.Parse(args)
+
+	wantArgs := 1
+	if subcommand == "import" {
+		wantArgs = 2
+	}
+	if flags.NArg() != wantArgs || flags.Arg(0) == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+	userID := flags.Arg(0)
+
+	if subcommand != "export" && subcommand != "delete" && subcommand != "import" {
+		return fmt.Errorf("unknown subcommand [%s]", subcommand)
+	}
+	if subcommand == "delete" && !*confirmed {
+		return fmt.Errorf("refusing to delete user data without -yes")
+	}
+
+	// Connect to MySQL
+	mysqlURI := os.Getenv("MYSQL_URI")
+	if mysqlURI == "" {
+		return fmt.Errorf("MYSQL_URI environment variable is required")
+	}
+
+	db, err := mysql.Connect(ctx, mysqlURI)
+	if err != nil {
+		return fmt.Errorf("connecting to MySQL: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	dataset := mysql.New(db)
+	logger := domain.LoggerFromContext(ctx).With("user_id", userID)
+
+	switch subcommand {
+	case "delete":
+		if err := dataset.DeleteUserData(ctx, userID); err != nil {
+			return fmt.Errorf("deleting user data: %w", err)
+		}
+		logger.InfoContext(ctx, "deleted user data")
+		return nil
+	case "import":
+		return runImport(ctx, dataset, userID, domain.ImportFormat(*format), flags.Arg(1), *like)
+	}
+
+	export, err := dataset.ExportUserData(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("exporting user data: %w", err)
+	}
+
+	if err := writeJSON(export); err != nil {
+		return fmt.Errorf("writing export: %w", err)
+	}
+	logger.InfoContext(ctx, "exported user data")
+	return nil
+}
+
+func runImport(
+	ctx context.Context,
+	dataset datasources.DatasetRepository,
+	userID string,
+	format domain.ImportFormat,
+	path string,
+	like bool,
+) error {
+	data, err := os.ReadFile(path) //nolint:gosec // path is supplied by the operator
+	if err != nil {
+		return fmt.Errorf("reading import file: %w", err)
+	}
+
+	// Pinecone is only needed to keep liked articles' vectors in sync
+	var similarity datasources.SimilarityRepository = datasources.NullSimilarityRepository{}
+	pineconeAPIKey := os.Getenv("PINECONE_API_KEY")
+	pineconeIndexName := os.Getenv("PINECONE_INDEX_NAME")
+	if pineconeAPIKey != "" && pineconeIndexName != "" {
+		similarity, err = pinecone.NewClient(ctx, pineconeAPIKey, pineconeIndexName)
+		if err != nil {
+			return fmt.Errorf("connecting to Pinecone: %w", err)
+		}
+	}
+
+	setRatingCmd := command.NewSetArticleRating(similarity, dataset, dataset, nil)
+	importCmd := command.NewImportReadingHistory(dataset, dataset, setRatingCmd)
+	result, err := importCmd.Execute(ctx, command.ImportReadingHistoryRequest{
+		UserID: userID,
+		Format: format,
+		Data:   data,
+		Like:   like,
+	})
+	if err != nil {
+		return fmt.Errorf("importing reading history: %w", err)
+	}
+
+	return writeJSON(result)
+}
+
+func writeJSON(v any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
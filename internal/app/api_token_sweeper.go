@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// APITokenSweeper periodically revokes expired API tokens.
+type APITokenSweeper struct {
+	SweepCmd command.Command[command.SweepExpiredAPITokensRequest, command.SweepExpiredAPITokensResponse]
+	Interval time.Duration
+}
+
+// Run sweeps once per interval until the context is cancelled.
+// Failed sweeps are logged and retried on the next tick.
+func (s *APITokenSweeper) Run(ctx context.Context) error {
+	logger := domain.LoggerFromContext(ctx)
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := s.SweepCmd.Execute(ctx, command.SweepExpiredAPITokensRequest{})
+		if err != nil {
+			logger.WarnContext(ctx, "unable to sweep expired API tokens", "error", err)
+		} else if result.Revoked > 0 {
+			logger.InfoContext(ctx, "swept expired API tokens", "revoked", result.Revoked)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/jbeshir/alignment-research-feed/internal/command"
 	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mail"
 	"github.com/jbeshir/alignment-research-feed/internal/datasources/mysql"
 	"github.com/jbeshir/alignment-research-feed/internal/datasources/pinecone"
 	"github.com/jbeshir/alignment-research-feed/internal/datasources/voyageai"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
 	"github.com/jbeshir/alignment-research-feed/internal/transport/web/router"
 	"github.com/jbeshir/alignment-research-feed/internal/transport/web/server"
 )
@@ -34,19 +36,42 @@ func Setup(ctx context.Context) ([]Component, error) {
 		return nil, fmt.Errorf("setting up embedder: %w", err)
 	}
 
-	authMiddleware, err := setupAuthMiddleware(ctx, dataset)
+	auditEvents := router.NewAuditEventRecorder(ctx, dataset)
+
+	authMiddleware, err := setupAuthMiddleware(ctx, dataset, auditEvents)
 	if err != nil {
 		return nil, fmt.Errorf("setting up auth middleware: %w", err)
 	}
 
-	createAPITokenCmd := command.NewCreateAPIToken(dataset, dataset)
+	rateLimitMiddleware, rateLimitComponents, err := setupRateLimiting(ctx, dataset)
+	if err != nil {
+		return nil, fmt.Errorf("setting up rate limit middleware: %w", err)
+	}
 
-	generateRecommendationsCmd := command.NewGenerateRecommendations(
-		similarity,
+	createAPITokenCmd := command.NewCreateAPIToken(dataset, dataset, dataset)
+	rotateAPITokenCmd := command.NewRotateAPIToken(
+		dataset,
 		dataset,
 		dataset,
 		dataset,
+		MustGetEnvAsDuration(ctx, "API_TOKEN_ROTATION_GRACE_PERIOD"),
+	)
+
+	mailer, err := SetupMailer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("setting up mailer: %w", err)
+	}
+	setDigestPreferencesCmd := command.NewSetDigestPreferences(
+		dataset,
+		mailer,
+		MustGetEnvAsString(ctx, "DIGEST_CONFIRM_BASE_URL"),
+	)
+
+	generateRecommendationsCmd := command.NewGenerateRecommendations(
+		similarity,
+		dataset,
 		DefaultGenerateRecommendationsConfig(),
+		DefaultRecommendationExperiment(),
 	)
 
 	recommendArticlesCmd := command.NewRecommendArticles(
@@ -56,6 +81,9 @@ func Setup(ctx context.Context) ([]Component, error) {
 		dataset,
 		dataset,
 		dataset,
+		dataset,
+		command.NewListFollowedArticleIDs(dataset, dataset),
+		dataset,
 		DefaultRecommendArticlesConfig(),
 	)
 
@@ -68,21 +96,32 @@ func Setup(ctx context.Context) ([]Component, error) {
 		MustGetEnvAsString(ctx, "RSS_FEED_AUTHOR_EMAIL"),
 		MustGetEnvAsDuration(ctx, "RSS_FEED_LATEST_CACHE_MAX_AGE"),
 		authMiddleware,
+		rateLimitMiddleware,
 		createAPITokenCmd,
+		rotateAPITokenCmd,
+		setDigestPreferencesCmd,
 		recommendArticlesCmd,
+		auditEvents,
+		router.NewImpressionRecorder(ctx, dataset),
+		router.NewRecommendationUpdater(ctx, command.NewApplyRatingToRecommendations(dataset, dataset, dataset)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create HTTP router: %w", err)
 	}
 
-	return []Component{
+	components := []Component{
 		&server.Server{
 			TLSDisabled:       MustGetEnvAsBoolean(ctx, "HTTP_TLS_DISABLED"),
 			TLSDisabledPort:   MustGetEnvAsInt(ctx, "PORT"),
 			AutocertHostnames: MustGetEnvAsStrings(ctx, "HTTP_AUTOCERT_HOSTNAMES"),
 			Router:            httpRouter,
 		},
-	}, nil
+		&APITokenSweeper{
+			SweepCmd: command.NewSweepExpiredAPITokens(dataset),
+			Interval: MustGetEnvAsDuration(ctx, "API_TOKEN_SWEEP_INTERVAL"),
+		},
+	}
+	return append(components, rateLimitComponents...), nil
 }
 
 func setupDatasetRepository(ctx context.Context) (datasources.DatasetRepository, error) {
@@ -127,8 +166,31 @@ func setupEmbedder(ctx context.Context) (datasources.Embedder, error) {
 	}
 }
 
+// SetupMailer creates the mailer selected by MAIL_DRIVER.
+func SetupMailer(ctx context.Context) (datasources.Mailer, error) {
+	switch driver := MustGetEnvAsString(ctx, "MAIL_DRIVER"); driver {
+	case "log":
+		return mail.LogMailer{}, nil
+	case "file":
+		return mail.NewFileMailer(
+			MustGetEnvAsString(ctx, "MAIL_FILE_DIR"),
+			MustGetEnvAsString(ctx, "MAIL_FROM"),
+		), nil
+	case "smtp":
+		return mail.NewSMTPMailer(
+			MustGetEnvAsString(ctx, "SMTP_HOST"),
+			MustGetEnvAsInt(ctx, "SMTP_PORT"),
+			MustGetEnvAsString(ctx, "SMTP_USERNAME"),
+			MustGetEnvAsString(ctx, "SMTP_PASSWORD"),
+			MustGetEnvAsString(ctx, "MAIL_FROM"),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver [%s]", driver)
+	}
+}
+
 func setupAuthMiddleware(
-	ctx context.Context, dataset datasources.DatasetRepository,
+	ctx context.Context, dataset datasources.DatasetRepository, auditEvents chan<- domain.AuditEvent,
 ) (func(http.Handler) http.Handler, error) {
 	var validators []router.AuthValidator
 
@@ -145,6 +207,16 @@ func setupAuthMiddleware(
 				return nil, fmt.Errorf("creating Auth0 validator: %w", err)
 			}
 			validators = append(validators, v)
+		case "oidc":
+			v, err := router.NewOIDCValidator(
+				MustGetEnvAsStrings(ctx, "OIDC_ISSUERS"),
+				MustGetEnvAsStrings(ctx, "OIDC_AUDIENCES"),
+				MustGetEnvAsString(ctx, "OIDC_USER_ID_CLAIM"),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("creating OIDC validator: %w", err)
+			}
+			validators = append(validators, v)
 		case "api_token":
 			validators = append(validators, router.NewAPITokenValidator(ctx, dataset, dataset))
 		default:
@@ -152,5 +224,5 @@ func setupAuthMiddleware(
 		}
 	}
 
-	return router.NewAuthMiddleware(validators), nil
+	return router.NewAuthMiddleware(validators, auditEvents), nil
 }
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// RateLimitBucketPruner periodically deletes idle rate limit buckets from a shared store.
+type RateLimitBucketPruner struct {
+	PruneCmd command.Command[command.PruneRateLimitBucketsRequest, command.PruneRateLimitBucketsResponse]
+	Interval time.Duration
+}
+
+// Run prunes once per interval until the context is cancelled.
+// Failed prunes are logged and retried on the next tick.
+func (p *RateLimitBucketPruner) Run(ctx context.Context) error {
+	logger := domain.LoggerFromContext(ctx)
+
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := p.PruneCmd.Execute(ctx, command.PruneRateLimitBucketsRequest{})
+		if err != nil {
+			logger.WarnContext(ctx, "unable to prune rate limit buckets", "error", err)
+		} else if result.Deleted > 0 {
+			logger.InfoContext(ctx, "pruned rate limit buckets", "deleted", result.Deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/memory"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/jbeshir/alignment-research-feed/internal/transport/web/router"
+)
+
+// rateLimitsFromEnv reads the number of requests allowed per window for each route
+// class from the environment.
+func rateLimitsFromEnv(ctx context.Context, window time.Duration) map[router.RateLimitClass]domain.RateLimit {
+	return map[router.RateLimitClass]domain.RateLimit{
+		router.RateLimitClassRead:   {Requests: MustGetEnvAsInt(ctx, "RATE_LIMIT_READ_REQUESTS"), Window: window},
+		router.RateLimitClassWrite:  {Requests: MustGetEnvAsInt(ctx, "RATE_LIMIT_WRITE_REQUESTS"), Window: window},
+		router.RateLimitClassSearch: {Requests: MustGetEnvAsInt(ctx, "RATE_LIMIT_SEARCH_REQUESTS"), Window: window},
+		router.RateLimitClassFeed:   {Requests: MustGetEnvAsInt(ctx, "RATE_LIMIT_FEED_REQUESTS"), Window: window},
+	}
+}
+
+// setupRateLimiting returns the rate limit middleware, plus a component pruning idle
+// buckets when they're kept in MySQL. The in-memory store prunes itself.
+func setupRateLimiting(
+	ctx context.Context, dataset datasources.DatasetRepository,
+) (func(http.Handler) http.Handler, []Component, error) {
+	driver := MustGetEnvAsString(ctx, "RATE_LIMIT_DRIVER")
+	if driver == "" {
+		// Rate limiting disabled
+		return func(next http.Handler) http.Handler { return next }, nil, nil
+	}
+
+	// All classes share one window, so a bucket idle for longer than it is always full
+	window := MustGetEnvAsDuration(ctx, "RATE_LIMIT_WINDOW")
+	limits := rateLimitsFromEnv(ctx, window)
+	for class, limit := range limits {
+		if err := limit.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s rate limit: %w", class, err)
+		}
+	}
+
+	switch driver {
+	case "memory":
+		return router.NewRateLimitMiddleware(memory.NewRateLimitStore(), limits), nil, nil
+	case "mysql":
+		pruner := &RateLimitBucketPruner{
+			PruneCmd: command.NewPruneRateLimitBuckets(dataset, window),
+			Interval: MustGetEnvAsDuration(ctx, "RATE_LIMIT_PRUNE_INTERVAL"),
+		}
+		return router.NewRateLimitMiddleware(dataset, limits), []Component{pruner}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown rate limit driver [%s]", driver)
+	}
+}
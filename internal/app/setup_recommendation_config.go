@@ -4,15 +4,78 @@ import (
 	"time"
 
 	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
 )
 
 // DefaultGenerateRecommendationsConfig returns the default config for recommendation generation.
+// Optional ranking signals are switched off here, and each is trialled through an arm of
+// DefaultRecommendationExperiment, so its effect can be measured against this config as control.
 func DefaultGenerateRecommendationsConfig() command.GenerateRecommendationsConfig {
 	return command.GenerateRecommendationsConfig{
-		TemporalDecayHalfLifeDays: 90,
-		NegativeSignalWeight:      0.3,
-		UseInterestClusters:       true,
-		CandidatesPerCluster:      20,
+		TemporalDecayHalfLifeDays:        90,
+		SignalWeights:                    domain.DefaultSignalWeights(),
+		NegativeSignalWeight:             0.3,
+		UseInterestClusters:              true,
+		CandidatesPerCluster:             20,
+		TagCooccurrenceWeight:            0,
+		TagCooccurrenceCandidates:        20,
+		CollaborativeWeight:              0,
+		CollaborativeCandidates:          20,
+		PopularityWindow:                 domain.PopularityWindowMonth,
+		PopularityPriorWeight:            0,
+		RecencyHalfLifeDays:              365,
+		RecencyWeight:                    0,
+		FreshCandidateMultiplier:         5,
+		ColdStartMinRatings:              domain.DefaultClusterConfig().MinArticlesForClustering,
+		PopularityFallbackWeight:         0.3,
+		PopularityFallbackCandidates:     100,
+		IgnoredMinDays:                   0,
+		IgnoredMaxPosition:               20,
+		IgnoredLookbackDays:              30,
+		IgnoredDemotion:                  0.7,
+		ExplorationShare:                 0,
+		ExplorationMaxCategoryEngaged:    2,
+		ExplorationCandidatesPerCategory: 10,
+		ExplorationLookbackDays:          90,
+	}
+}
+
+// DefaultRecommendationExperiment returns the experiment users are bucketed into for
+// recommendation generation. To trial a config change, add an arm holding the changed config
+// alongside the control, then compare the arms with the experiment-report job.
+// Renaming the experiment reshuffles users between arms.
+func DefaultRecommendationExperiment() command.RecommendationExperiment {
+	control := DefaultGenerateRecommendationsConfig()
+
+	popularityPrior := control
+	popularityPrior.PopularityPriorWeight = 0.05
+
+	tagCooccurrence := control
+	tagCooccurrence.TagCooccurrenceWeight = 0.5
+
+	ignoredDemotion := control
+	ignoredDemotion.IgnoredMinDays = 3
+
+	neighbours := control
+	neighbours.CollaborativeWeight = 0.5
+
+	exploration := control
+	exploration.ExplorationShare = 0.1
+
+	recency := control
+	recency.RecencyWeight = 0.3
+
+	return command.RecommendationExperiment{
+		Name: "default",
+		Arms: []command.RecommendationExperimentArm{
+			{Name: "control", Weight: 1, Config: control},
+			{Name: "popularity_prior", Weight: 1, Config: popularityPrior},
+			{Name: "tag_cooccurrence", Weight: 1, Config: tagCooccurrence},
+			{Name: "ignored_demotion", Weight: 1, Config: ignoredDemotion},
+			{Name: "neighbours", Weight: 1, Config: neighbours},
+			{Name: "exploration", Weight: 1, Config: exploration},
+			{Name: "recency", Weight: 1, Config: recency},
+		},
 	}
 }
 
@@ -21,6 +84,9 @@ func DefaultRecommendArticlesConfig() command.RecommendArticlesConfig {
 	return command.RecommendArticlesConfig{
 		PrecomputedStaleThreshold: 48 * time.Hour,
 		PrecomputedFetchLimit:     200,
+		FollowedLookbackDays:      14,
+		FollowedFetchLimit:        50,
+		FollowedMaxArticles:       5,
 	}
 }
 
@@ -30,3 +96,12 @@ func DefaultRunRecommendationGenerationConfig() command.RunRecommendationGenerat
 		CandidateLimit: 200,
 	}
 }
+
+// DefaultBuildDigestConfig returns the default config for building email digests.
+func DefaultBuildDigestConfig(unsubscribeBaseURL string) command.BuildDigestConfig {
+	return command.BuildDigestConfig{
+		RecommendationLimit:  10,
+		CategoryArticleLimit: 10,
+		UnsubscribeBaseURL:   unsubscribeBaseURL,
+	}
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// AggregateArticlePopularityRequest is the request for the AggregateArticlePopularity command.
+// This command takes no parameters beyond context.
+type AggregateArticlePopularityRequest struct{}
+
+// AggregateArticlePopularityResponse summarizes an aggregation run.
+type AggregateArticlePopularityResponse struct {
+	// Articles is the number of articles with stored popularity, per window.
+	Articles map[domain.PopularityWindow]int
+}
+
+// AggregateArticlePopularity recomputes per-article like, dislike and read counts across all
+// users over each popularity window, and scores them. Articles with interactions from fewer
+// users than the configured minimum are left out.
+type AggregateArticlePopularity struct {
+	Aggregator datasources.ArticlePopularityAggregator
+	Replacer   datasources.ArticlePopularityReplacer
+	Config     domain.PopularityConfig
+}
+
+// NewAggregateArticlePopularity creates a properly initialized AggregateArticlePopularity command.
+func NewAggregateArticlePopularity(
+	aggregator datasources.ArticlePopularityAggregator,
+	replacer datasources.ArticlePopularityReplacer,
+	config domain.PopularityConfig,
+) *AggregateArticlePopularity {
+	return &AggregateArticlePopularity{
+		Aggregator: aggregator,
+		Replacer:   replacer,
+		Config:     config,
+	}
+}
+
+// Execute replaces the stored popularity for every window.
+func (c *AggregateArticlePopularity) Execute(
+	ctx context.Context, _ AggregateArticlePopularityRequest,
+) (AggregateArticlePopularityResponse, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	now := time.Now()
+	result := AggregateArticlePopularityResponse{
+		Articles: make(map[domain.PopularityWindow]int, len(domain.ValidPopularityWindows)),
+	}
+	for _, window := range domain.ValidPopularityWindows {
+		since := now.AddDate(0, 0, -int(window))
+		popularity, err := c.Aggregator.AggregateArticlePopularity(ctx, since, c.Config.MinUsers)
+		if err != nil {
+			return AggregateArticlePopularityResponse{}, fmt.Errorf("aggregating %s popularity: %w", window, err)
+		}
+
+		for i := range popularity {
+			p := &popularity[i]
+			p.Window = window
+			p.Score = c.Config.Score(p.LikeCount, p.DislikeCount, p.ReadCount)
+		}
+
+		if err := c.Replacer.ReplaceArticlePopularity(ctx, window, popularity); err != nil {
+			return AggregateArticlePopularityResponse{}, fmt.Errorf("storing %s popularity: %w", window, err)
+		}
+
+		logger.InfoContext(ctx, "aggregated article popularity",
+			"window", window.String(), "article_count", len(popularity))
+		result.Articles[window] = len(popularity)
+	}
+
+	return result, nil
+}
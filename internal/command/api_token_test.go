@@ -0,0 +1,184 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITokenExpiry(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	future := now.Add(48 * time.Hour)
+	past := now.Add(-time.Hour)
+
+	cases := []struct {
+		name      string
+		ttl       time.Duration
+		expiresAt *time.Time
+		want      *time.Time
+		wantErr   bool
+	}{
+		{name: "no_expiry"},
+		{name: "ttl", ttl: 48 * time.Hour, want: &future},
+		{name: "absolute", expiresAt: &future, want: &future},
+		{name: "absolute_in_past", expiresAt: &past, wantErr: true},
+		{name: "negative_ttl", ttl: -time.Hour, wantErr: true},
+		{name: "both", ttl: time.Hour, expiresAt: &future, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := apiTokenExpiry(tc.ttl, tc.expiresAt, now)
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrInvalidTokenExpiry)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRotateAPIToken_Execute(t *testing.T) {
+	name := "CLI"
+	replacement := "tok2"
+	createdAt := time.Now().Add(-10 * 24 * time.Hour)
+	expiresSoon := time.Now().Add(time.Hour)
+	expiresLater := time.Now().Add(20 * 24 * time.Hour)
+	revokedAt := time.Now().Add(-time.Hour)
+
+	cases := []struct {
+		name       string
+		token      domain.APIToken
+		found      bool
+		count      int64
+		wantRotate bool
+		rotated    bool
+		wantErr    error
+	}{
+		{name: "not_found", wantErr: ErrTokenNotFound},
+		{
+			name:    "revoked",
+			token:   domain.APIToken{ID: "tok1", CreatedAt: createdAt, RevokedAt: &revokedAt},
+			found:   true,
+			wantErr: ErrTokenNotRotatable,
+		},
+		{
+			name:    "already_rotated",
+			token:   domain.APIToken{ID: "tok1", CreatedAt: createdAt, ReplacedByID: &replacement},
+			found:   true,
+			wantErr: ErrTokenNotRotatable,
+		},
+		{
+			name:    "limit_exceeded",
+			token:   domain.APIToken{ID: "tok1", Name: &name, CreatedAt: createdAt},
+			found:   true,
+			count:   MaxAPITokensPerUser,
+			wantErr: ErrTokenLimitExceeded,
+		},
+		{
+			name:       "concurrently_rotated",
+			token:      domain.APIToken{ID: "tok1", Name: &name, CreatedAt: createdAt},
+			found:      true,
+			wantRotate: true,
+			wantErr:    ErrTokenNotRotatable,
+		},
+		{
+			name:       "never_expires",
+			token:      domain.APIToken{ID: "tok1", Name: &name, CreatedAt: createdAt},
+			found:      true,
+			wantRotate: true,
+			rotated:    true,
+		},
+		{
+			name:       "expires_within_grace",
+			token:      domain.APIToken{ID: "tok1", Name: &name, CreatedAt: createdAt, ExpiresAt: &expiresSoon},
+			found:      true,
+			wantRotate: true,
+			rotated:    true,
+		},
+		{
+			name:       "expires_after_grace",
+			token:      domain.APIToken{ID: "tok1", Name: &name, CreatedAt: createdAt, ExpiresAt: &expiresLater},
+			found:      true,
+			wantRotate: true,
+			rotated:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.token.Scopes = []domain.APITokenScope{domain.APITokenScopeArticlesRead}
+
+			getter := mocks.NewAPITokenGetter(t)
+			counter := mocks.NewUserAPITokenCounter(t)
+			rotator := mocks.NewAPITokenRotator(t)
+			auditWriter := mocks.NewAuditEventWriter(t)
+
+			getter.EXPECT().GetUserAPIToken(mock.Anything, "tok1", "user1").Return(tc.token, tc.found, nil)
+			if tc.found && tc.token.RevokedAt == nil && tc.token.ReplacedByID == nil {
+				counter.EXPECT().CountUserActiveAPITokens(mock.Anything, "user1").Return(tc.count, nil)
+			}
+
+			var rotatedParams datasources.CreateAPITokenParams
+			var oldExpiresAt time.Time
+			if tc.wantRotate {
+				rotator.EXPECT().RotateAPIToken(mock.Anything, "tok1", mock.Anything, mock.Anything).
+					RunAndReturn(func(
+						_ context.Context, _ string, expiresAt time.Time, p datasources.CreateAPITokenParams,
+					) (bool, error) {
+						oldExpiresAt = expiresAt
+						rotatedParams = p
+						return tc.rotated, nil
+					})
+			}
+			if tc.rotated {
+				auditWriter.EXPECT().
+					WriteAuditEvent(mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+						return e.Type == domain.AuditEventAPITokenRotated && e.UserID == "user1" &&
+							e.TokenID != nil && *e.TokenID == "tok1"
+					})).
+					Return(nil)
+			}
+
+			cmd := NewRotateAPIToken(getter, counter, rotator, auditWriter, 24*time.Hour)
+			result, err := cmd.Execute(t.Context(), RotateAPITokenRequest{UserID: "user1", TokenID: "tok1"})
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "user1", rotatedParams.UserID)
+			assert.Equal(t, &name, rotatedParams.Name)
+			assert.Equal(t, tc.token.Scopes, rotatedParams.Scopes)
+			assert.Equal(t, rotatedParams.ID, result.Token.TokenID)
+			assert.Equal(t, oldExpiresAt, result.ReplacedTokenExpiresAt)
+
+			// The old token never outlives its own expiry
+			gracePeriodEnd := time.Now().Add(24 * time.Hour)
+			if tc.token.ExpiresAt != nil && tc.token.ExpiresAt.Before(gracePeriodEnd) {
+				assert.Equal(t, *tc.token.ExpiresAt, oldExpiresAt)
+			} else {
+				assert.WithinDuration(t, gracePeriodEnd, oldExpiresAt, time.Minute)
+			}
+
+			// The new token keeps the old token's lifetime
+			if tc.token.ExpiresAt == nil {
+				assert.Nil(t, rotatedParams.ExpiresAt)
+			} else {
+				require.NotNil(t, rotatedParams.ExpiresAt)
+				lifetime := tc.token.ExpiresAt.Sub(tc.token.CreatedAt)
+				assert.WithinDuration(t, time.Now().Add(lifetime), *rotatedParams.ExpiresAt, time.Minute)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ApplyRatingToRecommendationsRequest is the request for the ApplyRatingToRecommendations command.
+// LikedVector is the vector of the article rated, if the rating was a like.
+type ApplyRatingToRecommendationsRequest struct {
+	UserID      string
+	LikedVector []float32
+}
+
+// ApplyRatingToRecommendations brings a user's recommendations up to date with a rating change
+// without waiting for the batch job. A newly liked article is added to the user's nearest computed
+// interest cluster, moving its centroid towards the article, and the user's precomputed
+// recommendations are deleted so the next request generates them on demand.
+//
+// The update is approximate: likes count equally whatever their signal weight, and a like
+// repeated or later taken back isn't undone. The batch job's full reclustering corrects this.
+type ApplyRatingToRecommendations struct {
+	ClusterGetter      datasources.UserInterestClusterGetter
+	ClusterUpserter    datasources.UserInterestClusterUpserter
+	PrecomputedDeleter datasources.PrecomputedRecommendationDeleter
+}
+
+// NewApplyRatingToRecommendations creates a properly initialized ApplyRatingToRecommendations command.
+func NewApplyRatingToRecommendations(
+	clusterGetter datasources.UserInterestClusterGetter,
+	clusterUpserter datasources.UserInterestClusterUpserter,
+	precomputedDeleter datasources.PrecomputedRecommendationDeleter,
+) *ApplyRatingToRecommendations {
+	return &ApplyRatingToRecommendations{
+		ClusterGetter:      clusterGetter,
+		ClusterUpserter:    clusterUpserter,
+		PrecomputedDeleter: precomputedDeleter,
+	}
+}
+
+// Execute moves the cluster nearest a liked article towards it, then invalidates the user's
+// precomputed recommendations.
+func (c *ApplyRatingToRecommendations) Execute(
+	ctx context.Context, req ApplyRatingToRecommendationsRequest,
+) (Empty, error) {
+	if req.LikedVector != nil {
+		if err := c.addToNearestCluster(ctx, req.UserID, req.LikedVector); err != nil {
+			return Empty{}, err
+		}
+	}
+
+	if err := c.PrecomputedDeleter.DeleteUserPrecomputedRecommendations(ctx, req.UserID); err != nil {
+		return Empty{}, fmt.Errorf("deleting precomputed recommendations: %w", err)
+	}
+
+	return Empty{}, nil
+}
+
+// addToNearestCluster adds a liked vector to the nearest of the user's computed interest clusters.
+// Users with only provisional clusters, or none, are left for the batch job to cluster once
+// they have liked enough articles.
+func (c *ApplyRatingToRecommendations) addToNearestCluster(
+	ctx context.Context, userID string, vector []float32,
+) error {
+	logger := domain.LoggerFromContext(ctx)
+
+	clusters, err := c.ClusterGetter.GetUserInterestClusters(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("getting interest clusters: %w", err)
+	}
+
+	var computed []datasources.UserInterestCluster
+	var centroids [][]float32
+	for _, cluster := range clusters {
+		if cluster.Provisional {
+			continue
+		}
+		computed = append(computed, cluster)
+		centroids = append(centroids, cluster.CentroidVector)
+	}
+
+	nearest := domain.NearestCentroid(vector, centroids)
+	if nearest < 0 {
+		logger.DebugContext(ctx, "no computed clusters to update")
+		return nil
+	}
+
+	cluster := computed[nearest]
+	centroid := domain.AddPointToCentroid(cluster.CentroidVector, cluster.ArticleCount, vector)
+	if err := c.ClusterUpserter.UpsertUserInterestCluster(
+		ctx, userID, cluster.ClusterID, centroid, cluster.ArticleCount+1,
+	); err != nil {
+		return fmt.Errorf("saving cluster %d: %w", cluster.ClusterID, err)
+	}
+
+	logger.DebugContext(ctx, "added liked article to cluster", "clusterID", cluster.ClusterID)
+	return nil
+}
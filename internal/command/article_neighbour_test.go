@@ -0,0 +1,52 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeArticleNeighbours_Execute(t *testing.T) {
+	coLikeLister := mocks.NewArticleCoLikeLister(t)
+	likeCounter := mocks.NewArticleLikeCounter(t)
+	replacer := mocks.NewArticleNeighbourReplacer(t)
+	config := domain.NeighbourConfig{NeighboursPerArticle: 5, MinCoLikes: 2}
+
+	coLikeLister.EXPECT().
+		ListArticleCoLikes(mock.Anything, int64(2)).
+		Return([]domain.ArticleCoLike{
+			{HashID: "a", NeighbourHashID: "b", Count: 2},
+			{HashID: "b", NeighbourHashID: "a", Count: 2},
+		}, nil)
+	likeCounter.EXPECT().
+		CountArticleLikes(mock.Anything).
+		Return(map[string]int64{"a": 2, "b": 8}, nil)
+	replacer.EXPECT().
+		ReplaceArticleNeighbours(mock.Anything, []domain.ArticleNeighbour{
+			{HashID: "a", NeighbourHashID: "b", CoLikeCount: 2, Score: 0.5},
+			{HashID: "b", NeighbourHashID: "a", CoLikeCount: 2, Score: 0.5},
+		}).
+		Return(nil)
+
+	cmd := NewComputeArticleNeighbours(coLikeLister, likeCounter, replacer, config)
+	result, err := cmd.Execute(t.Context(), ComputeArticleNeighboursRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Neighbours)
+}
+
+func TestComputeArticleNeighbours_Execute_Error(t *testing.T) {
+	coLikeLister := mocks.NewArticleCoLikeLister(t)
+	coLikeLister.EXPECT().
+		ListArticleCoLikes(mock.Anything, mock.Anything).
+		Return(nil, errors.New("db down"))
+
+	cmd := NewComputeArticleNeighbours(coLikeLister, mocks.NewArticleLikeCounter(t),
+		mocks.NewArticleNeighbourReplacer(t), domain.DefaultNeighbourConfig())
+	_, err := cmd.Execute(t.Context(), ComputeArticleNeighboursRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateArticleNote_Execute(t *testing.T) {
+	cases := []struct {
+		name       string
+		req        CreateArticleNoteRequest
+		wantCreate bool
+		wantErr    error
+	}{
+		{
+			name:       "note",
+			req:        CreateArticleNoteRequest{UserID: "user1", ArticleHashID: "a1", Body: "Worth revisiting"},
+			wantCreate: true,
+		},
+		{
+			name:       "highlight",
+			req:        CreateArticleNoteRequest{UserID: "user1", ArticleHashID: "a1", Quote: "a key passage"},
+			wantCreate: true,
+		},
+		{
+			name:    "empty",
+			req:     CreateArticleNoteRequest{UserID: "user1", ArticleHashID: "a1"},
+			wantErr: ErrInvalidArticleNote,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			creator := mocks.NewArticleNoteCreator(t)
+			if tc.wantCreate {
+				creator.EXPECT().
+					CreateArticleNote(mock.Anything, mock.MatchedBy(func(n domain.ArticleNote) bool {
+						return n.UserID == "user1" && n.ArticleHashID == "a1" && n.ID != ""
+					})).
+					Return(nil)
+			}
+
+			cmd := NewCreateArticleNote(creator)
+			note, err := cmd.Execute(t.Context(), tc.req)
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.req.Body, note.Body)
+			assert.Equal(t, tc.req.Quote, note.Quote)
+		})
+	}
+}
+
+func TestUpdateArticleNote_Execute(t *testing.T) {
+	existing := domain.ArticleNote{ID: "note1", UserID: "user1", ArticleHashID: "a1", Body: "old", Quote: "passage"}
+	newBody := "new"
+	empty := ""
+
+	cases := []struct {
+		name       string
+		found      bool
+		req        UpdateArticleNoteRequest
+		wantUpdate *domain.ArticleNote
+		wantErr    error
+	}{
+		{
+			name:  "edit_body",
+			found: true,
+			req:   UpdateArticleNoteRequest{UserID: "user1", NoteID: "note1", Body: &newBody},
+			wantUpdate: &domain.ArticleNote{
+				ID: "note1", UserID: "user1", ArticleHashID: "a1", Body: "new", Quote: "passage",
+			},
+		},
+		{
+			name:  "remove_highlight",
+			found: true,
+			req:   UpdateArticleNoteRequest{UserID: "user1", NoteID: "note1", Quote: &empty},
+			wantUpdate: &domain.ArticleNote{
+				ID: "note1", UserID: "user1", ArticleHashID: "a1", Body: "old",
+			},
+		},
+		{
+			name:    "clears_everything",
+			found:   true,
+			req:     UpdateArticleNoteRequest{UserID: "user1", NoteID: "note1", Body: &empty, Quote: &empty},
+			wantErr: ErrInvalidArticleNote,
+		},
+		{
+			name:    "not_found",
+			req:     UpdateArticleNoteRequest{UserID: "user1", NoteID: "note1", Body: &newBody},
+			wantErr: ErrArticleNoteNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewUserArticleNoteRepository(t)
+			store.EXPECT().GetArticleNote(mock.Anything, "user1", "note1").Return(existing, tc.found, nil)
+			if tc.wantUpdate != nil {
+				store.EXPECT().UpdateArticleNote(mock.Anything, *tc.wantUpdate).Return(nil)
+			}
+
+			cmd := NewUpdateArticleNote(store)
+			note, err := cmd.Execute(t.Context(), tc.req)
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, *tc.wantUpdate, note)
+		})
+	}
+}
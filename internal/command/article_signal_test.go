@@ -0,0 +1,180 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetArticleRating_Execute_Graded(t *testing.T) {
+	thumbsUp := true
+	rating := func(r int) *int { return &r }
+
+	cases := []struct {
+		name       string
+		req        SetArticleRatingRequest
+		wantGraded bool
+		wantThumbs bool
+		wantErr    error
+	}{
+		{name: "graded_rating", req: SetArticleRatingRequest{Rating: rating(5)}, wantGraded: true},
+		{name: "clear_rating", req: SetArticleRatingRequest{Rating: rating(0)}, wantGraded: true},
+		{name: "thumbs_only", req: SetArticleRatingRequest{ThumbsUp: &thumbsUp}, wantThumbs: true},
+		{
+			name:    "out_of_range_rating",
+			req:     SetArticleRatingRequest{Rating: rating(6)},
+			wantErr: domain.ErrInvalidArticleRating,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vectorFetcher := mocks.NewArticleVectorFetcher(t)
+			ratingSetter := mocks.NewArticleRatingSetter(t)
+			regenMarker := mocks.NewUserRegenerationNeededMarker(t)
+
+			if tc.wantErr == nil {
+				vectorFetcher.EXPECT().
+					FetchArticleVector(mock.Anything, "hash1").
+					Return([]float32{1.0, 0.0}, nil)
+				regenMarker.EXPECT().
+					MarkUserNeedsRegeneration(mock.Anything, "user1").
+					Return(nil)
+			}
+			if tc.wantGraded {
+				ratingSetter.EXPECT().
+					SetArticleGradedRating(mock.Anything, "user1", "hash1", *tc.req.Rating, mock.Anything).
+					Return(nil)
+			}
+			if tc.wantThumbs {
+				ratingSetter.EXPECT().
+					SetArticleRating(mock.Anything, "user1", "hash1", &thumbsUp, (*bool)(nil), mock.Anything).
+					Return(nil)
+			}
+
+			tc.req.UserID = "user1"
+			tc.req.ArticleHashID = "hash1"
+			_, err := NewSetArticleRating(vectorFetcher, ratingSetter, regenMarker, nil).Execute(t.Context(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecordArticleSignal_Execute(t *testing.T) {
+	cases := []struct {
+		name        string
+		signal      domain.ArticleSignal
+		vectorErr   error
+		wantVector  []float32
+		recordErr   error
+		wantRecord  bool
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name:       "opened_link",
+			signal:     domain.ArticleSignal{Type: domain.ArticleSignalOpenedLink},
+			wantVector: []float32{1.0, 0.0},
+			wantRecord: true,
+		},
+		{
+			name:       "vector_error_records_without_vector",
+			signal:     domain.ArticleSignal{Type: domain.ArticleSignalSavedToCollection},
+			vectorErr:  errors.New("pinecone down"),
+			wantRecord: true,
+		},
+		{
+			name:       "record_error",
+			signal:     domain.ArticleSignal{Type: domain.ArticleSignalOpenedLink},
+			wantVector: []float32{1.0, 0.0},
+			recordErr:  errors.New("db down"),
+			wantRecord: true,
+			wantErr:    true,
+		},
+		{
+			name:        "invalid_signal",
+			signal:      domain.ArticleSignal{Type: domain.ArticleSignalTimeOnArticle},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vectorFetcher := mocks.NewArticleVectorFetcher(t)
+			recorder := mocks.NewArticleSignalRecorder(t)
+			regenMarker := mocks.NewUserRegenerationNeededMarker(t)
+
+			if tc.wantRecord {
+				vectorFetcher.EXPECT().
+					FetchArticleVector(mock.Anything, "hash1").
+					Return(tc.wantVector, tc.vectorErr)
+				recorder.EXPECT().
+					RecordArticleSignal(mock.Anything, "user1", "hash1", tc.signal, tc.wantVector).
+					Return(tc.recordErr)
+			}
+			if tc.wantRecord && tc.recordErr == nil {
+				regenMarker.EXPECT().
+					MarkUserNeedsRegeneration(mock.Anything, "user1").
+					Return(nil)
+			}
+
+			_, err := NewRecordArticleSignal(vectorFetcher, recorder, regenMarker).
+				Execute(t.Context(), RecordArticleSignalRequest{
+					UserID:        "user1",
+					ArticleHashID: "hash1",
+					Signal:        tc.signal,
+				})
+
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			if tc.wantInvalid {
+				assert.ErrorIs(t, err, domain.ErrInvalidArticleSignal)
+			}
+		})
+	}
+}
+
+func TestGenerateRecommendations_SignalWeightedVectors(t *testing.T) {
+	now := time.Now()
+	cmd := &GenerateRecommendations{Config: GenerateRecommendationsConfig{
+		TemporalDecayHalfLifeDays: 90,
+		SignalWeights:             domain.DefaultSignalWeights(),
+	}}
+
+	ratings := []domain.UserArticleRating{
+		{
+			ArticleHashID: "loved",
+			Vector:        []float32{1.0, 0.0},
+			RatedAt:       now,
+			RatingType:    domain.RatingTypeThumbsUp,
+			Signals:       domain.ArticleSignals{ThumbsUp: true, Rating: 5},
+		},
+		{
+			ArticleHashID: "opened",
+			Vector:        []float32{0.0, 1.0},
+			RatedAt:       now,
+			RatingType:    domain.RatingTypeThumbsUp,
+			Signals:       domain.ArticleSignals{OpenedLink: true},
+		},
+	}
+
+	// Weights 1.0 and 0.1, so the rated article dominates
+	expected := []float32{1.0 / 1.1, 0.1 / 1.1}
+	assert.InDeltaSlice(t, expected, cmd.computeTemporallyWeightedVector(ratings), 0.01)
+	assert.InDeltaSlice(t, expected, cmd.computeAverageVector(ratings), 0.001)
+}
@@ -0,0 +1,159 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// BuildDigestRequest is the request for the BuildDigest command.
+type BuildDigestRequest struct {
+	Preferences domain.DigestPreferences
+	Now         time.Time
+}
+
+// BuildDigestConfig holds configuration for building email digests.
+type BuildDigestConfig struct {
+	// RecommendationLimit is the maximum number of recommended articles in a digest.
+	RecommendationLimit int
+
+	// CategoryArticleLimit is the maximum number of new articles from the user's
+	// chosen categories in a digest, across all categories.
+	CategoryArticleLimit int
+
+	// UnsubscribeBaseURL is the URL of the unsubscribe endpoint.
+	// The user's unsubscribe token is added as the "token" query parameter.
+	UnsubscribeBaseURL string
+}
+
+// BuildDigest assembles the content of a user's email digest: their top unread
+// recommendations, plus unread articles published in their chosen categories
+// since the previous digest.
+type BuildDigest struct {
+	RecommendCommand   Command[RecommendArticlesRequest, RecommendArticlesResponse]
+	LatestLister       datasources.LatestArticleLister
+	ArticleFetcher     datasources.ArticleFetcher
+	ReadArticlesLister datasources.ReadArticleIDsLister
+	Config             BuildDigestConfig
+}
+
+// NewBuildDigest creates a properly initialized BuildDigest command.
+func NewBuildDigest(
+	recommendCommand Command[RecommendArticlesRequest, RecommendArticlesResponse],
+	latestLister datasources.LatestArticleLister,
+	articleFetcher datasources.ArticleFetcher,
+	readArticlesLister datasources.ReadArticleIDsLister,
+	config BuildDigestConfig,
+) *BuildDigest {
+	return &BuildDigest{
+		RecommendCommand:   recommendCommand,
+		LatestLister:       latestLister,
+		ArticleFetcher:     articleFetcher,
+		ReadArticlesLister: readArticlesLister,
+		Config:             config,
+	}
+}
+
+// Execute builds the digest for the user in the request.
+func (c *BuildDigest) Execute(ctx context.Context, req BuildDigestRequest) (domain.Digest, error) {
+	prefs := req.Preferences
+
+	recommendations, err := c.RecommendCommand.Execute(ctx, RecommendArticlesRequest{
+		UserID: prefs.UserID,
+		Limit:  c.Config.RecommendationLimit,
+	})
+	if err != nil {
+		return domain.Digest{}, fmt.Errorf("getting recommendations: %w", err)
+	}
+	recommended := recommendations.Articles
+
+	excludeIDs := readArticleIDSet(ctx, c.ReadArticlesLister, prefs.UserID)
+	for _, article := range recommended {
+		excludeIDs[article.HashID] = struct{}{}
+	}
+
+	newInCategories, err := c.newInCategories(ctx, prefs, req.Now, excludeIDs)
+	if err != nil {
+		return domain.Digest{}, err
+	}
+
+	unsubscribeURL, err := c.unsubscribeURL(prefs.UnsubscribeToken)
+	if err != nil {
+		return domain.Digest{}, err
+	}
+
+	return domain.Digest{
+		Frequency:       prefs.Frequency,
+		Recommended:     recommended,
+		NewInCategories: newInCategories,
+		UnsubscribeURL:  unsubscribeURL,
+		GeneratedAt:     req.Now,
+	}, nil
+}
+
+// newInCategories lists unread articles published in the user's categories since the last digest.
+func (c *BuildDigest) newInCategories(
+	ctx context.Context, prefs domain.DigestPreferences, now time.Time, excludeIDs map[string]struct{},
+) ([]domain.Article, error) {
+	if len(prefs.Categories) == 0 || c.Config.CategoryArticleLimit <= 0 {
+		return nil, nil
+	}
+
+	since := prefs.Since(now)
+
+	var ids []string
+	for _, category := range prefs.Categories {
+		categoryIDs, err := c.LatestLister.ListLatestArticleIDs(ctx, domain.ArticleFilters{
+			Category:       category,
+			PublishedAfter: since,
+		}, domain.ArticleListOptions{
+			Page:     1,
+			PageSize: c.Config.CategoryArticleLimit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing latest articles in category %s: %w", category, err)
+		}
+
+		for _, id := range categoryIDs {
+			if _, excluded := excludeIDs[id]; excluded {
+				continue
+			}
+			excludeIDs[id] = struct{}{}
+			ids = append(ids, id)
+			if len(ids) >= c.Config.CategoryArticleLimit {
+				break
+			}
+		}
+		if len(ids) >= c.Config.CategoryArticleLimit {
+			break
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	articles, err := c.ArticleFetcher.FetchArticlesByID(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("fetching category article details: %w", err)
+	}
+
+	return articles, nil
+}
+
+func (c *BuildDigest) unsubscribeURL(token string) (string, error) {
+	u, err := url.Parse(c.Config.UnsubscribeBaseURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing unsubscribe base URL: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,85 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCollection_Execute(t *testing.T) {
+	cases := []struct {
+		name       string
+		count      int64
+		wantCreate bool
+		wantErr    error
+	}{
+		{name: "creates", count: 3, wantCreate: true},
+		{name: "limit_exceeded", count: MaxCollectionsPerUser, wantErr: ErrCollectionLimitExceeded},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := mocks.NewUserCollectionCounter(t)
+			creator := mocks.NewCollectionCreator(t)
+
+			counter.EXPECT().CountUserCollections(mock.Anything, "user1").Return(tc.count, nil)
+			if tc.wantCreate {
+				creator.EXPECT().
+					CreateCollection(mock.Anything, mock.MatchedBy(func(c domain.Collection) bool {
+						return c.UserID == "user1" && c.Name == "Reading list" && c.IsPublic && len(c.ShareToken) == 64
+					})).
+					Return(nil)
+			}
+
+			cmd := NewCreateCollection(counter, creator)
+			collection, err := cmd.Execute(t.Context(), CreateCollectionRequest{
+				UserID: "user1", Name: "Reading list", IsPublic: true,
+			})
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, collection.ID)
+			assert.Equal(t, "Reading list", collection.Name)
+		})
+	}
+}
+
+func TestReorderCollection_Execute(t *testing.T) {
+	cases := []struct {
+		name        string
+		requested   []string
+		wantReorder bool
+		wantErr     error
+	}{
+		{name: "valid_order", requested: []string{"c", "a", "b"}, wantReorder: true},
+		{name: "missing_article", requested: []string{"c", "a"}, wantErr: ErrInvalidCollectionOrder},
+		{name: "unknown_article", requested: []string{"c", "a", "d"}, wantErr: ErrInvalidCollectionOrder},
+		{name: "duplicate_article", requested: []string{"a", "a", "b"}, wantErr: ErrInvalidCollectionOrder},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewCollectionArticleOrderRepository(t)
+			store.EXPECT().ListCollectionArticleIDs(mock.Anything, "col1").Return([]string{"a", "b", "c"}, nil)
+			if tc.wantReorder {
+				store.EXPECT().ReorderCollectionArticles(mock.Anything, "col1", tc.requested).Return(nil)
+			}
+
+			cmd := NewReorderCollection(store)
+			_, err := cmd.Execute(t.Context(), ReorderCollectionRequest{CollectionID: "col1", ArticleIDs: tc.requested})
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ComputeArticleNeighboursRequest is the request for the ComputeArticleNeighbours command.
+// This command takes no parameters beyond context.
+type ComputeArticleNeighboursRequest struct{}
+
+// ComputeArticleNeighboursResponse summarizes a neighbour computation run.
+type ComputeArticleNeighboursResponse struct {
+	Neighbours int
+}
+
+// ComputeArticleNeighbours recomputes the item-item collaborative filtering neighbours of
+// every liked article from which articles the same users have liked, keeping the most
+// similar neighbours of each.
+type ComputeArticleNeighbours struct {
+	CoLikeLister datasources.ArticleCoLikeLister
+	LikeCounter  datasources.ArticleLikeCounter
+	Replacer     datasources.ArticleNeighbourReplacer
+	Config       domain.NeighbourConfig
+}
+
+// NewComputeArticleNeighbours creates a properly initialized ComputeArticleNeighbours command.
+func NewComputeArticleNeighbours(
+	coLikeLister datasources.ArticleCoLikeLister,
+	likeCounter datasources.ArticleLikeCounter,
+	replacer datasources.ArticleNeighbourReplacer,
+	config domain.NeighbourConfig,
+) *ComputeArticleNeighbours {
+	return &ComputeArticleNeighbours{
+		CoLikeLister: coLikeLister,
+		LikeCounter:  likeCounter,
+		Replacer:     replacer,
+		Config:       config,
+	}
+}
+
+// Execute replaces all stored article neighbours.
+func (c *ComputeArticleNeighbours) Execute(
+	ctx context.Context, _ ComputeArticleNeighboursRequest,
+) (ComputeArticleNeighboursResponse, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	coLikes, err := c.CoLikeLister.ListArticleCoLikes(ctx, c.Config.MinCoLikes)
+	if err != nil {
+		return ComputeArticleNeighboursResponse{}, fmt.Errorf("listing co-liked articles: %w", err)
+	}
+
+	likeCounts, err := c.LikeCounter.CountArticleLikes(ctx)
+	if err != nil {
+		return ComputeArticleNeighboursResponse{}, fmt.Errorf("counting article likes: %w", err)
+	}
+
+	neighbours := domain.ComputeArticleNeighbours(coLikes, likeCounts, c.Config)
+	if err := c.Replacer.ReplaceArticleNeighbours(ctx, neighbours); err != nil {
+		return ComputeArticleNeighboursResponse{}, fmt.Errorf("storing article neighbours: %w", err)
+	}
+
+	logger.InfoContext(ctx, "computed article neighbours",
+		"pair_count", len(coLikes), "neighbour_count", len(neighbours))
+
+	return ComputeArticleNeighboursResponse{Neighbours: len(neighbours)}, nil
+}
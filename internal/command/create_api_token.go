@@ -7,6 +7,8 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jbeshir/alignment-research-feed/internal/datasources"
@@ -16,13 +18,24 @@ import (
 // MaxAPITokensPerUser is the maximum number of active tokens a user can have.
 const MaxAPITokensPerUser = 10
 
-// ErrTokenLimitExceeded is returned when a user has reached the maximum number of active tokens.
-var ErrTokenLimitExceeded = errors.New("user has reached maximum number of active tokens")
+var (
+	// ErrTokenLimitExceeded is returned when a user has reached the maximum number of active tokens.
+	ErrTokenLimitExceeded = errors.New("user has reached maximum number of active tokens")
+
+	// ErrInvalidTokenExpiry is returned when a token's expiry is in the past or is given
+	// both as a TTL and as an absolute time.
+	ErrInvalidTokenExpiry = errors.New("token expiry must be in the future and set by either TTL or date")
+)
 
 // CreateAPITokenRequest is the request for the CreateAPIToken command.
+// Scopes defaults to all scopes if empty. At most one of TTL and ExpiresAt may be set;
+// if neither is, the token never expires.
 type CreateAPITokenRequest struct {
-	UserID string
-	Name   *string
+	UserID    string
+	Name      *string
+	Scopes    []domain.APITokenScope
+	TTL       time.Duration
+	ExpiresAt *time.Time
 }
 
 // CreateAPITokenResponse is the response from the CreateAPIToken command.
@@ -30,22 +43,27 @@ type CreateAPITokenResponse struct {
 	TokenID   string
 	FullToken string
 	Prefix    string
+	Scopes    []domain.APITokenScope
+	ExpiresAt *time.Time
 }
 
 // CreateAPIToken handles creating new API tokens.
 type CreateAPIToken struct {
 	TokenCounter datasources.UserAPITokenCounter
 	TokenCreator datasources.APITokenCreator
+	AuditWriter  datasources.AuditEventWriter
 }
 
 // NewCreateAPIToken creates a properly initialized CreateAPIToken command.
 func NewCreateAPIToken(
 	tokenCounter datasources.UserAPITokenCounter,
 	tokenCreator datasources.APITokenCreator,
+	auditWriter datasources.AuditEventWriter,
 ) *CreateAPIToken {
 	return &CreateAPIToken{
 		TokenCounter: tokenCounter,
 		TokenCreator: tokenCreator,
+		AuditWriter:  auditWriter,
 	}
 }
 
@@ -61,10 +79,84 @@ func (c *CreateAPIToken) Execute(ctx context.Context, req CreateAPITokenRequest)
 		return CreateAPITokenResponse{}, ErrTokenLimitExceeded
 	}
 
+	expiresAt, err := apiTokenExpiry(req.TTL, req.ExpiresAt, time.Now())
+	if err != nil {
+		return CreateAPITokenResponse{}, err
+	}
+
+	scopes := req.Scopes
+	if len(scopes) == 0 {
+		scopes = domain.AllAPITokenScopes
+	}
+
+	fullToken, params, err := newAPITokenParams(req.UserID, req.Name, scopes, expiresAt)
+	if err != nil {
+		return CreateAPITokenResponse{}, err
+	}
+
+	// Store in database
+	if err := c.TokenCreator.CreateAPIToken(ctx, params); err != nil {
+		return CreateAPITokenResponse{}, fmt.Errorf("creating token: %w", err)
+	}
+
+	event := domain.NewAuditEvent(ctx, domain.AuditEventAPITokenCreated, domain.AuditOutcomeSuccess,
+		"scopes: "+joinScopes(scopes))
+	event.UserID = req.UserID
+	event.TokenID = &params.ID
+	writeAuditEvent(ctx, c.AuditWriter, event)
+
+	return CreateAPITokenResponse{
+		TokenID:   params.ID,
+		FullToken: fullToken,
+		Prefix:    params.TokenPrefix,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// writeAuditEvent records an audit event on a best-effort basis; failing to record
+// an event is logged rather than failing the action it describes.
+func writeAuditEvent(ctx context.Context, writer datasources.AuditEventWriter, event domain.AuditEvent) {
+	if err := writer.WriteAuditEvent(ctx, event); err != nil {
+		domain.LoggerFromContext(ctx).WarnContext(ctx, "unable to write audit event",
+			"error", err, "event_type", event.Type)
+	}
+}
+
+func joinScopes(scopes []domain.APITokenScope) string {
+	names := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		names = append(names, string(scope))
+	}
+	return strings.Join(names, ",")
+}
+
+// apiTokenExpiry resolves a requested TTL or absolute expiry into an expiry time.
+func apiTokenExpiry(ttl time.Duration, expiresAt *time.Time, now time.Time) (*time.Time, error) {
+	switch {
+	case ttl != 0 && expiresAt != nil:
+		return nil, ErrInvalidTokenExpiry
+	case ttl < 0:
+		return nil, ErrInvalidTokenExpiry
+	case ttl > 0:
+		t := now.Add(ttl)
+		return &t, nil
+	case expiresAt != nil && !expiresAt.After(now):
+		return nil, ErrInvalidTokenExpiry
+	default:
+		return expiresAt, nil
+	}
+}
+
+// newAPITokenParams generates a new token secret, returning the full token
+// to show the user once and the parameters to store.
+func newAPITokenParams(
+	userID string, name *string, scopes []domain.APITokenScope, expiresAt *time.Time,
+) (string, datasources.CreateAPITokenParams, error) {
 	// Generate cryptographically secure random token (32 bytes = 64 hex chars)
 	tokenBytes := make([]byte, 32)
 	if _, err := rand.Read(tokenBytes); err != nil {
-		return CreateAPITokenResponse{}, fmt.Errorf("generating random token: %w", err)
+		return "", datasources.CreateAPITokenParams{}, fmt.Errorf("generating random token: %w", err)
 	}
 
 	tokenHex := hex.EncodeToString(tokenBytes)
@@ -72,28 +164,15 @@ func (c *CreateAPIToken) Execute(ctx context.Context, req CreateAPITokenRequest)
 
 	// Compute SHA256 hash
 	hash := sha256.Sum256([]byte(fullToken))
-	tokenHash := hex.EncodeToString(hash[:])
-
-	// Extract prefix (first 8 chars of the random portion)
-	tokenPrefix := tokenHex[:8]
-
-	// Generate UUID for token ID
-	tokenID := uuid.New().String()
 
-	// Store in database
-	if err := c.TokenCreator.CreateAPIToken(ctx, datasources.CreateAPITokenParams{
-		ID:          tokenID,
-		UserID:      req.UserID,
-		TokenHash:   tokenHash,
-		TokenPrefix: tokenPrefix,
-		Name:        req.Name,
-	}); err != nil {
-		return CreateAPITokenResponse{}, fmt.Errorf("creating token: %w", err)
-	}
-
-	return CreateAPITokenResponse{
-		TokenID:   tokenID,
-		FullToken: fullToken,
-		Prefix:    tokenPrefix,
+	return fullToken, datasources.CreateAPITokenParams{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		TokenHash: hex.EncodeToString(hash[:]),
+		// Prefix is the first 8 chars of the random portion
+		TokenPrefix: tokenHex[:8],
+		Name:        name,
+		ExpiresAt:   expiresAt,
+		Scopes:      scopes,
 	}, nil
 }
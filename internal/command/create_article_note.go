@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ErrInvalidArticleNote is returned when a note has neither a body nor a highlighted quote.
+var ErrInvalidArticleNote = errors.New("note must have a body or a quote")
+
+// ErrArticleNoteNotFound is returned when a note doesn't exist or belongs to another user.
+var ErrArticleNoteNotFound = errors.New("note not found")
+
+// CreateArticleNoteRequest is the request for the CreateArticleNote command.
+type CreateArticleNoteRequest struct {
+	UserID        string
+	ArticleHashID string
+	Body          string
+	Quote         string
+}
+
+// CreateArticleNote handles adding a note or highlight to an article.
+type CreateArticleNote struct {
+	NoteCreator datasources.ArticleNoteCreator
+}
+
+// NewCreateArticleNote creates a properly initialized CreateArticleNote command.
+func NewCreateArticleNote(noteCreator datasources.ArticleNoteCreator) *CreateArticleNote {
+	return &CreateArticleNote{
+		NoteCreator: noteCreator,
+	}
+}
+
+// Execute creates the note and returns it.
+func (c *CreateArticleNote) Execute(ctx context.Context, req CreateArticleNoteRequest) (domain.ArticleNote, error) {
+	if req.Body == "" && req.Quote == "" {
+		return domain.ArticleNote{}, ErrInvalidArticleNote
+	}
+
+	note := domain.ArticleNote{
+		ID:            uuid.New().String(),
+		UserID:        req.UserID,
+		ArticleHashID: req.ArticleHashID,
+		Body:          req.Body,
+		Quote:         req.Quote,
+	}
+
+	if err := c.NoteCreator.CreateArticleNote(ctx, note); err != nil {
+		return domain.ArticleNote{}, fmt.Errorf("creating article note: %w", err)
+	}
+
+	return note, nil
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// MaxCollectionsPerUser is the maximum number of collections a user can have.
+const MaxCollectionsPerUser = 100
+
+// ErrCollectionLimitExceeded is returned when a user has reached the maximum number of collections.
+var ErrCollectionLimitExceeded = errors.New("user has reached maximum number of collections")
+
+// CreateCollectionRequest is the request for the CreateCollection command.
+type CreateCollectionRequest struct {
+	UserID   string
+	Name     string
+	IsPublic bool
+}
+
+// CreateCollection handles creating new, empty collections.
+type CreateCollection struct {
+	CollectionCounter datasources.UserCollectionCounter
+	CollectionCreator datasources.CollectionCreator
+}
+
+// NewCreateCollection creates a properly initialized CreateCollection command.
+func NewCreateCollection(
+	collectionCounter datasources.UserCollectionCounter,
+	collectionCreator datasources.CollectionCreator,
+) *CreateCollection {
+	return &CreateCollection{
+		CollectionCounter: collectionCounter,
+		CollectionCreator: collectionCreator,
+	}
+}
+
+// Execute creates a new collection for the user and returns it.
+func (c *CreateCollection) Execute(ctx context.Context, req CreateCollectionRequest) (domain.Collection, error) {
+	count, err := c.CollectionCounter.CountUserCollections(ctx, req.UserID)
+	if err != nil {
+		return domain.Collection{}, fmt.Errorf("counting user collections: %w", err)
+	}
+
+	if count >= MaxCollectionsPerUser {
+		return domain.Collection{}, ErrCollectionLimitExceeded
+	}
+
+	// Every collection gets a share token up front so making it public
+	// later doesn't change its identity.
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return domain.Collection{}, fmt.Errorf("generating share token: %w", err)
+	}
+
+	collection := domain.Collection{
+		ID:         uuid.New().String(),
+		UserID:     req.UserID,
+		Name:       req.Name,
+		IsPublic:   req.IsPublic,
+		ShareToken: hex.EncodeToString(tokenBytes),
+	}
+
+	if err := c.CollectionCreator.CreateCollection(ctx, collection); err != nil {
+		return domain.Collection{}, fmt.Errorf("creating collection: %w", err)
+	}
+
+	return collection, nil
+}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// MaxSavedSearchesPerUser is the maximum number of saved searches a user can have.
+const MaxSavedSearchesPerUser = 50
+
+var (
+	// ErrSavedSearchLimitExceeded is returned when a user has reached the maximum number of saved searches.
+	ErrSavedSearchLimitExceeded = errors.New("user has reached maximum number of saved searches")
+
+	// ErrInvalidSavedSearch is returned when a saved search does not have exactly one of filters or query text.
+	ErrInvalidSavedSearch = errors.New("saved search must have exactly one of filters or query_text")
+
+	// ErrSavedSearchNotFound is returned when a saved search does not exist or belongs to another user.
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+
+	// ErrEmbeddingUnavailable is returned when semantic query text cannot be embedded
+	// because no embedder is configured.
+	ErrEmbeddingUnavailable = errors.New("embedding is not available")
+)
+
+// CreateSavedSearchRequest is the request for the CreateSavedSearch command.
+// Exactly one of Filters or QueryText must be set.
+type CreateSavedSearchRequest struct {
+	UserID    string
+	Name      string
+	Filters   *domain.ArticleFilters
+	QueryText string
+}
+
+// CreateSavedSearch handles creating new saved searches, embedding semantic queries up front
+// so running them later only needs a similarity lookup.
+type CreateSavedSearch struct {
+	SearchCounter datasources.UserSavedSearchCounter
+	SearchCreator datasources.SavedSearchCreator
+	Embedder      datasources.Embedder
+}
+
+// NewCreateSavedSearch creates a properly initialized CreateSavedSearch command.
+func NewCreateSavedSearch(
+	searchCounter datasources.UserSavedSearchCounter,
+	searchCreator datasources.SavedSearchCreator,
+	embedder datasources.Embedder,
+) *CreateSavedSearch {
+	return &CreateSavedSearch{
+		SearchCounter: searchCounter,
+		SearchCreator: searchCreator,
+		Embedder:      embedder,
+	}
+}
+
+// Execute creates a new saved search for the user and returns it.
+func (c *CreateSavedSearch) Execute(ctx context.Context, req CreateSavedSearchRequest) (domain.SavedSearch, error) {
+	count, err := c.SearchCounter.CountUserSavedSearches(ctx, req.UserID)
+	if err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("counting user saved searches: %w", err)
+	}
+
+	if count >= MaxSavedSearchesPerUser {
+		return domain.SavedSearch{}, ErrSavedSearchLimitExceeded
+	}
+
+	search := domain.SavedSearch{
+		ID:     uuid.New().String(),
+		UserID: req.UserID,
+		Name:   req.Name,
+	}
+	if err := setSavedSearchQuery(ctx, c.Embedder, &search, req.Filters, req.QueryText); err != nil {
+		return domain.SavedSearch{}, err
+	}
+
+	// The feed token lets RSS readers fetch results without an Authorization header.
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("generating feed token: %w", err)
+	}
+	search.FeedToken = hex.EncodeToString(tokenBytes)
+
+	if err := c.SearchCreator.CreateSavedSearch(ctx, search); err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("creating saved search: %w", err)
+	}
+
+	return search, nil
+}
+
+// setSavedSearchQuery replaces the query of a saved search with either filters or
+// embedded query text, setting its kind accordingly.
+func setSavedSearchQuery(
+	ctx context.Context,
+	embedder datasources.Embedder,
+	search *domain.SavedSearch,
+	filters *domain.ArticleFilters,
+	queryText string,
+) error {
+	if (filters == nil) == (queryText == "") {
+		return ErrInvalidSavedSearch
+	}
+
+	if filters != nil {
+		search.Kind = domain.SavedSearchKindFilters
+		search.Filters = filters
+		search.QueryText = ""
+		search.QueryVector = nil
+		return nil
+	}
+
+	vector, err := embedder.EmbedText(ctx, queryText)
+	if err != nil {
+		return fmt.Errorf("embedding saved search query: %w", err)
+	}
+	if vector == nil {
+		return ErrEmbeddingUnavailable
+	}
+
+	search.Kind = domain.SavedSearchKindSemantic
+	search.Filters = nil
+	search.QueryText = queryText
+	search.QueryVector = vector
+	return nil
+}
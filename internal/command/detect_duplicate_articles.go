@@ -0,0 +1,202 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// detectDuplicatesPageSize is how many articles are checked for duplicates at a time.
+const detectDuplicatesPageSize = 100
+
+// DetectDuplicateArticlesRequest is the request for the DetectDuplicateArticles command.
+// This command takes no parameters beyond context.
+type DetectDuplicateArticlesRequest struct{}
+
+// DetectDuplicateArticlesResponse summarizes a duplicate detection run.
+type DetectDuplicateArticlesResponse struct {
+	Checked    int
+	Duplicates int
+}
+
+// DetectDuplicateArticles finds articles that are the same work published in several places,
+// such as an arXiv paper and its Alignment Forum and LessWrong crossposts, and groups each set
+// under one canonical article.
+//
+// Each recently published article is compared with its most similar articles by vector; those
+// similar enough with the same normalized title or a shared author are duplicates. Groups found
+// by earlier runs are kept and merged with new ones, unless every article is being checked.
+type DetectDuplicateArticles struct {
+	ArticleLister   datasources.LatestArticleLister
+	ArticleFetcher  datasources.ArticleFetcher
+	Similarity      datasources.SimilarArticleLister
+	DuplicateLister datasources.ArticleDuplicateLister
+	Replacer        datasources.ArticleDuplicateReplacer
+	Config          domain.DuplicateConfig
+}
+
+// NewDetectDuplicateArticles creates a properly initialized DetectDuplicateArticles command.
+func NewDetectDuplicateArticles(
+	articleLister datasources.LatestArticleLister,
+	articleFetcher datasources.ArticleFetcher,
+	similarity datasources.SimilarArticleLister,
+	duplicateLister datasources.ArticleDuplicateLister,
+	replacer datasources.ArticleDuplicateReplacer,
+	config domain.DuplicateConfig,
+) *DetectDuplicateArticles {
+	return &DetectDuplicateArticles{
+		ArticleLister:   articleLister,
+		ArticleFetcher:  articleFetcher,
+		Similarity:      similarity,
+		DuplicateLister: duplicateLister,
+		Replacer:        replacer,
+		Config:          config,
+	}
+}
+
+// Execute replaces all stored duplicate articles.
+func (c *DetectDuplicateArticles) Execute(
+	ctx context.Context, _ DetectDuplicateArticlesRequest,
+) (DetectDuplicateArticlesResponse, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	var pairs []domain.ArticleDuplicate
+	if c.Config.LookbackDays > 0 {
+		existing, err := c.DuplicateLister.ListArticleDuplicates(ctx)
+		if err != nil {
+			return DetectDuplicateArticlesResponse{}, fmt.Errorf("listing existing duplicates: %w", err)
+		}
+		pairs = existing
+	}
+
+	articles := make(map[string]domain.Article)
+	checked := 0
+	for page := 1; ; page++ {
+		ids, err := c.listArticlesToCheck(ctx, page)
+		if err != nil {
+			return DetectDuplicateArticlesResponse{}, err
+		}
+
+		found, err := c.findDuplicates(ctx, ids, articles)
+		if err != nil {
+			return DetectDuplicateArticlesResponse{}, err
+		}
+		pairs = append(pairs, found...)
+		checked += len(ids)
+
+		if len(ids) < detectDuplicatesPageSize {
+			break
+		}
+	}
+
+	// Canonical articles are chosen by publication date, so every article in a group is needed
+	var missing []string
+	for _, pair := range pairs {
+		missing = appendIfMissing(missing, articles, pair.CanonicalHashID)
+		missing = appendIfMissing(missing, articles, pair.VariantHashID)
+	}
+	if err := c.fetchInto(ctx, missing, articles); err != nil {
+		return DetectDuplicateArticlesResponse{}, err
+	}
+
+	duplicates := domain.GroupDuplicateArticles(pairs, articles)
+	if err := c.Replacer.ReplaceArticleDuplicates(ctx, duplicates); err != nil {
+		return DetectDuplicateArticlesResponse{}, fmt.Errorf("storing article duplicates: %w", err)
+	}
+
+	logger.InfoContext(ctx, "detected duplicate articles",
+		"checked_count", checked, "duplicate_count", len(duplicates))
+
+	return DetectDuplicateArticlesResponse{Checked: checked, Duplicates: len(duplicates)}, nil
+}
+
+// listArticlesToCheck lists a page of the articles published within the lookback window.
+func (c *DetectDuplicateArticles) listArticlesToCheck(ctx context.Context, page int) ([]string, error) {
+	var filters domain.ArticleFilters
+	if c.Config.LookbackDays > 0 {
+		filters.PublishedAfter = time.Now().AddDate(0, 0, -c.Config.LookbackDays)
+	}
+
+	ids, err := c.ArticleLister.ListLatestArticleIDs(ctx, filters, domain.ArticleListOptions{
+		Page:     page,
+		PageSize: detectDuplicatesPageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing articles to check: %w", err)
+	}
+	return ids, nil
+}
+
+// findDuplicates compares each of the given articles with its most similar articles, adding
+// every article fetched to articles.
+func (c *DetectDuplicateArticles) findDuplicates(
+	ctx context.Context, ids []string, articles map[string]domain.Article,
+) ([]domain.ArticleDuplicate, error) {
+	var missing []string
+	for _, id := range ids {
+		missing = appendIfMissing(missing, articles, id)
+	}
+
+	similarByID := make(map[string][]domain.SimilarArticle, len(ids))
+	for _, id := range ids {
+		similar, err := c.Similarity.ListSimilarArticles(ctx, []string{id}, c.Config.CandidatesPerArticle)
+		if err != nil {
+			return nil, fmt.Errorf("listing articles similar to %s: %w", id, err)
+		}
+		similarByID[id] = similar
+		for _, s := range similar {
+			if s.Score >= c.Config.SimilarityThreshold {
+				missing = appendIfMissing(missing, articles, s.HashID)
+			}
+		}
+	}
+
+	if err := c.fetchInto(ctx, missing, articles); err != nil {
+		return nil, err
+	}
+
+	var pairs []domain.ArticleDuplicate
+	for _, id := range ids {
+		article, ok := articles[id]
+		if !ok {
+			continue
+		}
+		for _, s := range similarByID[id] {
+			other, ok := articles[s.HashID]
+			if ok && domain.IsDuplicateArticle(article, other, s.Score, c.Config) {
+				pairs = append(pairs, domain.ArticleDuplicate{CanonicalHashID: id, VariantHashID: s.HashID})
+			}
+		}
+	}
+	return pairs, nil
+}
+
+// fetchInto fetches the given articles and adds them to articles.
+func (c *DetectDuplicateArticles) fetchInto(
+	ctx context.Context, ids []string, articles map[string]domain.Article,
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	fetched, err := c.ArticleFetcher.FetchArticlesByID(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("fetching articles: %w", err)
+	}
+	for _, article := range fetched {
+		articles[article.HashID] = article
+	}
+	return nil
+}
+
+// appendIfMissing appends id to ids if it isn't in articles or already in ids.
+func appendIfMissing(ids []string, articles map[string]domain.Article, id string) []string {
+	if _, ok := articles[id]; ok || slices.Contains(ids, id) {
+		return ids
+	}
+	return append(ids, id)
+}
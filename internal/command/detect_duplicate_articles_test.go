@@ -0,0 +1,177 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectDuplicateArticles_Execute(t *testing.T) {
+	date := func(day int) *time.Time {
+		d := time.Date(2024, 4, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	config := domain.DuplicateConfig{SimilarityThreshold: 0.9, CandidatesPerArticle: 5}
+
+	cases := []struct {
+		name         string
+		existing     []domain.ArticleDuplicate
+		replaceErr   error
+		want         []domain.ArticleDuplicate
+		wantErr      bool
+		lookbackDays int
+	}{
+		{
+			name: "new_duplicates_grouped_with_existing",
+			existing: []domain.ArticleDuplicate{
+				{CanonicalHashID: "arxiv", VariantHashID: "af"},
+			},
+			want: []domain.ArticleDuplicate{
+				{CanonicalHashID: "arxiv", VariantHashID: "af"},
+				{CanonicalHashID: "arxiv", VariantHashID: "lw"},
+			},
+			lookbackDays: 30,
+		},
+		{
+			name: "all_articles_recomputed",
+			want: []domain.ArticleDuplicate{
+				{CanonicalHashID: "af", VariantHashID: "lw"},
+			},
+		},
+		{
+			name:         "replace_error",
+			replaceErr:   errors.New("db down"),
+			wantErr:      true,
+			lookbackDays: 30,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lister := mocks.NewLatestArticleLister(t)
+			fetcher := mocks.NewArticleFetcher(t)
+			similarity := mocks.NewSimilarArticleLister(t)
+			duplicateLister := mocks.NewArticleDuplicateLister(t)
+			replacer := mocks.NewArticleDuplicateReplacer(t)
+
+			if tc.lookbackDays > 0 {
+				duplicateLister.EXPECT().
+					ListArticleDuplicates(mock.Anything).
+					Return(tc.existing, nil)
+			}
+			lister.EXPECT().
+				ListLatestArticleIDs(mock.Anything, mock.MatchedBy(func(f domain.ArticleFilters) bool {
+					return f.PublishedAfter.IsZero() == (tc.lookbackDays == 0)
+				}), domain.ArticleListOptions{Page: 1, PageSize: 100}).
+				Return([]string{"lw"}, nil)
+			similarity.EXPECT().
+				ListSimilarArticles(mock.Anything, []string{"lw"}, 5).
+				Return([]domain.SimilarArticle{
+					{HashID: "af", Score: 0.97},
+					{HashID: "response", Score: 0.92},
+					{HashID: "unrelated", Score: 0.5},
+				}, nil)
+			fetcher.EXPECT().
+				FetchArticlesByID(mock.Anything, []string{"lw", "af", "response"}).
+				Return([]domain.Article{
+					{HashID: "lw", Title: "Refusal in LLMs", Authors: "Neel Nanda", PublishedAt: date(3)},
+					{HashID: "af", Title: "Refusal in LLMs", Authors: "Neel Nanda", PublishedAt: date(2)},
+					{HashID: "response", Title: "A reply", Authors: "Someone Else", PublishedAt: date(4)},
+				}, nil)
+			if len(tc.existing) > 0 {
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"arxiv"}).
+					Return([]domain.Article{{HashID: "arxiv", PublishedAt: date(1)}}, nil)
+			}
+			replacer.EXPECT().
+				ReplaceArticleDuplicates(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, duplicates []domain.ArticleDuplicate) error {
+					if tc.replaceErr == nil {
+						assert.Equal(t, tc.want, duplicates)
+					}
+					return tc.replaceErr
+				})
+
+			cmdConfig := config
+			cmdConfig.LookbackDays = tc.lookbackDays
+			cmd := NewDetectDuplicateArticles(lister, fetcher, similarity, duplicateLister, replacer, cmdConfig)
+
+			result, err := cmd.Execute(t.Context(), DetectDuplicateArticlesRequest{})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, DetectDuplicateArticlesResponse{Checked: 1, Duplicates: len(tc.want)}, result)
+		})
+	}
+}
+
+func TestGenerateRecommendations_Execute_CollapsesDuplicates(t *testing.T) {
+	now := time.Now()
+
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	duplicates := mocks.NewCanonicalArticleGetter(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return([]string{"read_crosspost"}, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now}}, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return(nil, nil)
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+		Return([]domain.SimilarArticle{
+			{HashID: "crosspost", Score: 0.9},
+			{HashID: "paper", Score: 0.8},
+			{HashID: "read_paper", Score: 0.7},
+			{HashID: "other", Score: 0.6},
+		}, nil)
+	duplicates.EXPECT().
+		GetCanonicalArticleIDs(mock.Anything, []string{"crosspost", "paper", "read_paper", "other", "read_crosspost"}).
+		Return(map[string]string{"crosspost": "paper", "read_crosspost": "read_paper"}, nil)
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           duplicates,
+		Config:               testGenerateRecommendationsConfig(),
+		Experiment:           RecommendationExperiment{},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+
+	// The crosspost is recommended as its paper, at its higher score, and reading a crosspost
+	// excludes its paper
+	assertScoredArticlesEqual(t, []ScoredArticle{
+		{HashID: "paper", Score: 0.9},
+		{HashID: "other", Score: 0.6},
+	}, result)
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+//go:embed templates/digest.txt.tmpl templates/digest.html.tmpl
+var digestTemplateFS embed.FS
+
+var (
+	digestTextTemplate = texttemplate.Must(texttemplate.ParseFS(digestTemplateFS, "templates/digest.txt.tmpl"))
+	digestHTMLTemplate = htmltemplate.Must(htmltemplate.ParseFS(digestTemplateFS, "templates/digest.html.tmpl"))
+)
+
+// renderDigest renders a digest as plain-text and HTML email bodies.
+func renderDigest(digest domain.Digest) (text, html string, err error) {
+	var textBuf, htmlBuf bytes.Buffer
+
+	if err := digestTextTemplate.Execute(&textBuf, digest); err != nil {
+		return "", "", fmt.Errorf("rendering text digest: %w", err)
+	}
+	if err := digestHTMLTemplate.Execute(&htmlBuf, digest); err != nil {
+		return "", "", fmt.Errorf("rendering HTML digest: %w", err)
+	}
+
+	return textBuf.String(), htmlBuf.String(), nil
+}
+
+// digestSubject returns the email subject line for a digest.
+func digestSubject(digest domain.Digest) string {
+	return fmt.Sprintf("Your %s Alignment Research Feed digest", digest.Frequency)
+}
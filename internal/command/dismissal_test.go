@@ -0,0 +1,132 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecommendArticles_Execute_ExcludesHidden(t *testing.T) {
+	cases := []struct {
+		name      string
+		hidden    []string
+		hiddenErr error
+		want      []string
+	}{
+		{name: "hidden_excluded", hidden: []string{"dismissed1", "snoozed1"}, want: []string{"rec1"}},
+		{name: "lister_error_ignored", hiddenErr: errors.New("db down"),
+			want: []string{"dismissed1", "rec1", "snoozed1"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			precomputedReader := mocks.NewPrecomputedRecommendationReader(t)
+			readArticlesLister := mocks.NewReadArticleIDsLister(t)
+			hiddenArticlesLister := mocks.NewHiddenArticleIDsLister(t)
+			articleFetcher := mocks.NewArticleFetcher(t)
+
+			precomputedReader.EXPECT().
+				GetPrecomputedRecommendationAge(mock.Anything, "user1").
+				Return(time.Now(), nil)
+			precomputedReader.EXPECT().
+				GetPrecomputedRecommendations(mock.Anything, "user1", 50).
+				Return([]datasources.PrecomputedRecommendation{
+					{ArticleHashID: "dismissed1", Score: 0.9, Source: "temporal"},
+					{ArticleHashID: "rec1", Score: 0.8, Source: "temporal"},
+					{ArticleHashID: "snoozed1", Score: 0.7, Source: "temporal"},
+				}, nil)
+			readArticlesLister.EXPECT().
+				ListReadArticleIDs(mock.Anything, "user1").
+				Return(nil, nil)
+			hiddenArticlesLister.EXPECT().
+				ListHiddenArticleIDs(mock.Anything, "user1").
+				Return(tc.hidden, tc.hiddenErr)
+
+			var articles []domain.Article
+			for _, id := range tc.want {
+				articles = append(articles, domain.Article{HashID: id})
+			}
+			articleFetcher.EXPECT().
+				FetchArticlesByID(mock.Anything, tc.want).
+				Return(articles, nil)
+
+			cmd := NewRecommendArticles(
+				nil,
+				precomputedReader,
+				mocks.NewPrecomputedRecommendationWriter(t),
+				mocks.NewUserRegeneratedMarker(t),
+				readArticlesLister,
+				hiddenArticlesLister,
+				noFollowPreferences(t),
+				nil,
+				articleFetcher,
+				RecommendArticlesConfig{PrecomputedStaleThreshold: time.Hour, PrecomputedFetchLimit: 50},
+			)
+
+			result, err := cmd.Execute(t.Context(), RecommendArticlesRequest{UserID: "user1", Limit: 10})
+			require.NoError(t, err)
+			assert.Len(t, result.Articles, len(tc.want))
+		})
+	}
+}
+
+func TestGenerateRecommendations_Execute_ExcludesHidden(t *testing.T) {
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	hiddenArticlesLister := mocks.NewHiddenArticleIDsLister(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return([]string{"read1"}, nil)
+	hiddenArticlesLister.EXPECT().
+		ListHiddenArticleIDs(mock.Anything, "user1").
+		Return([]string{"dismissed1"}, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: time.Now()}}, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return(nil, nil)
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+		Return([]domain.SimilarArticle{
+			{HashID: "dismissed1", Score: 0.9},
+			{HashID: "read1", Score: 0.8},
+			{HashID: "rec1", Score: 0.7},
+		}, nil)
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: hiddenArticlesLister,
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               testGenerateRecommendationsConfig(),
+		Experiment:           RecommendationExperiment{},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "rec1", result[0].HashID)
+}
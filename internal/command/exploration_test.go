@@ -0,0 +1,163 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRecommendations_Execute_Exploration(t *testing.T) {
+	cases := []struct {
+		name          string
+		categoriesErr error
+		wantListing   bool
+		want          []string
+		wantSources   []string
+	}{
+		{
+			name:        "explores_underexplored_category",
+			wantListing: true,
+			want:        []string{"rec1", "rec2", "explore1", "rec3"},
+			wantSources: []string{"temporal", "temporal", "explore", "temporal"},
+		},
+		{
+			name:          "exploration_error_ignored",
+			categoriesErr: errors.New("db down"),
+			want:          []string{"rec1", "rec2", "rec3", "rec4"},
+			wantSources:   []string{"temporal", "temporal", "temporal", "temporal"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+			interactionStore := mocks.NewUserArticleInteractionStore(t)
+			clusterStore := mocks.NewUserInterestClusterStore(t)
+			readArticlesLister := mocks.NewReadArticleIDsLister(t)
+			explorationLister := mocks.NewCategoryExplorationLister(t)
+			articleLister := mocks.NewLatestArticleLister(t)
+
+			readArticlesLister.EXPECT().
+				ListReadArticleIDs(mock.Anything, "user1").
+				Return([]string{"read1"}, nil)
+			interactionStore.EXPECT().
+				GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+				Return([]domain.UserArticleRating{
+					{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: time.Now()},
+				}, nil)
+			interactionStore.EXPECT().
+				GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+				Return(nil, nil)
+			clusterStore.EXPECT().
+				GetUserInterestClusters(mock.Anything, "user1").
+				Return(nil, nil)
+			vectorSimilarity.EXPECT().
+				ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+				Return([]domain.SimilarArticle{
+					{HashID: "rec1", Score: 0.9},
+					{HashID: "rec2", Score: 0.8},
+					{HashID: "rec3", Score: 0.7},
+					{HashID: "rec4", Score: 0.6},
+				}, nil)
+
+			explorationLister.EXPECT().
+				ListCategoryExploration(mock.Anything, "user1", mock.Anything).
+				Return([]domain.CategoryExploration{
+					{Category: "governance", ExploreShown: 2, ExploreEngaged: 1},
+					{Category: "interpretability", UserEngaged: 10},
+				}, tc.categoriesErr)
+			if tc.wantListing {
+				articleLister.EXPECT().
+					ListLatestArticleIDs(mock.Anything, domain.ArticleFilters{Category: "governance"},
+						mock.MatchedBy(func(options domain.ArticleListOptions) bool {
+							return options.PageSize == 5 &&
+								options.Ordering[0].Field == domain.ArticleOrderingFieldPopularity
+						})).
+					Return([]string{"read1", "rec1", "explore1"}, nil)
+			}
+
+			config := testGenerateRecommendationsConfig()
+			config.ExplorationShare = 0.25
+			config.ExplorationMaxCategoryEngaged = 2
+			config.ExplorationCandidatesPerCategory = 5
+			config.ExplorationLookbackDays = 30
+
+			cmd := &GenerateRecommendations{
+				VectorSimilarity:     vectorSimilarity,
+				VectorsGetter:        interactionStore,
+				ClusterGetter:        clusterStore,
+				ReadArticlesLister:   readArticlesLister,
+				HiddenArticlesLister: noHiddenArticles(t),
+				TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+				Popularity:           mocks.NewArticlePopularityReader(t),
+				Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+				Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+				Exploration:          explorationLister,
+				ArticleLister:        articleLister,
+				PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+				Duplicates:           noDuplicates(t),
+				Config:               config,
+				Experiment:           RecommendationExperiment{},
+			}
+
+			result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 4})
+			require.NoError(t, err)
+
+			var ids, sources []string
+			for _, r := range result {
+				ids = append(ids, r.HashID)
+				sources = append(sources, r.Source)
+			}
+			assert.Equal(t, tc.want, ids)
+			assert.Equal(t, tc.wantSources, sources)
+		})
+	}
+}
+
+func TestInterleaveExploration(t *testing.T) {
+	articles := func(ids ...string) []ScoredArticle {
+		result := make([]ScoredArticle, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, ScoredArticle{HashID: id})
+		}
+		return result
+	}
+
+	cases := []struct {
+		name     string
+		ranked   []ScoredArticle
+		explore  []ScoredArticle
+		expected []ScoredArticle
+	}{
+		{
+			name:     "spread_through_list",
+			ranked:   articles("r1", "r2", "r3", "r4", "r5", "r6"),
+			explore:  articles("e1", "e2"),
+			expected: articles("r1", "r2", "e1", "r3", "r4", "r5", "e2", "r6"),
+		},
+		{
+			name:     "no_ranked_articles",
+			ranked:   nil,
+			explore:  articles("e1", "e2"),
+			expected: articles("e1", "e2"),
+		},
+		{
+			name:     "short_ranked_list",
+			ranked:   articles("r1"),
+			explore:  articles("e1", "e2"),
+			expected: articles("e1", "e2", "r1"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, interleaveExploration(tc.ranked, tc.explore))
+		})
+	}
+}
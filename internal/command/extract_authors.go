@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ExtractAuthorsRequest is the request for the ExtractAuthors command.
+// This command takes no parameters beyond context.
+type ExtractAuthorsRequest struct{}
+
+// ExtractAuthorsResponse summarizes an author extraction run.
+type ExtractAuthorsResponse struct {
+	Authors        int
+	ArticleAuthors int
+}
+
+// ExtractAuthors recomputes the authors of every article from their free-text author lists,
+// merging different spellings of each author's name. Previously extracted authors keep their IDs.
+type ExtractAuthors struct {
+	Lister     datasources.ArticleAuthorListLister
+	NameLister datasources.AuthorNameLister
+	Replacer   datasources.AuthorReplacer
+}
+
+// NewExtractAuthors creates a properly initialized ExtractAuthors command.
+func NewExtractAuthors(
+	lister datasources.ArticleAuthorListLister,
+	nameLister datasources.AuthorNameLister,
+	replacer datasources.AuthorReplacer,
+) *ExtractAuthors {
+	return &ExtractAuthors{
+		Lister:     lister,
+		NameLister: nameLister,
+		Replacer:   replacer,
+	}
+}
+
+// Execute replaces all stored authors.
+func (c *ExtractAuthors) Execute(ctx context.Context, _ ExtractAuthorsRequest) (ExtractAuthorsResponse, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	lists, err := c.Lister.ListArticleAuthorLists(ctx)
+	if err != nil {
+		return ExtractAuthorsResponse{}, fmt.Errorf("listing article authors: %w", err)
+	}
+
+	existingIDs, err := c.NameLister.ListAuthorNames(ctx)
+	if err != nil {
+		return ExtractAuthorsResponse{}, fmt.Errorf("listing existing authors: %w", err)
+	}
+
+	authors, articleAuthors := domain.ExtractAuthors(lists, existingIDs)
+	renamedIDs := domain.AuthorIDRenames(existingIDs, authors)
+	if err := c.Replacer.ReplaceAuthors(ctx, authors, articleAuthors, renamedIDs); err != nil {
+		return ExtractAuthorsResponse{}, fmt.Errorf("storing authors: %w", err)
+	}
+
+	logger.InfoContext(ctx, "extracted authors",
+		"article_count", len(lists), "author_count", len(authors), "article_author_count", len(articleAuthors),
+		"renamed_author_count", len(renamedIDs))
+
+	return ExtractAuthorsResponse{Authors: len(authors), ArticleAuthors: len(articleAuthors)}, nil
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractAuthors_Execute(t *testing.T) {
+	cases := []struct {
+		name       string
+		listErr    error
+		namesErr   error
+		replaceErr error
+		wantErr    bool
+	}{
+		{name: "success"},
+		{name: "list_error", listErr: errors.New("db down"), wantErr: true},
+		{name: "names_error", namesErr: errors.New("db down"), wantErr: true},
+		{name: "replace_error", replaceErr: errors.New("db down"), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lister := mocks.NewArticleAuthorListLister(t)
+			nameLister := mocks.NewAuthorNameLister(t)
+			replacer := mocks.NewAuthorReplacer(t)
+
+			lister.EXPECT().
+				ListArticleAuthorLists(mock.Anything).
+				Return([]domain.ArticleAuthorList{
+					{HashID: "a1", Authors: "Neel Nanda, Andy Arditi"},
+					{HashID: "a2", Authors: "N. Nanda"},
+					{HashID: "a3", Authors: "Neel Nanda"},
+				}, tc.listErr)
+			if tc.listErr == nil {
+				// Andy Arditi was extracted before under an older ID, and N. Nanda as a separate author
+				nameLister.EXPECT().ListAuthorNames(mock.Anything).
+					Return(map[string]string{
+						"Andy Arditi": "andrew-arditi",
+						"Neel Nanda":  "neel-nanda",
+						"N. Nanda":    "n-nanda",
+					}, tc.namesErr)
+			}
+			if tc.listErr == nil && tc.namesErr == nil {
+				replacer.EXPECT().
+					ReplaceAuthors(mock.Anything,
+						[]domain.Author{
+							{ID: "andrew-arditi", Name: "Andy Arditi"},
+							{ID: "neel-nanda", Name: "Neel Nanda", Aliases: []string{"N. Nanda"}},
+						},
+						[]domain.ArticleAuthor{
+							{ArticleHashID: "a1", AuthorID: "neel-nanda", Position: 0},
+							{ArticleHashID: "a1", AuthorID: "andrew-arditi", Position: 1},
+							{ArticleHashID: "a2", AuthorID: "neel-nanda", Position: 0},
+							{ArticleHashID: "a3", AuthorID: "neel-nanda", Position: 0},
+						},
+						map[string]string{"n-nanda": "neel-nanda"}).
+					Return(tc.replaceErr)
+			}
+
+			cmd := NewExtractAuthors(lister, nameLister, replacer)
+
+			result, err := cmd.Execute(t.Context(), ExtractAuthorsRequest{})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, ExtractAuthorsResponse{Authors: 2, ArticleAuthors: 4}, result)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// MaxFollowsPerUser is the maximum number of authors, sources and categories a user can follow.
+const MaxFollowsPerUser = 200
+
+// ErrFollowLimitExceeded is returned when a user has reached the maximum number of follows.
+var ErrFollowLimitExceeded = errors.New("user has reached maximum number of follows")
+
+// ErrFollowedAuthorNotFound is returned when following an author ID that doesn't exist.
+var ErrFollowedAuthorNotFound = errors.New("followed author not found")
+
+// FollowItemRequest is the request for the FollowItem command.
+type FollowItemRequest struct {
+	UserID string
+	Type   domain.FollowType
+	Target string
+}
+
+// FollowItem follows an author, source or category for a user,
+// creating their following feed token the first time they follow anything.
+type FollowItem struct {
+	AuthorGetter       datasources.AuthorGetter
+	FollowLister       datasources.FollowLister
+	Follower           datasources.ItemFollower
+	PreferencesCreator datasources.FollowPreferencesCreator
+}
+
+// NewFollowItem creates a properly initialized FollowItem command.
+func NewFollowItem(
+	authorGetter datasources.AuthorGetter,
+	followLister datasources.FollowLister,
+	follower datasources.ItemFollower,
+	preferencesCreator datasources.FollowPreferencesCreator,
+) *FollowItem {
+	return &FollowItem{
+		AuthorGetter:       authorGetter,
+		FollowLister:       followLister,
+		Follower:           follower,
+		PreferencesCreator: preferencesCreator,
+	}
+}
+
+// Execute follows the item. Following something already followed succeeds without change.
+func (c *FollowItem) Execute(ctx context.Context, req FollowItemRequest) (Empty, error) {
+	if req.Type == domain.FollowTypeAuthor {
+		_, found, err := c.AuthorGetter.GetAuthor(ctx, req.Target)
+		if err != nil {
+			return Empty{}, fmt.Errorf("fetching followed author: %w", err)
+		}
+		if !found {
+			return Empty{}, ErrFollowedAuthorNotFound
+		}
+	}
+
+	follows, err := c.FollowLister.ListFollows(ctx, req.UserID)
+	if err != nil {
+		return Empty{}, fmt.Errorf("listing follows: %w", err)
+	}
+	for _, f := range follows {
+		if f.Type == req.Type && f.Target == req.Target {
+			return Empty{}, nil
+		}
+	}
+	if len(follows) >= MaxFollowsPerUser {
+		return Empty{}, ErrFollowLimitExceeded
+	}
+
+	if err := c.Follower.FollowItem(ctx, req.UserID, req.Type, req.Target); err != nil {
+		return Empty{}, fmt.Errorf("following item: %w", err)
+	}
+
+	// The creation is a no-op if the user already has preferences,
+	// so their existing feed URL keeps working.
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return Empty{}, fmt.Errorf("generating feed token: %w", err)
+	}
+	if err := c.PreferencesCreator.CreateFollowPreferences(
+		ctx, req.UserID, hex.EncodeToString(tokenBytes),
+	); err != nil {
+		return Empty{}, fmt.Errorf("creating follow preferences: %w", err)
+	}
+
+	return Empty{}, nil
+}
@@ -0,0 +1,287 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowItem_Execute(t *testing.T) {
+	maxFollows := make([]domain.Follow, MaxFollowsPerUser)
+	for i := range maxFollows {
+		maxFollows[i] = domain.Follow{Type: domain.FollowTypeSource, Target: fmt.Sprintf("source%d", i)}
+	}
+
+	cases := []struct {
+		name        string
+		req         FollowItemRequest
+		authorFound bool
+		existing    []domain.Follow
+		wantFollow  bool
+		wantErr     error
+	}{
+		{
+			name:       "source",
+			req:        FollowItemRequest{UserID: "user1", Type: domain.FollowTypeSource, Target: "arxiv"},
+			wantFollow: true,
+		},
+		{
+			name:        "author",
+			req:         FollowItemRequest{UserID: "user1", Type: domain.FollowTypeAuthor, Target: "neel-nanda"},
+			authorFound: true,
+			wantFollow:  true,
+		},
+		{
+			name:    "unknown_author",
+			req:     FollowItemRequest{UserID: "user1", Type: domain.FollowTypeAuthor, Target: "nobody"},
+			wantErr: ErrFollowedAuthorNotFound,
+		},
+		{
+			name:     "limit_exceeded",
+			req:      FollowItemRequest{UserID: "user1", Type: domain.FollowTypeCategory, Target: "Interpretability"},
+			existing: maxFollows,
+			wantErr:  ErrFollowLimitExceeded,
+		},
+		{
+			name:     "already_followed_at_limit",
+			req:      FollowItemRequest{UserID: "user1", Type: domain.FollowTypeSource, Target: "source0"},
+			existing: maxFollows,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorGetter := mocks.NewAuthorGetter(t)
+			followLister := mocks.NewFollowLister(t)
+			follower := mocks.NewItemFollower(t)
+			prefsCreator := mocks.NewFollowPreferencesCreator(t)
+
+			if tc.req.Type == domain.FollowTypeAuthor {
+				authorGetter.EXPECT().
+					GetAuthor(mock.Anything, tc.req.Target).
+					Return(domain.Author{ID: tc.req.Target}, tc.authorFound, nil)
+			}
+			if tc.req.Type != domain.FollowTypeAuthor || tc.authorFound {
+				followLister.EXPECT().ListFollows(mock.Anything, "user1").Return(tc.existing, nil)
+			}
+			if tc.wantFollow {
+				follower.EXPECT().FollowItem(mock.Anything, "user1", tc.req.Type, tc.req.Target).Return(nil)
+				prefsCreator.EXPECT().
+					CreateFollowPreferences(mock.Anything, "user1", mock.AnythingOfType("string")).
+					Return(nil)
+			}
+
+			cmd := NewFollowItem(authorGetter, followLister, follower, prefsCreator)
+
+			_, err := cmd.Execute(t.Context(), tc.req)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetFollowPreferences_Execute(t *testing.T) {
+	store := mocks.NewUserFollowPreferencesRepository(t)
+
+	store.EXPECT().
+		UpsertFollowPreferences(mock.Anything, mock.MatchedBy(func(p domain.FollowPreferences) bool {
+			return p.UserID == "user1" && p.IncludeInRecommendations && len(p.FeedToken) == 64
+		})).
+		Return(nil)
+	store.EXPECT().
+		GetFollowPreferences(mock.Anything, "user1").
+		Return(domain.FollowPreferences{UserID: "user1", IncludeInRecommendations: true, FeedToken: "existing"},
+			true, nil)
+
+	cmd := NewSetFollowPreferences(store)
+
+	prefs, err := cmd.Execute(t.Context(), SetFollowPreferencesRequest{UserID: "user1", IncludeInRecommendations: true})
+	require.NoError(t, err)
+	assert.Equal(t, "existing", prefs.FeedToken)
+}
+
+func TestListFollowedArticleIDs_Execute(t *testing.T) {
+	cases := []struct {
+		name    string
+		follows []domain.Follow
+		want    []string
+	}{
+		{
+			name: "follows",
+			follows: []domain.Follow{
+				{Type: domain.FollowTypeAuthor, Target: "neel-nanda"},
+				{Type: domain.FollowTypeSource, Target: "arxiv"},
+				{Type: domain.FollowTypeCategory, Target: "Interpretability"},
+			},
+			want: []string{"a1", "a2"},
+		},
+		{
+			name: "no_follows",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			followLister := mocks.NewFollowLister(t)
+			latestLister := mocks.NewLatestArticleLister(t)
+
+			options := domain.ArticleListOptions{Page: 2, PageSize: 10}
+			followLister.EXPECT().ListFollows(mock.Anything, "user1").Return(tc.follows, nil)
+			if len(tc.follows) > 0 {
+				latestLister.EXPECT().
+					ListLatestArticleIDs(mock.Anything, domain.ArticleFilters{
+						SourcesAllowlist: []string{"lesswrong", "arxiv"},
+						Followed: domain.FollowedItems{
+							AuthorIDs:  []string{"neel-nanda"},
+							Sources:    []string{"arxiv"},
+							Categories: []string{"Interpretability"},
+						},
+					}, options).
+					Return(tc.want, nil)
+			}
+
+			cmd := NewListFollowedArticleIDs(followLister, latestLister)
+
+			ids, err := cmd.Execute(t.Context(), ListFollowedArticleIDsRequest{
+				UserID:  "user1",
+				Filters: domain.ArticleFilters{SourcesAllowlist: []string{"lesswrong", "arxiv"}},
+				Options: options,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestRecommendArticles_Execute_IncludesFollowed(t *testing.T) {
+	cases := []struct {
+		name       string
+		prefs      domain.FollowPreferences
+		found      bool
+		followErr  error
+		limit      int
+		wantIDs    []string
+		wantSource []string
+	}{
+		{
+			name:       "included",
+			prefs:      domain.FollowPreferences{IncludeInRecommendations: true},
+			found:      true,
+			limit:      3,
+			wantIDs:    []string{"f1", "f2", "rec1"},
+			wantSource: []string{"following", "following", "temporal"},
+		},
+		{
+			name:       "not_included",
+			prefs:      domain.FollowPreferences{},
+			found:      true,
+			limit:      3,
+			wantIDs:    []string{"rec1", "f2", "rec2"},
+			wantSource: []string{"temporal", "temporal", "temporal"},
+		},
+		{
+			name:       "no_preferences",
+			limit:      3,
+			wantIDs:    []string{"rec1", "f2", "rec2"},
+			wantSource: []string{"temporal", "temporal", "temporal"},
+		},
+		{
+			name:       "followed_list_error",
+			prefs:      domain.FollowPreferences{IncludeInRecommendations: true},
+			found:      true,
+			followErr:  errors.New("db down"),
+			limit:      3,
+			wantIDs:    []string{"rec1", "f2", "rec2"},
+			wantSource: []string{"temporal", "temporal", "temporal"},
+		},
+		{
+			name:       "limited_to_max",
+			prefs:      domain.FollowPreferences{IncludeInRecommendations: true},
+			found:      true,
+			limit:      1,
+			wantIDs:    []string{"f1"},
+			wantSource: []string{"following"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			precomputedReader := mocks.NewPrecomputedRecommendationReader(t)
+			readArticlesLister := mocks.NewReadArticleIDsLister(t)
+			followPrefs := mocks.NewFollowPreferencesGetter(t)
+			followedCmd := cmdmocks.NewCommand[ListFollowedArticleIDsRequest, []string](t)
+			articleFetcher := mocks.NewArticleFetcher(t)
+
+			precomputedReader.EXPECT().
+				GetPrecomputedRecommendationAge(mock.Anything, "user1").
+				Return(time.Now(), nil)
+			precomputedReader.EXPECT().
+				GetPrecomputedRecommendations(mock.Anything, "user1", 50).
+				Return([]datasources.PrecomputedRecommendation{
+					{ArticleHashID: "rec1", Score: 0.9, Source: "temporal"},
+					{ArticleHashID: "f2", Score: 0.8, Source: "temporal"},
+					{ArticleHashID: "rec2", Score: 0.7, Source: "temporal"},
+					{ArticleHashID: "rec3", Score: 0.6, Source: "temporal"},
+				}, nil)
+			readArticlesLister.EXPECT().
+				ListReadArticleIDs(mock.Anything, "user1").
+				Return([]string{"f3"}, nil)
+			followPrefs.EXPECT().
+				GetFollowPreferences(mock.Anything, "user1").
+				Return(tc.prefs, tc.found, nil)
+			if tc.prefs.IncludeInRecommendations {
+				followedCmd.EXPECT().
+					Execute(mock.Anything, mock.MatchedBy(func(req ListFollowedArticleIDsRequest) bool {
+						return req.UserID == "user1" && req.Options.PageSize == 20 &&
+							time.Since(req.Filters.PublishedAfter) > 13*24*time.Hour
+					})).
+					Return([]string{"f3", "f1", "f2"}, tc.followErr)
+			}
+
+			var articles []domain.Article
+			for _, id := range tc.wantIDs {
+				articles = append(articles, domain.Article{HashID: id})
+			}
+			articleFetcher.EXPECT().FetchArticlesByID(mock.Anything, tc.wantIDs).Return(articles, nil)
+
+			cmd := NewRecommendArticles(
+				nil,
+				precomputedReader,
+				mocks.NewPrecomputedRecommendationWriter(t),
+				mocks.NewUserRegeneratedMarker(t),
+				readArticlesLister,
+				noHiddenArticles(t),
+				followPrefs,
+				followedCmd,
+				articleFetcher,
+				RecommendArticlesConfig{
+					PrecomputedStaleThreshold: time.Hour,
+					PrecomputedFetchLimit:     50,
+					FollowedLookbackDays:      14,
+					FollowedFetchLimit:        20,
+					FollowedMaxArticles:       2,
+				},
+			)
+
+			result, err := cmd.Execute(t.Context(), RecommendArticlesRequest{UserID: "user1", Limit: tc.limit})
+			require.NoError(t, err)
+			require.Len(t, result.Impressions, len(tc.wantIDs))
+			for i, impression := range result.Impressions {
+				assert.Equal(t, tc.wantIDs[i], impression.ArticleHashID)
+				assert.Equal(t, tc.wantSource[i], impression.Source)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package command
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"sort"
 	"time"
 
@@ -11,9 +13,11 @@ import (
 )
 
 // GenerateRecommendationsRequest is the request for the GenerateRecommendations command.
+// PublishedAfter, if set, limits recommendations to articles published after it.
 type GenerateRecommendationsRequest struct {
-	UserID string
-	Limit  int
+	UserID         string
+	Limit          int
+	PublishedAfter time.Time
 }
 
 // GenerateRecommendationsConfig holds configuration for recommendation generation.
@@ -22,6 +26,10 @@ type GenerateRecommendationsConfig struct {
 	// After this many days, a rating has half its original weight.
 	TemporalDecayHalfLifeDays float64
 
+	// SignalWeights weights each article's vector by the strength of the user's signals on it,
+	// so a 5 rating or long read counts for more than a plain like or an opened link.
+	SignalWeights domain.SignalWeights
+
 	// NegativeSignalWeight controls how much thumbs-down ratings penalize recommendations.
 	// Range: 0.0 (no penalty) to 1.0 (full penalty)
 	NegativeSignalWeight float64
@@ -32,32 +40,139 @@ type GenerateRecommendationsConfig struct {
 
 	// CandidatesPerCluster is how many candidates to retrieve per cluster.
 	CandidatesPerCluster int
+
+	// TagCooccurrenceWeight is the score given to the strongest tag co-occurrence candidate:
+	// an article other users gave the same tags as the user, counting only users who share
+	// their tags. Weaker candidates score proportionally less. 0 disables the signal.
+	TagCooccurrenceWeight float64
+
+	// TagCooccurrenceCandidates is how many tag co-occurrence candidates to retrieve.
+	TagCooccurrenceCandidates int
+
+	// CollaborativeWeight is the score given to the strongest collaborative filtering candidate:
+	// an article liked by the users who liked the same articles as the user. Weaker candidates
+	// score proportionally less. 0 disables the signal.
+	CollaborativeWeight float64
+
+	// CollaborativeCandidates is how many collaborative filtering candidates to retrieve.
+	CollaborativeCandidates int
+
+	// PopularityWindow is the window popularity scores are taken from, for both the
+	// popularity fallback and the popularity prior.
+	PopularityWindow domain.PopularityWindow
+
+	// PopularityPriorWeight is how much popularity adds to a candidate's score.
+	// The most popular candidate gains this much and others proportionally less;
+	// candidates with a negative popularity score lose score. 0 disables the prior.
+	PopularityPriorWeight float64
+
+	// RecencyHalfLifeDays is the article age in days at which recency scoring has taken away
+	// half of what it can from an article's score.
+	RecencyHalfLifeDays float64
+
+	// RecencyWeight is the most recency scoring can take from the score of an old article,
+	// as a share of the score. Newer articles lose less, and articles without a publication
+	// date are left alone. Range: 0.0 (disabled) to 1.0
+	RecencyWeight float64
+
+	// FreshCandidateMultiplier scales how many candidates each source retrieves when
+	// recommendations are limited to recently published articles, since most candidates
+	// will be too old.
+	FreshCandidateMultiplier int
+
+	// ColdStartMinRatings is the number of positive explicit ratings (thumbs up, or 4 and 5 ratings)
+	// below which popular articles are added to fill the list. Articles the user only engaged with,
+	// by opening, reading or saving them, don't count. 0 disables the popularity fallback.
+	ColdStartMinRatings int
+
+	// PopularityFallbackWeight is the score given to the most popular fallback candidate.
+	// Less popular candidates score proportionally less.
+	PopularityFallbackWeight float64
+
+	// PopularityFallbackCandidates is how many popular candidates to retrieve.
+	PopularityFallbackCandidates int
+
+	// IgnoredMinDays is the number of days an article must have been shown to the user
+	// without being read or rated before it is demoted. 0 disables demotion.
+	IgnoredMinDays int
+
+	// IgnoredMaxPosition limits the impressions counted towards demotion to the top positions
+	// of the list, since articles further down may never have been seen.
+	IgnoredMaxPosition int
+
+	// IgnoredLookbackDays limits the impressions counted towards demotion to recent days,
+	// so that demoted articles eventually recover.
+	IgnoredLookbackDays int
+
+	// IgnoredDemotion multiplies the score of an ignored article once for each day it was
+	// shown from IgnoredMinDays on. Range: 0.0 (drop entirely) to 1.0 (no demotion)
+	IgnoredDemotion float64
+
+	// ExplorationShare is the share of the list given to exploration: popular articles from
+	// categories the user has engaged with little, interleaved through the list with the
+	// "explore" source. Categories are picked by Thompson sampling on how the user received
+	// earlier exploration recommendations. Range: 0.0 (disabled) to 1.0
+	ExplorationShare float64
+
+	// ExplorationMaxCategoryEngaged is the most articles a user may have read, liked or engaged
+	// with in a category for it to still be explored.
+	ExplorationMaxCategoryEngaged int
+
+	// ExplorationCandidatesPerCategory is how many of a category's most popular articles are
+	// considered when exploring it, so one remains once read and hidden articles are left out.
+	ExplorationCandidatesPerCategory int
+
+	// ExplorationLookbackDays limits the exploration outcomes categories are picked by to
+	// recent days, so categories the user ignored are eventually tried again.
+	ExplorationLookbackDays int
 }
 
-// GenerateRecommendations generates recommendations using vector similarity,
-// temporal decay, multi-interest clustering, and negative signal integration.
+// GenerateRecommendations generates recommendations from the user's signals by vector
+// similarity, blending in their interest clusters, tag co-occurrence, collaborative
+// filtering, popularity and category exploration as configured, and leaving out articles
+// they have read, hidden or repeatedly ignored. Users in an experiment arm are given
+// recommendations generated with the arm's config instead of Config.
 type GenerateRecommendations struct {
-	VectorSimilarity   datasources.SimilarArticlesByVectorLister
-	VectorsGetter      datasources.UserArticleVectorsGetter
-	ClusterGetter      datasources.UserInterestClusterGetter
-	ReadArticlesLister datasources.ReadArticleIDsLister
-	Config             GenerateRecommendationsConfig
+	VectorSimilarity     datasources.SimilarArticlesByVectorLister
+	VectorsGetter        datasources.UserArticleVectorsGetter
+	ClusterGetter        datasources.UserInterestClusterGetter
+	ReadArticlesLister   datasources.ReadArticleIDsLister
+	HiddenArticlesLister datasources.HiddenArticleIDsLister
+	TagCooccurrence      datasources.TagCooccurrenceLister
+	Popularity           datasources.ArticlePopularityReader
+	Collaborative        datasources.CollaborativeCandidateLister
+	Impressions          datasources.IgnoredRecommendationCounter
+	Exploration          datasources.CategoryExplorationLister
+	ArticleLister        datasources.LatestArticleLister
+	PublishedDates       datasources.ArticlePublishedDateGetter
+	Duplicates           datasources.CanonicalArticleGetter
+	Config               GenerateRecommendationsConfig
+	Experiment           RecommendationExperiment
 }
 
 // NewGenerateRecommendations creates a properly initialized GenerateRecommendations command.
 func NewGenerateRecommendations(
 	vectorSimilarity datasources.SimilarArticlesByVectorLister,
-	vectorsGetter datasources.UserArticleVectorsGetter,
-	clusterGetter datasources.UserInterestClusterGetter,
-	readArticlesLister datasources.ReadArticleIDsLister,
+	dataset datasources.RecommendationDataReader,
 	config GenerateRecommendationsConfig,
+	experiment RecommendationExperiment,
 ) *GenerateRecommendations {
 	return &GenerateRecommendations{
-		VectorSimilarity:   vectorSimilarity,
-		VectorsGetter:      vectorsGetter,
-		ClusterGetter:      clusterGetter,
-		ReadArticlesLister: readArticlesLister,
-		Config:             config,
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        dataset,
+		ClusterGetter:        dataset,
+		ReadArticlesLister:   dataset,
+		HiddenArticlesLister: dataset,
+		TagCooccurrence:      dataset,
+		Popularity:           dataset,
+		Collaborative:        dataset,
+		Impressions:          dataset,
+		Exploration:          dataset,
+		ArticleLister:        dataset,
+		PublishedDates:       dataset,
+		Duplicates:           dataset,
+		Config:               config,
+		Experiment:           experiment,
 	}
 }
 
@@ -65,14 +180,48 @@ func NewGenerateRecommendations(
 type ScoredArticle struct {
 	HashID string
 	Score  float64
-	Source string // "temporal", "cluster_N", etc.
+	Source string // "temporal", "cluster_N", "provisional_cluster_N", "tag_cooccurrence", "explore", etc.
+
+	// ExperimentArm is the name of the experiment arm whose config generated the recommendation, if any.
+	ExperimentArm string
 }
 
-// Execute generates recommendations for a user using vector similarity.
+// Execute generates recommendations for a user using vector similarity,
+// with the config of the user's experiment arm if an experiment is running.
 func (c *GenerateRecommendations) Execute(
 	ctx context.Context, req GenerateRecommendationsRequest,
 ) ([]ScoredArticle, error) {
-	readArticleIDs := readArticleIDSet(ctx, c.ReadArticlesLister, req.UserID)
+	arm, ok := c.Experiment.ArmForUser(req.UserID)
+	if !ok {
+		return c.generate(ctx, req)
+	}
+
+	variant := *c
+	variant.Config = arm.Config
+	scored, err := variant.generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range scored {
+		scored[i].ExperimentArm = arm.Name
+	}
+	return scored, nil
+}
+
+// generate generates recommendations for a user using the command's config.
+func (c *GenerateRecommendations) generate(
+	ctx context.Context, req GenerateRecommendationsRequest,
+) ([]ScoredArticle, error) {
+	if !req.PublishedAfter.IsZero() && c.Config.FreshCandidateMultiplier > 1 {
+		// Most candidates will be too old, so retrieve more of them
+		fresh := *c
+		fresh.Config = c.Config.withCandidatesScaled(c.Config.FreshCandidateMultiplier)
+		c = &fresh
+	}
+
+	excludeIDs := readArticleIDSet(ctx, c.ReadArticlesLister, req.UserID)
+	addHiddenArticleIDs(ctx, c.HiddenArticlesLister, req.UserID, excludeIDs)
 
 	thumbsUpVectors, err := c.VectorsGetter.GetUserArticleVectorsByType(
 		ctx, req.UserID, domain.RatingTypeThumbsUp,
@@ -81,24 +230,80 @@ func (c *GenerateRecommendations) Execute(
 		return nil, fmt.Errorf("getting thumbs up vectors: %w", err)
 	}
 
-	if len(thumbsUpVectors) == 0 {
-		return nil, nil
-	}
-
+	// Users without ratings may still have provisional clusters from onboarding,
+	// and the popularity fallback covers users with neither
 	negativeVector := c.getNegativeVector(ctx, req.UserID)
 
 	var candidates []ScoredArticle
 	candidates = append(candidates, c.getCandidatesUsingClusters(ctx, req.UserID, negativeVector)...)
 	candidates = append(candidates, c.getCandidatesUsingTemporalVector(ctx, thumbsUpVectors, negativeVector)...)
+	candidates = append(candidates, c.getCandidatesUsingTagCooccurrence(ctx, req.UserID)...)
+	candidates = append(candidates, c.getCandidatesUsingCollaborative(ctx, req.UserID)...)
+	candidates = append(candidates, c.getCandidatesUsingPopularity(ctx, countExplicitRatings(thumbsUpVectors))...)
+
+	c.collapseDuplicates(ctx, candidates, excludeIDs)
 
-	if len(candidates) == 0 {
-		return nil, nil
+	var ranked []ScoredArticle
+	if len(candidates) > 0 {
+		c.applyPopularityPrior(ctx, candidates)
+		c.applyIgnoredDemotion(ctx, req.UserID, candidates)
+		candidates, err = c.applyRecency(ctx, candidates, req.PublishedAfter)
+		if err != nil {
+			return nil, err
+		}
+		ranked = c.rankAndDeduplicate(candidates, req.Limit, excludeIDs)
 	}
 
-	return c.rankAndDeduplicate(candidates, req.Limit, readArticleIDs), nil
+	return c.addExploration(ctx, req, ranked, excludeIDs), nil
 }
 
-// getNegativeVector computes the negative signal vector from thumbs-down ratings.
+// collapseDuplicates replaces candidates that are duplicates of another article with their
+// canonical article, so each work is recommended once, and excludes the canonical articles of
+// excluded duplicates, so reading one version of a work excludes the others.
+// Leaves candidates unchanged on error (best-effort).
+func (c *GenerateRecommendations) collapseDuplicates(
+	ctx context.Context, candidates []ScoredArticle, excludeIDs map[string]struct{},
+) {
+	hashIDs := make([]string, 0, len(candidates)+len(excludeIDs))
+	for _, cand := range candidates {
+		hashIDs = append(hashIDs, cand.HashID)
+	}
+	for id := range excludeIDs {
+		hashIDs = append(hashIDs, id)
+	}
+
+	canonical, err := c.Duplicates.GetCanonicalArticleIDs(ctx, hashIDs)
+	if err != nil {
+		logger := domain.LoggerFromContext(ctx)
+		logger.WarnContext(ctx, "failed to get canonical articles", "error", err)
+		return
+	}
+
+	for id := range excludeIDs {
+		if canonicalID, ok := canonical[id]; ok {
+			excludeIDs[canonicalID] = struct{}{}
+		}
+	}
+	for i := range candidates {
+		if canonicalID, ok := canonical[candidates[i].HashID]; ok {
+			candidates[i].HashID = canonicalID
+		}
+	}
+}
+
+// withCandidatesScaled returns a copy of the config retrieving multiplier times as many
+// candidates from each source.
+func (c GenerateRecommendationsConfig) withCandidatesScaled(multiplier int) GenerateRecommendationsConfig {
+	c.CandidatesPerCluster *= multiplier
+	c.TagCooccurrenceCandidates *= multiplier
+	c.CollaborativeCandidates *= multiplier
+	c.PopularityFallbackCandidates *= multiplier
+	c.ExplorationCandidatesPerCategory *= multiplier
+	return c
+}
+
+// getNegativeVector computes the negative signal vector from thumbs-down ratings,
+// weighted by how negative each rating was.
 func (c *GenerateRecommendations) getNegativeVector(ctx context.Context, userID string) []float32 {
 	if c.Config.NegativeSignalWeight <= 0 {
 		return nil
@@ -173,6 +378,253 @@ func (c *GenerateRecommendations) getCandidatesUsingTemporalVector(
 	return candidates
 }
 
+// getCandidatesUsingTagCooccurrence retrieves candidates that other users sharing their tags
+// tagged the same way as the user, scored relative to the most shared candidate.
+func (c *GenerateRecommendations) getCandidatesUsingTagCooccurrence(
+	ctx context.Context,
+	userID string,
+) []ScoredArticle {
+	if c.Config.TagCooccurrenceWeight <= 0 {
+		return nil
+	}
+
+	logger := domain.LoggerFromContext(ctx)
+	cooccurring, err := c.TagCooccurrence.ListTagCooccurringArticles(
+		ctx, userID, c.Config.TagCooccurrenceCandidates,
+	)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get tag co-occurrence candidates", "error", err)
+		return nil
+	}
+
+	var maxCount int64
+	for _, co := range cooccurring {
+		maxCount = max(maxCount, co.Count)
+	}
+	if maxCount == 0 {
+		return nil
+	}
+
+	candidates := make([]ScoredArticle, 0, len(cooccurring))
+	for _, co := range cooccurring {
+		candidates = append(candidates, ScoredArticle{
+			HashID: co.HashID,
+			Score:  c.Config.TagCooccurrenceWeight * float64(co.Count) / float64(maxCount),
+			Source: "tag_cooccurrence",
+		})
+	}
+
+	return candidates
+}
+
+// getCandidatesUsingCollaborative retrieves neighbours of the articles the user has liked,
+// from item-item collaborative filtering, scored relative to the strongest candidate.
+func (c *GenerateRecommendations) getCandidatesUsingCollaborative(
+	ctx context.Context,
+	userID string,
+) []ScoredArticle {
+	if c.Config.CollaborativeWeight <= 0 {
+		return nil
+	}
+
+	logger := domain.LoggerFromContext(ctx)
+	neighbours, err := c.Collaborative.ListCollaborativeCandidates(ctx, userID, c.Config.CollaborativeCandidates)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get collaborative candidates", "error", err)
+		return nil
+	}
+
+	var maxScore float64
+	for _, n := range neighbours {
+		maxScore = max(maxScore, n.Score)
+	}
+	if maxScore <= 0 {
+		return nil
+	}
+
+	candidates := make([]ScoredArticle, 0, len(neighbours))
+	for _, n := range neighbours {
+		candidates = append(candidates, ScoredArticle{
+			HashID: n.HashID,
+			Score:  c.Config.CollaborativeWeight * n.Score / maxScore,
+			Source: "collaborative",
+		})
+	}
+
+	return candidates
+}
+
+// getCandidatesUsingPopularity retrieves the most popular articles as fallback candidates
+// for users with too few ratings to personalize well, scored relative to the most popular.
+func (c *GenerateRecommendations) getCandidatesUsingPopularity(
+	ctx context.Context,
+	ratingCount int,
+) []ScoredArticle {
+	if ratingCount >= c.Config.ColdStartMinRatings || c.Config.PopularityFallbackWeight <= 0 {
+		return nil
+	}
+
+	logger := domain.LoggerFromContext(ctx)
+	popular, err := c.Popularity.ListPopularArticles(
+		ctx, c.Config.PopularityWindow, 1, c.Config.PopularityFallbackCandidates,
+	)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get popular candidates", "error", err)
+		return nil
+	}
+
+	var maxScore float64
+	for _, p := range popular {
+		maxScore = max(maxScore, p.Score)
+	}
+	if maxScore <= 0 {
+		return nil
+	}
+
+	candidates := make([]ScoredArticle, 0, len(popular))
+	for _, p := range popular {
+		if p.Score <= 0 {
+			continue
+		}
+		candidates = append(candidates, ScoredArticle{
+			HashID: p.HashID,
+			Score:  c.Config.PopularityFallbackWeight * p.Score / maxScore,
+			Source: "popular",
+		})
+	}
+
+	return candidates
+}
+
+// countExplicitRatings counts the ratings the user gave explicitly, leaving out articles
+// they only engaged with.
+func countExplicitRatings(ratings []domain.UserArticleRating) int {
+	count := 0
+	for _, rating := range ratings {
+		if rating.IsExplicit() {
+			count++
+		}
+	}
+	return count
+}
+
+// applyPopularityPrior adjusts candidate scores in place by how popular each article is
+// across all users, relative to the most popular candidate. Popularity fallback candidates
+// are already scored by popularity and are left alone.
+func (c *GenerateRecommendations) applyPopularityPrior(ctx context.Context, candidates []ScoredArticle) {
+	if c.Config.PopularityPriorWeight <= 0 {
+		return
+	}
+
+	hashIDs := make([]string, 0, len(candidates))
+	seen := make(map[string]struct{}, len(candidates))
+	for _, cand := range candidates {
+		if _, ok := seen[cand.HashID]; !ok {
+			seen[cand.HashID] = struct{}{}
+			hashIDs = append(hashIDs, cand.HashID)
+		}
+	}
+
+	logger := domain.LoggerFromContext(ctx)
+	popularity, err := c.Popularity.GetArticlePopularity(ctx, c.Config.PopularityWindow, hashIDs)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get candidate popularity", "error", err)
+		return
+	}
+
+	var maxScore float64
+	for _, p := range popularity {
+		maxScore = max(maxScore, math.Abs(p.Score))
+	}
+	if maxScore == 0 {
+		return
+	}
+
+	for i, cand := range candidates {
+		p, ok := popularity[cand.HashID]
+		if !ok || cand.Source == "popular" {
+			continue
+		}
+		candidates[i].Score += c.Config.PopularityPriorWeight * p.Score / maxScore
+	}
+}
+
+// applyIgnoredDemotion lowers the scores of candidates the user has repeatedly been shown
+// near the top of their recommendations without reading or rating them, more so the more
+// days they were shown. Errors are logged and leave scores unchanged.
+func (c *GenerateRecommendations) applyIgnoredDemotion(
+	ctx context.Context, userID string, candidates []ScoredArticle,
+) {
+	if c.Config.IgnoredMinDays <= 0 {
+		return
+	}
+
+	logger := domain.LoggerFromContext(ctx)
+	since := time.Now().AddDate(0, 0, -c.Config.IgnoredLookbackDays)
+	ignored, err := c.Impressions.CountIgnoredRecommendations(
+		ctx, userID, since, c.Config.IgnoredMaxPosition, c.Config.IgnoredMinDays,
+	)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to count ignored recommendations", "error", err)
+		return
+	}
+
+	for i := range candidates {
+		days, ok := ignored[candidates[i].HashID]
+		if !ok || candidates[i].Score <= 0 {
+			continue
+		}
+		candidates[i].Score *= math.Pow(c.Config.IgnoredDemotion, float64(days-c.Config.IgnoredMinDays+1))
+	}
+}
+
+// applyRecency scales candidate scores down by the age of each article, and if publishedAfter
+// is set, leaves out articles not published after it, including those without a publication date.
+// Errors looking up publication dates are logged and leave scores unchanged, unless
+// publishedAfter is set, as the list can't then be limited to recent articles.
+func (c *GenerateRecommendations) applyRecency(
+	ctx context.Context, candidates []ScoredArticle, publishedAfter time.Time,
+) ([]ScoredArticle, error) {
+	if c.Config.RecencyWeight <= 0 && publishedAfter.IsZero() {
+		return candidates, nil
+	}
+
+	hashIDs := make([]string, 0, len(candidates))
+	seen := make(map[string]struct{}, len(candidates))
+	for _, cand := range candidates {
+		if _, ok := seen[cand.HashID]; !ok {
+			seen[cand.HashID] = struct{}{}
+			hashIDs = append(hashIDs, cand.HashID)
+		}
+	}
+
+	published, err := c.PublishedDates.GetArticlePublishedDates(ctx, hashIDs)
+	if err != nil {
+		if !publishedAfter.IsZero() {
+			return nil, fmt.Errorf("getting candidate published dates: %w", err)
+		}
+		logger := domain.LoggerFromContext(ctx)
+		logger.WarnContext(ctx, "failed to get candidate published dates", "error", err)
+		return candidates, nil
+	}
+
+	now := time.Now()
+	kept := candidates[:0]
+	for _, cand := range candidates {
+		publishedAt, ok := published[cand.HashID]
+		if !publishedAfter.IsZero() && (!ok || !publishedAt.After(publishedAfter)) {
+			continue
+		}
+		if ok && cand.Score > 0 {
+			cand.Score *= domain.RecencyFactor(
+				publishedAt, now, c.Config.RecencyHalfLifeDays, c.Config.RecencyWeight,
+			)
+		}
+		kept = append(kept, cand)
+	}
+	return kept, nil
+}
+
 // computeTemporallyWeightedVector computes a weighted average vector with temporal decay.
 func (c *GenerateRecommendations) computeTemporallyWeightedVector(
 	vectors []domain.UserArticleRating,
@@ -187,6 +639,7 @@ func (c *GenerateRecommendations) computeTemporallyWeightedVector(
 		timestamped[i] = domain.TimestampedVector{
 			Vector:    v.Vector,
 			Timestamp: v.RatedAt,
+			Weight:    v.Weight(c.Config.SignalWeights),
 		}
 	}
 
@@ -203,6 +656,9 @@ func (c *GenerateRecommendations) getCandidatesFromClusters(
 
 	for _, cluster := range clusters {
 		source := fmt.Sprintf("cluster_%d", cluster.ClusterID)
+		if cluster.Provisional {
+			source = "provisional_" + source
+		}
 		candidates, err := c.getCandidatesFromVector(
 			ctx, cluster.CentroidVector, negativeVector != nil, source, c.Config.CandidatesPerCluster,
 		)
@@ -248,26 +704,32 @@ func (c *GenerateRecommendations) getCandidatesFromVector(
 	return candidates, nil
 }
 
-// computeAverageVector computes a simple average of vectors.
+// computeAverageVector computes the average of vectors, weighted by signal strength.
 func (c *GenerateRecommendations) computeAverageVector(vectors []domain.UserArticleRating) []float32 {
 	if len(vectors) == 0 {
 		return nil
 	}
 
 	var sum []float32
+	var totalWeight float64
 	for _, v := range vectors {
 		if sum == nil {
 			sum = make([]float32, len(v.Vector))
 		}
+		weight := v.Weight(c.Config.SignalWeights)
 		for i, val := range v.Vector {
-			sum[i] += val
+			sum[i] += float32(weight) * val
 		}
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return nil
 	}
 
-	count := float32(len(vectors))
 	result := make([]float32, len(sum))
 	for i, val := range sum {
-		result[i] = val / count
+		result[i] = val / float32(totalWeight)
 	}
 
 	return result
@@ -282,7 +744,7 @@ func (c *GenerateRecommendations) rankAndDeduplicate(
 	// Deduplicate by HashID, keeping highest score
 	seen := make(map[string]ScoredArticle)
 	for _, cand := range candidates {
-		// Skip excluded articles (already read)
+		// Skip excluded articles (already read, dismissed or snoozed)
 		if _, excluded := excludeIDs[cand.HashID]; excluded {
 			continue
 		}
@@ -307,3 +769,114 @@ func (c *GenerateRecommendations) rankAndDeduplicate(
 
 	return unique
 }
+
+// addExploration gives ExplorationShare of the list to popular articles from categories the user
+// has engaged with little, picked by Thompson sampling on earlier exploration outcomes, replacing
+// the lowest ranked articles. Exploration articles are interleaved evenly through the list, and
+// limited to those published after req.PublishedAfter if set.
+// Errors are logged and leave the list unchanged.
+func (c *GenerateRecommendations) addExploration(
+	ctx context.Context,
+	req GenerateRecommendationsRequest,
+	ranked []ScoredArticle,
+	excludeIDs map[string]struct{},
+) []ScoredArticle {
+	slots := int(float64(req.Limit) * c.Config.ExplorationShare)
+	if slots <= 0 {
+		return ranked
+	}
+
+	logger := domain.LoggerFromContext(ctx)
+	since := time.Now().AddDate(0, 0, -c.Config.ExplorationLookbackDays)
+	categories, err := c.Exploration.ListCategoryExploration(ctx, req.UserID, since)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to list category exploration", "error", err)
+		return ranked
+	}
+
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	chosen := domain.ChooseExplorationCategories(
+		categories, int64(c.Config.ExplorationMaxCategoryEngaged), slots, rng,
+	)
+
+	usedIDs := make(map[string]struct{}, len(excludeIDs)+len(ranked))
+	for id := range excludeIDs {
+		usedIDs[id] = struct{}{}
+	}
+	for _, cand := range ranked {
+		usedIDs[cand.HashID] = struct{}{}
+	}
+
+	var explore []ScoredArticle
+	for _, category := range chosen {
+		hashID, ok, err := c.getExplorationArticle(ctx, category.Category, req.PublishedAfter, usedIDs)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to get exploration article", "error", err, "category", category.Category)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		usedIDs[hashID] = struct{}{}
+		explore = append(explore, ScoredArticle{
+			HashID: hashID,
+			Score:  category.Score,
+			Source: "explore",
+		})
+	}
+
+	if len(explore) == 0 {
+		return ranked
+	}
+	if keep := req.Limit - len(explore); len(ranked) > keep {
+		ranked = ranked[:keep]
+	}
+	return interleaveExploration(ranked, explore)
+}
+
+// getExplorationArticle returns the most popular article in a category published after
+// publishedAfter that isn't in usedIDs, or false if none of the category's top articles are available.
+func (c *GenerateRecommendations) getExplorationArticle(
+	ctx context.Context, category string, publishedAfter time.Time, usedIDs map[string]struct{},
+) (string, bool, error) {
+	ids, err := c.ArticleLister.ListLatestArticleIDs(ctx, domain.ArticleFilters{
+		Category:       category,
+		PublishedAfter: publishedAfter,
+	}, domain.ArticleListOptions{
+		Ordering: []domain.ArticleOrdering{
+			{Field: domain.ArticleOrderingFieldPopularity, Desc: true},
+			{Field: domain.ArticleOrderingFieldPublishedAt, Desc: true},
+		},
+		Page:     1,
+		PageSize: c.Config.ExplorationCandidatesPerCategory,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("listing popular articles in category: %w", err)
+	}
+
+	for _, id := range ids {
+		if _, used := usedIDs[id]; !used {
+			return id, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// interleaveExploration spreads exploration articles evenly through the ranked list,
+// starting partway down the first stretch so the top results stay the best matches.
+func interleaveExploration(ranked, explore []ScoredArticle) []ScoredArticle {
+	total := len(ranked) + len(explore)
+	every := total / len(explore)
+
+	result := make([]ScoredArticle, 0, total)
+	for len(ranked) > 0 || len(explore) > 0 {
+		if len(explore) > 0 && (len(ranked) == 0 || len(result)%every == every/2) {
+			result = append(result, explore[0])
+			explore = explore[1:]
+			continue
+		}
+		result = append(result, ranked[0])
+		ranked = ranked[1:]
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// MaxImportItems is the maximum number of references a single import may contain.
+const MaxImportItems = 2000
+
+// ErrTooManyImportItems is returned when an import file contains more than MaxImportItems references.
+var ErrTooManyImportItems = fmt.Errorf("import contains more than %d items", MaxImportItems)
+
+// ImportReadingHistoryRequest is the request for the ImportReadingHistory command.
+// When Like is set, matched articles are given a thumbs up as well as marked read,
+// which is what seeds the user's recommendations from them.
+type ImportReadingHistoryRequest struct {
+	UserID string
+	Format domain.ImportFormat
+	Data   []byte
+	Like   bool
+}
+
+// ImportReadingHistoryResult reports which imported items were matched to articles.
+type ImportReadingHistoryResult struct {
+	Matched   []domain.ImportMatch `json:"matched"`
+	Unmatched []domain.ImportItem  `json:"unmatched"`
+}
+
+// ImportReadingHistory handles importing reading history exported from other tools.
+// Items are matched to articles by arXiv ID, then by URL or DOI, then by title.
+// Matched articles are marked read directly, the same as the read endpoint, which
+// keeps them out of the user's recommendations but records no signal of interest;
+// liked articles also go through SetRatingCmd, so they seed the user's interests.
+type ImportReadingHistory struct {
+	Matcher      datasources.ArticleMatcher
+	ReadSetter   datasources.ArticleReadSetter
+	SetRatingCmd Command[SetArticleRatingRequest, Empty]
+}
+
+// NewImportReadingHistory creates a properly initialized ImportReadingHistory command.
+func NewImportReadingHistory(
+	matcher datasources.ArticleMatcher,
+	readSetter datasources.ArticleReadSetter,
+	setRatingCmd Command[SetArticleRatingRequest, Empty],
+) *ImportReadingHistory {
+	return &ImportReadingHistory{
+		Matcher:      matcher,
+		ReadSetter:   readSetter,
+		SetRatingCmd: setRatingCmd,
+	}
+}
+
+// Execute parses the import, matches its items and records the matched articles for the user.
+func (c *ImportReadingHistory) Execute(
+	ctx context.Context,
+	req ImportReadingHistoryRequest,
+) (ImportReadingHistoryResult, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	items, err := domain.ParseImport(req.Format, req.Data)
+	if err != nil {
+		return ImportReadingHistoryResult{}, fmt.Errorf("parsing import: %w", err)
+	}
+	if len(items) > MaxImportItems {
+		return ImportReadingHistoryResult{}, ErrTooManyImportItems
+	}
+
+	result, err := c.match(ctx, items)
+	if err != nil {
+		return ImportReadingHistoryResult{}, err
+	}
+
+	// The same article may be referenced more than once, e.g. by both its arXiv and journal versions
+	seen := make(map[string]bool, len(result.Matched))
+	thumbsUp := true
+	for _, match := range result.Matched {
+		if seen[match.ArticleHashID] {
+			continue
+		}
+		seen[match.ArticleHashID] = true
+
+		if err := c.ReadSetter.SetArticleRead(ctx, match.ArticleHashID, req.UserID, true); err != nil {
+			return ImportReadingHistoryResult{}, fmt.Errorf("marking imported article read: %w", err)
+		}
+		if req.Like {
+			if _, err := c.SetRatingCmd.Execute(ctx, SetArticleRatingRequest{
+				UserID:        req.UserID,
+				ArticleHashID: match.ArticleHashID,
+				ThumbsUp:      &thumbsUp,
+			}); err != nil {
+				return ImportReadingHistoryResult{}, fmt.Errorf("liking imported article: %w", err)
+			}
+		}
+	}
+
+	logger.InfoContext(ctx, "imported reading history",
+		"format", req.Format, "items", len(items),
+		"matched", len(result.Matched), "articles", len(seen))
+
+	return result, nil
+}
+
+func (c *ImportReadingHistory) match(
+	ctx context.Context,
+	items []domain.ImportItem,
+) (ImportReadingHistoryResult, error) {
+	// Look up every candidate URL and title in one pass rather than per item
+	var urls, titles []string
+	for _, item := range items {
+		urls = append(urls, item.ArxivURLs()...)
+		urls = append(urls, item.LinkURLs()...)
+		if title := domain.NormalizeTitle(item.Title); title != "" {
+			titles = append(titles, title)
+		}
+	}
+	urlMatches, err := c.Matcher.FindArticlesByURLs(ctx, urls)
+	if err != nil {
+		return ImportReadingHistoryResult{}, fmt.Errorf("matching import URLs: %w", err)
+	}
+	titleMatches, err := c.Matcher.FindArticlesByNormalizedTitles(ctx, titles)
+	if err != nil {
+		return ImportReadingHistoryResult{}, fmt.Errorf("matching import titles: %w", err)
+	}
+
+	result := ImportReadingHistoryResult{
+		Matched:   []domain.ImportMatch{},
+		Unmatched: []domain.ImportItem{},
+	}
+	for _, item := range items {
+		if hashID, ok := firstMatch(urlMatches, item.ArxivURLs()); ok {
+			result.Matched = append(result.Matched, domain.ImportMatch{
+				Item: item, ArticleHashID: hashID, MatchedBy: domain.ImportMatchArxivID,
+			})
+			continue
+		}
+		if hashID, ok := firstMatch(urlMatches, item.LinkURLs()); ok {
+			result.Matched = append(result.Matched, domain.ImportMatch{
+				Item: item, ArticleHashID: hashID, MatchedBy: domain.ImportMatchURL,
+			})
+			continue
+		}
+
+		if hashID, ok := titleMatches[domain.NormalizeTitle(item.Title)]; ok {
+			result.Matched = append(result.Matched, domain.ImportMatch{
+				Item: item, ArticleHashID: hashID, MatchedBy: domain.ImportMatchTitle,
+			})
+			continue
+		}
+		result.Unmatched = append(result.Unmatched, item)
+	}
+	return result, nil
+}
+
+func firstMatch(matches map[string]string, urls []string) (string, bool) {
+	for _, u := range urls {
+		if hashID, ok := matches[u]; ok {
+			return hashID, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportReadingHistory_Execute(t *testing.T) {
+	data := `@article{a, eprint = {2301.00001v2}, archivePrefix = {arXiv}}
+@article{b, title = {Some Post}, url = {http://www.example.com/post/}}
+@article{c, title = {Debate as a Safety Technique}}
+@article{d, title = {Unknown Paper}}
+@article{e, url = {https://example.com/post}}`
+
+	urlMatches := map[string]string{
+		"https://arxiv.org/abs/2301.00001": "art1",
+		"https://example.com/post":         "art2",
+	}
+	titleMatches := map[string]string{
+		"debate as a safety technique": "art3",
+	}
+
+	cases := []struct {
+		name string
+		like bool
+	}{
+		{name: "mark_read"},
+		{name: "mark_liked", like: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := mocks.NewArticleMatcher(t)
+			readSetter := mocks.NewArticleReadSetter(t)
+			ratingCmd := cmdmocks.NewCommand[SetArticleRatingRequest, Empty](t)
+
+			matcher.EXPECT().FindArticlesByURLs(mock.Anything, mock.Anything).Return(urlMatches, nil)
+			// Every title is looked up in a single batch, normalized
+			matcher.EXPECT().
+				FindArticlesByNormalizedTitles(mock.Anything,
+					[]string{"some post", "debate as a safety technique", "unknown paper"}).
+				Return(titleMatches, nil).Once()
+
+			// art2 is referenced twice but only recorded once
+			for _, hashID := range []string{"art1", "art2", "art3"} {
+				readSetter.EXPECT().SetArticleRead(mock.Anything, hashID, "user1", true).Return(nil).Once()
+				if tc.like {
+					ratingCmd.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(req SetArticleRatingRequest) bool {
+						return req.ArticleHashID == hashID && req.UserID == "user1" &&
+							req.ThumbsUp != nil && *req.ThumbsUp && req.ThumbsDown == nil
+					})).Return(Empty{}, nil).Once()
+				}
+			}
+
+			cmd := NewImportReadingHistory(matcher, readSetter, ratingCmd)
+			result, err := cmd.Execute(t.Context(), ImportReadingHistoryRequest{
+				UserID: "user1",
+				Format: domain.ImportFormatBibTeX,
+				Data:   []byte(data),
+				Like:   tc.like,
+			})
+			require.NoError(t, err)
+
+			// Read-only imports record no rating, so they don't seed recommendations
+			if !tc.like {
+				ratingCmd.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+
+			var matched []string
+			for _, m := range result.Matched {
+				matched = append(matched, m.Item.Reference+"="+m.ArticleHashID+"/"+string(m.MatchedBy))
+			}
+			assert.Equal(t, []string{"a=art1/arxiv_id", "b=art2/url", "c=art3/title", "e=art2/url"}, matched)
+			require.Len(t, result.Unmatched, 1)
+			assert.Equal(t, "d", result.Unmatched[0].Reference)
+		})
+	}
+}
+
+func TestImportReadingHistory_Execute_Errors(t *testing.T) {
+	cases := []struct {
+		name      string
+		format    domain.ImportFormat
+		data      string
+		lookupErr error
+		wantErr   error
+	}{
+		{name: "unknown_format", format: "endnote", wantErr: domain.ErrUnknownImportFormat},
+		{name: "invalid_file", format: domain.ImportFormatOPML, data: "<opml>", wantErr: domain.ErrInvalidImport},
+		{
+			name:    "too_many_items",
+			format:  domain.ImportFormatURLList,
+			data:    strings.Repeat("https://example.com/\n", MaxImportItems+1),
+			wantErr: ErrTooManyImportItems,
+		},
+		{
+			name:      "lookup_error",
+			format:    domain.ImportFormatURLList,
+			data:      "https://example.com/",
+			lookupErr: errors.New("db down"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := mocks.NewArticleMatcher(t)
+			if tc.lookupErr != nil {
+				matcher.EXPECT().FindArticlesByURLs(mock.Anything, mock.Anything).Return(nil, tc.lookupErr)
+			}
+
+			cmd := NewImportReadingHistory(matcher, mocks.NewArticleReadSetter(t),
+				cmdmocks.NewCommand[SetArticleRatingRequest, Empty](t))
+			_, err := cmd.Execute(t.Context(), ImportReadingHistoryRequest{
+				UserID: "user1",
+				Format: tc.format,
+				Data:   []byte(tc.data),
+			})
+
+			if tc.lookupErr != nil {
+				require.ErrorIs(t, err, tc.lookupErr)
+				return
+			}
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
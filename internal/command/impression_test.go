@@ -0,0 +1,138 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecommendArticles_Execute_Impressions(t *testing.T) {
+	precomputedReader := mocks.NewPrecomputedRecommendationReader(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	articleFetcher := mocks.NewArticleFetcher(t)
+
+	precomputedReader.EXPECT().
+		GetPrecomputedRecommendationAge(mock.Anything, "user1").
+		Return(time.Now(), nil)
+	precomputedReader.EXPECT().
+		GetPrecomputedRecommendations(mock.Anything, "user1", 50).
+		Return([]datasources.PrecomputedRecommendation{
+			{ArticleHashID: "read1", Score: 0.9, Source: "temporal", ExperimentArm: "control"},
+			{ArticleHashID: "rec1", Score: 0.8, Source: "cluster_0", ExperimentArm: "control"},
+			{ArticleHashID: "gone", Score: 0.75, Source: "temporal", ExperimentArm: "control"},
+			{ArticleHashID: "rec2", Score: 0.7, Source: "collaborative", ExperimentArm: "control"},
+		}, nil)
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return([]string{"read1"}, nil)
+
+	// Articles missing from the dataset are not served, so take no position
+	articleFetcher.EXPECT().
+		FetchArticlesByID(mock.Anything, []string{"rec1", "gone", "rec2"}).
+		Return([]domain.Article{{HashID: "rec1"}, {HashID: "rec2"}}, nil)
+
+	cmd := NewRecommendArticles(
+		nil,
+		precomputedReader,
+		mocks.NewPrecomputedRecommendationWriter(t),
+		mocks.NewUserRegeneratedMarker(t),
+		readArticlesLister,
+		noHiddenArticles(t),
+		noFollowPreferences(t),
+		nil,
+		articleFetcher,
+		RecommendArticlesConfig{PrecomputedStaleThreshold: time.Hour, PrecomputedFetchLimit: 50},
+	)
+
+	result, err := cmd.Execute(t.Context(), RecommendArticlesRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, result.Articles, 2)
+
+	require.Len(t, result.Impressions, 2)
+	for i, want := range []domain.RecommendationImpression{
+		{UserID: "user1", ArticleHashID: "rec1", ExperimentArm: "control", Source: "cluster_0", Position: 0},
+		{UserID: "user1", ArticleHashID: "rec2", ExperimentArm: "control", Source: "collaborative", Position: 1},
+	} {
+		got := result.Impressions[i]
+		assert.False(t, got.ServedAt.IsZero())
+		got.ServedAt = time.Time{}
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestGenerateRecommendations_Execute_DemotesIgnored(t *testing.T) {
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	ignoredCounter := mocks.NewIgnoredRecommendationCounter(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return(nil, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: time.Now()}}, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return(nil, nil)
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+		Return([]domain.SimilarArticle{
+			{HashID: "ignored3", Score: 0.9},
+			{HashID: "ignored4", Score: 0.85},
+			{HashID: "fresh", Score: 0.6},
+		}, nil)
+
+	var since time.Time
+	ignoredCounter.EXPECT().
+		CountIgnoredRecommendations(mock.Anything, "user1", mock.Anything, 20, 3).
+		RunAndReturn(func(_ context.Context, _ string, s time.Time, _, _ int) (map[string]int, error) {
+			since = s
+			return map[string]int{"ignored3": 3, "ignored4": 4}, nil
+		})
+
+	config := testGenerateRecommendationsConfig()
+	config.IgnoredMinDays = 3
+	config.IgnoredMaxPosition = 20
+	config.IgnoredLookbackDays = 30
+	config.IgnoredDemotion = 0.5
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          ignoredCounter,
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               config,
+		Experiment:           RecommendationExperiment{},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+
+	assertScoredArticlesEqual(t, []ScoredArticle{
+		{HashID: "fresh", Score: 0.6},
+		{HashID: "ignored3", Score: 0.45},
+		{HashID: "ignored4", Score: 0.2125},
+	}, result)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -30), since, time.Minute)
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ListFollowedArticleIDsRequest is the request for the ListFollowedArticleIDs command.
+type ListFollowedArticleIDsRequest struct {
+	UserID  string
+	Filters domain.ArticleFilters
+	Options domain.ArticleListOptions
+}
+
+// ListFollowedArticleIDs lists the latest articles by any author, or in any source or category,
+// that a user follows.
+type ListFollowedArticleIDs struct {
+	FollowLister datasources.FollowLister
+	LatestLister datasources.LatestArticleLister
+}
+
+// NewListFollowedArticleIDs creates a properly initialized ListFollowedArticleIDs command.
+func NewListFollowedArticleIDs(
+	followLister datasources.FollowLister,
+	latestLister datasources.LatestArticleLister,
+) *ListFollowedArticleIDs {
+	return &ListFollowedArticleIDs{
+		FollowLister: followLister,
+		LatestLister: latestLister,
+	}
+}
+
+// Execute returns the matching article IDs, or none if the user follows nothing.
+func (c *ListFollowedArticleIDs) Execute(ctx context.Context, req ListFollowedArticleIDsRequest) ([]string, error) {
+	follows, err := c.FollowLister.ListFollows(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("listing follows: %w", err)
+	}
+
+	followed := domain.NewFollowedItems(follows)
+	if followed.IsEmpty() {
+		return nil, nil
+	}
+
+	filters := req.Filters
+	filters.Followed = followed
+	ids, err := c.LatestLister.ListLatestArticleIDs(ctx, filters, req.Options)
+	if err != nil {
+		return nil, fmt.Errorf("listing followed articles: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetOnboardingInterests_Execute(t *testing.T) {
+	cases := []struct {
+		name          string
+		categories    []string
+		interests     []string
+		thumbsUpCount int64
+		nullEmbedder  bool
+		wantSeed      bool
+		wantErr       error
+	}{
+		{
+			name:       "seeds_new_user",
+			categories: []string{"Interpretability"},
+			interests:  []string{" reward hacking "},
+			wantSeed:   true,
+		},
+		{
+			name:          "only_stores_for_rated_user",
+			categories:    []string{"Interpretability"},
+			thumbsUpCount: 6,
+		},
+		{name: "invalid", categories: []string{""}, wantErr: domain.ErrInvalidOnboardingInterests},
+		{
+			name:         "embedding_unavailable",
+			interests:    []string{"debate"},
+			nullEmbedder: true,
+			wantErr:      ErrEmbeddingUnavailable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			embedder := mocks.NewEmbedder(t)
+			counter := mocks.NewUserArticleVectorsCounter(t)
+			seeder := mocks.NewUserInterestClusterSeeder(t)
+			setter := mocks.NewOnboardingInterestsSetter(t)
+			precomputed := mocks.NewPrecomputedRecommendationWriter(t)
+			marker := mocks.NewUserRegenerationNeededMarker(t)
+
+			if !errors.Is(tc.wantErr, domain.ErrInvalidOnboardingInterests) {
+				counter.EXPECT().
+					CountUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+					Return(tc.thumbsUpCount, nil)
+			}
+			if tc.nullEmbedder {
+				embedder.EXPECT().EmbedText(mock.Anything, "debate").Return(nil, nil)
+			}
+			if tc.wantSeed {
+				embedder.EXPECT().EmbedText(mock.Anything, "Interpretability").Return([]float32{1, 0}, nil)
+				embedder.EXPECT().EmbedText(mock.Anything, "reward hacking").Return([]float32{0, 1}, nil)
+				seeder.EXPECT().
+					SeedUserInterestClusters(mock.Anything, "user1", [][]float32{{1, 0}, {0, 1}}).
+					Return(nil)
+				precomputed.EXPECT().DeleteUserPrecomputedRecommendations(mock.Anything, "user1").Return(nil)
+				marker.EXPECT().MarkUserNeedsRegeneration(mock.Anything, "user1").Return(nil)
+			}
+			if tc.wantErr == nil {
+				setter.EXPECT().
+					SetOnboardingInterests(mock.Anything, mock.MatchedBy(func(o domain.OnboardingInterests) bool {
+						return o.UserID == "user1" && len(o.Categories) == len(tc.categories)
+					})).
+					Return(nil)
+			}
+
+			cmd := NewSetOnboardingInterests(embedder, counter, seeder, setter, precomputed, marker, 6)
+			interests, err := cmd.Execute(t.Context(), SetOnboardingInterestsRequest{
+				UserID:     "user1",
+				Categories: tc.categories,
+				Interests:  tc.interests,
+			})
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.categories, interests.Categories)
+			assert.False(t, interests.UpdatedAt.IsZero())
+		})
+	}
+}
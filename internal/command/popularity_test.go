@@ -0,0 +1,60 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateArticlePopularity_Execute(t *testing.T) {
+	aggregator := mocks.NewArticlePopularityAggregator(t)
+	replacer := mocks.NewArticlePopularityReplacer(t)
+	config := domain.PopularityConfig{MinUsers: 3, LikeWeight: 1, DislikeWeight: 2, ReadWeight: 0.5}
+
+	start := time.Now()
+	for _, window := range domain.ValidPopularityWindows {
+		aggregator.EXPECT().
+			AggregateArticlePopularity(mock.Anything, mock.MatchedBy(func(since time.Time) bool {
+				age := start.Sub(since)
+				return age >= time.Duration(window)*24*time.Hour-time.Minute &&
+					age <= time.Duration(window)*24*time.Hour+time.Minute
+			}), int64(3)).
+			Return([]domain.ArticlePopularity{
+				{HashID: "art1", LikeCount: 4, DislikeCount: 1, ReadCount: 6, UserCount: 8},
+			}, nil).Once()
+
+		replacer.EXPECT().
+			ReplaceArticlePopularity(mock.Anything, window, []domain.ArticlePopularity{
+				{HashID: "art1", Window: window, LikeCount: 4, DislikeCount: 1, ReadCount: 6, UserCount: 8, Score: 5},
+			}).
+			Return(nil).Once()
+	}
+
+	cmd := NewAggregateArticlePopularity(aggregator, replacer, config)
+	result, err := cmd.Execute(t.Context(), AggregateArticlePopularityRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[domain.PopularityWindow]int{
+		domain.PopularityWindowDay:   1,
+		domain.PopularityWindowWeek:  1,
+		domain.PopularityWindowMonth: 1,
+	}, result.Articles)
+}
+
+func TestAggregateArticlePopularity_Execute_Error(t *testing.T) {
+	aggregator := mocks.NewArticlePopularityAggregator(t)
+	aggregator.EXPECT().
+		AggregateArticlePopularity(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db down"))
+
+	cmd := NewAggregateArticlePopularity(aggregator, mocks.NewArticlePopularityReplacer(t),
+		domain.DefaultPopularityConfig())
+	_, err := cmd.Execute(t.Context(), AggregateArticlePopularityRequest{})
+	require.Error(t, err)
+}
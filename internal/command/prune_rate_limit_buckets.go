@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+)
+
+// PruneRateLimitBucketsRequest is the request for the PruneRateLimitBuckets command.
+// This command takes no parameters beyond context.
+type PruneRateLimitBucketsRequest struct{}
+
+// PruneRateLimitBucketsResponse summarizes a prune.
+type PruneRateLimitBucketsResponse struct {
+	Deleted int64
+}
+
+// PruneRateLimitBuckets deletes token buckets idle for longer than the longest
+// rate limit window. Such buckets have refilled completely and behave the same
+// as missing ones.
+type PruneRateLimitBuckets struct {
+	Deleter datasources.RateLimitBucketDeleter
+	MaxIdle time.Duration
+}
+
+// NewPruneRateLimitBuckets creates a properly initialized PruneRateLimitBuckets command.
+func NewPruneRateLimitBuckets(
+	deleter datasources.RateLimitBucketDeleter,
+	maxIdle time.Duration,
+) *PruneRateLimitBuckets {
+	return &PruneRateLimitBuckets{
+		Deleter: deleter,
+		MaxIdle: maxIdle,
+	}
+}
+
+// Execute deletes every bucket idle for longer than MaxIdle.
+func (c *PruneRateLimitBuckets) Execute(
+	ctx context.Context, _ PruneRateLimitBucketsRequest,
+) (PruneRateLimitBucketsResponse, error) {
+	deleted, err := c.Deleter.DeleteRateLimitBucketsUpdatedBefore(ctx, time.Now().Add(-c.MaxIdle))
+	if err != nil {
+		return PruneRateLimitBucketsResponse{}, fmt.Errorf("deleting idle rate limit buckets: %w", err)
+	}
+	return PruneRateLimitBucketsResponse{Deleted: deleted}, nil
+}
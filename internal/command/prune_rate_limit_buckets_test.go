@@ -0,0 +1,26 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneRateLimitBuckets_Execute(t *testing.T) {
+	deleter := mocks.NewRateLimitBucketDeleter(t)
+	deleter.EXPECT().
+		DeleteRateLimitBucketsUpdatedBefore(mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return before.Sub(time.Now().Add(-time.Minute)).Abs() < time.Second
+		})).
+		Return(3, nil)
+
+	cmd := NewPruneRateLimitBuckets(deleter, time.Minute)
+	result, err := cmd.Execute(t.Context(), PruneRateLimitBucketsRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.Deleted)
+}
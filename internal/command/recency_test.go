@@ -0,0 +1,209 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRecommendations_Execute_Recency(t *testing.T) {
+	now := time.Now()
+	published := map[string]time.Time{
+		"old": now.AddDate(-2, 0, 0),
+		"new": now.AddDate(0, 0, -1),
+	}
+
+	cases := []struct {
+		name           string
+		recencyWeight  float64
+		publishedAfter time.Time
+		datesErr       error
+		wantLimit      int
+		want           []string
+		wantErr        bool
+	}{
+		{
+			name:          "old_articles_scored_down",
+			recencyWeight: 0.5,
+			wantLimit:     40,
+			want:          []string{"new", "undated", "old"},
+		},
+		{
+			name:          "date_error_ignored",
+			recencyWeight: 0.5,
+			datesErr:      errors.New("db down"),
+			wantLimit:     40,
+			want:          []string{"old", "new", "undated"},
+		},
+		{
+			name:           "fresh_only",
+			publishedAfter: now.AddDate(0, 0, -7),
+			wantLimit:      120,
+			want:           []string{"new"},
+		},
+		{
+			name:           "fresh_date_error",
+			publishedAfter: now.AddDate(0, 0, -7),
+			datesErr:       errors.New("db down"),
+			wantLimit:      120,
+			wantErr:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+			interactionStore := mocks.NewUserArticleInteractionStore(t)
+			clusterStore := mocks.NewUserInterestClusterStore(t)
+			readArticlesLister := mocks.NewReadArticleIDsLister(t)
+			publishedDates := mocks.NewArticlePublishedDateGetter(t)
+
+			readArticlesLister.EXPECT().
+				ListReadArticleIDs(mock.Anything, "user1").
+				Return(nil, nil)
+			interactionStore.EXPECT().
+				GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+				Return([]domain.UserArticleRating{
+					{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now},
+				}, nil)
+			interactionStore.EXPECT().
+				GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+				Return(nil, nil)
+			clusterStore.EXPECT().
+				GetUserInterestClusters(mock.Anything, "user1").
+				Return(nil, nil)
+			vectorSimilarity.EXPECT().
+				ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, tc.wantLimit).
+				Return([]domain.SimilarArticle{
+					{HashID: "old", Score: 0.9},
+					{HashID: "new", Score: 0.8},
+					{HashID: "undated", Score: 0.7},
+				}, nil)
+			publishedDates.EXPECT().
+				GetArticlePublishedDates(mock.Anything, []string{"old", "new", "undated"}).
+				Return(published, tc.datesErr)
+
+			config := testGenerateRecommendationsConfig()
+			config.RecencyHalfLifeDays = 180
+			config.RecencyWeight = tc.recencyWeight
+			config.FreshCandidateMultiplier = 3
+
+			cmd := &GenerateRecommendations{
+				VectorSimilarity:     vectorSimilarity,
+				VectorsGetter:        interactionStore,
+				ClusterGetter:        clusterStore,
+				ReadArticlesLister:   readArticlesLister,
+				HiddenArticlesLister: noHiddenArticles(t),
+				TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+				Popularity:           mocks.NewArticlePopularityReader(t),
+				Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+				Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+				Exploration:          mocks.NewCategoryExplorationLister(t),
+				ArticleLister:        mocks.NewLatestArticleLister(t),
+				PublishedDates:       publishedDates,
+				Duplicates:           noDuplicates(t),
+				Config:               config,
+				Experiment:           RecommendationExperiment{},
+			}
+
+			result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{
+				UserID:         "user1",
+				Limit:          10,
+				PublishedAfter: tc.publishedAfter,
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var ids []string
+			for _, r := range result {
+				ids = append(ids, r.HashID)
+			}
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestRecommendArticles_Execute_FreshSkipsPrecomputed(t *testing.T) {
+	now := time.Now()
+	publishedAfter := now.AddDate(0, 0, -7)
+
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	publishedDates := mocks.NewArticlePublishedDateGetter(t)
+	articleFetcher := mocks.NewArticleFetcher(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return(nil, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now}}, nil)
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return(nil, nil)
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+		Return([]domain.SimilarArticle{{HashID: "old", Score: 0.9}, {HashID: "new", Score: 0.8}}, nil)
+	publishedDates.EXPECT().
+		GetArticlePublishedDates(mock.Anything, []string{"old", "new"}).
+		Return(map[string]time.Time{"old": now.AddDate(-1, 0, 0), "new": now}, nil)
+	articleFetcher.EXPECT().
+		FetchArticlesByID(mock.Anything, []string{"new"}).
+		Return([]domain.Article{{HashID: "new"}}, nil)
+
+	generateCmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       publishedDates,
+		Duplicates:           noDuplicates(t),
+		Config:               testGenerateRecommendationsConfig(),
+		Experiment:           RecommendationExperiment{},
+	}
+
+	// Neither the precomputed reader nor writer are used, as precomputed lists aren't limited by date
+	cmd := NewRecommendArticles(
+		generateCmd,
+		mocks.NewPrecomputedRecommendationReader(t),
+		mocks.NewPrecomputedRecommendationWriter(t),
+		mocks.NewUserRegeneratedMarker(t),
+		readArticlesLister,
+		mocks.NewHiddenArticleIDsLister(t),
+		noFollowPreferences(t),
+		nil,
+		articleFetcher,
+		RecommendArticlesConfig{PrecomputedStaleThreshold: time.Hour, PrecomputedFetchLimit: 50},
+	)
+
+	result, err := cmd.Execute(t.Context(), RecommendArticlesRequest{
+		UserID:         "user1",
+		Limit:          10,
+		PublishedAfter: publishedAfter,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Article{{HashID: "new"}}, result.Articles)
+	require.Len(t, result.Impressions, 1)
+	assert.Equal(t, "temporal", result.Impressions[0].Source)
+}
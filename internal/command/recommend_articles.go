@@ -10,9 +10,20 @@ import (
 )
 
 // RecommendArticlesRequest is the request for the RecommendArticles command.
+// PublishedAfter, if set, limits recommendations to articles published after it.
 type RecommendArticlesRequest struct {
-	UserID string
-	Limit  int
+	UserID         string
+	Limit          int
+	PublishedAfter time.Time
+}
+
+// RecommendArticlesResponse is the response for the RecommendArticles command.
+type RecommendArticlesResponse struct {
+	Articles []domain.Article
+
+	// Impressions describe the recommendations served, in order, for callers that show them
+	// to the user to record.
+	Impressions []domain.RecommendationImpression
 }
 
 // RecommendArticlesConfig holds configuration for serving recommendations.
@@ -24,20 +35,37 @@ type RecommendArticlesConfig struct {
 	// PrecomputedFetchLimit is how many precomputed recommendations to fetch.
 	// This should be higher than the return limit to account for read article filtering.
 	PrecomputedFetchLimit int
+
+	// FollowedLookbackDays is how far back to look for articles from a user's follows,
+	// for users who include them in their recommendations.
+	FollowedLookbackDays int
+
+	// FollowedFetchLimit is how many recent followed articles to fetch. This should be higher
+	// than FollowedMaxArticles to account for read article filtering.
+	FollowedFetchLimit int
+
+	// FollowedMaxArticles is the most followed articles placed ahead of other recommendations.
+	FollowedMaxArticles int
 }
 
 // RecommendArticles serves personalized article recommendations.
 // It uses precomputed recommendations when available and fresh,
 // falling back to on-demand generation via GenerateRecommendations.
-// On-demand results are stored for subsequent requests.
+// On-demand results are stored for subsequent requests. Requests limited to recently
+// published articles are always generated on demand, and not stored.
+// Users who choose to include their follows get recent followed articles first; these are
+// added as recommendations are served, so precomputed recommendations never contain them.
 type RecommendArticles struct {
-	GenerateCommand    *GenerateRecommendations
-	PrecomputedReader  datasources.PrecomputedRecommendationReader
-	PrecomputedWriter  datasources.PrecomputedRecommendationWriter
-	RegenerationStatus datasources.UserRegeneratedMarker
-	ReadArticlesLister datasources.ReadArticleIDsLister
-	ArticleFetcher     datasources.ArticleFetcher
-	Config             RecommendArticlesConfig
+	GenerateCommand      *GenerateRecommendations
+	PrecomputedReader    datasources.PrecomputedRecommendationReader
+	PrecomputedWriter    datasources.PrecomputedRecommendationWriter
+	RegenerationStatus   datasources.UserRegeneratedMarker
+	ReadArticlesLister   datasources.ReadArticleIDsLister
+	HiddenArticlesLister datasources.HiddenArticleIDsLister
+	FollowPreferences    datasources.FollowPreferencesGetter
+	FollowedArticlesCmd  Command[ListFollowedArticleIDsRequest, []string]
+	ArticleFetcher       datasources.ArticleFetcher
+	Config               RecommendArticlesConfig
 }
 
 // NewRecommendArticles creates a properly initialized RecommendArticles command.
@@ -47,46 +75,63 @@ func NewRecommendArticles(
 	precomputedWriter datasources.PrecomputedRecommendationWriter,
 	regenerationStatus datasources.UserRegeneratedMarker,
 	readArticlesLister datasources.ReadArticleIDsLister,
+	hiddenArticlesLister datasources.HiddenArticleIDsLister,
+	followPreferences datasources.FollowPreferencesGetter,
+	followedArticlesCmd Command[ListFollowedArticleIDsRequest, []string],
 	articleFetcher datasources.ArticleFetcher,
 	config RecommendArticlesConfig,
 ) *RecommendArticles {
 	return &RecommendArticles{
-		GenerateCommand:    generateCommand,
-		PrecomputedReader:  precomputedReader,
-		PrecomputedWriter:  precomputedWriter,
-		RegenerationStatus: regenerationStatus,
-		ReadArticlesLister: readArticlesLister,
-		ArticleFetcher:     articleFetcher,
-		Config:             config,
+		GenerateCommand:      generateCommand,
+		PrecomputedReader:    precomputedReader,
+		PrecomputedWriter:    precomputedWriter,
+		RegenerationStatus:   regenerationStatus,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: hiddenArticlesLister,
+		FollowPreferences:    followPreferences,
+		FollowedArticlesCmd:  followedArticlesCmd,
+		ArticleFetcher:       articleFetcher,
+		Config:               config,
 	}
 }
 
 // Execute returns recommendations for a user.
 // It first tries to use precomputed recommendations if available and fresh,
 // then falls back to on-demand generation and stores the results.
-// Finally, it fetches full article data.
-func (c *RecommendArticles) Execute(ctx context.Context, req RecommendArticlesRequest) ([]domain.Article, error) {
+// Finally, it fetches full article data and describes what was served.
+func (c *RecommendArticles) Execute(
+	ctx context.Context, req RecommendArticlesRequest,
+) (RecommendArticlesResponse, error) {
 	logger := domain.LoggerFromContext(ctx)
 
-	scored, err := c.getPrecomputedRecommendations(ctx, req.UserID, req.Limit)
-	if err != nil {
-		logger.WarnContext(ctx, "failed to get precomputed recommendations, falling back to on-demand",
-			"error", err)
+	// Precomputed recommendations aren't limited by publication date
+	fresh := !req.PublishedAfter.IsZero()
+
+	var scored []ScoredArticle
+	var err error
+	if !fresh {
+		scored, err = c.getPrecomputedRecommendations(ctx, req.UserID, req.Limit)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to get precomputed recommendations, falling back to on-demand",
+				"error", err)
+		}
 	}
 
 	if len(scored) == 0 {
 		scored, err = c.GenerateCommand.Execute(ctx, GenerateRecommendationsRequest(req))
 		if err != nil {
-			return nil, err
+			return RecommendArticlesResponse{}, err
 		}
 
-		if len(scored) > 0 {
+		if len(scored) > 0 && !fresh {
 			c.storeGeneratedRecommendations(ctx, req.UserID, scored)
 		}
 	}
 
+	scored = c.includeFollowedArticles(ctx, req, scored)
+
 	if len(scored) == 0 {
-		return nil, nil
+		return RecommendArticlesResponse{}, nil
 	}
 
 	// Fetch full article data
@@ -97,10 +142,103 @@ func (c *RecommendArticles) Execute(ctx context.Context, req RecommendArticlesRe
 
 	articles, err := c.ArticleFetcher.FetchArticlesByID(ctx, ids)
 	if err != nil {
-		return nil, fmt.Errorf("fetching article details: %w", err)
+		return RecommendArticlesResponse{}, fmt.Errorf("fetching article details: %w", err)
+	}
+
+	return RecommendArticlesResponse{
+		Articles:    articles,
+		Impressions: recommendationImpressions(req.UserID, scored, articles, time.Now()),
+	}, nil
+}
+
+// includeFollowedArticles places recent unread articles from the user's follows ahead of the
+// other recommendations, if they've chosen to include them, keeping within the limit.
+// Errors are logged and the recommendations returned unchanged (best-effort).
+func (c *RecommendArticles) includeFollowedArticles(
+	ctx context.Context, req RecommendArticlesRequest, scored []ScoredArticle,
+) []ScoredArticle {
+	logger := domain.LoggerFromContext(ctx)
+
+	prefs, found, err := c.FollowPreferences.GetFollowPreferences(ctx, req.UserID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get follow preferences", "error", err)
+		return scored
+	}
+	if !found || !prefs.IncludeInRecommendations {
+		return scored
+	}
+
+	publishedAfter := time.Now().AddDate(0, 0, -c.Config.FollowedLookbackDays)
+	if req.PublishedAfter.After(publishedAfter) {
+		publishedAfter = req.PublishedAfter
+	}
+
+	ids, err := c.FollowedArticlesCmd.Execute(ctx, ListFollowedArticleIDsRequest{
+		UserID:  req.UserID,
+		Filters: domain.ArticleFilters{PublishedAfter: publishedAfter},
+		Options: domain.ArticleListOptions{Page: 1, PageSize: c.Config.FollowedFetchLimit},
+	})
+	if err != nil {
+		logger.WarnContext(ctx, "failed to list followed articles", "error", err)
+		return scored
+	}
+	if len(ids) == 0 {
+		return scored
+	}
+
+	excludeIDs := readArticleIDSet(ctx, c.ReadArticlesLister, req.UserID)
+	addHiddenArticleIDs(ctx, c.HiddenArticlesLister, req.UserID, excludeIDs)
+
+	maxFollowed := min(c.Config.FollowedMaxArticles, req.Limit)
+	result := make([]ScoredArticle, 0, len(scored)+maxFollowed)
+	followedIDs := make(map[string]struct{}, maxFollowed)
+	for _, id := range ids {
+		if len(result) >= maxFollowed {
+			break
+		}
+		if _, excluded := excludeIDs[id]; excluded {
+			continue
+		}
+		result = append(result, ScoredArticle{HashID: id, Source: "following"})
+		followedIDs[id] = struct{}{}
 	}
 
-	return articles, nil
+	for _, s := range scored {
+		if len(result) >= req.Limit {
+			break
+		}
+		if _, followed := followedIDs[s.HashID]; followed {
+			continue
+		}
+		result = append(result, s)
+	}
+
+	return result
+}
+
+// recommendationImpressions describes the articles served to a user, in the order they were
+// served, with the source and experiment arm of the recommendation for each.
+func recommendationImpressions(
+	userID string, scored []ScoredArticle, articles []domain.Article, servedAt time.Time,
+) []domain.RecommendationImpression {
+	byID := make(map[string]ScoredArticle, len(scored))
+	for _, s := range scored {
+		byID[s.HashID] = s
+	}
+
+	impressions := make([]domain.RecommendationImpression, 0, len(articles))
+	for position, article := range articles {
+		s := byID[article.HashID]
+		impressions = append(impressions, domain.RecommendationImpression{
+			UserID:        userID,
+			ArticleHashID: article.HashID,
+			ExperimentArm: s.ExperimentArm,
+			Source:        s.Source,
+			Position:      position,
+			ServedAt:      servedAt,
+		})
+	}
+	return impressions
 }
 
 // getPrecomputedRecommendations retrieves and filters precomputed recommendations.
@@ -133,17 +271,19 @@ func (c *RecommendArticles) getPrecomputedRecommendations(
 		return nil, nil
 	}
 
-	readIDs := readArticleIDSet(ctx, c.ReadArticlesLister, userID)
+	excludeIDs := readArticleIDSet(ctx, c.ReadArticlesLister, userID)
+	addHiddenArticleIDs(ctx, c.HiddenArticlesLister, userID, excludeIDs)
 
 	result := make([]ScoredArticle, 0, limit)
 	for _, rec := range precomputed {
-		if _, isRead := readIDs[rec.ArticleHashID]; isRead {
+		if _, excluded := excludeIDs[rec.ArticleHashID]; excluded {
 			continue
 		}
 		result = append(result, ScoredArticle{
-			HashID: rec.ArticleHashID,
-			Score:  rec.Score,
-			Source: rec.Source,
+			HashID:        rec.ArticleHashID,
+			Score:         rec.Score,
+			Source:        rec.Source,
+			ExperimentArm: rec.ExperimentArm,
 		})
 		if len(result) >= limit {
 			break
@@ -170,6 +310,23 @@ func readArticleIDSet(ctx context.Context, lister datasources.ReadArticleIDsList
 	return result
 }
 
+// addHiddenArticleIDs adds the article IDs the user has dismissed or snoozed to excludeIDs.
+// Leaves excludeIDs unchanged on error (best-effort).
+func addHiddenArticleIDs(
+	ctx context.Context, lister datasources.HiddenArticleIDsLister, userID string, excludeIDs map[string]struct{},
+) {
+	logger := domain.LoggerFromContext(ctx)
+	hiddenIDs, err := lister.ListHiddenArticleIDs(ctx, userID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get hidden article IDs", "error", err)
+		return
+	}
+
+	for _, id := range hiddenIDs {
+		excludeIDs[id] = struct{}{}
+	}
+}
+
 // storeGeneratedRecommendations stores on-demand generated recommendations.
 // Errors are logged but not returned since this is best-effort caching.
 func (c *RecommendArticles) storeGeneratedRecommendations(
@@ -205,6 +362,7 @@ func storePrecomputedRecommendations(
 			ArticleHashID: article.HashID,
 			Score:         article.Score,
 			Source:        article.Source,
+			ExperimentArm: article.ExperimentArm,
 			Position:      position,
 			GeneratedAt:   generatedAt,
 		}); err != nil {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
 	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
 	"github.com/jbeshir/alignment-research-feed/internal/domain"
 	"github.com/stretchr/testify/assert"
@@ -19,9 +20,37 @@ func testGenerateRecommendationsConfig() GenerateRecommendationsConfig {
 		NegativeSignalWeight:      0.3,
 		UseInterestClusters:       true,
 		CandidatesPerCluster:      20,
+		PopularityWindow:          domain.PopularityWindowMonth,
 	}
 }
 
+// noHiddenArticles returns a lister for a user who has not dismissed or snoozed any articles.
+func noHiddenArticles(t *testing.T) *mocks.HiddenArticleIDsLister {
+	lister := mocks.NewHiddenArticleIDsLister(t)
+	lister.EXPECT().
+		ListHiddenArticleIDs(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	return lister
+}
+
+// noFollowPreferences returns a FollowPreferencesGetter mock finding no follow preferences.
+func noFollowPreferences(t *testing.T) *mocks.FollowPreferencesGetter {
+	getter := mocks.NewFollowPreferencesGetter(t)
+	getter.EXPECT().
+		GetFollowPreferences(mock.Anything, mock.Anything).
+		Return(domain.FollowPreferences{}, false, nil)
+	return getter
+}
+
+// noDuplicates returns a CanonicalArticleGetter mock finding no duplicate articles.
+func noDuplicates(t *testing.T) *mocks.CanonicalArticleGetter {
+	getter := mocks.NewCanonicalArticleGetter(t)
+	getter.EXPECT().
+		GetCanonicalArticleIDs(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	return getter
+}
+
 // assertScoredArticlesEqual compares ScoredArticle slices ignoring Source field differences.
 func assertScoredArticlesEqual(t *testing.T, expected, actual []ScoredArticle) {
 	t.Helper()
@@ -108,8 +137,9 @@ func TestGenerateRecommendations_Execute(t *testing.T) {
 				GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
 				Return(tc.thumbsUpVecs, tc.thumbsUpErr)
 
-			// When we have thumbs up vectors, also expect thumbs down query and cluster check
-			if !tc.skipSimilarity && len(tc.thumbsUpVecs) > 0 {
+			// Unless loading ratings fails, also expect thumbs down query and cluster check,
+			// since users without ratings may have provisional clusters
+			if tc.thumbsUpErr == nil {
 				interactionStore.EXPECT().
 					GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
 					Return(nil, nil)
@@ -117,19 +147,37 @@ func TestGenerateRecommendations_Execute(t *testing.T) {
 				clusterStore.EXPECT().
 					GetUserInterestClusters(mock.Anything, "user1").
 					Return(nil, nil)
+			}
+
+			duplicates := mocks.NewCanonicalArticleGetter(t)
+			if tc.thumbsUpErr == nil {
+				duplicates = noDuplicates(t)
+			}
 
+			// When we have thumbs up vectors, also expect a similarity query
+			if !tc.skipSimilarity && len(tc.thumbsUpVecs) > 0 {
 				vectorSimilarity.EXPECT().
 					ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
 					Return(tc.similar, nil)
 			}
 
-			cmd := NewGenerateRecommendations(
-				vectorSimilarity,
-				interactionStore,
-				clusterStore,
-				readArticlesLister,
-				testGenerateRecommendationsConfig(),
-			)
+			cmd := &GenerateRecommendations{
+				VectorSimilarity:     vectorSimilarity,
+				VectorsGetter:        interactionStore,
+				ClusterGetter:        clusterStore,
+				ReadArticlesLister:   readArticlesLister,
+				HiddenArticlesLister: noHiddenArticles(t),
+				TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+				Popularity:           mocks.NewArticlePopularityReader(t),
+				Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+				Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+				Exploration:          mocks.NewCategoryExplorationLister(t),
+				ArticleLister:        mocks.NewLatestArticleLister(t),
+				PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+				Duplicates:           duplicates,
+				Config:               testGenerateRecommendationsConfig(),
+				Experiment:           RecommendationExperiment{},
+			}
 
 			result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
 
@@ -183,13 +231,23 @@ func TestGenerateRecommendations_Execute_WithNegativeSignals(t *testing.T) {
 		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
 		Return(similar, nil)
 
-	cmd := NewGenerateRecommendations(
-		vectorSimilarity,
-		interactionStore,
-		clusterStore,
-		readArticlesLister,
-		testGenerateRecommendationsConfig(),
-	)
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               testGenerateRecommendationsConfig(),
+		Experiment:           RecommendationExperiment{},
+	}
 
 	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
 
@@ -199,3 +257,375 @@ func TestGenerateRecommendations_Execute_WithNegativeSignals(t *testing.T) {
 	// Score should be penalized: 0.9 - (0.3 * 0.9 * 0.5) = 0.765
 	assert.InDelta(t, 0.765, result[0].Score, 0.01)
 }
+
+func TestGenerateRecommendations_Execute_WithTagCooccurrence(t *testing.T) {
+	now := time.Now()
+
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	tagCooccurrence := mocks.NewTagCooccurrenceLister(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return([]string{"read1"}, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now}}, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return(nil, nil)
+
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+		Return([]domain.SimilarArticle{{HashID: "rec1", Score: 0.9}, {HashID: "tagged1", Score: 0.2}}, nil)
+
+	tagCooccurrence.EXPECT().
+		ListTagCooccurringArticles(mock.Anything, "user1", 10).
+		Return([]domain.TagCooccurrence{
+			{HashID: "tagged1", Count: 4},
+			{HashID: "read1", Count: 4},
+			{HashID: "tagged2", Count: 2},
+		}, nil)
+
+	config := testGenerateRecommendationsConfig()
+	config.TagCooccurrenceWeight = 0.5
+	config.TagCooccurrenceCandidates = 10
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      tagCooccurrence,
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               config,
+		Experiment:           RecommendationExperiment{},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ScoredArticle{
+		{HashID: "rec1", Score: 0.9, Source: "temporal"},
+		{HashID: "tagged1", Score: 0.5, Source: "tag_cooccurrence"},
+		{HashID: "tagged2", Score: 0.25, Source: "tag_cooccurrence"},
+	}, result)
+}
+
+func TestGenerateRecommendations_Execute_ColdStart(t *testing.T) {
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	popularity := mocks.NewArticlePopularityReader(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return([]string{"read1"}, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return(nil, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+
+	// A provisional cluster seeded from onboarding interests
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return([]datasources.UserInterestCluster{
+			{ClusterID: 0, CentroidVector: []float32{1.0, 0.0}, Provisional: true},
+		}, nil)
+
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, []float32{1.0, 0.0}, 20).
+		Return([]domain.SimilarArticle{{HashID: "rec1", Score: 0.8}, {HashID: "pop2", Score: 0.1}}, nil)
+
+	popularity.EXPECT().
+		ListPopularArticles(mock.Anything, domain.PopularityWindowMonth, 1, 50).
+		Return([]domain.ArticlePopularity{
+			{HashID: "pop1", Score: 10},
+			{HashID: "read1", Score: 8},
+			{HashID: "pop2", Score: 5},
+			{HashID: "disliked1", Score: -2},
+		}, nil)
+
+	config := testGenerateRecommendationsConfig()
+	config.ColdStartMinRatings = 6
+	config.PopularityFallbackWeight = 0.3
+	config.PopularityFallbackCandidates = 50
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           popularity,
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               config,
+		Experiment:           RecommendationExperiment{},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ScoredArticle{
+		{HashID: "rec1", Score: 0.8, Source: "provisional_cluster_0"},
+		{HashID: "pop1", Score: 0.3, Source: "popular"},
+		{HashID: "pop2", Score: 0.15, Source: "popular"},
+	}, result)
+}
+
+func TestGenerateRecommendations_Execute_ColdStartCountsExplicitRatings(t *testing.T) {
+	now := time.Now()
+	implicit := domain.ArticleSignals{OpenedLink: true, TimeOnArticle: time.Minute}
+
+	cases := []struct {
+		name        string
+		ratings     []domain.UserArticleRating
+		wantPopular bool
+	}{
+		{
+			name: "implicit_signals_only",
+			ratings: []domain.UserArticleRating{
+				{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now, Signals: implicit},
+				{ArticleHashID: "art2", Vector: []float32{1.0, 0.0}, RatedAt: now, Signals: implicit},
+			},
+			wantPopular: true,
+		},
+		{
+			name: "explicit_ratings",
+			ratings: []domain.UserArticleRating{
+				{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now,
+					Signals: domain.ArticleSignals{ThumbsUp: true}},
+				{ArticleHashID: "art2", Vector: []float32{1.0, 0.0}, RatedAt: now,
+					Signals: domain.ArticleSignals{Rating: 5, OpenedLink: true}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+			interactionStore := mocks.NewUserArticleInteractionStore(t)
+			readArticlesLister := mocks.NewReadArticleIDsLister(t)
+			popularity := mocks.NewArticlePopularityReader(t)
+
+			readArticlesLister.EXPECT().
+				ListReadArticleIDs(mock.Anything, "user1").
+				Return(nil, nil)
+
+			interactionStore.EXPECT().
+				GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+				Return(tc.ratings, nil)
+
+			vectorSimilarity.EXPECT().
+				ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+				Return([]domain.SimilarArticle{{HashID: "rec1", Score: 0.8}}, nil)
+
+			if tc.wantPopular {
+				popularity.EXPECT().
+					ListPopularArticles(mock.Anything, domain.PopularityWindowMonth, 1, 50).
+					Return([]domain.ArticlePopularity{{HashID: "pop1", Score: 10}}, nil)
+			}
+
+			config := testGenerateRecommendationsConfig()
+			config.UseInterestClusters = false
+			config.NegativeSignalWeight = 0
+			config.ColdStartMinRatings = 2
+			config.PopularityFallbackWeight = 0.3
+			config.PopularityFallbackCandidates = 50
+
+			cmd := &GenerateRecommendations{
+				VectorSimilarity:     vectorSimilarity,
+				VectorsGetter:        interactionStore,
+				ClusterGetter:        mocks.NewUserInterestClusterStore(t),
+				ReadArticlesLister:   readArticlesLister,
+				HiddenArticlesLister: noHiddenArticles(t),
+				TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+				Popularity:           popularity,
+				Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+				Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+				Exploration:          mocks.NewCategoryExplorationLister(t),
+				ArticleLister:        mocks.NewLatestArticleLister(t),
+				PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+				Duplicates:           noDuplicates(t),
+				Config:               config,
+				Experiment:           RecommendationExperiment{},
+			}
+
+			result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+			require.NoError(t, err)
+
+			want := []ScoredArticle{{HashID: "rec1", Score: 0.8, Source: "temporal"}}
+			if tc.wantPopular {
+				want = append(want, ScoredArticle{HashID: "pop1", Score: 0.3, Source: "popular"})
+			}
+			assert.Equal(t, want, result)
+		})
+	}
+}
+
+func TestGenerateRecommendations_Execute_WithPopularityPrior(t *testing.T) {
+	now := time.Now()
+
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	popularity := mocks.NewArticlePopularityReader(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return(nil, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now}}, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return(nil, nil)
+
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+		Return([]domain.SimilarArticle{
+			{HashID: "rec1", Score: 0.80},
+			{HashID: "rec2", Score: 0.75},
+			{HashID: "rec3", Score: 0.70},
+		}, nil)
+
+	popularity.EXPECT().
+		GetArticlePopularity(mock.Anything, domain.PopularityWindowMonth, []string{"rec1", "rec2", "rec3"}).
+		Return(map[string]domain.ArticlePopularity{
+			"rec1": {HashID: "rec1", Score: -5},
+			"rec2": {HashID: "rec2", Score: 10},
+		}, nil)
+
+	config := testGenerateRecommendationsConfig()
+	config.PopularityPriorWeight = 0.1
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           popularity,
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               config,
+		Experiment:           RecommendationExperiment{},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+
+	// The most popular candidate gains the full weight; disliked ones are pushed down
+	assertScoredArticlesEqual(t, []ScoredArticle{
+		{HashID: "rec2", Score: 0.85},
+		{HashID: "rec1", Score: 0.75},
+		{HashID: "rec3", Score: 0.70},
+	}, result)
+}
+
+func TestGenerateRecommendations_Execute_WithCollaborative(t *testing.T) {
+	now := time.Now()
+
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	clusterStore := mocks.NewUserInterestClusterStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+	collaborative := mocks.NewCollaborativeCandidateLister(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return([]string{"read1"}, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: now}}, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsDown).
+		Return(nil, nil)
+
+	clusterStore.EXPECT().
+		GetUserInterestClusters(mock.Anything, "user1").
+		Return(nil, nil)
+
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 40).
+		Return([]domain.SimilarArticle{{HashID: "rec1", Score: 0.9}, {HashID: "colike1", Score: 0.2}}, nil)
+
+	collaborative.EXPECT().
+		ListCollaborativeCandidates(mock.Anything, "user1", 10).
+		Return([]domain.SimilarArticle{
+			{HashID: "colike1", Score: 1.6},
+			{HashID: "read1", Score: 1.2},
+			{HashID: "colike2", Score: 0.4},
+		}, nil)
+
+	config := testGenerateRecommendationsConfig()
+	config.CollaborativeWeight = 0.5
+	config.CollaborativeCandidates = 10
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        clusterStore,
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        collaborative,
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               config,
+		Experiment:           RecommendationExperiment{},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ScoredArticle{
+		{HashID: "rec1", Score: 0.9, Source: "temporal"},
+		{HashID: "colike1", Score: 0.5, Source: "collaborative"},
+		{HashID: "colike2", Score: 0.125, Source: "collaborative"},
+	}, result)
+}
@@ -0,0 +1,36 @@
+package command
+
+import "github.com/jbeshir/alignment-research-feed/internal/domain"
+
+// RecommendationExperimentArm is a named variant of the recommendation generation config.
+type RecommendationExperimentArm struct {
+	Name string
+
+	// Weight is the arm's share of users relative to the other arms' weights.
+	Weight int
+
+	Config GenerateRecommendationsConfig
+}
+
+// RecommendationExperiment splits users between arms, each generating recommendations with its
+// own config, so config changes can be compared by how users respond to what they are shown.
+// Users are bucketed by hashing their ID, so each user stays in the same arm while the
+// experiment's name and arms are unchanged.
+type RecommendationExperiment struct {
+	Name string
+	Arms []RecommendationExperimentArm
+}
+
+// ArmForUser returns the arm a user is bucketed into, or false if the experiment has no arms.
+func (e RecommendationExperiment) ArmForUser(userID string) (RecommendationExperimentArm, bool) {
+	weights := make([]int, len(e.Arms))
+	for i, arm := range e.Arms {
+		weights[i] = arm.Weight
+	}
+
+	i := domain.ChooseExperimentArm(e.Name, userID, weights)
+	if i < 0 {
+		return RecommendationExperimentArm{}, false
+	}
+	return e.Arms[i], true
+}
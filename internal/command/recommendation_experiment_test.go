@@ -0,0 +1,76 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecommendationExperiment_ArmForUser(t *testing.T) {
+	_, ok := RecommendationExperiment{Name: "exp"}.ArmForUser("user1")
+	assert.False(t, ok)
+
+	arm, ok := RecommendationExperiment{
+		Name: "exp",
+		Arms: []RecommendationExperimentArm{{Name: "off", Weight: 0}, {Name: "on", Weight: 1}},
+	}.ArmForUser("user1")
+	require.True(t, ok)
+	assert.Equal(t, "on", arm.Name)
+}
+
+func TestGenerateRecommendations_Execute_WithExperiment(t *testing.T) {
+	vectorSimilarity := mocks.NewSimilarArticlesByVectorLister(t)
+	interactionStore := mocks.NewUserArticleInteractionStore(t)
+	readArticlesLister := mocks.NewReadArticleIDsLister(t)
+
+	readArticlesLister.EXPECT().
+		ListReadArticleIDs(mock.Anything, "user1").
+		Return(nil, nil)
+
+	interactionStore.EXPECT().
+		GetUserArticleVectorsByType(mock.Anything, "user1", domain.RatingTypeThumbsUp).
+		Return([]domain.UserArticleRating{{ArticleHashID: "art1", Vector: []float32{1.0, 0.0}, RatedAt: time.Now()}}, nil)
+
+	// The arm's config turns off clusters and negative signals, and fetches fewer candidates
+	vectorSimilarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, 10).
+		Return([]domain.SimilarArticle{{HashID: "rec1", Score: 0.9}}, nil)
+
+	armConfig := testGenerateRecommendationsConfig()
+	armConfig.UseInterestClusters = false
+	armConfig.NegativeSignalWeight = 0
+	armConfig.CandidatesPerCluster = 5
+
+	cmd := &GenerateRecommendations{
+		VectorSimilarity:     vectorSimilarity,
+		VectorsGetter:        interactionStore,
+		ClusterGetter:        mocks.NewUserInterestClusterStore(t),
+		ReadArticlesLister:   readArticlesLister,
+		HiddenArticlesLister: noHiddenArticles(t),
+		TagCooccurrence:      mocks.NewTagCooccurrenceLister(t),
+		Popularity:           mocks.NewArticlePopularityReader(t),
+		Collaborative:        mocks.NewCollaborativeCandidateLister(t),
+		Impressions:          mocks.NewIgnoredRecommendationCounter(t),
+		Exploration:          mocks.NewCategoryExplorationLister(t),
+		ArticleLister:        mocks.NewLatestArticleLister(t),
+		PublishedDates:       mocks.NewArticlePublishedDateGetter(t),
+		Duplicates:           noDuplicates(t),
+		Config:               testGenerateRecommendationsConfig(),
+		Experiment: RecommendationExperiment{
+			Name: "exp",
+			Arms: []RecommendationExperimentArm{{Name: "variant", Weight: 1, Config: armConfig}},
+		},
+	}
+
+	result, err := cmd.Execute(t.Context(), GenerateRecommendationsRequest{UserID: "user1", Limit: 10})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ScoredArticle{
+		{HashID: "rec1", Score: 0.9, Source: "temporal", ExperimentArm: "variant"},
+	}, result)
+}
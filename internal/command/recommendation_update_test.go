@@ -0,0 +1,173 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyRatingToRecommendations_Execute(t *testing.T) {
+	clusters := []datasources.UserInterestCluster{
+		{ClusterID: 0, CentroidVector: []float32{1.0, 0.0}, ArticleCount: 3},
+		{ClusterID: 1, CentroidVector: []float32{0.0, 1.0}, ArticleCount: 1},
+		{ClusterID: 2, CentroidVector: []float32{0.0, 0.9}, Provisional: true},
+	}
+
+	cases := []struct {
+		name         string
+		likedVector  []float32
+		clusters     []datasources.UserInterestCluster
+		clustersErr  error
+		wantUpsert   bool
+		wantID       int
+		wantCentroid []float32
+		wantCount    int
+		deleteErr    error
+		wantDelete   bool
+		wantErr      bool
+	}{
+		{
+			name:         "like_moves_nearest_computed_cluster",
+			likedVector:  []float32{0.0, 0.8},
+			clusters:     clusters,
+			wantUpsert:   true,
+			wantID:       1,
+			wantCentroid: []float32{0.0, 0.9},
+			wantCount:    2,
+			wantDelete:   true,
+		},
+		{
+			name:        "only_provisional_clusters",
+			likedVector: []float32{0.0, 0.8},
+			clusters:    clusters[2:],
+			wantDelete:  true,
+		},
+		{
+			name:       "not_a_like_only_invalidates",
+			wantDelete: true,
+		},
+		{
+			name:        "cluster_error",
+			likedVector: []float32{0.0, 0.8},
+			clustersErr: errors.New("db down"),
+			wantErr:     true,
+		},
+		{
+			name:       "delete_error",
+			deleteErr:  errors.New("db down"),
+			wantDelete: true,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			clusterStore := mocks.NewUserInterestClusterStore(t)
+			precomputedDeleter := mocks.NewPrecomputedRecommendationDeleter(t)
+
+			if tc.likedVector != nil {
+				clusterStore.EXPECT().
+					GetUserInterestClusters(mock.Anything, "user1").
+					Return(tc.clusters, tc.clustersErr)
+			}
+			if tc.wantUpsert {
+				clusterStore.EXPECT().
+					UpsertUserInterestCluster(mock.Anything, "user1", tc.wantID,
+						mock.MatchedBy(func(centroid []float32) bool {
+							return assert.InDeltaSlice(t, tc.wantCentroid, centroid, 0.0001)
+						}), tc.wantCount).
+					Return(nil)
+			}
+			if tc.wantDelete {
+				precomputedDeleter.EXPECT().
+					DeleteUserPrecomputedRecommendations(mock.Anything, "user1").
+					Return(tc.deleteErr)
+			}
+
+			_, err := NewApplyRatingToRecommendations(clusterStore, clusterStore, precomputedDeleter).
+				Execute(t.Context(), ApplyRatingToRecommendationsRequest{UserID: "user1", LikedVector: tc.likedVector})
+
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetArticleRating_Execute_QueuesRecommendationUpdate(t *testing.T) {
+	thumbsUp := true
+	thumbsDown := true
+	rating := func(r int) *int { return &r }
+	vector := []float32{1.0, 0.0}
+
+	cases := []struct {
+		name      string
+		req       SetArticleRatingRequest
+		wantLiked bool
+	}{
+		{name: "thumbs_up", req: SetArticleRatingRequest{ThumbsUp: &thumbsUp}, wantLiked: true},
+		{name: "thumbs_down", req: SetArticleRatingRequest{ThumbsDown: &thumbsDown}},
+		{name: "high_rating", req: SetArticleRatingRequest{Rating: rating(5)}, wantLiked: true},
+		{name: "middling_rating", req: SetArticleRatingRequest{Rating: rating(3)}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vectorFetcher := mocks.NewArticleVectorFetcher(t)
+			ratingSetter := mocks.NewArticleRatingSetter(t)
+			regenMarker := mocks.NewUserRegenerationNeededMarker(t)
+
+			vectorFetcher.EXPECT().FetchArticleVector(mock.Anything, "hash1").Return(vector, nil)
+			if tc.req.Rating != nil {
+				ratingSetter.EXPECT().
+					SetArticleGradedRating(mock.Anything, "user1", "hash1", *tc.req.Rating, vector).
+					Return(nil)
+			} else {
+				ratingSetter.EXPECT().
+					SetArticleRating(mock.Anything, "user1", "hash1", tc.req.ThumbsUp, tc.req.ThumbsDown, vector).
+					Return(nil)
+			}
+			regenMarker.EXPECT().MarkUserNeedsRegeneration(mock.Anything, "user1").Return(nil)
+
+			updates := make(chan ApplyRatingToRecommendationsRequest, 1)
+			tc.req.UserID = "user1"
+			tc.req.ArticleHashID = "hash1"
+			_, err := NewSetArticleRating(vectorFetcher, ratingSetter, regenMarker, updates).
+				Execute(t.Context(), tc.req)
+			require.NoError(t, err)
+
+			want := ApplyRatingToRecommendationsRequest{UserID: "user1"}
+			if tc.wantLiked {
+				want.LikedVector = vector
+			}
+			require.Len(t, updates, 1)
+			assert.Equal(t, want, <-updates)
+		})
+	}
+}
+
+func TestSetArticleRating_Execute_FullQueueDropsUpdate(t *testing.T) {
+	thumbsUp := true
+	vectorFetcher := mocks.NewArticleVectorFetcher(t)
+	ratingSetter := mocks.NewArticleRatingSetter(t)
+	regenMarker := mocks.NewUserRegenerationNeededMarker(t)
+
+	vectorFetcher.EXPECT().FetchArticleVector(mock.Anything, "hash1").Return(nil, nil)
+	ratingSetter.EXPECT().
+		SetArticleRating(mock.Anything, "user1", "hash1", &thumbsUp, (*bool)(nil), []float32(nil)).
+		Return(nil)
+	regenMarker.EXPECT().MarkUserNeedsRegeneration(mock.Anything, "user1").Return(nil)
+
+	// A full queue doesn't hold up or fail the rating
+	updates := make(chan ApplyRatingToRecommendationsRequest)
+	_, err := NewSetArticleRating(vectorFetcher, ratingSetter, regenMarker, updates).
+		Execute(t.Context(), SetArticleRatingRequest{UserID: "user1", ArticleHashID: "hash1", ThumbsUp: &thumbsUp})
+	require.NoError(t, err)
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// RecordArticleSignalRequest is the request for the RecordArticleSignal command.
+type RecordArticleSignalRequest struct {
+	UserID        string
+	ArticleHashID string
+	Signal        domain.ArticleSignal
+}
+
+// RecordArticleSignal records an implicit signal of a user's interest in an article, such as
+// opening its link, so it can weight the article's vector in their recommendations.
+type RecordArticleSignal struct {
+	ArticleVectorFetcher datasources.ArticleVectorFetcher
+	SignalRecorder       datasources.ArticleSignalRecorder
+	RegenerationMarker   datasources.UserRegenerationNeededMarker
+}
+
+// NewRecordArticleSignal creates a properly initialized RecordArticleSignal command.
+func NewRecordArticleSignal(
+	articleVectorFetcher datasources.ArticleVectorFetcher,
+	signalRecorder datasources.ArticleSignalRecorder,
+	regenerationMarker datasources.UserRegenerationNeededMarker,
+) *RecordArticleSignal {
+	return &RecordArticleSignal{
+		ArticleVectorFetcher: articleVectorFetcher,
+		SignalRecorder:       signalRecorder,
+		RegenerationMarker:   regenerationMarker,
+	}
+}
+
+// Execute validates and records the signal, storing the article's vector if it has one.
+func (c *RecordArticleSignal) Execute(ctx context.Context, req RecordArticleSignalRequest) (Empty, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	if err := req.Signal.Validate(); err != nil {
+		return Empty{}, err
+	}
+
+	vector, err := c.ArticleVectorFetcher.FetchArticleVector(ctx, req.ArticleHashID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to fetch article vector, proceeding without vector",
+			"error", err, "articleHashID", req.ArticleHashID)
+	}
+
+	if err := c.SignalRecorder.RecordArticleSignal(ctx, req.UserID, req.ArticleHashID, req.Signal, vector); err != nil {
+		return Empty{}, fmt.Errorf("recording article signal: %w", err)
+	}
+
+	if err := c.RegenerationMarker.MarkUserNeedsRegeneration(ctx, req.UserID); err != nil {
+		logger.WarnContext(ctx, "failed to mark user for regeneration", "error", err)
+	}
+
+	return Empty{}, nil
+}
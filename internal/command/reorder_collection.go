@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+)
+
+// ErrInvalidCollectionOrder is returned when a reorder request doesn't list exactly
+// the articles currently in the collection.
+var ErrInvalidCollectionOrder = errors.New("order must list each article in the collection exactly once")
+
+// ReorderCollectionRequest is the request for the ReorderCollection command.
+type ReorderCollectionRequest struct {
+	CollectionID string
+	ArticleIDs   []string
+}
+
+// ReorderCollection sets the order of articles in a collection.
+type ReorderCollection struct {
+	OrderStore datasources.CollectionArticleOrderRepository
+}
+
+// NewReorderCollection creates a properly initialized ReorderCollection command.
+func NewReorderCollection(orderStore datasources.CollectionArticleOrderRepository) *ReorderCollection {
+	return &ReorderCollection{
+		OrderStore: orderStore,
+	}
+}
+
+// Execute validates the requested order against the collection's contents and applies it.
+func (c *ReorderCollection) Execute(ctx context.Context, req ReorderCollectionRequest) (Empty, error) {
+	current, err := c.OrderStore.ListCollectionArticleIDs(ctx, req.CollectionID)
+	if err != nil {
+		return Empty{}, fmt.Errorf("listing collection articles: %w", err)
+	}
+
+	if len(current) != len(req.ArticleIDs) {
+		return Empty{}, ErrInvalidCollectionOrder
+	}
+
+	sortedCurrent := slices.Sorted(slices.Values(current))
+	sortedRequested := slices.Sorted(slices.Values(req.ArticleIDs))
+	if !slices.Equal(sortedCurrent, sortedRequested) {
+		return Empty{}, ErrInvalidCollectionOrder
+	}
+
+	if err := c.OrderStore.ReorderCollectionArticles(ctx, req.CollectionID, req.ArticleIDs); err != nil {
+		return Empty{}, fmt.Errorf("reordering collection articles: %w", err)
+	}
+
+	return Empty{}, nil
+}
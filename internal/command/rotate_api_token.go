@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+var (
+	// ErrTokenNotFound is returned when a token does not exist or belongs to another user.
+	ErrTokenNotFound = errors.New("token not found")
+
+	// ErrTokenNotRotatable is returned when rotating a token that is no longer active
+	// or has already been rotated.
+	ErrTokenNotRotatable = errors.New("token is revoked, expired or already rotated")
+)
+
+// RotateAPITokenRequest is the request for the RotateAPIToken command.
+type RotateAPITokenRequest struct {
+	UserID  string
+	TokenID string
+}
+
+// RotateAPITokenResponse is the response from the RotateAPIToken command.
+type RotateAPITokenResponse struct {
+	Token CreateAPITokenResponse
+	// ReplacedTokenExpiresAt is when the rotated token stops working.
+	ReplacedTokenExpiresAt time.Time
+}
+
+// RotateAPIToken issues a new secret for a token. The new token keeps the old one's
+// name, scopes and lifetime; the old token keeps working until the grace period ends
+// so clients can be switched over. Tokens in their grace period still count towards
+// the user's token limit.
+type RotateAPIToken struct {
+	TokenGetter  datasources.APITokenGetter
+	TokenCounter datasources.UserAPITokenCounter
+	TokenRotator datasources.APITokenRotator
+	AuditWriter  datasources.AuditEventWriter
+	GracePeriod  time.Duration
+}
+
+// NewRotateAPIToken creates a properly initialized RotateAPIToken command.
+func NewRotateAPIToken(
+	tokenGetter datasources.APITokenGetter,
+	tokenCounter datasources.UserAPITokenCounter,
+	tokenRotator datasources.APITokenRotator,
+	auditWriter datasources.AuditEventWriter,
+	gracePeriod time.Duration,
+) *RotateAPIToken {
+	return &RotateAPIToken{
+		TokenGetter:  tokenGetter,
+		TokenCounter: tokenCounter,
+		TokenRotator: tokenRotator,
+		AuditWriter:  auditWriter,
+		GracePeriod:  gracePeriod,
+	}
+}
+
+// Execute creates the replacement token and schedules the old one to expire.
+func (c *RotateAPIToken) Execute(ctx context.Context, req RotateAPITokenRequest) (RotateAPITokenResponse, error) {
+	old, ok, err := c.TokenGetter.GetUserAPIToken(ctx, req.TokenID, req.UserID)
+	if err != nil {
+		return RotateAPITokenResponse{}, fmt.Errorf("fetching token: %w", err)
+	}
+	if !ok {
+		return RotateAPITokenResponse{}, ErrTokenNotFound
+	}
+
+	now := time.Now()
+	if old.Status(now) != domain.APITokenStatusActive || old.ReplacedByID != nil {
+		return RotateAPITokenResponse{}, ErrTokenNotRotatable
+	}
+
+	// The old token is counted as active until its grace period ends, so the
+	// replacement needs a free slot of its own.
+	count, err := c.TokenCounter.CountUserActiveAPITokens(ctx, req.UserID)
+	if err != nil {
+		return RotateAPITokenResponse{}, fmt.Errorf("counting user tokens: %w", err)
+	}
+	if count >= MaxAPITokensPerUser {
+		return RotateAPITokenResponse{}, ErrTokenLimitExceeded
+	}
+
+	var expiresAt *time.Time
+	if old.ExpiresAt != nil {
+		t := now.Add(old.ExpiresAt.Sub(old.CreatedAt))
+		expiresAt = &t
+	}
+
+	oldExpiresAt := now.Add(c.GracePeriod)
+	if old.ExpiresAt != nil && old.ExpiresAt.Before(oldExpiresAt) {
+		oldExpiresAt = *old.ExpiresAt
+	}
+
+	fullToken, params, err := newAPITokenParams(req.UserID, old.Name, old.Scopes, expiresAt)
+	if err != nil {
+		return RotateAPITokenResponse{}, err
+	}
+
+	rotated, err := c.TokenRotator.RotateAPIToken(ctx, old.ID, oldExpiresAt, params)
+	if err != nil {
+		return RotateAPITokenResponse{}, fmt.Errorf("rotating token: %w", err)
+	}
+	if !rotated {
+		// Rotated or revoked concurrently since we fetched it
+		return RotateAPITokenResponse{}, ErrTokenNotRotatable
+	}
+
+	event := domain.NewAuditEvent(ctx, domain.AuditEventAPITokenRotated, domain.AuditOutcomeSuccess,
+		"replaced by "+params.ID)
+	event.UserID = req.UserID
+	event.TokenID = &old.ID
+	writeAuditEvent(ctx, c.AuditWriter, event)
+
+	return RotateAPITokenResponse{
+		Token: CreateAPITokenResponse{
+			TokenID:   params.ID,
+			FullToken: fullToken,
+			Prefix:    params.TokenPrefix,
+			Scopes:    params.Scopes,
+			ExpiresAt: expiresAt,
+		},
+		ReplacedTokenExpiresAt: oldExpiresAt,
+	}, nil
+}
@@ -0,0 +1,139 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// savedSearchSemanticCandidates is how many of the most similar articles a semantic
+// saved search considers; pages and "new since" results are taken from these.
+const savedSearchSemanticCandidates = 100
+
+// RunSavedSearchRequest is the request for the RunSavedSearch command.
+type RunSavedSearchRequest struct {
+	Search   domain.SavedSearch
+	Page     int
+	PageSize int
+
+	// NewOnly restricts results to articles published since the search was last viewed,
+	// and records this run as the latest view.
+	NewOnly bool
+}
+
+// RunSavedSearch executes a saved search, dispatching on its kind.
+type RunSavedSearch struct {
+	LatestLister datasources.LatestArticleLister
+	Similarity   datasources.SimilarArticlesByVectorLister
+	Fetcher      datasources.ArticleFetcher
+	ViewedMarker datasources.SavedSearchViewedMarker
+}
+
+// NewRunSavedSearch creates a properly initialized RunSavedSearch command.
+func NewRunSavedSearch(
+	latestLister datasources.LatestArticleLister,
+	similarity datasources.SimilarArticlesByVectorLister,
+	fetcher datasources.ArticleFetcher,
+	viewedMarker datasources.SavedSearchViewedMarker,
+) *RunSavedSearch {
+	return &RunSavedSearch{
+		LatestLister: latestLister,
+		Similarity:   similarity,
+		Fetcher:      fetcher,
+		ViewedMarker: viewedMarker,
+	}
+}
+
+// Execute returns the requested page of results for the saved search.
+func (c *RunSavedSearch) Execute(ctx context.Context, req RunSavedSearchRequest) ([]domain.Article, error) {
+	// Captured before querying so articles arriving mid-run show up next time.
+	viewedAt := time.Now()
+
+	var articles []domain.Article
+	var err error
+	switch req.Search.Kind {
+	case domain.SavedSearchKindFilters:
+		articles, err = c.runFilters(ctx, req)
+	case domain.SavedSearchKindSemantic:
+		articles, err = c.runSemantic(ctx, req)
+	default:
+		return nil, fmt.Errorf("unknown saved search kind: %s", req.Search.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NewOnly {
+		if err := c.ViewedMarker.MarkSavedSearchViewed(
+			ctx, req.Search.UserID, req.Search.ID, viewedAt,
+		); err != nil {
+			return nil, fmt.Errorf("marking saved search viewed: %w", err)
+		}
+	}
+
+	return articles, nil
+}
+
+func (c *RunSavedSearch) runFilters(ctx context.Context, req RunSavedSearchRequest) ([]domain.Article, error) {
+	var filters domain.ArticleFilters
+	if req.Search.Filters != nil {
+		filters = *req.Search.Filters
+	}
+	if req.NewOnly {
+		if since := req.Search.NewSince(); since.After(filters.PublishedAfter) {
+			filters.PublishedAfter = since
+		}
+	}
+
+	ids, err := c.LatestLister.ListLatestArticleIDs(ctx, filters, domain.ArticleListOptions{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing articles for saved search: %w", err)
+	}
+
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("fetching articles for saved search: %w", err)
+	}
+
+	return articles, nil
+}
+
+func (c *RunSavedSearch) runSemantic(ctx context.Context, req RunSavedSearchRequest) ([]domain.Article, error) {
+	similar, err := c.Similarity.ListSimilarArticlesByVector(
+		ctx, nil, req.Search.QueryVector, savedSearchSemanticCandidates,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding similar articles for saved search: %w", err)
+	}
+
+	ids := make([]string, 0, len(similar))
+	for _, s := range similar {
+		ids = append(ids, s.HashID)
+	}
+
+	candidates, err := c.Fetcher.FetchArticlesByID(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("fetching articles for saved search: %w", err)
+	}
+
+	if req.NewOnly {
+		since := req.Search.NewSince()
+		fresh := candidates[:0]
+		for _, a := range candidates {
+			if a.PublishedAt != nil && a.PublishedAt.After(since) {
+				fresh = append(fresh, a)
+			}
+		}
+		candidates = fresh
+	}
+
+	start := min((req.Page-1)*req.PageSize, len(candidates))
+	end := min(start+req.PageSize, len(candidates))
+	return candidates[start:end], nil
+}
@@ -0,0 +1,229 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSavedSearch_Execute(t *testing.T) {
+	filters := &domain.ArticleFilters{Category: "Interpretability"}
+
+	cases := []struct {
+		name       string
+		req        CreateSavedSearchRequest
+		count      int64
+		vector     []float32
+		wantEmbed  bool
+		wantCreate bool
+		wantKind   domain.SavedSearchKind
+		wantErr    error
+	}{
+		{
+			name:       "filters",
+			req:        CreateSavedSearchRequest{UserID: "user1", Name: "Interp", Filters: filters},
+			wantCreate: true,
+			wantKind:   domain.SavedSearchKindFilters,
+		},
+		{
+			name:       "semantic",
+			req:        CreateSavedSearchRequest{UserID: "user1", Name: "SAEs", QueryText: "sparse autoencoders"},
+			vector:     []float32{0.1, 0.2},
+			wantEmbed:  true,
+			wantCreate: true,
+			wantKind:   domain.SavedSearchKindSemantic,
+		},
+		{
+			name:    "both_filters_and_query",
+			req:     CreateSavedSearchRequest{UserID: "user1", Name: "x", Filters: filters, QueryText: "y"},
+			wantErr: ErrInvalidSavedSearch,
+		},
+		{
+			name:    "neither_filters_nor_query",
+			req:     CreateSavedSearchRequest{UserID: "user1", Name: "x"},
+			wantErr: ErrInvalidSavedSearch,
+		},
+		{
+			name:      "embedder_unavailable",
+			req:       CreateSavedSearchRequest{UserID: "user1", Name: "x", QueryText: "y"},
+			wantEmbed: true,
+			wantErr:   ErrEmbeddingUnavailable,
+		},
+		{
+			name:    "limit_exceeded",
+			req:     CreateSavedSearchRequest{UserID: "user1", Name: "x", Filters: filters},
+			count:   MaxSavedSearchesPerUser,
+			wantErr: ErrSavedSearchLimitExceeded,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := mocks.NewUserSavedSearchCounter(t)
+			creator := mocks.NewSavedSearchCreator(t)
+			embedder := mocks.NewEmbedder(t)
+
+			counter.EXPECT().CountUserSavedSearches(mock.Anything, "user1").Return(tc.count, nil)
+			if tc.wantEmbed {
+				embedder.EXPECT().EmbedText(mock.Anything, tc.req.QueryText).Return(tc.vector, nil)
+			}
+			if tc.wantCreate {
+				creator.EXPECT().
+					CreateSavedSearch(mock.Anything, mock.MatchedBy(func(s domain.SavedSearch) bool {
+						return s.UserID == "user1" && s.Kind == tc.wantKind && len(s.FeedToken) == 64
+					})).
+					Return(nil)
+			}
+
+			cmd := NewCreateSavedSearch(counter, creator, embedder)
+			search, err := cmd.Execute(t.Context(), tc.req)
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, search.ID)
+			assert.Equal(t, tc.req.Name, search.Name)
+			assert.Equal(t, tc.wantKind, search.Kind)
+			assert.Equal(t, tc.vector, search.QueryVector)
+		})
+	}
+}
+
+func TestRunSavedSearch_Execute_FiltersNewOnly(t *testing.T) {
+	created := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	viewed := time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC)
+
+	search := domain.SavedSearch{
+		ID:           "search1",
+		UserID:       "user1",
+		Kind:         domain.SavedSearchKindFilters,
+		Filters:      &domain.ArticleFilters{Category: "Interpretability", PublishedAfter: created},
+		LastViewedAt: &viewed,
+		CreatedAt:    created,
+	}
+
+	lister := mocks.NewLatestArticleLister(t)
+	fetcher := mocks.NewArticleFetcher(t)
+	marker := mocks.NewSavedSearchViewedMarker(t)
+
+	lister.EXPECT().
+		ListLatestArticleIDs(mock.Anything,
+			domain.ArticleFilters{Category: "Interpretability", PublishedAfter: viewed},
+			domain.ArticleListOptions{Page: 1, PageSize: 10}).
+		Return([]string{"a1"}, nil)
+	fetcher.EXPECT().FetchArticlesByID(mock.Anything, []string{"a1"}).Return([]domain.Article{{HashID: "a1"}}, nil)
+	marker.EXPECT().MarkSavedSearchViewed(mock.Anything, "user1", "search1", mock.Anything).Return(nil)
+
+	cmd := NewRunSavedSearch(lister, mocks.NewSimilarArticlesByVectorLister(t), fetcher, marker)
+	articles, err := cmd.Execute(t.Context(), RunSavedSearchRequest{
+		Search: search, Page: 1, PageSize: 10, NewOnly: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Article{{HashID: "a1"}}, articles)
+
+	// The caller's filters must not be modified by the new-only cutoff.
+	assert.Equal(t, created, search.Filters.PublishedAfter)
+}
+
+func TestRunSavedSearch_Execute_Semantic(t *testing.T) {
+	created := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	before := created.Add(-24 * time.Hour)
+	after := created.Add(24 * time.Hour)
+
+	search := domain.SavedSearch{
+		ID:          "search1",
+		UserID:      "user1",
+		Kind:        domain.SavedSearchKindSemantic,
+		QueryVector: []float32{0.1, 0.2},
+		CreatedAt:   created,
+	}
+
+	candidates := []domain.Article{
+		{HashID: "old", PublishedAt: &before},
+		{HashID: "new1", PublishedAt: &after},
+		{HashID: "undated"},
+		{HashID: "new2", PublishedAt: &after},
+	}
+
+	cases := []struct {
+		name     string
+		req      RunSavedSearchRequest
+		wantIDs  []string
+		wantMark bool
+	}{
+		{
+			name:    "first_page",
+			req:     RunSavedSearchRequest{Search: search, Page: 1, PageSize: 3},
+			wantIDs: []string{"old", "new1", "undated"},
+		},
+		{
+			name:    "second_page",
+			req:     RunSavedSearchRequest{Search: search, Page: 2, PageSize: 3},
+			wantIDs: []string{"new2"},
+		},
+		{
+			name:    "past_end",
+			req:     RunSavedSearchRequest{Search: search, Page: 3, PageSize: 3},
+			wantIDs: []string{},
+		},
+		{
+			name:     "new_only",
+			req:      RunSavedSearchRequest{Search: search, Page: 1, PageSize: 10, NewOnly: true},
+			wantIDs:  []string{"new1", "new2"},
+			wantMark: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			similarity := mocks.NewSimilarArticlesByVectorLister(t)
+			fetcher := mocks.NewArticleFetcher(t)
+			marker := mocks.NewSavedSearchViewedMarker(t)
+
+			similarity.EXPECT().
+				ListSimilarArticlesByVector(mock.Anything, []string(nil), search.QueryVector, savedSearchSemanticCandidates).
+				Return([]domain.SimilarArticle{{HashID: "old"}, {HashID: "new1"}, {HashID: "undated"}, {HashID: "new2"}}, nil)
+			fetcher.EXPECT().
+				FetchArticlesByID(mock.Anything, []string{"old", "new1", "undated", "new2"}).
+				Return(append([]domain.Article(nil), candidates...), nil)
+			if tc.wantMark {
+				marker.EXPECT().MarkSavedSearchViewed(mock.Anything, "user1", "search1", mock.Anything).Return(nil)
+			}
+
+			cmd := NewRunSavedSearch(mocks.NewLatestArticleLister(t), similarity, fetcher, marker)
+			articles, err := cmd.Execute(t.Context(), tc.req)
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(articles))
+			for _, a := range articles {
+				ids = append(ids, a.HashID)
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+		})
+	}
+}
+
+func TestRunSavedSearch_Execute_SimilarityError(t *testing.T) {
+	similarity := mocks.NewSimilarArticlesByVectorLister(t)
+	similarity.EXPECT().
+		ListSimilarArticlesByVector(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("pinecone unavailable"))
+
+	cmd := NewRunSavedSearch(
+		mocks.NewLatestArticleLister(t), similarity, mocks.NewArticleFetcher(t), mocks.NewSavedSearchViewedMarker(t),
+	)
+	_, err := cmd.Execute(t.Context(), RunSavedSearchRequest{
+		Search:  domain.SavedSearch{Kind: domain.SavedSearchKindSemantic},
+		Page:    1,
+		NewOnly: true,
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// SendDigestsRequest is the request for the SendDigests command.
+// This command takes no parameters beyond context.
+type SendDigestsRequest struct{}
+
+// SendDigestsResponse summarizes a digest sending run.
+type SendDigestsResponse struct {
+	Sent    int
+	Skipped int
+	Failed  int
+}
+
+// SendDigests sends email digests to all users whose digest is due.
+type SendDigests struct {
+	BuildCommand      Command[BuildDigestRequest, domain.Digest]
+	PreferencesLister datasources.EnabledDigestPreferencesLister
+	SentMarker        datasources.DigestSentMarker
+	Mailer            datasources.Mailer
+}
+
+// NewSendDigests creates a properly initialized SendDigests command.
+func NewSendDigests(
+	buildCommand Command[BuildDigestRequest, domain.Digest],
+	preferencesLister datasources.EnabledDigestPreferencesLister,
+	sentMarker datasources.DigestSentMarker,
+	mailer datasources.Mailer,
+) *SendDigests {
+	return &SendDigests{
+		BuildCommand:      buildCommand,
+		PreferencesLister: preferencesLister,
+		SentMarker:        sentMarker,
+		Mailer:            mailer,
+	}
+}
+
+// Execute sends digests to every user with a digest due.
+// Failures for individual users are logged and counted rather than aborting the run.
+func (c *SendDigests) Execute(ctx context.Context, _ SendDigestsRequest) (SendDigestsResponse, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	prefsList, err := c.PreferencesLister.ListEnabledDigestPreferences(ctx)
+	if err != nil {
+		return SendDigestsResponse{}, fmt.Errorf("listing digest preferences: %w", err)
+	}
+
+	now := time.Now()
+	var result SendDigestsResponse
+	for _, prefs := range prefsList {
+		if !prefs.IsDue(now) {
+			continue
+		}
+
+		sent, err := c.sendToUser(ctx, prefs, now)
+		switch {
+		case err != nil:
+			logger.ErrorContext(ctx, "failed to send digest",
+				"user_id", prefs.UserID, "error", err)
+			result.Failed++
+		case !sent:
+			result.Skipped++
+		default:
+			result.Sent++
+		}
+	}
+
+	logger.InfoContext(ctx, "digest sending complete",
+		"sent_count", result.Sent, "skipped_count", result.Skipped, "fail_count", result.Failed)
+
+	return result, nil
+}
+
+// sendToUser builds and sends a single user's digest.
+// Returns false without error if the digest had nothing in it.
+func (c *SendDigests) sendToUser(ctx context.Context, prefs domain.DigestPreferences, now time.Time) (bool, error) {
+	// Article fetches use the context user to populate per-user interaction state.
+	ctx = domain.ContextWithUserID(ctx, prefs.UserID)
+
+	digest, err := c.BuildCommand.Execute(ctx, BuildDigestRequest{
+		Preferences: prefs,
+		Now:         now,
+	})
+	if err != nil {
+		return false, fmt.Errorf("building digest: %w", err)
+	}
+
+	if digest.IsEmpty() {
+		return false, nil
+	}
+
+	text, html, err := renderDigest(digest)
+	if err != nil {
+		return false, err
+	}
+
+	if err := c.Mailer.SendMail(ctx, datasources.MailMessage{
+		To:       prefs.Email,
+		Subject:  digestSubject(digest),
+		TextBody: text,
+		HTMLBody: html,
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + digest.UnsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}); err != nil {
+		return false, fmt.Errorf("sending digest email: %w", err)
+	}
+
+	if err := c.SentMarker.MarkDigestSent(ctx, prefs.UserID, now); err != nil {
+		return true, fmt.Errorf("marking digest as sent: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,316 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDigest_Execute(t *testing.T) {
+	now := time.Date(2024, 6, 10, 8, 0, 0, 0, time.UTC)
+	lastSent := now.Add(-24 * time.Hour)
+
+	prefs := domain.DigestPreferences{
+		UserID:           "user1",
+		Email:            "user@example.com",
+		Frequency:        domain.DigestFrequencyDaily,
+		Categories:       []string{"Interpretability", "Governance & Policy"},
+		Enabled:          true,
+		UnsubscribeToken: "tok123",
+		LastSentAt:       &lastSent,
+	}
+
+	recommendCmd := cmdmocks.NewCommand[RecommendArticlesRequest, RecommendArticlesResponse](t)
+	latestLister := mocks.NewLatestArticleLister(t)
+	fetcher := mocks.NewArticleFetcher(t)
+	readLister := mocks.NewReadArticleIDsLister(t)
+
+	recommendCmd.EXPECT().
+		Execute(mock.Anything, RecommendArticlesRequest{UserID: "user1", Limit: 5}).
+		Return(RecommendArticlesResponse{Articles: []domain.Article{{HashID: "rec1"}}}, nil)
+
+	readLister.EXPECT().ListReadArticleIDs(mock.Anything, "user1").Return([]string{"read1"}, nil)
+
+	options := domain.ArticleListOptions{Page: 1, PageSize: 3}
+	latestLister.EXPECT().
+		ListLatestArticleIDs(mock.Anything, domain.ArticleFilters{
+			Category: "Interpretability", PublishedAfter: lastSent,
+		}, options).
+		Return([]string{"rec1", "read1", "new1"}, nil)
+	latestLister.EXPECT().
+		ListLatestArticleIDs(mock.Anything, domain.ArticleFilters{
+			Category: "Governance & Policy", PublishedAfter: lastSent,
+		}, options).
+		Return([]string{"new1", "new2", "new3", "new4"}, nil)
+
+	fetcher.EXPECT().
+		FetchArticlesByID(mock.Anything, []string{"new1", "new2", "new3"}).
+		Return([]domain.Article{{HashID: "new1"}, {HashID: "new2"}, {HashID: "new3"}}, nil)
+
+	cmd := NewBuildDigest(recommendCmd, latestLister, fetcher, readLister, BuildDigestConfig{
+		RecommendationLimit:  5,
+		CategoryArticleLimit: 3,
+		UnsubscribeBaseURL:   "https://api.example.com/v1/digest/unsubscribe",
+	})
+
+	digest, err := cmd.Execute(t.Context(), BuildDigestRequest{Preferences: prefs, Now: now})
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.Article{{HashID: "rec1"}}, digest.Recommended)
+	assert.Len(t, digest.NewInCategories, 3)
+	assert.Equal(t, "https://api.example.com/v1/digest/unsubscribe?token=tok123", digest.UnsubscribeURL)
+	assert.Equal(t, domain.DigestFrequencyDaily, digest.Frequency)
+}
+
+func TestSendDigests_Execute(t *testing.T) {
+	summaryArticle := domain.Article{
+		HashID:    "rec1",
+		Title:     "Sparse Autoencoders <Revisited>",
+		Link:      "https://example.com/sae",
+		Summary:   "A summary of the paper.",
+		KeyPoints: []string{"First point", "Second point"},
+	}
+
+	cases := []struct {
+		name        string
+		prefs       []domain.DigestPreferences
+		digest      domain.Digest
+		buildErr    error
+		mailErr     error
+		wantBuild   bool
+		wantMail    bool
+		wantResult  SendDigestsResponse
+		wantListErr error
+	}{
+		{
+			name: "sends_due_digest",
+			prefs: []domain.DigestPreferences{{
+				UserID: "user1", Email: "user@example.com",
+				Frequency: domain.DigestFrequencyDaily, Enabled: true,
+			}},
+			digest: domain.Digest{
+				Frequency:      domain.DigestFrequencyDaily,
+				Recommended:    []domain.Article{summaryArticle},
+				UnsubscribeURL: "https://api.example.com/unsub?token=abc",
+			},
+			wantBuild:  true,
+			wantMail:   true,
+			wantResult: SendDigestsResponse{Sent: 1},
+		},
+		{
+			name: "skips_not_due",
+			prefs: []domain.DigestPreferences{{
+				UserID: "user1", Email: "user@example.com",
+				Frequency: domain.DigestFrequencyWeekly, Enabled: true,
+				LastSentAt: func() *time.Time { ts := time.Now().Add(-time.Hour); return &ts }(),
+			}},
+			wantResult: SendDigestsResponse{},
+		},
+		{
+			name: "skips_empty_digest",
+			prefs: []domain.DigestPreferences{{
+				UserID: "user1", Email: "user@example.com",
+				Frequency: domain.DigestFrequencyDaily, Enabled: true,
+			}},
+			digest:     domain.Digest{Frequency: domain.DigestFrequencyDaily},
+			wantBuild:  true,
+			wantResult: SendDigestsResponse{Skipped: 1},
+		},
+		{
+			name: "build_error_counted_as_failure",
+			prefs: []domain.DigestPreferences{{
+				UserID: "user1", Email: "user@example.com",
+				Frequency: domain.DigestFrequencyDaily, Enabled: true,
+			}},
+			buildErr:   errors.New("similarity unavailable"),
+			wantBuild:  true,
+			wantResult: SendDigestsResponse{Failed: 1},
+		},
+		{
+			name: "mail_error_counted_as_failure",
+			prefs: []domain.DigestPreferences{{
+				UserID: "user1", Email: "user@example.com",
+				Frequency: domain.DigestFrequencyDaily, Enabled: true,
+			}},
+			digest: domain.Digest{
+				Frequency:   domain.DigestFrequencyDaily,
+				Recommended: []domain.Article{summaryArticle},
+			},
+			mailErr:    errors.New("connection refused"),
+			wantBuild:  true,
+			wantMail:   true,
+			wantResult: SendDigestsResponse{Failed: 1},
+		},
+		{
+			name:        "list_error",
+			wantListErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buildCmd := cmdmocks.NewCommand[BuildDigestRequest, domain.Digest](t)
+			lister := mocks.NewEnabledDigestPreferencesLister(t)
+			marker := mocks.NewDigestSentMarker(t)
+			mailer := mocks.NewMailer(t)
+
+			lister.EXPECT().ListEnabledDigestPreferences(mock.Anything).Return(tc.prefs, tc.wantListErr)
+
+			if tc.wantBuild {
+				buildCmd.EXPECT().
+					Execute(mock.Anything, mock.MatchedBy(func(req BuildDigestRequest) bool {
+						return req.Preferences.UserID == "user1"
+					})).
+					Return(tc.digest, tc.buildErr)
+			}
+
+			if tc.wantMail {
+				mailer.EXPECT().
+					SendMail(mock.Anything, mock.MatchedBy(func(msg datasources.MailMessage) bool {
+						return msg.To == "user@example.com" &&
+							strings.Contains(msg.Subject, "daily") &&
+							strings.Contains(msg.TextBody, "A summary of the paper.") &&
+							strings.Contains(msg.TextBody, "  - Second point") &&
+							strings.Contains(msg.HTMLBody, "Sparse Autoencoders &lt;Revisited&gt;") &&
+							strings.Contains(msg.HTMLBody, "<li>First point</li>")
+					})).
+					Return(tc.mailErr)
+				if tc.mailErr == nil {
+					marker.EXPECT().MarkDigestSent(mock.Anything, "user1", mock.Anything).Return(nil)
+				}
+			}
+
+			cmd := NewSendDigests(buildCmd, lister, marker, mailer)
+			result, err := cmd.Execute(t.Context(), SendDigestsRequest{})
+
+			if tc.wantListErr != nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+		})
+	}
+}
+
+func TestSetDigestPreferences_Execute(t *testing.T) {
+	existing := domain.DigestPreferences{
+		UserID:                 "user1",
+		Email:                  "old@example.com",
+		PendingEmail:           "pending@example.com",
+		EmailConfirmationToken: "confirm123",
+		Frequency:              domain.DigestFrequencyDaily,
+		Enabled:                true,
+		UnsubscribeToken:       "tok123",
+	}
+
+	cases := []struct {
+		name             string
+		existing         domain.DigestPreferences
+		found            bool
+		email            string
+		mailErr          error
+		wantMail         bool
+		wantEmail        string
+		wantPendingEmail string
+		wantToken        string
+		wantErr          bool
+	}{
+		{
+			name:             "first_address",
+			email:            "new@example.com",
+			wantMail:         true,
+			wantPendingEmail: "new@example.com",
+		},
+		{
+			name:             "changed_address",
+			existing:         existing,
+			found:            true,
+			email:            "new@example.com",
+			wantMail:         true,
+			wantEmail:        "old@example.com",
+			wantPendingEmail: "new@example.com",
+		},
+		{
+			name:      "confirmed_address",
+			existing:  existing,
+			found:     true,
+			email:     "old@example.com",
+			wantEmail: "old@example.com",
+		},
+		{
+			name:             "already_pending",
+			existing:         existing,
+			found:            true,
+			email:            "pending@example.com",
+			wantEmail:        "old@example.com",
+			wantPendingEmail: "pending@example.com",
+			wantToken:        "confirm123",
+		},
+		{
+			name:     "mail_error",
+			email:    "new@example.com",
+			mailErr:  errors.New("connection refused"),
+			wantMail: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewUserDigestPreferencesRepository(t)
+			mailer := mocks.NewMailer(t)
+
+			store.EXPECT().GetDigestPreferences(mock.Anything, "user1").Return(tc.existing, tc.found, nil).Once()
+
+			var mailed datasources.MailMessage
+			if tc.wantMail {
+				mailer.EXPECT().SendMail(mock.Anything, mock.Anything).
+					Run(func(_ context.Context, msg datasources.MailMessage) { mailed = msg }).
+					Return(tc.mailErr)
+			}
+			if !tc.wantErr {
+				store.EXPECT().UpsertDigestPreferences(mock.Anything, mock.Anything).
+					Run(func(_ context.Context, prefs domain.DigestPreferences) {
+						assert.Equal(t, tc.wantEmail, prefs.Email)
+						assert.Equal(t, tc.wantPendingEmail, prefs.PendingEmail)
+						if tc.wantMail {
+							// The mailed link carries the stored token
+							assert.Len(t, prefs.EmailConfirmationToken, 64)
+							assert.Equal(t, tc.wantPendingEmail, mailed.To)
+							assert.Contains(t, mailed.TextBody,
+								"https://api.example.com/v1/digest/confirm?token="+prefs.EmailConfirmationToken)
+						} else {
+							assert.Equal(t, tc.wantToken, prefs.EmailConfirmationToken)
+						}
+					}).
+					Return(nil)
+				store.EXPECT().GetDigestPreferences(mock.Anything, "user1").Return(tc.existing, true, nil).Once()
+			}
+
+			cmd := NewSetDigestPreferences(store, mailer, "https://api.example.com/v1/digest/confirm")
+
+			_, err := cmd.Execute(t.Context(), SetDigestPreferencesRequest{
+				UserID:    "user1",
+				Email:     tc.email,
+				Frequency: domain.DigestFrequencyWeekly,
+				Enabled:   true,
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
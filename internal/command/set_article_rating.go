@@ -11,20 +11,26 @@ import (
 // SetArticleRatingRequest is the request for the SetArticleRating command.
 // ThumbsUp and ThumbsDown are pointers: nil means "don't change",
 // true/false means "set to this value".
+// Rating, if set, instead gives a 1-5 graded rating along with its matching thumbs;
+// zero clears the rating and both thumbs.
 type SetArticleRatingRequest struct {
 	UserID        string
 	ArticleHashID string
 	ThumbsUp      *bool
 	ThumbsDown    *bool
+	Rating        *int
 }
 
-// SetArticleRating handles setting article ratings (thumbs up/down) with vector sync.
+// SetArticleRating handles setting article ratings (thumbs up/down or graded) with vector sync.
 // It fetches the article vector from Pinecone, then atomically updates the rating
-// and syncs the aggregate user vector.
+// and syncs the aggregate user vector. If RecommendationUpdates is set, the rating is also
+// sent there to be applied to the user's interest clusters and recommendations in the
+// background, so it takes effect before the batch job next runs.
 type SetArticleRating struct {
-	ArticleVectorFetcher datasources.ArticleVectorFetcher
-	RatingSetter         datasources.ArticleRatingSetter
-	RegenerationMarker   datasources.UserRegenerationNeededMarker
+	ArticleVectorFetcher  datasources.ArticleVectorFetcher
+	RatingSetter          datasources.ArticleRatingSetter
+	RegenerationMarker    datasources.UserRegenerationNeededMarker
+	RecommendationUpdates chan<- ApplyRatingToRecommendationsRequest
 }
 
 // NewSetArticleRating creates a properly initialized SetArticleRating command.
@@ -32,11 +38,13 @@ func NewSetArticleRating(
 	articleVectorFetcher datasources.ArticleVectorFetcher,
 	ratingSetter datasources.ArticleRatingSetter,
 	regenerationMarker datasources.UserRegenerationNeededMarker,
+	recommendationUpdates chan<- ApplyRatingToRecommendationsRequest,
 ) *SetArticleRating {
 	return &SetArticleRating{
-		ArticleVectorFetcher: articleVectorFetcher,
-		RatingSetter:         ratingSetter,
-		RegenerationMarker:   regenerationMarker,
+		ArticleVectorFetcher:  articleVectorFetcher,
+		RatingSetter:          ratingSetter,
+		RegenerationMarker:    regenerationMarker,
+		RecommendationUpdates: recommendationUpdates,
 	}
 }
 
@@ -44,6 +52,12 @@ func NewSetArticleRating(
 func (c *SetArticleRating) Execute(ctx context.Context, req SetArticleRatingRequest) (Empty, error) {
 	logger := domain.LoggerFromContext(ctx)
 
+	if req.Rating != nil && *req.Rating != 0 {
+		if err := domain.ValidateArticleRating(*req.Rating); err != nil {
+			return Empty{}, err
+		}
+	}
+
 	// 1. Fetch vector from Pinecone (graceful failure - nil is OK)
 	vector, err := c.ArticleVectorFetcher.FetchArticleVector(ctx, req.ArticleHashID)
 	if err != nil {
@@ -55,8 +69,13 @@ func (c *SetArticleRating) Execute(ctx context.Context, req SetArticleRatingRequ
 	}
 
 	// 2. Set rating atomically (handles aggregate sync internally)
-	if err := c.RatingSetter.SetArticleRating(ctx, req.UserID, req.ArticleHashID,
-		req.ThumbsUp, req.ThumbsDown, vector); err != nil {
+	if req.Rating != nil {
+		err = c.RatingSetter.SetArticleGradedRating(ctx, req.UserID, req.ArticleHashID, *req.Rating, vector)
+	} else {
+		err = c.RatingSetter.SetArticleRating(ctx, req.UserID, req.ArticleHashID,
+			req.ThumbsUp, req.ThumbsDown, vector)
+	}
+	if err != nil {
 		return Empty{}, fmt.Errorf("setting article rating: %w", err)
 	}
 
@@ -67,5 +86,36 @@ func (c *SetArticleRating) Execute(ctx context.Context, req SetArticleRatingRequ
 		logger.WarnContext(ctx, "failed to mark user for regeneration", "error", err)
 	}
 
+	// 4. Queue the online recommendation update (best-effort)
+	c.queueRecommendationUpdate(ctx, req, vector)
+
 	return Empty{}, nil
 }
+
+// queueRecommendationUpdate sends the rating to RecommendationUpdates, with the article's vector
+// if the rating was a like. If the queue is full the update is dropped; the batch job still
+// picks up the rating.
+func (c *SetArticleRating) queueRecommendationUpdate(
+	ctx context.Context, req SetArticleRatingRequest, vector []float32,
+) {
+	if c.RecommendationUpdates == nil {
+		return
+	}
+
+	liked := req.ThumbsUp != nil && *req.ThumbsUp
+	if req.Rating != nil {
+		liked, _ = domain.ThumbsForRating(*req.Rating)
+	}
+
+	update := ApplyRatingToRecommendationsRequest{UserID: req.UserID}
+	if liked {
+		update.LikedVector = vector
+	}
+
+	select {
+	case c.RecommendationUpdates <- update:
+	default:
+		logger := domain.LoggerFromContext(ctx)
+		logger.WarnContext(ctx, "recommendation update queue full, leaving rating to batch job")
+	}
+}
@@ -0,0 +1,136 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// SetDigestPreferencesRequest is the request for the SetDigestPreferences command.
+type SetDigestPreferencesRequest struct {
+	UserID     string
+	Email      string
+	Frequency  domain.DigestFrequency
+	Categories []string
+	Enabled    bool
+}
+
+// SetDigestPreferences stores a user's digest preferences,
+// generating an unsubscribe token the first time they are saved.
+//
+// A new email address is held as pending, and a confirmation link is mailed to it;
+// digests keep going to the previously confirmed address, if any, until the link is followed.
+type SetDigestPreferences struct {
+	PreferencesStore datasources.UserDigestPreferencesRepository
+	Mailer           datasources.Mailer
+
+	// ConfirmBaseURL is the URL of the email confirmation endpoint.
+	// The confirmation token is added as the "token" query parameter.
+	ConfirmBaseURL string
+}
+
+// NewSetDigestPreferences creates a properly initialized SetDigestPreferences command.
+func NewSetDigestPreferences(
+	preferencesStore datasources.UserDigestPreferencesRepository,
+	mailer datasources.Mailer,
+	confirmBaseURL string,
+) *SetDigestPreferences {
+	return &SetDigestPreferences{
+		PreferencesStore: preferencesStore,
+		Mailer:           mailer,
+		ConfirmBaseURL:   confirmBaseURL,
+	}
+}
+
+// Execute saves the preferences and returns them as stored.
+func (c *SetDigestPreferences) Execute(
+	ctx context.Context, req SetDigestPreferencesRequest,
+) (domain.DigestPreferences, error) {
+	existing, _, err := c.PreferencesStore.GetDigestPreferences(ctx, req.UserID)
+	if err != nil {
+		return domain.DigestPreferences{}, fmt.Errorf("fetching existing digest preferences: %w", err)
+	}
+
+	// Generate a token up front; the upsert keeps any existing token so
+	// links in previously sent digests remain valid.
+	unsubscribeToken, err := randomHexToken()
+	if err != nil {
+		return domain.DigestPreferences{}, fmt.Errorf("generating unsubscribe token: %w", err)
+	}
+
+	prefs := domain.DigestPreferences{
+		UserID:           req.UserID,
+		Email:            existing.Email,
+		Frequency:        req.Frequency,
+		Categories:       req.Categories,
+		Enabled:          req.Enabled,
+		UnsubscribeToken: unsubscribeToken,
+	}
+
+	switch req.Email {
+	case existing.Email:
+		// Switching back to the confirmed address drops any pending one
+	case existing.PendingEmail:
+		// Already sent a confirmation link, which stays valid
+		prefs.PendingEmail = existing.PendingEmail
+		prefs.EmailConfirmationToken = existing.EmailConfirmationToken
+	default:
+		prefs.PendingEmail = req.Email
+		prefs.EmailConfirmationToken, err = randomHexToken()
+		if err != nil {
+			return domain.DigestPreferences{}, fmt.Errorf("generating email confirmation token: %w", err)
+		}
+
+		// Sent before storing, so if sending fails the address isn't left pending with
+		// no link, and submitting it again retries
+		if err := c.sendConfirmation(ctx, prefs); err != nil {
+			return domain.DigestPreferences{}, err
+		}
+	}
+
+	if err := c.PreferencesStore.UpsertDigestPreferences(ctx, prefs); err != nil {
+		return domain.DigestPreferences{}, fmt.Errorf("storing digest preferences: %w", err)
+	}
+
+	stored, _, err := c.PreferencesStore.GetDigestPreferences(ctx, req.UserID)
+	if err != nil {
+		return domain.DigestPreferences{}, fmt.Errorf("fetching stored digest preferences: %w", err)
+	}
+
+	return stored, nil
+}
+
+func (c *SetDigestPreferences) sendConfirmation(ctx context.Context, prefs domain.DigestPreferences) error {
+	u, err := url.Parse(c.ConfirmBaseURL)
+	if err != nil {
+		return fmt.Errorf("parsing email confirmation base URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", prefs.EmailConfirmationToken)
+	u.RawQuery = q.Encode()
+
+	if err := c.Mailer.SendMail(ctx, datasources.MailMessage{
+		To:      prefs.PendingEmail,
+		Subject: "Confirm your Alignment Research Feed digest",
+		TextBody: "Someone asked for Alignment Research Feed digests to be sent to this address.\n\n" +
+			"To start receiving them, confirm your address by visiting:\n" + u.String() + "\n\n" +
+			"If you didn't ask for this, you can ignore this email and no digests will be sent.\n",
+	}); err != nil {
+		return fmt.Errorf("sending email confirmation: %w", err)
+	}
+
+	return nil
+}
+
+func randomHexToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(tokenBytes), nil
+}
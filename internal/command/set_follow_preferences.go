@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// SetFollowPreferencesRequest is the request for the SetFollowPreferences command.
+type SetFollowPreferencesRequest struct {
+	UserID                   string
+	IncludeInRecommendations bool
+}
+
+// SetFollowPreferences stores a user's follow preferences,
+// generating a following feed token the first time they are saved.
+type SetFollowPreferences struct {
+	PreferencesStore datasources.UserFollowPreferencesRepository
+}
+
+// NewSetFollowPreferences creates a properly initialized SetFollowPreferences command.
+func NewSetFollowPreferences(
+	preferencesStore datasources.UserFollowPreferencesRepository,
+) *SetFollowPreferences {
+	return &SetFollowPreferences{
+		PreferencesStore: preferencesStore,
+	}
+}
+
+// Execute saves the preferences and returns them as stored.
+func (c *SetFollowPreferences) Execute(
+	ctx context.Context, req SetFollowPreferencesRequest,
+) (domain.FollowPreferences, error) {
+	// The upsert keeps any existing token so subscribed feed readers keep working.
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return domain.FollowPreferences{}, fmt.Errorf("generating feed token: %w", err)
+	}
+
+	if err := c.PreferencesStore.UpsertFollowPreferences(ctx, domain.FollowPreferences{
+		UserID:                   req.UserID,
+		IncludeInRecommendations: req.IncludeInRecommendations,
+		FeedToken:                hex.EncodeToString(tokenBytes),
+	}); err != nil {
+		return domain.FollowPreferences{}, fmt.Errorf("storing follow preferences: %w", err)
+	}
+
+	prefs, _, err := c.PreferencesStore.GetFollowPreferences(ctx, req.UserID)
+	if err != nil {
+		return domain.FollowPreferences{}, fmt.Errorf("fetching stored follow preferences: %w", err)
+	}
+
+	return prefs, nil
+}
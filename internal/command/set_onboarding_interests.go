@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// SetOnboardingInterestsRequest is the request for the SetOnboardingInterests command.
+type SetOnboardingInterestsRequest struct {
+	UserID     string
+	Categories []string
+	Interests  []string
+}
+
+// SetOnboardingInterests handles a new user picking categories and free-text interests.
+// Each one is embedded as the centre of a provisional interest cluster, so recommendations
+// can be generated before the user has rated anything. Once the user has rated enough
+// articles to cluster, the provisional clusters are replaced by computed ones and new
+// onboarding interests are only stored.
+type SetOnboardingInterests struct {
+	Embedder              datasources.Embedder
+	VectorsCounter        datasources.UserArticleVectorsCounter
+	ClusterSeeder         datasources.UserInterestClusterSeeder
+	InterestsSetter       datasources.OnboardingInterestsSetter
+	PrecomputedWriter     datasources.PrecomputedRecommendationWriter
+	RegenerationMarker    datasources.UserRegenerationNeededMarker
+	MinRatingsForClusters int
+}
+
+// NewSetOnboardingInterests creates a properly initialized SetOnboardingInterests command.
+func NewSetOnboardingInterests(
+	embedder datasources.Embedder,
+	vectorsCounter datasources.UserArticleVectorsCounter,
+	clusterSeeder datasources.UserInterestClusterSeeder,
+	interestsSetter datasources.OnboardingInterestsSetter,
+	precomputedWriter datasources.PrecomputedRecommendationWriter,
+	regenerationMarker datasources.UserRegenerationNeededMarker,
+	minRatingsForClusters int,
+) *SetOnboardingInterests {
+	return &SetOnboardingInterests{
+		Embedder:              embedder,
+		VectorsCounter:        vectorsCounter,
+		ClusterSeeder:         clusterSeeder,
+		InterestsSetter:       interestsSetter,
+		PrecomputedWriter:     precomputedWriter,
+		RegenerationMarker:    regenerationMarker,
+		MinRatingsForClusters: minRatingsForClusters,
+	}
+}
+
+// Execute stores the user's onboarding interests and seeds provisional interest clusters from them.
+func (c *SetOnboardingInterests) Execute(
+	ctx context.Context,
+	req SetOnboardingInterestsRequest,
+) (domain.OnboardingInterests, error) {
+	logger := domain.LoggerFromContext(ctx)
+
+	interests, err := domain.OnboardingInterests{
+		UserID:     req.UserID,
+		Categories: req.Categories,
+		Interests:  req.Interests,
+	}.Normalize()
+	if err != nil {
+		return domain.OnboardingInterests{}, err
+	}
+
+	thumbsUpCount, err := c.VectorsCounter.CountUserArticleVectorsByType(ctx, req.UserID, domain.RatingTypeThumbsUp)
+	if err != nil {
+		return domain.OnboardingInterests{}, fmt.Errorf("counting thumbs up ratings: %w", err)
+	}
+
+	if thumbsUpCount < int64(c.MinRatingsForClusters) {
+		seedVectors, err := c.embedSeeds(ctx, interests.SeedTexts())
+		if err != nil {
+			return domain.OnboardingInterests{}, err
+		}
+		if err := c.ClusterSeeder.SeedUserInterestClusters(ctx, req.UserID, seedVectors); err != nil {
+			return domain.OnboardingInterests{}, fmt.Errorf("seeding interest clusters: %w", err)
+		}
+
+		// Stored recommendations were generated without the new clusters
+		if err := c.PrecomputedWriter.DeleteUserPrecomputedRecommendations(ctx, req.UserID); err != nil {
+			logger.WarnContext(ctx, "failed to clear precomputed recommendations", "error", err)
+		}
+		if err := c.RegenerationMarker.MarkUserNeedsRegeneration(ctx, req.UserID); err != nil {
+			logger.WarnContext(ctx, "failed to mark user for regeneration", "error", err)
+		}
+	}
+
+	interests.UpdatedAt = time.Now()
+	if err := c.InterestsSetter.SetOnboardingInterests(ctx, interests); err != nil {
+		return domain.OnboardingInterests{}, fmt.Errorf("storing onboarding interests: %w", err)
+	}
+
+	logger.DebugContext(ctx, "set onboarding interests",
+		"categories", len(interests.Categories), "interests", len(interests.Interests),
+		"seeded", thumbsUpCount < int64(c.MinRatingsForClusters))
+
+	return interests, nil
+}
+
+func (c *SetOnboardingInterests) embedSeeds(ctx context.Context, texts []string) ([][]float32, error) {
+	seedVectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vector, err := c.Embedder.EmbedText(ctx, text)
+		if err != nil {
+			return nil, fmt.Errorf("embedding onboarding interest: %w", err)
+		}
+		if vector == nil {
+			return nil, ErrEmbeddingUnavailable
+		}
+		seedVectors = append(seedVectors, vector)
+	}
+	return seedVectors, nil
+}
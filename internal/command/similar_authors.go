@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+const (
+	// similarAuthorsSeedArticles is how many of an author's newest articles make up their centroid.
+	similarAuthorsSeedArticles = 50
+
+	// similarAuthorsCandidateArticles is how many articles near the centroid are searched for authors.
+	similarAuthorsCandidateArticles = 100
+)
+
+// SimilarAuthorsRequest is the request for the SimilarAuthors command.
+type SimilarAuthorsRequest struct {
+	AuthorID string
+	Limit    int
+}
+
+// SimilarAuthors finds authors who write about the same things as an author. The author's
+// articles are averaged into a centroid, and the authors of the articles nearest it are scored
+// by the summed similarity of their articles.
+type SimilarAuthors struct {
+	ArticleLister datasources.AuthorArticleIDsLister
+	VectorFetcher datasources.ArticleVectorFetcher
+	Similarity    datasources.SimilarArticlesByVectorLister
+	AuthorsGetter datasources.ArticleAuthorsGetter
+}
+
+// NewSimilarAuthors creates a properly initialized SimilarAuthors command.
+func NewSimilarAuthors(
+	articleLister datasources.AuthorArticleIDsLister,
+	vectorFetcher datasources.ArticleVectorFetcher,
+	similarity datasources.SimilarArticlesByVectorLister,
+	authorsGetter datasources.ArticleAuthorsGetter,
+) *SimilarAuthors {
+	return &SimilarAuthors{
+		ArticleLister: articleLister,
+		VectorFetcher: vectorFetcher,
+		Similarity:    similarity,
+		AuthorsGetter: authorsGetter,
+	}
+}
+
+// Execute returns the authors most similar to the requested author, most similar first.
+func (c *SimilarAuthors) Execute(ctx context.Context, req SimilarAuthorsRequest) ([]domain.SimilarAuthor, error) {
+	articleIDs, err := c.ArticleLister.ListAuthorArticleIDs(ctx, req.AuthorID, 1, similarAuthorsSeedArticles)
+	if err != nil {
+		return nil, fmt.Errorf("listing author articles: %w", err)
+	}
+
+	centroid := c.centroid(ctx, articleIDs)
+	if centroid == nil {
+		return nil, nil
+	}
+
+	similar, err := c.Similarity.ListSimilarArticlesByVector(
+		ctx, articleIDs, centroid, similarAuthorsCandidateArticles,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding articles near author centroid: %w", err)
+	}
+
+	hashIDs := make([]string, 0, len(similar))
+	for _, s := range similar {
+		hashIDs = append(hashIDs, s.HashID)
+	}
+	authors, err := c.AuthorsGetter.GetArticleAuthors(ctx, hashIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting authors of similar articles: %w", err)
+	}
+
+	scores := make(map[string]*domain.SimilarAuthor)
+	for _, s := range similar {
+		for _, author := range authors[s.HashID] {
+			if author.ID == req.AuthorID {
+				continue
+			}
+			if _, ok := scores[author.ID]; !ok {
+				scores[author.ID] = &domain.SimilarAuthor{ID: author.ID, Name: author.Name}
+			}
+			scores[author.ID].Score += s.Score
+		}
+	}
+
+	result := make([]domain.SimilarAuthor, 0, len(scores))
+	for _, author := range scores {
+		result = append(result, *author)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].ID < result[j].ID
+	})
+	if len(result) > req.Limit {
+		result = result[:req.Limit]
+	}
+	return result, nil
+}
+
+// centroid averages the vectors of the given articles, skipping those without one.
+// Returns nil if none have a vector.
+func (c *SimilarAuthors) centroid(ctx context.Context, articleIDs []string) []float32 {
+	logger := domain.LoggerFromContext(ctx)
+
+	var centroid []float32
+	count := 0
+	for _, id := range articleIDs {
+		vector, err := c.VectorFetcher.FetchArticleVector(ctx, id)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to fetch article vector", "article_id", id, "error", err)
+			continue
+		}
+		if len(vector) == 0 {
+			continue
+		}
+
+		if centroid == nil {
+			centroid = vector
+		} else {
+			centroid = domain.AddPointToCentroid(centroid, count, vector)
+		}
+		count++
+	}
+	return centroid
+}
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimilarAuthors_Execute(t *testing.T) {
+	cases := []struct {
+		name     string
+		limit    int
+		vectors  map[string][]float32
+		want     []domain.SimilarAuthor
+		wantFind bool
+	}{
+		{
+			name:  "scored_by_summed_similarity",
+			limit: 10,
+			vectors: map[string][]float32{
+				"own1": {1.0, 0.0},
+				"own2": {0.0, 1.0},
+			},
+			want: []domain.SimilarAuthor{
+				{ID: "jane-doe", Name: "Jane Doe", Score: 1.5},
+				{ID: "john-roe", Name: "John Roe", Score: 0.9},
+				{ID: "alex-poe", Name: "Alex Poe", Score: 0.6},
+			},
+			wantFind: true,
+		},
+		{
+			name:  "limited",
+			limit: 1,
+			vectors: map[string][]float32{
+				"own1": {1.0, 0.0},
+			},
+			want: []domain.SimilarAuthor{
+				{ID: "jane-doe", Name: "Jane Doe", Score: 1.5},
+			},
+			wantFind: true,
+		},
+		{
+			name:    "no_vectors",
+			limit:   10,
+			vectors: map[string][]float32{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			articleLister := mocks.NewAuthorArticleIDsLister(t)
+			vectorFetcher := mocks.NewArticleVectorFetcher(t)
+			similarity := mocks.NewSimilarArticlesByVectorLister(t)
+			authorsGetter := mocks.NewArticleAuthorsGetter(t)
+
+			articleLister.EXPECT().
+				ListAuthorArticleIDs(mock.Anything, "neel-nanda", 1, 50).
+				Return([]string{"own1", "own2"}, nil)
+			for _, id := range []string{"own1", "own2"} {
+				vector, ok := tc.vectors[id]
+				if ok {
+					vectorFetcher.EXPECT().FetchArticleVector(mock.Anything, id).Return(vector, nil)
+				} else {
+					vectorFetcher.EXPECT().FetchArticleVector(mock.Anything, id).Return(nil, errors.New("not found"))
+				}
+			}
+
+			if tc.wantFind {
+				similarity.EXPECT().
+					ListSimilarArticlesByVector(mock.Anything, []string{"own1", "own2"}, mock.Anything, 100).
+					Return([]domain.SimilarArticle{
+						{HashID: "s1", Score: 0.9},
+						{HashID: "s2", Score: 0.6},
+					}, nil)
+				authorsGetter.EXPECT().
+					GetArticleAuthors(mock.Anything, []string{"s1", "s2"}).
+					Return(map[string][]domain.AuthorRef{
+						"s1": {{ID: "neel-nanda", Name: "Neel Nanda"}, {ID: "jane-doe", Name: "Jane Doe"},
+							{ID: "john-roe", Name: "John Roe"}},
+						"s2": {{ID: "jane-doe", Name: "Jane Doe"}, {ID: "alex-poe", Name: "Alex Poe"}},
+					}, nil)
+			}
+
+			cmd := NewSimilarAuthors(articleLister, vectorFetcher, similarity, authorsGetter)
+
+			result, err := cmd.Execute(t.Context(), SimilarAuthorsRequest{AuthorID: "neel-nanda", Limit: tc.limit})
+			require.NoError(t, err)
+			require.Len(t, result, len(tc.want))
+			for i := range tc.want {
+				assert.Equal(t, tc.want[i].ID, result[i].ID)
+				assert.Equal(t, tc.want[i].Name, result[i].Name)
+				assert.InDelta(t, tc.want[i].Score, result[i].Score, 1e-9)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+)
+
+// SweepExpiredAPITokensRequest is the request for the SweepExpiredAPITokens command.
+// This command takes no parameters beyond context.
+type SweepExpiredAPITokensRequest struct{}
+
+// SweepExpiredAPITokensResponse summarizes a sweep.
+type SweepExpiredAPITokensResponse struct {
+	Revoked int64
+}
+
+// SweepExpiredAPITokens marks tokens past their expiry as revoked, so every token
+// that can no longer be used has revoked_at set.
+type SweepExpiredAPITokens struct {
+	Revoker datasources.ExpiredAPITokenRevoker
+}
+
+// NewSweepExpiredAPITokens creates a properly initialized SweepExpiredAPITokens command.
+func NewSweepExpiredAPITokens(revoker datasources.ExpiredAPITokenRevoker) *SweepExpiredAPITokens {
+	return &SweepExpiredAPITokens{
+		Revoker: revoker,
+	}
+}
+
+// Execute revokes every expired token.
+func (c *SweepExpiredAPITokens) Execute(
+	ctx context.Context, _ SweepExpiredAPITokensRequest,
+) (SweepExpiredAPITokensResponse, error) {
+	revoked, err := c.Revoker.RevokeExpiredAPITokens(ctx)
+	if err != nil {
+		return SweepExpiredAPITokensResponse{}, fmt.Errorf("revoking expired tokens: %w", err)
+	}
+	return SweepExpiredAPITokensResponse{Revoked: revoked}, nil
+}
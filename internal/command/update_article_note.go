@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// UpdateArticleNoteRequest is the request for the UpdateArticleNote command.
+// Fields left nil are unchanged; set Quote to an empty string to remove a highlight.
+type UpdateArticleNoteRequest struct {
+	UserID string
+	NoteID string
+	Body   *string
+	Quote  *string
+}
+
+// UpdateArticleNote handles editing a note or highlight.
+type UpdateArticleNote struct {
+	NoteStore datasources.UserArticleNoteRepository
+}
+
+// NewUpdateArticleNote creates a properly initialized UpdateArticleNote command.
+func NewUpdateArticleNote(noteStore datasources.UserArticleNoteRepository) *UpdateArticleNote {
+	return &UpdateArticleNote{
+		NoteStore: noteStore,
+	}
+}
+
+// Execute applies the update and returns the note as stored.
+func (c *UpdateArticleNote) Execute(ctx context.Context, req UpdateArticleNoteRequest) (domain.ArticleNote, error) {
+	note, ok, err := c.NoteStore.GetArticleNote(ctx, req.UserID, req.NoteID)
+	if err != nil {
+		return domain.ArticleNote{}, fmt.Errorf("fetching article note: %w", err)
+	}
+	if !ok {
+		return domain.ArticleNote{}, ErrArticleNoteNotFound
+	}
+
+	if req.Body != nil {
+		note.Body = *req.Body
+	}
+	if req.Quote != nil {
+		note.Quote = *req.Quote
+	}
+	if note.Body == "" && note.Quote == "" {
+		return domain.ArticleNote{}, ErrInvalidArticleNote
+	}
+
+	if err := c.NoteStore.UpdateArticleNote(ctx, note); err != nil {
+		return domain.ArticleNote{}, fmt.Errorf("updating article note: %w", err)
+	}
+
+	return note, nil
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// UpdateSavedSearchRequest is the request for the UpdateSavedSearch command.
+// Name is left unchanged if nil. The query is replaced only if Filters or QueryText is set,
+// in which case exactly one of them must be.
+type UpdateSavedSearchRequest struct {
+	UserID    string
+	SearchID  string
+	Name      *string
+	Filters   *domain.ArticleFilters
+	QueryText string
+}
+
+// UpdateSavedSearch handles renaming a saved search or replacing its query.
+type UpdateSavedSearch struct {
+	SearchStore datasources.UserSavedSearchRepository
+	Embedder    datasources.Embedder
+}
+
+// NewUpdateSavedSearch creates a properly initialized UpdateSavedSearch command.
+func NewUpdateSavedSearch(
+	searchStore datasources.UserSavedSearchRepository,
+	embedder datasources.Embedder,
+) *UpdateSavedSearch {
+	return &UpdateSavedSearch{
+		SearchStore: searchStore,
+		Embedder:    embedder,
+	}
+}
+
+// Execute applies the update and returns the saved search as stored.
+func (c *UpdateSavedSearch) Execute(ctx context.Context, req UpdateSavedSearchRequest) (domain.SavedSearch, error) {
+	search, ok, err := c.SearchStore.GetSavedSearch(ctx, req.UserID, req.SearchID)
+	if err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("fetching saved search: %w", err)
+	}
+	if !ok {
+		return domain.SavedSearch{}, ErrSavedSearchNotFound
+	}
+
+	if req.Name != nil {
+		search.Name = *req.Name
+	}
+
+	if req.Filters != nil || req.QueryText != "" {
+		if err := setSavedSearchQuery(ctx, c.Embedder, &search, req.Filters, req.QueryText); err != nil {
+			return domain.SavedSearch{}, err
+		}
+	}
+
+	if err := c.SearchStore.UpdateSavedSearch(ctx, search); err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("updating saved search: %w", err)
+	}
+
+	return search, nil
+}
@@ -14,7 +14,8 @@ type UpdateUserClustersRequest struct {
 	UserID string
 }
 
-// UpdateUserClusters recomputes interest clusters for a user based on their liked articles.
+// UpdateUserClusters recomputes interest clusters for a user based on the articles they
+// liked or engaged with.
 type UpdateUserClusters struct {
 	VectorsGetter datasources.UserArticleVectorsGetter
 	ClusterWriter datasources.UserInterestClusterWriter
@@ -37,12 +38,12 @@ func NewUpdateUserClusters(
 	}
 }
 
-// Execute runs k-means clustering on the user's liked article vectors
-// and stores the resulting cluster centroids.
+// Execute runs k-means clustering on the user's liked and engaged article vectors, weighted by
+// signal strength, and stores the resulting cluster centroids.
 func (c *UpdateUserClusters) Execute(ctx context.Context, req UpdateUserClustersRequest) (Empty, error) {
 	logger := domain.LoggerFromContext(ctx)
 
-	// Get user's thumbs up vectors
+	// Get user's positive signal vectors
 	vectors, err := c.VectorsGetter.GetUserArticleVectorsByType(ctx, req.UserID, domain.RatingTypeThumbsUp)
 	if err != nil {
 		return Empty{}, fmt.Errorf("getting thumbs up vectors: %w", err)
@@ -52,17 +53,20 @@ func (c *UpdateUserClusters) Execute(ctx context.Context, req UpdateUserClusters
 	if len(vectors) < c.Config.MinArticlesForClustering {
 		logger.DebugContext(ctx, "not enough articles for clustering",
 			"count", len(vectors), "min", c.Config.MinArticlesForClustering)
-		// Clear existing clusters since we don't have enough data
-		if err := c.ClusterWriter.DeleteUserInterestClusters(ctx, req.UserID); err != nil {
+		// Clear existing computed clusters since we don't have enough data,
+		// keeping any provisional clusters seeded at onboarding
+		if err := c.ClusterWriter.DeleteUserComputedInterestClusters(ctx, req.UserID); err != nil {
 			logger.WarnContext(ctx, "failed to delete user clusters", "error", err)
 		}
 		return Empty{}, nil
 	}
 
-	// Extract just the vectors for clustering
+	// Extract the vectors and their signal weights for clustering
 	data := make([][]float32, len(vectors))
+	weights := make([]float64, len(vectors))
 	for i, v := range vectors {
 		data[i] = v.Vector
+		weights[i] = v.Weight(c.Config.SignalWeights)
 	}
 
 	// Determine actual number of clusters (can't have more clusters than data points)
@@ -72,12 +76,12 @@ func (c *UpdateUserClusters) Execute(ctx context.Context, req UpdateUserClusters
 	}
 
 	// Run k-means clustering
-	result := domain.KMeans(data, k, c.Config, c.Rand)
+	result := domain.WeightedKMeans(data, weights, k, c.Config, c.Rand)
 
 	// Count articles per cluster
 	clusterCounts := domain.CountClusterAssignments(result.Assignments, k)
 
-	// Delete existing clusters and save new ones
+	// Delete existing clusters, including provisional ones, and save new ones
 	if err := c.ClusterWriter.DeleteUserInterestClusters(ctx, req.UserID); err != nil {
 		return Empty{}, fmt.Errorf("deleting old clusters: %w", err)
 	}
@@ -15,6 +15,7 @@ type CreateAPITokenParams struct {
 	TokenPrefix string
 	Name        *string
 	ExpiresAt   *time.Time
+	Scopes      []domain.APITokenScope
 }
 
 // APITokenCreator creates a new API token.
@@ -43,8 +44,32 @@ type UserAPITokenCounter interface {
 }
 
 // APITokenRevoker revokes a token.
+// Returns false if the user has no such token or it is already revoked.
 type APITokenRevoker interface {
-	RevokeAPIToken(ctx context.Context, tokenID, userID string) error
+	RevokeAPIToken(ctx context.Context, tokenID, userID string) (bool, error)
+}
+
+// APITokenGetter retrieves one of a user's tokens by ID.
+type APITokenGetter interface {
+	GetUserAPIToken(ctx context.Context, tokenID, userID string) (domain.APIToken, bool, error)
+}
+
+// APITokenRenamer sets or clears a token's name.
+type APITokenRenamer interface {
+	RenameAPIToken(ctx context.Context, tokenID, userID string, name *string) error
+}
+
+// APITokenRotator atomically creates a replacement token and sets the old one to expire.
+// It returns false if the old token has already been rotated or revoked.
+type APITokenRotator interface {
+	RotateAPIToken(
+		ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params CreateAPITokenParams,
+	) (bool, error)
+}
+
+// ExpiredAPITokenRevoker marks all expired tokens as revoked.
+type ExpiredAPITokenRevoker interface {
+	RevokeExpiredAPITokens(ctx context.Context) (int64, error)
 }
 
 // APITokenRepository combines all API token operations.
@@ -55,4 +80,8 @@ type APITokenRepository interface {
 	UserAPITokenLister
 	UserAPITokenCounter
 	APITokenRevoker
+	APITokenGetter
+	APITokenRenamer
+	APITokenRotator
+	ExpiredAPITokenRevoker
 }
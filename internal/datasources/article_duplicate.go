@@ -0,0 +1,30 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleDuplicateLister lists every stored duplicate article.
+type ArticleDuplicateLister interface {
+	ListArticleDuplicates(ctx context.Context) ([]domain.ArticleDuplicate, error)
+}
+
+// ArticleDuplicateReplacer replaces all stored duplicate articles.
+type ArticleDuplicateReplacer interface {
+	ReplaceArticleDuplicates(ctx context.Context, duplicates []domain.ArticleDuplicate) error
+}
+
+// CanonicalArticleGetter looks up the canonical article of each of the given articles that is
+// a duplicate, keyed by the duplicate's hash ID. Articles that aren't duplicates are left out.
+type CanonicalArticleGetter interface {
+	GetCanonicalArticleIDs(ctx context.Context, hashIDs []string) (map[string]string, error)
+}
+
+// ArticleDuplicateStore combines all duplicate article operations.
+type ArticleDuplicateStore interface {
+	ArticleDuplicateLister
+	ArticleDuplicateReplacer
+	CanonicalArticleGetter
+}
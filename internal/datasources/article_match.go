@@ -0,0 +1,21 @@
+package datasources
+
+import "context"
+
+// ArticleURLMatcher finds articles stored under any of the given URLs,
+// returning a map from each matched URL to the article's hash ID.
+type ArticleURLMatcher interface {
+	FindArticlesByURLs(ctx context.Context, urls []string) (map[string]string, error)
+}
+
+// ArticleTitleMatcher finds articles whose titles are any of the given titles once normalized
+// with domain.NormalizeTitle, returning a map from each matched normalized title to the article's hash ID.
+type ArticleTitleMatcher interface {
+	FindArticlesByNormalizedTitles(ctx context.Context, titles []string) (map[string]string, error)
+}
+
+// ArticleMatcher combines the lookups used to match external references to articles.
+type ArticleMatcher interface {
+	ArticleURLMatcher
+	ArticleTitleMatcher
+}
@@ -0,0 +1,37 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleCoLikeLister lists pairs of articles liked by at least minCoLikes of the same users.
+// Each pair is listed in both directions.
+type ArticleCoLikeLister interface {
+	ListArticleCoLikes(ctx context.Context, minCoLikes int64) ([]domain.ArticleCoLike, error)
+}
+
+// ArticleLikeCounter counts how many users have given each article a thumbs up, by hash ID.
+type ArticleLikeCounter interface {
+	CountArticleLikes(ctx context.Context) (map[string]int64, error)
+}
+
+// ArticleNeighbourReplacer replaces all stored article neighbours.
+type ArticleNeighbourReplacer interface {
+	ReplaceArticleNeighbours(ctx context.Context, neighbours []domain.ArticleNeighbour) error
+}
+
+// CollaborativeCandidateLister finds neighbours of the articles a user has liked,
+// scored by the sum of their neighbour scores, excluding articles the user has rated.
+type CollaborativeCandidateLister interface {
+	ListCollaborativeCandidates(ctx context.Context, userID string, limit int) ([]domain.SimilarArticle, error)
+}
+
+// ArticleNeighbourStore combines all article neighbour operations.
+type ArticleNeighbourStore interface {
+	ArticleCoLikeLister
+	ArticleLikeCounter
+	ArticleNeighbourReplacer
+	CollaborativeCandidateLister
+}
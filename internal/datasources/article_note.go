@@ -0,0 +1,62 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleNoteCreator stores a new note.
+type ArticleNoteCreator interface {
+	CreateArticleNote(ctx context.Context, note domain.ArticleNote) error
+}
+
+// ArticleNoteGetter retrieves one of a user's notes.
+// Returns ok=false if the note does not exist or belongs to another user.
+type ArticleNoteGetter interface {
+	GetArticleNote(ctx context.Context, userID, noteID string) (domain.ArticleNote, bool, error)
+}
+
+// ArticleNoteLister lists a user's notes on a single article, oldest first.
+type ArticleNoteLister interface {
+	ListArticleNotes(ctx context.Context, userID, articleHashID string) ([]domain.ArticleNote, error)
+}
+
+// UserArticleNoteLister lists a user's notes across all articles, most recently updated first.
+type UserArticleNoteLister interface {
+	ListUserArticleNotes(ctx context.Context, userID string, page, pageSize int) ([]domain.ArticleNote, error)
+}
+
+// ArticleNoteSearcher runs a full-text search over a user's notes and highlighted quotes,
+// most relevant first.
+type ArticleNoteSearcher interface {
+	SearchUserArticleNotes(
+		ctx context.Context, userID, query string, page, pageSize int,
+	) ([]domain.ArticleNote, error)
+}
+
+// ArticleNoteUpdater replaces the body and quote of one of a user's notes.
+type ArticleNoteUpdater interface {
+	UpdateArticleNote(ctx context.Context, note domain.ArticleNote) error
+}
+
+// ArticleNoteDeleter deletes one of a user's notes.
+type ArticleNoteDeleter interface {
+	DeleteArticleNote(ctx context.Context, userID, noteID string) error
+}
+
+// UserArticleNoteRepository reads and updates a single note.
+type UserArticleNoteRepository interface {
+	ArticleNoteGetter
+	ArticleNoteUpdater
+}
+
+// ArticleNoteStore combines all article note operations.
+type ArticleNoteStore interface {
+	ArticleNoteCreator
+	UserArticleNoteRepository
+	ArticleNoteLister
+	UserArticleNoteLister
+	ArticleNoteSearcher
+	ArticleNoteDeleter
+}
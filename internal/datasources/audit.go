@@ -0,0 +1,23 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// AuditEventWriter appends an event to the audit log. Events are never updated.
+type AuditEventWriter interface {
+	WriteAuditEvent(ctx context.Context, event domain.AuditEvent) error
+}
+
+// UserAuditEventLister lists the audit events for a user's account, most recent first.
+type UserAuditEventLister interface {
+	ListUserAuditEvents(ctx context.Context, userID string, page, pageSize int) ([]domain.AuditEvent, error)
+}
+
+// AuditEventStore combines audit log operations.
+type AuditEventStore interface {
+	AuditEventWriter
+	UserAuditEventLister
+}
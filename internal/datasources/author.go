@@ -0,0 +1,60 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleAuthorListLister lists every article's free-text author list.
+type ArticleAuthorListLister interface {
+	ListArticleAuthorLists(ctx context.Context) ([]domain.ArticleAuthorList, error)
+}
+
+// AuthorNameLister maps every stored author name and alias to its author's ID.
+type AuthorNameLister interface {
+	ListAuthorNames(ctx context.Context) (map[string]string, error)
+}
+
+// AuthorReplacer replaces all stored authors, their aliases and the articles they're credited on,
+// moving follows of renamed author IDs to their new IDs.
+type AuthorReplacer interface {
+	ReplaceAuthors(
+		ctx context.Context,
+		authors []domain.Author,
+		articleAuthors []domain.ArticleAuthor,
+		renamedIDs map[string]string,
+	) error
+}
+
+// AuthorGetter retrieves an author, with their aliases and how many articles they're credited on.
+type AuthorGetter interface {
+	GetAuthor(ctx context.Context, authorID string) (domain.Author, bool, error)
+}
+
+// AuthorArticleIDsLister lists the articles an author is credited on, newest first.
+type AuthorArticleIDsLister interface {
+	ListAuthorArticleIDs(ctx context.Context, authorID string, page, pageSize int) ([]string, error)
+}
+
+// CoAuthorLister lists the authors who have written the most articles with an author.
+type CoAuthorLister interface {
+	ListCoAuthors(ctx context.Context, authorID string, limit int) ([]domain.CoAuthor, error)
+}
+
+// ArticleAuthorsGetter looks up the authors credited on each of the given articles, in order,
+// keyed by hash ID.
+type ArticleAuthorsGetter interface {
+	GetArticleAuthors(ctx context.Context, hashIDs []string) (map[string][]domain.AuthorRef, error)
+}
+
+// AuthorStore combines all author operations.
+type AuthorStore interface {
+	ArticleAuthorListLister
+	AuthorNameLister
+	AuthorReplacer
+	AuthorGetter
+	AuthorArticleIDsLister
+	CoAuthorLister
+	ArticleAuthorsGetter
+}
@@ -0,0 +1,86 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// CollectionCreator stores a new, empty collection.
+type CollectionCreator interface {
+	CreateCollection(ctx context.Context, collection domain.Collection) error
+}
+
+// CollectionGetter retrieves one of a user's collections.
+// Returns ok=false if the collection does not exist or belongs to another user.
+type CollectionGetter interface {
+	GetCollection(ctx context.Context, userID, collectionID string) (domain.Collection, bool, error)
+}
+
+// CollectionByShareTokenGetter retrieves a collection by its share token.
+// Returns ok=false if no collection matches the token; callers must check IsPublic.
+type CollectionByShareTokenGetter interface {
+	GetCollectionByShareToken(ctx context.Context, shareToken string) (domain.Collection, bool, error)
+}
+
+// UserCollectionLister lists all collections for a user.
+type UserCollectionLister interface {
+	ListUserCollections(ctx context.Context, userID string) ([]domain.Collection, error)
+}
+
+// UserCollectionCounter counts collections for a user.
+type UserCollectionCounter interface {
+	CountUserCollections(ctx context.Context, userID string) (int64, error)
+}
+
+// CollectionUpdater updates the name and visibility of a collection.
+type CollectionUpdater interface {
+	UpdateCollection(ctx context.Context, collection domain.Collection) error
+}
+
+// CollectionDeleter deletes one of a user's collections along with its article list.
+type CollectionDeleter interface {
+	DeleteCollection(ctx context.Context, userID, collectionID string) error
+}
+
+// CollectionArticleAdder appends an article to the end of a collection.
+// Adding an article already in the collection leaves its position unchanged.
+type CollectionArticleAdder interface {
+	AddCollectionArticle(ctx context.Context, collectionID, articleHashID string) error
+}
+
+// CollectionArticleRemover removes an article from a collection.
+type CollectionArticleRemover interface {
+	RemoveCollectionArticle(ctx context.Context, collectionID, articleHashID string) error
+}
+
+// CollectionArticleIDsLister lists the article IDs in a collection in order.
+type CollectionArticleIDsLister interface {
+	ListCollectionArticleIDs(ctx context.Context, collectionID string) ([]string, error)
+}
+
+// CollectionArticleReorderer sets the order of articles in a collection.
+// articleHashIDs must contain exactly the articles currently in the collection.
+type CollectionArticleReorderer interface {
+	ReorderCollectionArticles(ctx context.Context, collectionID string, articleHashIDs []string) error
+}
+
+// CollectionArticleOrderRepository reads and sets the order of articles in a collection.
+type CollectionArticleOrderRepository interface {
+	CollectionArticleIDsLister
+	CollectionArticleReorderer
+}
+
+// CollectionStore combines all collection operations.
+type CollectionStore interface {
+	CollectionCreator
+	CollectionGetter
+	CollectionByShareTokenGetter
+	UserCollectionLister
+	UserCollectionCounter
+	CollectionUpdater
+	CollectionDeleter
+	CollectionArticleAdder
+	CollectionArticleRemover
+	CollectionArticleOrderRepository
+}
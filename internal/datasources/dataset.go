@@ -15,12 +15,49 @@ type DatasetRepository interface {
 	DislikedArticleLister
 	ReadArticleIDsLister
 	ArticleFetcher
+	ArticlePublishedDateGetter
+	ArticleMatcher
+	ArticleCategoryLister
 	ArticleReadSetter
+	ArticleDismissalStore
 	UserArticleInteractionStore
 	UserInterestClusterStore
+	OnboardingInterestsStore
+	ArticlePopularityStore
+	ArticleNeighbourStore
+	ArticleDuplicateStore
+	AuthorStore
+	RecommendationImpressionStore
+	ExperimentReporter
 	PrecomputedRecommendationStore
 	UserRecommendationStateStore
 	APITokenRepository
+	DigestPreferencesStore
+	SavedSearchStore
+	CollectionStore
+	ArticleNoteStore
+	UserTagStore
+	FollowStore
+	AuditEventStore
+	RateLimitStore
+	RateLimitBucketDeleter
+	UserDataStore
+}
+
+// RecommendationDataReader reads everything recommendation generation needs from the dataset.
+type RecommendationDataReader interface {
+	UserArticleVectorsGetter
+	UserInterestClusterGetter
+	ReadArticleIDsLister
+	HiddenArticleIDsLister
+	TagCooccurrenceLister
+	ArticlePopularityReader
+	CollaborativeCandidateLister
+	IgnoredRecommendationCounter
+	CategoryExplorationLister
+	LatestArticleLister
+	ArticlePublishedDateGetter
+	CanonicalArticleGetter
 }
 
 type ArticleFetcher interface {
@@ -30,6 +67,12 @@ type ArticleFetcher interface {
 	) ([]domain.Article, error)
 }
 
+// ArticlePublishedDateGetter looks up when articles were published, keyed by hash ID.
+// Articles without a known publication date are left out.
+type ArticlePublishedDateGetter interface {
+	GetArticlePublishedDates(ctx context.Context, hashIDs []string) (map[string]time.Time, error)
+}
+
 type ArticleReadSetter interface {
 	SetArticleRead(
 		ctx context.Context,
@@ -51,16 +94,18 @@ type ThumbsUpArticleLister interface {
 	ListThumbsUpArticleIDs(ctx context.Context, userID string) ([]string, error)
 }
 
+// UnreviewedArticleLister, LikedArticleLister and DislikedArticleLister list a user's articles
+// in each review state. If tags is non-empty, only articles the user has given every tag are listed.
 type UnreviewedArticleLister interface {
-	ListUnreviewedArticleIDs(ctx context.Context, userID string, page, pageSize int) ([]string, error)
+	ListUnreviewedArticleIDs(ctx context.Context, userID string, tags []string, page, pageSize int) ([]string, error)
 }
 
 type LikedArticleLister interface {
-	ListLikedArticleIDs(ctx context.Context, userID string, page, pageSize int) ([]string, error)
+	ListLikedArticleIDs(ctx context.Context, userID string, tags []string, page, pageSize int) ([]string, error)
 }
 
 type DislikedArticleLister interface {
-	ListDislikedArticleIDs(ctx context.Context, userID string, page, pageSize int) ([]string, error)
+	ListDislikedArticleIDs(ctx context.Context, userID string, tags []string, page, pageSize int) ([]string, error)
 }
 
 // ReadArticleIDsLister lists all article IDs a user has marked as read.
@@ -68,17 +113,27 @@ type ReadArticleIDsLister interface {
 	ListReadArticleIDs(ctx context.Context, userID string) ([]string, error)
 }
 
-// ArticleRatingSetter atomically sets thumbs up/down.
+// ArticleRatingSetter atomically sets thumbs up/down or a graded rating.
 // thumbsUp/thumbsDown are pointers: nil means "don't change".
 // Setting either to true automatically clears the other (mutual exclusivity).
+// A graded rating of 1-5 also sets the thumbs matching it; zero clears it and both thumbs.
 type ArticleRatingSetter interface {
 	SetArticleRating(
 		ctx context.Context, userID, articleHashID string,
 		thumbsUp, thumbsDown *bool, vector []float32,
 	) error
+	SetArticleGradedRating(ctx context.Context, userID, articleHashID string, rating int, vector []float32) error
 }
 
-// UserArticleVectorsGetter retrieves article vectors for a user filtered by rating type.
+// ArticleSignalRecorder records implicit signals of a user's interest in an article.
+type ArticleSignalRecorder interface {
+	RecordArticleSignal(
+		ctx context.Context, userID, articleHashID string, signal domain.ArticleSignal, vector []float32,
+	) error
+}
+
+// UserArticleVectorsGetter retrieves article vectors for a user filtered by rating type,
+// with the signals used to weight them.
 type UserArticleVectorsGetter interface {
 	GetUserArticleVectorsByType(
 		ctx context.Context, userID string, ratingType domain.UserRatingType,
@@ -95,15 +150,18 @@ type UserArticleVectorsCounter interface {
 // UserArticleInteractionStore combines all user-article interaction operations.
 type UserArticleInteractionStore interface {
 	ArticleRatingSetter
+	ArticleSignalRecorder
 	UserArticleVectorsGetter
 	UserArticleVectorsCounter
 }
 
 // UserInterestCluster represents a cluster centroid for a user's interests.
+// Provisional clusters are seeded from onboarding interests rather than computed from ratings.
 type UserInterestCluster struct {
 	ClusterID      int
 	CentroidVector []float32
 	ArticleCount   int
+	Provisional    bool
 	UpdatedAt      time.Time
 }
 
@@ -124,16 +182,30 @@ type UserInterestClusterDeleter interface {
 	DeleteUserInterestClusters(ctx context.Context, userID string) error
 }
 
+// UserComputedInterestClusterDeleter removes the interest clusters computed from a user's ratings,
+// keeping any provisional ones.
+type UserComputedInterestClusterDeleter interface {
+	DeleteUserComputedInterestClusters(ctx context.Context, userID string) error
+}
+
+// UserInterestClusterSeeder replaces all of a user's interest clusters with provisional
+// clusters centred on the given seed vectors.
+type UserInterestClusterSeeder interface {
+	SeedUserInterestClusters(ctx context.Context, userID string, seedVectors [][]float32) error
+}
+
 // UserInterestClusterWriter combines cluster write operations.
 type UserInterestClusterWriter interface {
 	UserInterestClusterUpserter
 	UserInterestClusterDeleter
+	UserComputedInterestClusterDeleter
 }
 
 // UserInterestClusterStore combines all user interest cluster operations.
 type UserInterestClusterStore interface {
 	UserInterestClusterWriter
 	UserInterestClusterGetter
+	UserInterestClusterSeeder
 }
 
 // PrecomputedRecommendation represents a stored recommendation for a user.
@@ -141,6 +213,7 @@ type PrecomputedRecommendation struct {
 	ArticleHashID string
 	Score         float64
 	Source        string
+	ExperimentArm string
 	Position      int
 	GeneratedAt   time.Time
 }
@@ -151,6 +224,7 @@ type UpsertPrecomputedRecommendationParams struct {
 	ArticleHashID string
 	Score         float64
 	Source        string
+	ExperimentArm string
 	Position      int
 	GeneratedAt   time.Time
 }
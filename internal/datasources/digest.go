@@ -0,0 +1,58 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// DigestPreferencesGetter retrieves a user's digest preferences.
+// Returns ok=false if the user has never configured a digest.
+type DigestPreferencesGetter interface {
+	GetDigestPreferences(ctx context.Context, userID string) (domain.DigestPreferences, bool, error)
+}
+
+// DigestPreferencesUpserter stores a user's digest preferences.
+// The unsubscribe token is only used when creating new preferences;
+// an existing token is kept so previously sent links continue to work.
+type DigestPreferencesUpserter interface {
+	UpsertDigestPreferences(ctx context.Context, prefs domain.DigestPreferences) error
+}
+
+// EnabledDigestPreferencesLister lists preferences for all users with digests enabled.
+type EnabledDigestPreferencesLister interface {
+	ListEnabledDigestPreferences(ctx context.Context) ([]domain.DigestPreferences, error)
+}
+
+// DigestUnsubscriber disables digests for the user owning an unsubscribe token.
+// Returns false if no preferences match the token.
+type DigestUnsubscriber interface {
+	UnsubscribeDigest(ctx context.Context, unsubscribeToken string) (bool, error)
+}
+
+// DigestEmailConfirmer makes the pending digest address matching a confirmation token the
+// address digests are sent to. Returns false if no pending address matches the token.
+type DigestEmailConfirmer interface {
+	ConfirmDigestEmail(ctx context.Context, confirmationToken string) (bool, error)
+}
+
+// DigestSentMarker records when a digest was last sent to a user.
+type DigestSentMarker interface {
+	MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error
+}
+
+// UserDigestPreferencesRepository reads and writes a single user's digest preferences.
+type UserDigestPreferencesRepository interface {
+	DigestPreferencesGetter
+	DigestPreferencesUpserter
+}
+
+// DigestPreferencesStore combines all digest preference operations.
+type DigestPreferencesStore interface {
+	UserDigestPreferencesRepository
+	EnabledDigestPreferencesLister
+	DigestUnsubscriber
+	DigestEmailConfirmer
+	DigestSentMarker
+}
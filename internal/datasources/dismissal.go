@@ -0,0 +1,28 @@
+package datasources
+
+import (
+	"context"
+	"time"
+)
+
+// ArticleDismissedSetter sets whether a user has dismissed an article.
+type ArticleDismissedSetter interface {
+	SetArticleDismissed(ctx context.Context, hashID, userID string, dismissed bool) error
+}
+
+// ArticleSnoozer snoozes an article for a user until a time. The zero time clears the snooze.
+type ArticleSnoozer interface {
+	SnoozeArticle(ctx context.Context, hashID, userID string, until time.Time) error
+}
+
+// HiddenArticleIDsLister lists the article IDs a user has dismissed or snoozed until a future time.
+type HiddenArticleIDsLister interface {
+	ListHiddenArticleIDs(ctx context.Context, userID string) ([]string, error)
+}
+
+// ArticleDismissalStore combines all article dismissal and snooze operations.
+type ArticleDismissalStore interface {
+	ArticleDismissedSetter
+	ArticleSnoozer
+	HiddenArticleIDsLister
+}
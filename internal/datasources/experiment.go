@@ -0,0 +1,14 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ExperimentReporter compares experiment arms by how users responded to recommendations
+// shown to them since a time, ordered by arm name.
+type ExperimentReporter interface {
+	ReportExperimentArms(ctx context.Context, since time.Time) ([]domain.ExperimentArmReport, error)
+}
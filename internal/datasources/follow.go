@@ -0,0 +1,63 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ItemFollower adds an author, source or category to those a user follows.
+// Following something twice is a no-op.
+type ItemFollower interface {
+	FollowItem(ctx context.Context, userID string, followType domain.FollowType, target string) error
+}
+
+// ItemUnfollower removes an author, source or category from those a user follows.
+type ItemUnfollower interface {
+	UnfollowItem(ctx context.Context, userID string, followType domain.FollowType, target string) error
+}
+
+// FollowLister lists everything a user follows, grouped by type.
+type FollowLister interface {
+	ListFollows(ctx context.Context, userID string) ([]domain.Follow, error)
+}
+
+// FollowPreferencesGetter retrieves a user's follow preferences.
+// Returns ok=false if the user has never followed anything or set preferences.
+type FollowPreferencesGetter interface {
+	GetFollowPreferences(ctx context.Context, userID string) (domain.FollowPreferences, bool, error)
+}
+
+// FollowPreferencesByFeedTokenGetter retrieves the follow preferences owning an RSS feed token.
+type FollowPreferencesByFeedTokenGetter interface {
+	GetFollowPreferencesByFeedToken(ctx context.Context, feedToken string) (domain.FollowPreferences, bool, error)
+}
+
+// FollowPreferencesCreator creates a user's default follow preferences with the given feed token,
+// leaving existing preferences unchanged.
+type FollowPreferencesCreator interface {
+	CreateFollowPreferences(ctx context.Context, userID, feedToken string) error
+}
+
+// FollowPreferencesUpserter stores a user's follow preferences.
+// The feed token is only used when creating new preferences;
+// an existing token is kept so subscribed feed readers continue to work.
+type FollowPreferencesUpserter interface {
+	UpsertFollowPreferences(ctx context.Context, prefs domain.FollowPreferences) error
+}
+
+// UserFollowPreferencesRepository reads and writes a single user's follow preferences.
+type UserFollowPreferencesRepository interface {
+	FollowPreferencesGetter
+	FollowPreferencesUpserter
+}
+
+// FollowStore combines all follow operations.
+type FollowStore interface {
+	ItemFollower
+	ItemUnfollower
+	FollowLister
+	UserFollowPreferencesRepository
+	FollowPreferencesByFeedTokenGetter
+	FollowPreferencesCreator
+}
@@ -0,0 +1,36 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// RecommendationImpressionRecorder records recommended articles shown to users.
+type RecommendationImpressionRecorder interface {
+	RecordRecommendationImpressions(ctx context.Context, impressions []domain.RecommendationImpression) error
+}
+
+// IgnoredRecommendationCounter counts the distinct days since a time on which each article was
+// shown to a user in the top maxPosition positions, keyed by hash ID. Articles the user has read
+// or rated are left out, as are articles shown on fewer than minDays days.
+type IgnoredRecommendationCounter interface {
+	CountIgnoredRecommendations(
+		ctx context.Context, userID string, since time.Time, maxPosition, minDays int,
+	) (map[string]int, error)
+}
+
+// CategoryExplorationLister lists every article category with how much a user has explored it:
+// the articles in it they have read, liked or engaged with, and the exploration recommendations
+// from it shown to them since a time and how many of those they went on to read, like or engage with.
+type CategoryExplorationLister interface {
+	ListCategoryExploration(ctx context.Context, userID string, since time.Time) ([]domain.CategoryExploration, error)
+}
+
+// RecommendationImpressionStore combines all recommendation impression operations.
+type RecommendationImpressionStore interface {
+	RecommendationImpressionRecorder
+	IgnoredRecommendationCounter
+	CategoryExplorationLister
+}
@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+)
+
+var _ datasources.Mailer = (*FileMailer)(nil)
+
+// FileMailer writes each message to a .eml file in a directory instead of sending it.
+// This is intended for local development and testing.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer creates a new file mailer writing to dir.
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{
+		dir:  dir,
+		from: from,
+	}
+}
+
+func (m *FileMailer) SendMail(_ context.Context, msg datasources.MailMessage) error {
+	now := time.Now()
+	data, err := buildMessage(m.from, msg, now)
+	if err != nil {
+		return fmt.Errorf("building message: %w", err)
+	}
+
+	if err := os.MkdirAll(m.dir, 0o750); err != nil {
+		return fmt.Errorf("creating mail directory: %w", err)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("generating file name: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405Z"), hex.EncodeToString(suffix))
+	if err := os.WriteFile(filepath.Join(m.dir, name), data, 0o600); err != nil {
+		return fmt.Errorf("writing mail file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileMailer_SendMail(t *testing.T) {
+	dir := t.TempDir()
+	mailer := NewFileMailer(dir, "Alignment Feed <feed@example.com>")
+
+	err := mailer.SendMail(t.Context(), datasources.MailMessage{
+		To:       "user@example.com",
+		Subject:  "Your daily digest",
+		TextBody: "Plain text body",
+		HTMLBody: "<p>HTML body</p>",
+		Headers: map[string]string{
+			"List-Unsubscribe": "<https://example.com/unsub?token=abc>",
+		},
+	})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, "user@example.com", msg.Header.Get("To"))
+	assert.Equal(t, "Alignment Feed <feed@example.com>", msg.Header.Get("From"))
+	assert.Equal(t, "<https://example.com/unsub?token=abc>", msg.Header.Get("List-Unsubscribe"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Your daily digest", subject)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var bodies []string
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+	}
+	assert.Equal(t, []string{"Plain text body", "<p>HTML body</p>"}, bodies)
+}
+
+func TestBuildMessage_RejectsHeaderInjection(t *testing.T) {
+	_, err := buildMessage("feed@example.com", datasources.MailMessage{
+		To:       "user@example.com\r\nBcc: victim@example.com",
+		TextBody: "body",
+	}, time.Now())
+	assert.Error(t, err)
+}
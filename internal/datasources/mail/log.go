@@ -0,0 +1,22 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+var _ datasources.Mailer = LogMailer{}
+
+// LogMailer logs messages instead of sending them.
+type LogMailer struct{}
+
+func (LogMailer) SendMail(ctx context.Context, msg datasources.MailMessage) error {
+	logger := domain.LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "mail not sent (log mail driver)",
+		"to", msg.To,
+		"subject", msg.Subject,
+		"text_body", msg.TextBody)
+	return nil
+}
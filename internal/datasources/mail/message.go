@@ -0,0 +1,80 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+)
+
+// buildMessage renders a message as an RFC 5322 email with a
+// multipart/alternative body containing the plain-text and HTML parts.
+func buildMessage(from string, msg datasources.MailMessage, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	headers := map[string]string{
+		"From":         from,
+		"To":           msg.To,
+		"Subject":      mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date":         now.Format(time.RFC1123Z),
+		"MIME-Version": "1.0",
+	}
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+
+	body := multipart.NewWriter(&buf)
+	headers["Content-Type"] = "multipart/alternative; boundary=" + body.Boundary()
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var header bytes.Buffer
+	for _, k := range keys {
+		if strings.ContainsAny(headers[k], "\r\n") {
+			return nil, fmt.Errorf("invalid newline in header %s", k)
+		}
+		fmt.Fprintf(&header, "%s: %s\r\n", k, headers[k])
+	}
+	header.WriteString("\r\n")
+
+	if err := writePart(body, "text/plain; charset=utf-8", msg.TextBody); err != nil {
+		return nil, fmt.Errorf("writing text part: %w", err)
+	}
+	if msg.HTMLBody != "" {
+		if err := writePart(body, "text/html; charset=utf-8", msg.HTMLBody); err != nil {
+			return nil, fmt.Errorf("writing HTML part: %w", err)
+		}
+	}
+	if err := body.Close(); err != nil {
+		return nil, fmt.Errorf("closing multipart body: %w", err)
+	}
+
+	return append(header.Bytes(), buf.Bytes()...), nil
+}
+
+func writePart(w *multipart.Writer, contentType, content string) error {
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
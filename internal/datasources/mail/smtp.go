@@ -0,0 +1,56 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+)
+
+var _ datasources.Mailer = (*SMTPMailer)(nil)
+
+// SMTPMailer delivers mail via an SMTP relay.
+// STARTTLS is used automatically when the server supports it.
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTPMailer creates a new SMTP mailer.
+// If username is empty, no authentication is performed.
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPMailer{
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (m *SMTPMailer) SendMail(_ context.Context, msg datasources.MailMessage) error {
+	data, err := buildMessage(m.from, msg, time.Now())
+	if err != nil {
+		return fmt.Errorf("building message: %w", err)
+	}
+
+	fromAddr, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("parsing from address: %w", err)
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, fromAddr.Address, []string{msg.To}, data); err != nil {
+		return fmt.Errorf("sending mail via SMTP: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package datasources
+
+import "context"
+
+// MailMessage is an email with plain-text and HTML alternatives.
+type MailMessage struct {
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+
+	// Headers holds additional headers, such as List-Unsubscribe.
+	Headers map[string]string
+}
+
+// Mailer delivers email messages.
+type Mailer interface {
+	SendMail(ctx context.Context, msg MailMessage) error
+}
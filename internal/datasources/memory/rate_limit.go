@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+var _ datasources.RateLimitStore = (*RateLimitStore)(nil)
+
+// rateLimitPruneInterval is how often buckets idle long enough to be full are dropped.
+const rateLimitPruneInterval = time.Minute
+
+type rateLimitEntry struct {
+	bucket domain.RateLimitBucket
+	window time.Duration
+}
+
+// RateLimitStore keeps token buckets in process memory. Limits are per instance,
+// so multi-instance deployments should use a shared store instead.
+type RateLimitStore struct {
+	mu         sync.Mutex
+	buckets    map[string]rateLimitEntry
+	lastPruned time.Time
+}
+
+// NewRateLimitStore creates an empty in-memory rate limit store.
+func NewRateLimitStore() *RateLimitStore {
+	return &RateLimitStore{
+		buckets: make(map[string]rateLimitEntry),
+	}
+}
+
+// TakeRateLimitToken takes a token from the key's bucket if one is available.
+func (s *RateLimitStore) TakeRateLimitToken(
+	_ context.Context, key string, limit domain.RateLimit, now time.Time,
+) (domain.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastPruned) >= rateLimitPruneInterval {
+		s.prune(now)
+	}
+
+	bucket, decision := s.buckets[key].bucket.Take(limit, now)
+	s.buckets[key] = rateLimitEntry{bucket: bucket, window: limit.Window}
+
+	return decision, nil
+}
+
+// prune drops buckets that have refilled completely, which behave the same as missing ones.
+func (s *RateLimitStore) prune(now time.Time) {
+	for key, entry := range s.buckets {
+		if now.Sub(entry.bucket.UpdatedAt) >= entry.window {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastPruned = now
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitStore_TakeRateLimitToken(t *testing.T) {
+	store := NewRateLimitStore()
+	limit := domain.RateLimit{Requests: 1, Window: time.Minute}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	decision, err := store.TakeRateLimitToken(t.Context(), "user:a", limit, now)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+
+	decision, err = store.TakeRateLimitToken(t.Context(), "user:a", limit, now)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+
+	// Buckets are independent per key
+	decision, err = store.TakeRateLimitToken(t.Context(), "user:b", limit, now)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+
+	// Full buckets are pruned once idle for their window
+	decision, err = store.TakeRateLimitToken(t.Context(), "user:c", limit, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Len(t, store.buckets, 1)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/jbeshir/alignment-research-feed/internal/datasources"
 	"github.com/jbeshir/alignment-research-feed/internal/domain"
@@ -129,19 +130,25 @@ type APITokenRepository_CreateAPIToken_Call struct {
 
 // CreateAPIToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - userID string
-//   - tokenHash string
-//   - tokenPrefix string
-//   - name *string
-//   - expiresAt *time.Time
+//   - params datasources.CreateAPITokenParams
 func (_e *APITokenRepository_Expecter) CreateAPIToken(ctx interface{}, params interface{}) *APITokenRepository_CreateAPIToken_Call {
 	return &APITokenRepository_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, params)}
 }
 
 func (_c *APITokenRepository_CreateAPIToken_Call) Run(run func(ctx context.Context, params datasources.CreateAPITokenParams)) *APITokenRepository_CreateAPIToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(datasources.CreateAPITokenParams))
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 datasources.CreateAPITokenParams
+		if args[1] != nil {
+			arg1 = args[1].(datasources.CreateAPITokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
@@ -222,6 +229,84 @@ func (_c *APITokenRepository_GetAPITokenByHash_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// GetUserAPIToken provides a mock function for the type APITokenRepository
+func (_mock *APITokenRepository) GetUserAPIToken(ctx context.Context, tokenID string, userID string) (domain.APIToken, bool, error) {
+	ret := _mock.Called(ctx, tokenID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAPIToken")
+	}
+
+	var r0 domain.APIToken
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.APIToken, bool, error)); ok {
+		return returnFunc(ctx, tokenID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.APIToken); ok {
+		r0 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.APIToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// APITokenRepository_GetUserAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAPIToken'
+type APITokenRepository_GetUserAPIToken_Call struct {
+	*mock.Call
+}
+
+// GetUserAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID string
+func (_e *APITokenRepository_Expecter) GetUserAPIToken(ctx interface{}, tokenID interface{}, userID interface{}) *APITokenRepository_GetUserAPIToken_Call {
+	return &APITokenRepository_GetUserAPIToken_Call{Call: _e.mock.On("GetUserAPIToken", ctx, tokenID, userID)}
+}
+
+func (_c *APITokenRepository_GetUserAPIToken_Call) Run(run func(ctx context.Context, tokenID string, userID string)) *APITokenRepository_GetUserAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *APITokenRepository_GetUserAPIToken_Call) Return(aPIToken domain.APIToken, b bool, err error) *APITokenRepository_GetUserAPIToken_Call {
+	_c.Call.Return(aPIToken, b, err)
+	return _c
+}
+
+func (_c *APITokenRepository_GetUserAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) (domain.APIToken, bool, error)) *APITokenRepository_GetUserAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserAPITokens provides a mock function for the type APITokenRepository
 func (_mock *APITokenRepository) ListUserAPITokens(ctx context.Context, userID string) ([]domain.APIToken, error) {
 	ret := _mock.Called(ctx, userID)
@@ -290,21 +375,99 @@ func (_c *APITokenRepository_ListUserAPITokens_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// RenameAPIToken provides a mock function for the type APITokenRepository
+func (_mock *APITokenRepository) RenameAPIToken(ctx context.Context, tokenID string, userID string, name *string) error {
+	ret := _mock.Called(ctx, tokenID, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameAPIToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *string) error); ok {
+		r0 = returnFunc(ctx, tokenID, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// APITokenRepository_RenameAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameAPIToken'
+type APITokenRepository_RenameAPIToken_Call struct {
+	*mock.Call
+}
+
+// RenameAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID string
+//   - name *string
+func (_e *APITokenRepository_Expecter) RenameAPIToken(ctx interface{}, tokenID interface{}, userID interface{}, name interface{}) *APITokenRepository_RenameAPIToken_Call {
+	return &APITokenRepository_RenameAPIToken_Call{Call: _e.mock.On("RenameAPIToken", ctx, tokenID, userID, name)}
+}
+
+func (_c *APITokenRepository_RenameAPIToken_Call) Run(run func(ctx context.Context, tokenID string, userID string, name *string)) *APITokenRepository_RenameAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *APITokenRepository_RenameAPIToken_Call) Return(err error) *APITokenRepository_RenameAPIToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *APITokenRepository_RenameAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string, name *string) error) *APITokenRepository_RenameAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeAPIToken provides a mock function for the type APITokenRepository
-func (_mock *APITokenRepository) RevokeAPIToken(ctx context.Context, tokenID string, userID string) error {
+func (_mock *APITokenRepository) RevokeAPIToken(ctx context.Context, tokenID string, userID string) (bool, error) {
 	ret := _mock.Called(ctx, tokenID, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RevokeAPIToken")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, tokenID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
 		r0 = returnFunc(ctx, tokenID, userID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // APITokenRepository_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
@@ -343,12 +506,150 @@ func (_c *APITokenRepository_RevokeAPIToken_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *APITokenRepository_RevokeAPIToken_Call) Return(err error) *APITokenRepository_RevokeAPIToken_Call {
-	_c.Call.Return(err)
+func (_c *APITokenRepository_RevokeAPIToken_Call) Return(b bool, err error) *APITokenRepository_RevokeAPIToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *APITokenRepository_RevokeAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) (bool, error)) *APITokenRepository_RevokeAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeExpiredAPITokens provides a mock function for the type APITokenRepository
+func (_mock *APITokenRepository) RevokeExpiredAPITokens(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeExpiredAPITokens")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APITokenRepository_RevokeExpiredAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeExpiredAPITokens'
+type APITokenRepository_RevokeExpiredAPITokens_Call struct {
+	*mock.Call
+}
+
+// RevokeExpiredAPITokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *APITokenRepository_Expecter) RevokeExpiredAPITokens(ctx interface{}) *APITokenRepository_RevokeExpiredAPITokens_Call {
+	return &APITokenRepository_RevokeExpiredAPITokens_Call{Call: _e.mock.On("RevokeExpiredAPITokens", ctx)}
+}
+
+func (_c *APITokenRepository_RevokeExpiredAPITokens_Call) Run(run func(ctx context.Context)) *APITokenRepository_RevokeExpiredAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *APITokenRepository_RevokeExpiredAPITokens_Call) Return(n int64, err error) *APITokenRepository_RevokeExpiredAPITokens_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *APITokenRepository_RevokeExpiredAPITokens_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *APITokenRepository_RevokeExpiredAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAPIToken provides a mock function for the type APITokenRepository
+func (_mock *APITokenRepository) RotateAPIToken(ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params datasources.CreateAPITokenParams) (bool, error) {
+	ret := _mock.Called(ctx, oldTokenID, oldExpiresAt, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateAPIToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, datasources.CreateAPITokenParams) (bool, error)); ok {
+		return returnFunc(ctx, oldTokenID, oldExpiresAt, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, datasources.CreateAPITokenParams) bool); ok {
+		r0 = returnFunc(ctx, oldTokenID, oldExpiresAt, params)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, datasources.CreateAPITokenParams) error); ok {
+		r1 = returnFunc(ctx, oldTokenID, oldExpiresAt, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APITokenRepository_RotateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIToken'
+type APITokenRepository_RotateAPIToken_Call struct {
+	*mock.Call
+}
+
+// RotateAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldTokenID string
+//   - oldExpiresAt time.Time
+//   - params datasources.CreateAPITokenParams
+func (_e *APITokenRepository_Expecter) RotateAPIToken(ctx interface{}, oldTokenID interface{}, oldExpiresAt interface{}, params interface{}) *APITokenRepository_RotateAPIToken_Call {
+	return &APITokenRepository_RotateAPIToken_Call{Call: _e.mock.On("RotateAPIToken", ctx, oldTokenID, oldExpiresAt, params)}
+}
+
+func (_c *APITokenRepository_RotateAPIToken_Call) Run(run func(ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params datasources.CreateAPITokenParams)) *APITokenRepository_RotateAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 datasources.CreateAPITokenParams
+		if args[3] != nil {
+			arg3 = args[3].(datasources.CreateAPITokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *APITokenRepository_RotateAPIToken_Call) Return(b bool, err error) *APITokenRepository_RotateAPIToken_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *APITokenRepository_RevokeAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) error) *APITokenRepository_RevokeAPIToken_Call {
+func (_c *APITokenRepository_RotateAPIToken_Call) RunAndReturn(run func(ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params datasources.CreateAPITokenParams) (bool, error)) *APITokenRepository_RotateAPIToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
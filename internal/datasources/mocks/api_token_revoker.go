@@ -38,20 +38,29 @@ func (_m *APITokenRevoker) EXPECT() *APITokenRevoker_Expecter {
 }
 
 // RevokeAPIToken provides a mock function for the type APITokenRevoker
-func (_mock *APITokenRevoker) RevokeAPIToken(ctx context.Context, tokenID string, userID string) error {
+func (_mock *APITokenRevoker) RevokeAPIToken(ctx context.Context, tokenID string, userID string) (bool, error) {
 	ret := _mock.Called(ctx, tokenID, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RevokeAPIToken")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, tokenID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
 		r0 = returnFunc(ctx, tokenID, userID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
 // APITokenRevoker_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
@@ -90,12 +99,12 @@ func (_c *APITokenRevoker_RevokeAPIToken_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *APITokenRevoker_RevokeAPIToken_Call) Return(err error) *APITokenRevoker_RevokeAPIToken_Call {
-	_c.Call.Return(err)
+func (_c *APITokenRevoker_RevokeAPIToken_Call) Return(b bool, err error) *APITokenRevoker_RevokeAPIToken_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *APITokenRevoker_RevokeAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) error) *APITokenRevoker_RevokeAPIToken_Call {
+func (_c *APITokenRevoker_RevokeAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) (bool, error)) *APITokenRevoker_RevokeAPIToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
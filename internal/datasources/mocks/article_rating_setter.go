@@ -37,6 +37,81 @@ func (_m *ArticleRatingSetter) EXPECT() *ArticleRatingSetter_Expecter {
 	return &ArticleRatingSetter_Expecter{mock: &_m.Mock}
 }
 
+// SetArticleGradedRating provides a mock function for the type ArticleRatingSetter
+func (_mock *ArticleRatingSetter) SetArticleGradedRating(ctx context.Context, userID string, articleHashID string, rating int, vector []float32) error {
+	ret := _mock.Called(ctx, userID, articleHashID, rating, vector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArticleGradedRating")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, []float32) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, rating, vector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ArticleRatingSetter_SetArticleGradedRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleGradedRating'
+type ArticleRatingSetter_SetArticleGradedRating_Call struct {
+	*mock.Call
+}
+
+// SetArticleGradedRating is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+//   - rating int
+//   - vector []float32
+func (_e *ArticleRatingSetter_Expecter) SetArticleGradedRating(ctx interface{}, userID interface{}, articleHashID interface{}, rating interface{}, vector interface{}) *ArticleRatingSetter_SetArticleGradedRating_Call {
+	return &ArticleRatingSetter_SetArticleGradedRating_Call{Call: _e.mock.On("SetArticleGradedRating", ctx, userID, articleHashID, rating, vector)}
+}
+
+func (_c *ArticleRatingSetter_SetArticleGradedRating_Call) Run(run func(ctx context.Context, userID string, articleHashID string, rating int, vector []float32)) *ArticleRatingSetter_SetArticleGradedRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 []float32
+		if args[4] != nil {
+			arg4 = args[4].([]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *ArticleRatingSetter_SetArticleGradedRating_Call) Return(err error) *ArticleRatingSetter_SetArticleGradedRating_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ArticleRatingSetter_SetArticleGradedRating_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, rating int, vector []float32) error) *ArticleRatingSetter_SetArticleGradedRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetArticleRating provides a mock function for the type ArticleRatingSetter
 func (_mock *ArticleRatingSetter) SetArticleRating(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32) error {
 	ret := _mock.Called(ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)
@@ -40,45 +40,37 @@ func (_m *DatasetRepository) EXPECT() *DatasetRepository_Expecter {
 	return &DatasetRepository_Expecter{mock: &_m.Mock}
 }
 
-// CountUserActiveAPITokens provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) CountUserActiveAPITokens(ctx context.Context, userID string) (int64, error) {
-	ret := _mock.Called(ctx, userID)
+// AddCollectionArticle provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) AddCollectionArticle(ctx context.Context, collectionID string, articleHashID string) error {
+	ret := _mock.Called(ctx, collectionID, articleHashID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountUserActiveAPITokens")
+		panic("no return value specified for AddCollectionArticle")
 	}
 
-	var r0 int64
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		r0 = ret.Get(0).(int64)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, collectionID, articleHashID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_CountUserActiveAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserActiveAPITokens'
-type DatasetRepository_CountUserActiveAPITokens_Call struct {
+// DatasetRepository_AddCollectionArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionArticle'
+type DatasetRepository_AddCollectionArticle_Call struct {
 	*mock.Call
 }
 
-// CountUserActiveAPITokens is a helper method to define mock.On call
+// AddCollectionArticle is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) CountUserActiveAPITokens(ctx interface{}, userID interface{}) *DatasetRepository_CountUserActiveAPITokens_Call {
-	return &DatasetRepository_CountUserActiveAPITokens_Call{Call: _e.mock.On("CountUserActiveAPITokens", ctx, userID)}
+//   - collectionID string
+//   - articleHashID string
+func (_e *DatasetRepository_Expecter) AddCollectionArticle(ctx interface{}, collectionID interface{}, articleHashID interface{}) *DatasetRepository_AddCollectionArticle_Call {
+	return &DatasetRepository_AddCollectionArticle_Call{Call: _e.mock.On("AddCollectionArticle", ctx, collectionID, articleHashID)}
 }
 
-func (_c *DatasetRepository_CountUserActiveAPITokens_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_CountUserActiveAPITokens_Call {
+func (_c *DatasetRepository_AddCollectionArticle_Call) Run(run func(ctx context.Context, collectionID string, articleHashID string)) *DatasetRepository_AddCollectionArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,76 +80,83 @@ func (_c *DatasetRepository_CountUserActiveAPITokens_Call) Run(run func(ctx cont
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_CountUserActiveAPITokens_Call) Return(n int64, err error) *DatasetRepository_CountUserActiveAPITokens_Call {
-	_c.Call.Return(n, err)
+func (_c *DatasetRepository_AddCollectionArticle_Call) Return(err error) *DatasetRepository_AddCollectionArticle_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_CountUserActiveAPITokens_Call) RunAndReturn(run func(ctx context.Context, userID string) (int64, error)) *DatasetRepository_CountUserActiveAPITokens_Call {
+func (_c *DatasetRepository_AddCollectionArticle_Call) RunAndReturn(run func(ctx context.Context, collectionID string, articleHashID string) error) *DatasetRepository_AddCollectionArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountUserArticleVectorsByType provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) CountUserArticleVectorsByType(ctx context.Context, userID string, ratingType domain.UserRatingType) (int64, error) {
-	ret := _mock.Called(ctx, userID, ratingType)
+// AggregateArticlePopularity provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) AggregateArticlePopularity(ctx context.Context, since time.Time, minUsers int64) ([]domain.ArticlePopularity, error) {
+	ret := _mock.Called(ctx, since, minUsers)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountUserArticleVectorsByType")
+		panic("no return value specified for AggregateArticlePopularity")
 	}
 
-	var r0 int64
+	var r0 []domain.ArticlePopularity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) (int64, error)); ok {
-		return returnFunc(ctx, userID, ratingType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int64) ([]domain.ArticlePopularity, error)); ok {
+		return returnFunc(ctx, since, minUsers)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) int64); ok {
-		r0 = returnFunc(ctx, userID, ratingType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int64) []domain.ArticlePopularity); ok {
+		r0 = returnFunc(ctx, since, minUsers)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticlePopularity)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UserRatingType) error); ok {
-		r1 = returnFunc(ctx, userID, ratingType)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int64) error); ok {
+		r1 = returnFunc(ctx, since, minUsers)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_CountUserArticleVectorsByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserArticleVectorsByType'
-type DatasetRepository_CountUserArticleVectorsByType_Call struct {
+// DatasetRepository_AggregateArticlePopularity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AggregateArticlePopularity'
+type DatasetRepository_AggregateArticlePopularity_Call struct {
 	*mock.Call
 }
 
-// CountUserArticleVectorsByType is a helper method to define mock.On call
+// AggregateArticlePopularity is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - ratingType domain.UserRatingType
-func (_e *DatasetRepository_Expecter) CountUserArticleVectorsByType(ctx interface{}, userID interface{}, ratingType interface{}) *DatasetRepository_CountUserArticleVectorsByType_Call {
-	return &DatasetRepository_CountUserArticleVectorsByType_Call{Call: _e.mock.On("CountUserArticleVectorsByType", ctx, userID, ratingType)}
+//   - since time.Time
+//   - minUsers int64
+func (_e *DatasetRepository_Expecter) AggregateArticlePopularity(ctx interface{}, since interface{}, minUsers interface{}) *DatasetRepository_AggregateArticlePopularity_Call {
+	return &DatasetRepository_AggregateArticlePopularity_Call{Call: _e.mock.On("AggregateArticlePopularity", ctx, since, minUsers)}
 }
 
-func (_c *DatasetRepository_CountUserArticleVectorsByType_Call) Run(run func(ctx context.Context, userID string, ratingType domain.UserRatingType)) *DatasetRepository_CountUserArticleVectorsByType_Call {
+func (_c *DatasetRepository_AggregateArticlePopularity_Call) Run(run func(ctx context.Context, since time.Time, minUsers int64)) *DatasetRepository_AggregateArticlePopularity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(time.Time)
 		}
-		var arg2 domain.UserRatingType
+		var arg2 int64
 		if args[2] != nil {
-			arg2 = args[2].(domain.UserRatingType)
+			arg2 = args[2].(int64)
 		}
 		run(
 			arg0,
@@ -168,97 +167,55 @@ func (_c *DatasetRepository_CountUserArticleVectorsByType_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *DatasetRepository_CountUserArticleVectorsByType_Call) Return(n int64, err error) *DatasetRepository_CountUserArticleVectorsByType_Call {
-	_c.Call.Return(n, err)
+func (_c *DatasetRepository_AggregateArticlePopularity_Call) Return(articlePopularitys []domain.ArticlePopularity, err error) *DatasetRepository_AggregateArticlePopularity_Call {
+	_c.Call.Return(articlePopularitys, err)
 	return _c
 }
 
-func (_c *DatasetRepository_CountUserArticleVectorsByType_Call) RunAndReturn(run func(ctx context.Context, userID string, ratingType domain.UserRatingType) (int64, error)) *DatasetRepository_CountUserArticleVectorsByType_Call {
+func (_c *DatasetRepository_AggregateArticlePopularity_Call) RunAndReturn(run func(ctx context.Context, since time.Time, minUsers int64) ([]domain.ArticlePopularity, error)) *DatasetRepository_AggregateArticlePopularity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateAPIToken provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) CreateAPIToken(ctx context.Context, params datasources.CreateAPITokenParams) error {
-	ret := _mock.Called(ctx, params)
+// ConfirmDigestEmail provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ConfirmDigestEmail(ctx context.Context, confirmationToken string) (bool, error) {
+	ret := _mock.Called(ctx, confirmationToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAPIToken")
+		panic("no return value specified for ConfirmDigestEmail")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, datasources.CreateAPITokenParams) error); ok {
-		r0 = returnFunc(ctx, params)
-	} else {
-		r0 = ret.Error(0)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, confirmationToken)
 	}
-	return r0
-}
-
-// DatasetRepository_CreateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIToken'
-type DatasetRepository_CreateAPIToken_Call struct {
-	*mock.Call
-}
-
-// CreateAPIToken is a helper method to define mock.On call
-//   - ctx context.Context
-//   - id string
-//   - userID string
-//   - tokenHash string
-//   - tokenPrefix string
-//   - name *string
-//   - expiresAt *time.Time
-func (_e *DatasetRepository_Expecter) CreateAPIToken(ctx interface{}, params interface{}) *DatasetRepository_CreateAPIToken_Call {
-	return &DatasetRepository_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, params)}
-}
-
-func (_c *DatasetRepository_CreateAPIToken_Call) Run(run func(ctx context.Context, params datasources.CreateAPITokenParams)) *DatasetRepository_CreateAPIToken_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(datasources.CreateAPITokenParams))
-	})
-	return _c
-}
-
-func (_c *DatasetRepository_CreateAPIToken_Call) Return(err error) *DatasetRepository_CreateAPIToken_Call {
-	_c.Call.Return(err)
-	return _c
-}
-
-func (_c *DatasetRepository_CreateAPIToken_Call) RunAndReturn(run func(ctx context.Context, params datasources.CreateAPITokenParams) error) *DatasetRepository_CreateAPIToken_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// DeleteUserInterestClusters provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) DeleteUserInterestClusters(ctx context.Context, userID string) error {
-	ret := _mock.Called(ctx, userID)
-
-	if len(ret) == 0 {
-		panic("no return value specified for DeleteUserInterestClusters")
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, confirmationToken)
+	} else {
+		r0 = ret.Get(0).(bool)
 	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, confirmationToken)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
-// DatasetRepository_DeleteUserInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserInterestClusters'
-type DatasetRepository_DeleteUserInterestClusters_Call struct {
+// DatasetRepository_ConfirmDigestEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmDigestEmail'
+type DatasetRepository_ConfirmDigestEmail_Call struct {
 	*mock.Call
 }
 
-// DeleteUserInterestClusters is a helper method to define mock.On call
+// ConfirmDigestEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) DeleteUserInterestClusters(ctx interface{}, userID interface{}) *DatasetRepository_DeleteUserInterestClusters_Call {
-	return &DatasetRepository_DeleteUserInterestClusters_Call{Call: _e.mock.On("DeleteUserInterestClusters", ctx, userID)}
+//   - confirmationToken string
+func (_e *DatasetRepository_Expecter) ConfirmDigestEmail(ctx interface{}, confirmationToken interface{}) *DatasetRepository_ConfirmDigestEmail_Call {
+	return &DatasetRepository_ConfirmDigestEmail_Call{Call: _e.mock.On("ConfirmDigestEmail", ctx, confirmationToken)}
 }
 
-func (_c *DatasetRepository_DeleteUserInterestClusters_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_DeleteUserInterestClusters_Call {
+func (_c *DatasetRepository_ConfirmDigestEmail_Call) Run(run func(ctx context.Context, confirmationToken string)) *DatasetRepository_ConfirmDigestEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -276,180 +233,203 @@ func (_c *DatasetRepository_DeleteUserInterestClusters_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *DatasetRepository_DeleteUserInterestClusters_Call) Return(err error) *DatasetRepository_DeleteUserInterestClusters_Call {
-	_c.Call.Return(err)
+func (_c *DatasetRepository_ConfirmDigestEmail_Call) Return(b bool, err error) *DatasetRepository_ConfirmDigestEmail_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *DatasetRepository_DeleteUserInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_DeleteUserInterestClusters_Call {
+func (_c *DatasetRepository_ConfirmDigestEmail_Call) RunAndReturn(run func(ctx context.Context, confirmationToken string) (bool, error)) *DatasetRepository_ConfirmDigestEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUserPrecomputedRecommendations provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) DeleteUserPrecomputedRecommendations(ctx context.Context, userID string) error {
-	ret := _mock.Called(ctx, userID)
+// CountArticleLikes provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CountArticleLikes(ctx context.Context) (map[string]int64, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUserPrecomputedRecommendations")
+		panic("no return value specified for CountArticleLikes")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, userID)
+	var r0 map[string]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]int64); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// DatasetRepository_DeleteUserPrecomputedRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserPrecomputedRecommendations'
-type DatasetRepository_DeleteUserPrecomputedRecommendations_Call struct {
+// DatasetRepository_CountArticleLikes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountArticleLikes'
+type DatasetRepository_CountArticleLikes_Call struct {
 	*mock.Call
 }
 
-// DeleteUserPrecomputedRecommendations is a helper method to define mock.On call
+// CountArticleLikes is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) DeleteUserPrecomputedRecommendations(ctx interface{}, userID interface{}) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
-	return &DatasetRepository_DeleteUserPrecomputedRecommendations_Call{Call: _e.mock.On("DeleteUserPrecomputedRecommendations", ctx, userID)}
+func (_e *DatasetRepository_Expecter) CountArticleLikes(ctx interface{}) *DatasetRepository_CountArticleLikes_Call {
+	return &DatasetRepository_CountArticleLikes_Call{Call: _e.mock.On("CountArticleLikes", ctx)}
 }
 
-func (_c *DatasetRepository_DeleteUserPrecomputedRecommendations_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
+func (_c *DatasetRepository_CountArticleLikes_Call) Run(run func(ctx context.Context)) *DatasetRepository_CountArticleLikes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_DeleteUserPrecomputedRecommendations_Call) Return(err error) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
-	_c.Call.Return(err)
+func (_c *DatasetRepository_CountArticleLikes_Call) Return(stringToInt64 map[string]int64, err error) *DatasetRepository_CountArticleLikes_Call {
+	_c.Call.Return(stringToInt64, err)
 	return _c
 }
 
-func (_c *DatasetRepository_DeleteUserPrecomputedRecommendations_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
+func (_c *DatasetRepository_CountArticleLikes_Call) RunAndReturn(run func(ctx context.Context) (map[string]int64, error)) *DatasetRepository_CountArticleLikes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchArticlesByID provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) FetchArticlesByID(ctx context.Context, hashIDs []string) ([]domain.Article, error) {
-	ret := _mock.Called(ctx, hashIDs)
+// CountIgnoredRecommendations provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CountIgnoredRecommendations(ctx context.Context, userID string, since time.Time, maxPosition int, minDays int) (map[string]int, error) {
+	ret := _mock.Called(ctx, userID, since, maxPosition, minDays)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FetchArticlesByID")
+		panic("no return value specified for CountIgnoredRecommendations")
 	}
 
-	var r0 []domain.Article
+	var r0 map[string]int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Article, error)); ok {
-		return returnFunc(ctx, hashIDs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, int, int) (map[string]int, error)); ok {
+		return returnFunc(ctx, userID, since, maxPosition, minDays)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.Article); ok {
-		r0 = returnFunc(ctx, hashIDs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, int, int) map[string]int); ok {
+		r0 = returnFunc(ctx, userID, since, maxPosition, minDays)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Article)
+			r0 = ret.Get(0).(map[string]int)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = returnFunc(ctx, hashIDs)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, since, maxPosition, minDays)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_FetchArticlesByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchArticlesByID'
-type DatasetRepository_FetchArticlesByID_Call struct {
+// DatasetRepository_CountIgnoredRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountIgnoredRecommendations'
+type DatasetRepository_CountIgnoredRecommendations_Call struct {
 	*mock.Call
 }
 
-// FetchArticlesByID is a helper method to define mock.On call
+// CountIgnoredRecommendations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - hashIDs []string
-func (_e *DatasetRepository_Expecter) FetchArticlesByID(ctx interface{}, hashIDs interface{}) *DatasetRepository_FetchArticlesByID_Call {
-	return &DatasetRepository_FetchArticlesByID_Call{Call: _e.mock.On("FetchArticlesByID", ctx, hashIDs)}
+//   - userID string
+//   - since time.Time
+//   - maxPosition int
+//   - minDays int
+func (_e *DatasetRepository_Expecter) CountIgnoredRecommendations(ctx interface{}, userID interface{}, since interface{}, maxPosition interface{}, minDays interface{}) *DatasetRepository_CountIgnoredRecommendations_Call {
+	return &DatasetRepository_CountIgnoredRecommendations_Call{Call: _e.mock.On("CountIgnoredRecommendations", ctx, userID, since, maxPosition, minDays)}
 }
 
-func (_c *DatasetRepository_FetchArticlesByID_Call) Run(run func(ctx context.Context, hashIDs []string)) *DatasetRepository_FetchArticlesByID_Call {
+func (_c *DatasetRepository_CountIgnoredRecommendations_Call) Run(run func(ctx context.Context, userID string, since time.Time, maxPosition int, minDays int)) *DatasetRepository_CountIgnoredRecommendations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []string
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].([]string)
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_FetchArticlesByID_Call) Return(articles []domain.Article, err error) *DatasetRepository_FetchArticlesByID_Call {
-	_c.Call.Return(articles, err)
+func (_c *DatasetRepository_CountIgnoredRecommendations_Call) Return(stringToInt map[string]int, err error) *DatasetRepository_CountIgnoredRecommendations_Call {
+	_c.Call.Return(stringToInt, err)
 	return _c
 }
 
-func (_c *DatasetRepository_FetchArticlesByID_Call) RunAndReturn(run func(ctx context.Context, hashIDs []string) ([]domain.Article, error)) *DatasetRepository_FetchArticlesByID_Call {
+func (_c *DatasetRepository_CountIgnoredRecommendations_Call) RunAndReturn(run func(ctx context.Context, userID string, since time.Time, maxPosition int, minDays int) (map[string]int, error)) *DatasetRepository_CountIgnoredRecommendations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAPITokenByHash provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) GetAPITokenByHash(ctx context.Context, tokenHash string) (domain.APIToken, error) {
-	ret := _mock.Called(ctx, tokenHash)
+// CountUserActiveAPITokens provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CountUserActiveAPITokens(ctx context.Context, userID string) (int64, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAPITokenByHash")
+		panic("no return value specified for CountUserActiveAPITokens")
 	}
 
-	var r0 domain.APIToken
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.APIToken, error)); ok {
-		return returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.APIToken); ok {
-		r0 = returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(domain.APIToken)
+		r0 = ret.Get(0).(int64)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, tokenHash)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_GetAPITokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPITokenByHash'
-type DatasetRepository_GetAPITokenByHash_Call struct {
+// DatasetRepository_CountUserActiveAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserActiveAPITokens'
+type DatasetRepository_CountUserActiveAPITokens_Call struct {
 	*mock.Call
 }
 
-// GetAPITokenByHash is a helper method to define mock.On call
+// CountUserActiveAPITokens is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenHash string
-func (_e *DatasetRepository_Expecter) GetAPITokenByHash(ctx interface{}, tokenHash interface{}) *DatasetRepository_GetAPITokenByHash_Call {
-	return &DatasetRepository_GetAPITokenByHash_Call{Call: _e.mock.On("GetAPITokenByHash", ctx, tokenHash)}
+//   - userID string
+func (_e *DatasetRepository_Expecter) CountUserActiveAPITokens(ctx interface{}, userID interface{}) *DatasetRepository_CountUserActiveAPITokens_Call {
+	return &DatasetRepository_CountUserActiveAPITokens_Call{Call: _e.mock.On("CountUserActiveAPITokens", ctx, userID)}
 }
 
-func (_c *DatasetRepository_GetAPITokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *DatasetRepository_GetAPITokenByHash_Call {
+func (_c *DatasetRepository_CountUserActiveAPITokens_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_CountUserActiveAPITokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -467,55 +447,56 @@ func (_c *DatasetRepository_GetAPITokenByHash_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *DatasetRepository_GetAPITokenByHash_Call) Return(aPIToken domain.APIToken, err error) *DatasetRepository_GetAPITokenByHash_Call {
-	_c.Call.Return(aPIToken, err)
+func (_c *DatasetRepository_CountUserActiveAPITokens_Call) Return(n int64, err error) *DatasetRepository_CountUserActiveAPITokens_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *DatasetRepository_GetAPITokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (domain.APIToken, error)) *DatasetRepository_GetAPITokenByHash_Call {
+func (_c *DatasetRepository_CountUserActiveAPITokens_Call) RunAndReturn(run func(ctx context.Context, userID string) (int64, error)) *DatasetRepository_CountUserActiveAPITokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrecomputedRecommendationAge provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) GetPrecomputedRecommendationAge(ctx context.Context, userID string) (time.Time, error) {
-	ret := _mock.Called(ctx, userID)
+// CountUserArticleVectorsByType provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CountUserArticleVectorsByType(ctx context.Context, userID string, ratingType domain.UserRatingType) (int64, error) {
+	ret := _mock.Called(ctx, userID, ratingType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrecomputedRecommendationAge")
+		panic("no return value specified for CountUserArticleVectorsByType")
 	}
 
-	var r0 time.Time
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (time.Time, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) (int64, error)); ok {
+		return returnFunc(ctx, userID, ratingType)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) time.Time); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) int64); ok {
+		r0 = returnFunc(ctx, userID, ratingType)
 	} else {
-		r0 = ret.Get(0).(time.Time)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UserRatingType) error); ok {
+		r1 = returnFunc(ctx, userID, ratingType)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_GetPrecomputedRecommendationAge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrecomputedRecommendationAge'
-type DatasetRepository_GetPrecomputedRecommendationAge_Call struct {
+// DatasetRepository_CountUserArticleVectorsByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserArticleVectorsByType'
+type DatasetRepository_CountUserArticleVectorsByType_Call struct {
 	*mock.Call
 }
 
-// GetPrecomputedRecommendationAge is a helper method to define mock.On call
+// CountUserArticleVectorsByType is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *DatasetRepository_Expecter) GetPrecomputedRecommendationAge(ctx interface{}, userID interface{}) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
-	return &DatasetRepository_GetPrecomputedRecommendationAge_Call{Call: _e.mock.On("GetPrecomputedRecommendationAge", ctx, userID)}
+//   - ratingType domain.UserRatingType
+func (_e *DatasetRepository_Expecter) CountUserArticleVectorsByType(ctx interface{}, userID interface{}, ratingType interface{}) *DatasetRepository_CountUserArticleVectorsByType_Call {
+	return &DatasetRepository_CountUserArticleVectorsByType_Call{Call: _e.mock.On("CountUserArticleVectorsByType", ctx, userID, ratingType)}
 }
 
-func (_c *DatasetRepository_GetPrecomputedRecommendationAge_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
+func (_c *DatasetRepository_CountUserArticleVectorsByType_Call) Run(run func(ctx context.Context, userID string, ratingType domain.UserRatingType)) *DatasetRepository_CountUserArticleVectorsByType_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -525,66 +506,68 @@ func (_c *DatasetRepository_GetPrecomputedRecommendationAge_Call) Run(run func(c
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 domain.UserRatingType
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserRatingType)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_GetPrecomputedRecommendationAge_Call) Return(time1 time.Time, err error) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
-	_c.Call.Return(time1, err)
+func (_c *DatasetRepository_CountUserArticleVectorsByType_Call) Return(n int64, err error) *DatasetRepository_CountUserArticleVectorsByType_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *DatasetRepository_GetPrecomputedRecommendationAge_Call) RunAndReturn(run func(ctx context.Context, userID string) (time.Time, error)) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
+func (_c *DatasetRepository_CountUserArticleVectorsByType_Call) RunAndReturn(run func(ctx context.Context, userID string, ratingType domain.UserRatingType) (int64, error)) *DatasetRepository_CountUserArticleVectorsByType_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrecomputedRecommendations provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) GetPrecomputedRecommendations(ctx context.Context, userID string, limit int) ([]datasources.PrecomputedRecommendation, error) {
-	ret := _mock.Called(ctx, userID, limit)
+// CountUserCollections provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CountUserCollections(ctx context.Context, userID string) (int64, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrecomputedRecommendations")
+		panic("no return value specified for CountUserCollections")
 	}
 
-	var r0 []datasources.PrecomputedRecommendation
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]datasources.PrecomputedRecommendation, error)); ok {
-		return returnFunc(ctx, userID, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []datasources.PrecomputedRecommendation); ok {
-		r0 = returnFunc(ctx, userID, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]datasources.PrecomputedRecommendation)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
-		r1 = returnFunc(ctx, userID, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_GetPrecomputedRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrecomputedRecommendations'
-type DatasetRepository_GetPrecomputedRecommendations_Call struct {
+// DatasetRepository_CountUserCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserCollections'
+type DatasetRepository_CountUserCollections_Call struct {
 	*mock.Call
 }
 
-// GetPrecomputedRecommendations is a helper method to define mock.On call
+// CountUserCollections is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - limit int
-func (_e *DatasetRepository_Expecter) GetPrecomputedRecommendations(ctx interface{}, userID interface{}, limit interface{}) *DatasetRepository_GetPrecomputedRecommendations_Call {
-	return &DatasetRepository_GetPrecomputedRecommendations_Call{Call: _e.mock.On("GetPrecomputedRecommendations", ctx, userID, limit)}
+func (_e *DatasetRepository_Expecter) CountUserCollections(ctx interface{}, userID interface{}) *DatasetRepository_CountUserCollections_Call {
+	return &DatasetRepository_CountUserCollections_Call{Call: _e.mock.On("CountUserCollections", ctx, userID)}
 }
 
-func (_c *DatasetRepository_GetPrecomputedRecommendations_Call) Run(run func(ctx context.Context, userID string, limit int)) *DatasetRepository_GetPrecomputedRecommendations_Call {
+func (_c *DatasetRepository_CountUserCollections_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_CountUserCollections_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -594,71 +577,63 @@ func (_c *DatasetRepository_GetPrecomputedRecommendations_Call) Run(run func(ctx
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_GetPrecomputedRecommendations_Call) Return(precomputedRecommendations []datasources.PrecomputedRecommendation, err error) *DatasetRepository_GetPrecomputedRecommendations_Call {
-	_c.Call.Return(precomputedRecommendations, err)
+func (_c *DatasetRepository_CountUserCollections_Call) Return(n int64, err error) *DatasetRepository_CountUserCollections_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *DatasetRepository_GetPrecomputedRecommendations_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int) ([]datasources.PrecomputedRecommendation, error)) *DatasetRepository_GetPrecomputedRecommendations_Call {
+func (_c *DatasetRepository_CountUserCollections_Call) RunAndReturn(run func(ctx context.Context, userID string) (int64, error)) *DatasetRepository_CountUserCollections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserArticleVectorsByType provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) GetUserArticleVectorsByType(ctx context.Context, userID string, ratingType domain.UserRatingType) ([]domain.UserArticleRating, error) {
-	ret := _mock.Called(ctx, userID, ratingType)
+// CountUserSavedSearches provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CountUserSavedSearches(ctx context.Context, userID string) (int64, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserArticleVectorsByType")
+		panic("no return value specified for CountUserSavedSearches")
 	}
 
-	var r0 []domain.UserArticleRating
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) ([]domain.UserArticleRating, error)); ok {
-		return returnFunc(ctx, userID, ratingType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) []domain.UserArticleRating); ok {
-		r0 = returnFunc(ctx, userID, ratingType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.UserArticleRating)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UserRatingType) error); ok {
-		r1 = returnFunc(ctx, userID, ratingType)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_GetUserArticleVectorsByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserArticleVectorsByType'
-type DatasetRepository_GetUserArticleVectorsByType_Call struct {
+// DatasetRepository_CountUserSavedSearches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserSavedSearches'
+type DatasetRepository_CountUserSavedSearches_Call struct {
 	*mock.Call
 }
 
-// GetUserArticleVectorsByType is a helper method to define mock.On call
+// CountUserSavedSearches is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - ratingType domain.UserRatingType
-func (_e *DatasetRepository_Expecter) GetUserArticleVectorsByType(ctx interface{}, userID interface{}, ratingType interface{}) *DatasetRepository_GetUserArticleVectorsByType_Call {
-	return &DatasetRepository_GetUserArticleVectorsByType_Call{Call: _e.mock.On("GetUserArticleVectorsByType", ctx, userID, ratingType)}
+func (_e *DatasetRepository_Expecter) CountUserSavedSearches(ctx interface{}, userID interface{}) *DatasetRepository_CountUserSavedSearches_Call {
+	return &DatasetRepository_CountUserSavedSearches_Call{Call: _e.mock.On("CountUserSavedSearches", ctx, userID)}
 }
 
-func (_c *DatasetRepository_GetUserArticleVectorsByType_Call) Run(run func(ctx context.Context, userID string, ratingType domain.UserRatingType)) *DatasetRepository_GetUserArticleVectorsByType_Call {
+func (_c *DatasetRepository_CountUserSavedSearches_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_CountUserSavedSearches_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -668,78 +643,62 @@ func (_c *DatasetRepository_GetUserArticleVectorsByType_Call) Run(run func(ctx c
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 domain.UserRatingType
-		if args[2] != nil {
-			arg2 = args[2].(domain.UserRatingType)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_GetUserArticleVectorsByType_Call) Return(userArticleRatings []domain.UserArticleRating, err error) *DatasetRepository_GetUserArticleVectorsByType_Call {
-	_c.Call.Return(userArticleRatings, err)
+func (_c *DatasetRepository_CountUserSavedSearches_Call) Return(n int64, err error) *DatasetRepository_CountUserSavedSearches_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *DatasetRepository_GetUserArticleVectorsByType_Call) RunAndReturn(run func(ctx context.Context, userID string, ratingType domain.UserRatingType) ([]domain.UserArticleRating, error)) *DatasetRepository_GetUserArticleVectorsByType_Call {
+func (_c *DatasetRepository_CountUserSavedSearches_Call) RunAndReturn(run func(ctx context.Context, userID string) (int64, error)) *DatasetRepository_CountUserSavedSearches_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserInterestClusters provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) GetUserInterestClusters(ctx context.Context, userID string) ([]datasources.UserInterestCluster, error) {
-	ret := _mock.Called(ctx, userID)
+// CreateAPIToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CreateAPIToken(ctx context.Context, params datasources.CreateAPITokenParams) error {
+	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserInterestClusters")
+		panic("no return value specified for CreateAPIToken")
 	}
 
-	var r0 []datasources.UserInterestCluster
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]datasources.UserInterestCluster, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []datasources.UserInterestCluster); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]datasources.UserInterestCluster)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, datasources.CreateAPITokenParams) error); ok {
+		r0 = returnFunc(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_GetUserInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInterestClusters'
-type DatasetRepository_GetUserInterestClusters_Call struct {
+// DatasetRepository_CreateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIToken'
+type DatasetRepository_CreateAPIToken_Call struct {
 	*mock.Call
 }
 
-// GetUserInterestClusters is a helper method to define mock.On call
+// CreateAPIToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) GetUserInterestClusters(ctx interface{}, userID interface{}) *DatasetRepository_GetUserInterestClusters_Call {
-	return &DatasetRepository_GetUserInterestClusters_Call{Call: _e.mock.On("GetUserInterestClusters", ctx, userID)}
+//   - params datasources.CreateAPITokenParams
+func (_e *DatasetRepository_Expecter) CreateAPIToken(ctx interface{}, params interface{}) *DatasetRepository_CreateAPIToken_Call {
+	return &DatasetRepository_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, params)}
 }
 
-func (_c *DatasetRepository_GetUserInterestClusters_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetUserInterestClusters_Call {
+func (_c *DatasetRepository_CreateAPIToken_Call) Run(run func(ctx context.Context, params datasources.CreateAPITokenParams)) *DatasetRepository_CreateAPIToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 datasources.CreateAPITokenParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(datasources.CreateAPITokenParams)
 		}
 		run(
 			arg0,
@@ -749,63 +708,54 @@ func (_c *DatasetRepository_GetUserInterestClusters_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *DatasetRepository_GetUserInterestClusters_Call) Return(userInterestClusters []datasources.UserInterestCluster, err error) *DatasetRepository_GetUserInterestClusters_Call {
-	_c.Call.Return(userInterestClusters, err)
+func (_c *DatasetRepository_CreateAPIToken_Call) Return(err error) *DatasetRepository_CreateAPIToken_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_GetUserInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]datasources.UserInterestCluster, error)) *DatasetRepository_GetUserInterestClusters_Call {
+func (_c *DatasetRepository_CreateAPIToken_Call) RunAndReturn(run func(ctx context.Context, params datasources.CreateAPITokenParams) error) *DatasetRepository_CreateAPIToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserRecommendationState provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) GetUserRecommendationState(ctx context.Context, userID string) (datasources.UserRecommendationState, error) {
-	ret := _mock.Called(ctx, userID)
+// CreateArticleNote provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CreateArticleNote(ctx context.Context, note domain.ArticleNote) error {
+	ret := _mock.Called(ctx, note)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserRecommendationState")
+		panic("no return value specified for CreateArticleNote")
 	}
 
-	var r0 datasources.UserRecommendationState
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (datasources.UserRecommendationState, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) datasources.UserRecommendationState); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		r0 = ret.Get(0).(datasources.UserRecommendationState)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ArticleNote) error); ok {
+		r0 = returnFunc(ctx, note)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_GetUserRecommendationState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRecommendationState'
-type DatasetRepository_GetUserRecommendationState_Call struct {
+// DatasetRepository_CreateArticleNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateArticleNote'
+type DatasetRepository_CreateArticleNote_Call struct {
 	*mock.Call
 }
 
-// GetUserRecommendationState is a helper method to define mock.On call
+// CreateArticleNote is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) GetUserRecommendationState(ctx interface{}, userID interface{}) *DatasetRepository_GetUserRecommendationState_Call {
-	return &DatasetRepository_GetUserRecommendationState_Call{Call: _e.mock.On("GetUserRecommendationState", ctx, userID)}
+//   - note domain.ArticleNote
+func (_e *DatasetRepository_Expecter) CreateArticleNote(ctx interface{}, note interface{}) *DatasetRepository_CreateArticleNote_Call {
+	return &DatasetRepository_CreateArticleNote_Call{Call: _e.mock.On("CreateArticleNote", ctx, note)}
 }
 
-func (_c *DatasetRepository_GetUserRecommendationState_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetUserRecommendationState_Call {
+func (_c *DatasetRepository_CreateArticleNote_Call) Run(run func(ctx context.Context, note domain.ArticleNote)) *DatasetRepository_CreateArticleNote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.ArticleNote
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.ArticleNote)
 		}
 		run(
 			arg0,
@@ -815,75 +765,6348 @@ func (_c *DatasetRepository_GetUserRecommendationState_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *DatasetRepository_GetUserRecommendationState_Call) Return(userRecommendationState datasources.UserRecommendationState, err error) *DatasetRepository_GetUserRecommendationState_Call {
-	_c.Call.Return(userRecommendationState, err)
+func (_c *DatasetRepository_CreateArticleNote_Call) Return(err error) *DatasetRepository_CreateArticleNote_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_GetUserRecommendationState_Call) RunAndReturn(run func(ctx context.Context, userID string) (datasources.UserRecommendationState, error)) *DatasetRepository_GetUserRecommendationState_Call {
+func (_c *DatasetRepository_CreateArticleNote_Call) RunAndReturn(run func(ctx context.Context, note domain.ArticleNote) error) *DatasetRepository_CreateArticleNote_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListDislikedArticleIDs provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListDislikedArticleIDs(ctx context.Context, userID string, page int, pageSize int) ([]string, error) {
-	ret := _mock.Called(ctx, userID, page, pageSize)
+// CreateCollection provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CreateCollection(ctx context.Context, collection domain.Collection) error {
+	ret := _mock.Called(ctx, collection)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListDislikedArticleIDs")
+		panic("no return value specified for CreateCollection")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]string, error)); ok {
-		return returnFunc(ctx, userID, page, pageSize)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []string); ok {
-		r0 = returnFunc(ctx, userID, page, pageSize)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
-		r1 = returnFunc(ctx, userID, page, pageSize)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Collection) error); ok {
+		r0 = returnFunc(ctx, collection)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_ListDislikedArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDislikedArticleIDs'
-type DatasetRepository_ListDislikedArticleIDs_Call struct {
+// DatasetRepository_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type DatasetRepository_CreateCollection_Call struct {
 	*mock.Call
 }
 
-// ListDislikedArticleIDs is a helper method to define mock.On call
+// CreateCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - page int
-//   - pageSize int
-func (_e *DatasetRepository_Expecter) ListDislikedArticleIDs(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListDislikedArticleIDs_Call {
-	return &DatasetRepository_ListDislikedArticleIDs_Call{Call: _e.mock.On("ListDislikedArticleIDs", ctx, userID, page, pageSize)}
+//   - collection domain.Collection
+func (_e *DatasetRepository_Expecter) CreateCollection(ctx interface{}, collection interface{}) *DatasetRepository_CreateCollection_Call {
+	return &DatasetRepository_CreateCollection_Call{Call: _e.mock.On("CreateCollection", ctx, collection)}
 }
 
-func (_c *DatasetRepository_ListDislikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, page int, pageSize int)) *DatasetRepository_ListDislikedArticleIDs_Call {
+func (_c *DatasetRepository_CreateCollection_Call) Run(run func(ctx context.Context, collection domain.Collection)) *DatasetRepository_CreateCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.Collection
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.Collection)
 		}
-		var arg2 int
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_CreateCollection_Call) Return(err error) *DatasetRepository_CreateCollection_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_CreateCollection_Call) RunAndReturn(run func(ctx context.Context, collection domain.Collection) error) *DatasetRepository_CreateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFollowPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CreateFollowPreferences(ctx context.Context, userID string, feedToken string) error {
+	ret := _mock.Called(ctx, userID, feedToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFollowPreferences")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, feedToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_CreateFollowPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFollowPreferences'
+type DatasetRepository_CreateFollowPreferences_Call struct {
+	*mock.Call
+}
+
+// CreateFollowPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - feedToken string
+func (_e *DatasetRepository_Expecter) CreateFollowPreferences(ctx interface{}, userID interface{}, feedToken interface{}) *DatasetRepository_CreateFollowPreferences_Call {
+	return &DatasetRepository_CreateFollowPreferences_Call{Call: _e.mock.On("CreateFollowPreferences", ctx, userID, feedToken)}
+}
+
+func (_c *DatasetRepository_CreateFollowPreferences_Call) Run(run func(ctx context.Context, userID string, feedToken string)) *DatasetRepository_CreateFollowPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_CreateFollowPreferences_Call) Return(err error) *DatasetRepository_CreateFollowPreferences_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_CreateFollowPreferences_Call) RunAndReturn(run func(ctx context.Context, userID string, feedToken string) error) *DatasetRepository_CreateFollowPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSavedSearch provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) CreateSavedSearch(ctx context.Context, search domain.SavedSearch) error {
+	ret := _mock.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSavedSearch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SavedSearch) error); ok {
+		r0 = returnFunc(ctx, search)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_CreateSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSavedSearch'
+type DatasetRepository_CreateSavedSearch_Call struct {
+	*mock.Call
+}
+
+// CreateSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search domain.SavedSearch
+func (_e *DatasetRepository_Expecter) CreateSavedSearch(ctx interface{}, search interface{}) *DatasetRepository_CreateSavedSearch_Call {
+	return &DatasetRepository_CreateSavedSearch_Call{Call: _e.mock.On("CreateSavedSearch", ctx, search)}
+}
+
+func (_c *DatasetRepository_CreateSavedSearch_Call) Run(run func(ctx context.Context, search domain.SavedSearch)) *DatasetRepository_CreateSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SavedSearch
+		if args[1] != nil {
+			arg1 = args[1].(domain.SavedSearch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_CreateSavedSearch_Call) Return(err error) *DatasetRepository_CreateSavedSearch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_CreateSavedSearch_Call) RunAndReturn(run func(ctx context.Context, search domain.SavedSearch) error) *DatasetRepository_CreateSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteArticleNote provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteArticleNote(ctx context.Context, userID string, noteID string) error {
+	ret := _mock.Called(ctx, userID, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteArticleNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, noteID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_DeleteArticleNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticleNote'
+type DatasetRepository_DeleteArticleNote_Call struct {
+	*mock.Call
+}
+
+// DeleteArticleNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - noteID string
+func (_e *DatasetRepository_Expecter) DeleteArticleNote(ctx interface{}, userID interface{}, noteID interface{}) *DatasetRepository_DeleteArticleNote_Call {
+	return &DatasetRepository_DeleteArticleNote_Call{Call: _e.mock.On("DeleteArticleNote", ctx, userID, noteID)}
+}
+
+func (_c *DatasetRepository_DeleteArticleNote_Call) Run(run func(ctx context.Context, userID string, noteID string)) *DatasetRepository_DeleteArticleNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteArticleNote_Call) Return(err error) *DatasetRepository_DeleteArticleNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteArticleNote_Call) RunAndReturn(run func(ctx context.Context, userID string, noteID string) error) *DatasetRepository_DeleteArticleNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCollection provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteCollection(ctx context.Context, userID string, collectionID string) error {
+	ret := _mock.Called(ctx, userID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollection")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_DeleteCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCollection'
+type DatasetRepository_DeleteCollection_Call struct {
+	*mock.Call
+}
+
+// DeleteCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - collectionID string
+func (_e *DatasetRepository_Expecter) DeleteCollection(ctx interface{}, userID interface{}, collectionID interface{}) *DatasetRepository_DeleteCollection_Call {
+	return &DatasetRepository_DeleteCollection_Call{Call: _e.mock.On("DeleteCollection", ctx, userID, collectionID)}
+}
+
+func (_c *DatasetRepository_DeleteCollection_Call) Run(run func(ctx context.Context, userID string, collectionID string)) *DatasetRepository_DeleteCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteCollection_Call) Return(err error) *DatasetRepository_DeleteCollection_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteCollection_Call) RunAndReturn(run func(ctx context.Context, userID string, collectionID string) error) *DatasetRepository_DeleteCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRateLimitBucketsUpdatedBefore provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteRateLimitBucketsUpdatedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRateLimitBucketsUpdatedBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRateLimitBucketsUpdatedBefore'
+type DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteRateLimitBucketsUpdatedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *DatasetRepository_Expecter) DeleteRateLimitBucketsUpdatedBefore(ctx interface{}, before interface{}) *DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call {
+	return &DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call{Call: _e.mock.On("DeleteRateLimitBucketsUpdatedBefore", ctx, before)}
+}
+
+func (_c *DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call) Return(n int64, err error) *DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, error)) *DatasetRepository_DeleteRateLimitBucketsUpdatedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSavedSearch provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteSavedSearch(ctx context.Context, userID string, searchID string) error {
+	ret := _mock.Called(ctx, userID, searchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSavedSearch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, searchID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_DeleteSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSavedSearch'
+type DatasetRepository_DeleteSavedSearch_Call struct {
+	*mock.Call
+}
+
+// DeleteSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - searchID string
+func (_e *DatasetRepository_Expecter) DeleteSavedSearch(ctx interface{}, userID interface{}, searchID interface{}) *DatasetRepository_DeleteSavedSearch_Call {
+	return &DatasetRepository_DeleteSavedSearch_Call{Call: _e.mock.On("DeleteSavedSearch", ctx, userID, searchID)}
+}
+
+func (_c *DatasetRepository_DeleteSavedSearch_Call) Run(run func(ctx context.Context, userID string, searchID string)) *DatasetRepository_DeleteSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteSavedSearch_Call) Return(err error) *DatasetRepository_DeleteSavedSearch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteSavedSearch_Call) RunAndReturn(run func(ctx context.Context, userID string, searchID string) error) *DatasetRepository_DeleteSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserComputedInterestClusters provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteUserComputedInterestClusters(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserComputedInterestClusters")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_DeleteUserComputedInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserComputedInterestClusters'
+type DatasetRepository_DeleteUserComputedInterestClusters_Call struct {
+	*mock.Call
+}
+
+// DeleteUserComputedInterestClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) DeleteUserComputedInterestClusters(ctx interface{}, userID interface{}) *DatasetRepository_DeleteUserComputedInterestClusters_Call {
+	return &DatasetRepository_DeleteUserComputedInterestClusters_Call{Call: _e.mock.On("DeleteUserComputedInterestClusters", ctx, userID)}
+}
+
+func (_c *DatasetRepository_DeleteUserComputedInterestClusters_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserComputedInterestClusters_Call) Return(err error) *DatasetRepository_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserComputedInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserData provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteUserData(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserData")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_DeleteUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserData'
+type DatasetRepository_DeleteUserData_Call struct {
+	*mock.Call
+}
+
+// DeleteUserData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) DeleteUserData(ctx interface{}, userID interface{}) *DatasetRepository_DeleteUserData_Call {
+	return &DatasetRepository_DeleteUserData_Call{Call: _e.mock.On("DeleteUserData", ctx, userID)}
+}
+
+func (_c *DatasetRepository_DeleteUserData_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_DeleteUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserData_Call) Return(err error) *DatasetRepository_DeleteUserData_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserData_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_DeleteUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserInterestClusters provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteUserInterestClusters(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserInterestClusters")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_DeleteUserInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserInterestClusters'
+type DatasetRepository_DeleteUserInterestClusters_Call struct {
+	*mock.Call
+}
+
+// DeleteUserInterestClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) DeleteUserInterestClusters(ctx interface{}, userID interface{}) *DatasetRepository_DeleteUserInterestClusters_Call {
+	return &DatasetRepository_DeleteUserInterestClusters_Call{Call: _e.mock.On("DeleteUserInterestClusters", ctx, userID)}
+}
+
+func (_c *DatasetRepository_DeleteUserInterestClusters_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_DeleteUserInterestClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserInterestClusters_Call) Return(err error) *DatasetRepository_DeleteUserInterestClusters_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_DeleteUserInterestClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserPrecomputedRecommendations provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) DeleteUserPrecomputedRecommendations(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserPrecomputedRecommendations")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_DeleteUserPrecomputedRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserPrecomputedRecommendations'
+type DatasetRepository_DeleteUserPrecomputedRecommendations_Call struct {
+	*mock.Call
+}
+
+// DeleteUserPrecomputedRecommendations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) DeleteUserPrecomputedRecommendations(ctx interface{}, userID interface{}) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
+	return &DatasetRepository_DeleteUserPrecomputedRecommendations_Call{Call: _e.mock.On("DeleteUserPrecomputedRecommendations", ctx, userID)}
+}
+
+func (_c *DatasetRepository_DeleteUserPrecomputedRecommendations_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserPrecomputedRecommendations_Call) Return(err error) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_DeleteUserPrecomputedRecommendations_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_DeleteUserPrecomputedRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ExportUserData(ctx context.Context, userID string) (domain.UserDataExport, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 domain.UserDataExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.UserDataExport, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.UserDataExport); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.UserDataExport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type DatasetRepository_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ExportUserData(ctx interface{}, userID interface{}) *DatasetRepository_ExportUserData_Call {
+	return &DatasetRepository_ExportUserData_Call{Call: _e.mock.On("ExportUserData", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ExportUserData_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ExportUserData_Call) Return(userDataExport domain.UserDataExport, err error) *DatasetRepository_ExportUserData_Call {
+	_c.Call.Return(userDataExport, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ExportUserData_Call) RunAndReturn(run func(ctx context.Context, userID string) (domain.UserDataExport, error)) *DatasetRepository_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchArticlesByID provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) FetchArticlesByID(ctx context.Context, hashIDs []string) ([]domain.Article, error) {
+	ret := _mock.Called(ctx, hashIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchArticlesByID")
+	}
+
+	var r0 []domain.Article
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Article, error)); ok {
+		return returnFunc(ctx, hashIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.Article); ok {
+		r0 = returnFunc(ctx, hashIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Article)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, hashIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_FetchArticlesByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchArticlesByID'
+type DatasetRepository_FetchArticlesByID_Call struct {
+	*mock.Call
+}
+
+// FetchArticlesByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashIDs []string
+func (_e *DatasetRepository_Expecter) FetchArticlesByID(ctx interface{}, hashIDs interface{}) *DatasetRepository_FetchArticlesByID_Call {
+	return &DatasetRepository_FetchArticlesByID_Call{Call: _e.mock.On("FetchArticlesByID", ctx, hashIDs)}
+}
+
+func (_c *DatasetRepository_FetchArticlesByID_Call) Run(run func(ctx context.Context, hashIDs []string)) *DatasetRepository_FetchArticlesByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_FetchArticlesByID_Call) Return(articles []domain.Article, err error) *DatasetRepository_FetchArticlesByID_Call {
+	_c.Call.Return(articles, err)
+	return _c
+}
+
+func (_c *DatasetRepository_FetchArticlesByID_Call) RunAndReturn(run func(ctx context.Context, hashIDs []string) ([]domain.Article, error)) *DatasetRepository_FetchArticlesByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticlesByNormalizedTitles provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) FindArticlesByNormalizedTitles(ctx context.Context, titles []string) (map[string]string, error) {
+	ret := _mock.Called(ctx, titles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticlesByNormalizedTitles")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]string, error)); ok {
+		return returnFunc(ctx, titles)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]string); ok {
+		r0 = returnFunc(ctx, titles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, titles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_FindArticlesByNormalizedTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesByNormalizedTitles'
+type DatasetRepository_FindArticlesByNormalizedTitles_Call struct {
+	*mock.Call
+}
+
+// FindArticlesByNormalizedTitles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - titles []string
+func (_e *DatasetRepository_Expecter) FindArticlesByNormalizedTitles(ctx interface{}, titles interface{}) *DatasetRepository_FindArticlesByNormalizedTitles_Call {
+	return &DatasetRepository_FindArticlesByNormalizedTitles_Call{Call: _e.mock.On("FindArticlesByNormalizedTitles", ctx, titles)}
+}
+
+func (_c *DatasetRepository_FindArticlesByNormalizedTitles_Call) Run(run func(ctx context.Context, titles []string)) *DatasetRepository_FindArticlesByNormalizedTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_FindArticlesByNormalizedTitles_Call) Return(stringToString map[string]string, err error) *DatasetRepository_FindArticlesByNormalizedTitles_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *DatasetRepository_FindArticlesByNormalizedTitles_Call) RunAndReturn(run func(ctx context.Context, titles []string) (map[string]string, error)) *DatasetRepository_FindArticlesByNormalizedTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticlesByURLs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) FindArticlesByURLs(ctx context.Context, urls []string) (map[string]string, error) {
+	ret := _mock.Called(ctx, urls)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticlesByURLs")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]string, error)); ok {
+		return returnFunc(ctx, urls)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]string); ok {
+		r0 = returnFunc(ctx, urls)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, urls)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_FindArticlesByURLs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesByURLs'
+type DatasetRepository_FindArticlesByURLs_Call struct {
+	*mock.Call
+}
+
+// FindArticlesByURLs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urls []string
+func (_e *DatasetRepository_Expecter) FindArticlesByURLs(ctx interface{}, urls interface{}) *DatasetRepository_FindArticlesByURLs_Call {
+	return &DatasetRepository_FindArticlesByURLs_Call{Call: _e.mock.On("FindArticlesByURLs", ctx, urls)}
+}
+
+func (_c *DatasetRepository_FindArticlesByURLs_Call) Run(run func(ctx context.Context, urls []string)) *DatasetRepository_FindArticlesByURLs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_FindArticlesByURLs_Call) Return(stringToString map[string]string, err error) *DatasetRepository_FindArticlesByURLs_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *DatasetRepository_FindArticlesByURLs_Call) RunAndReturn(run func(ctx context.Context, urls []string) (map[string]string, error)) *DatasetRepository_FindArticlesByURLs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FollowItem provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) FollowItem(ctx context.Context, userID string, followType domain.FollowType, target string) error {
+	ret := _mock.Called(ctx, userID, followType, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowItem")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.FollowType, string) error); ok {
+		r0 = returnFunc(ctx, userID, followType, target)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_FollowItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowItem'
+type DatasetRepository_FollowItem_Call struct {
+	*mock.Call
+}
+
+// FollowItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - followType domain.FollowType
+//   - target string
+func (_e *DatasetRepository_Expecter) FollowItem(ctx interface{}, userID interface{}, followType interface{}, target interface{}) *DatasetRepository_FollowItem_Call {
+	return &DatasetRepository_FollowItem_Call{Call: _e.mock.On("FollowItem", ctx, userID, followType, target)}
+}
+
+func (_c *DatasetRepository_FollowItem_Call) Run(run func(ctx context.Context, userID string, followType domain.FollowType, target string)) *DatasetRepository_FollowItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.FollowType
+		if args[2] != nil {
+			arg2 = args[2].(domain.FollowType)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_FollowItem_Call) Return(err error) *DatasetRepository_FollowItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_FollowItem_Call) RunAndReturn(run func(ctx context.Context, userID string, followType domain.FollowType, target string) error) *DatasetRepository_FollowItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPITokenByHash provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetAPITokenByHash(ctx context.Context, tokenHash string) (domain.APIToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPITokenByHash")
+	}
+
+	var r0 domain.APIToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.APIToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.APIToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(domain.APIToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetAPITokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPITokenByHash'
+type DatasetRepository_GetAPITokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetAPITokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *DatasetRepository_Expecter) GetAPITokenByHash(ctx interface{}, tokenHash interface{}) *DatasetRepository_GetAPITokenByHash_Call {
+	return &DatasetRepository_GetAPITokenByHash_Call{Call: _e.mock.On("GetAPITokenByHash", ctx, tokenHash)}
+}
+
+func (_c *DatasetRepository_GetAPITokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *DatasetRepository_GetAPITokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetAPITokenByHash_Call) Return(aPIToken domain.APIToken, err error) *DatasetRepository_GetAPITokenByHash_Call {
+	_c.Call.Return(aPIToken, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetAPITokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (domain.APIToken, error)) *DatasetRepository_GetAPITokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleAuthors provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetArticleAuthors(ctx context.Context, hashIDs []string) (map[string][]domain.AuthorRef, error) {
+	ret := _mock.Called(ctx, hashIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleAuthors")
+	}
+
+	var r0 map[string][]domain.AuthorRef
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string][]domain.AuthorRef, error)); ok {
+		return returnFunc(ctx, hashIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string][]domain.AuthorRef); ok {
+		r0 = returnFunc(ctx, hashIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]domain.AuthorRef)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, hashIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetArticleAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleAuthors'
+type DatasetRepository_GetArticleAuthors_Call struct {
+	*mock.Call
+}
+
+// GetArticleAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashIDs []string
+func (_e *DatasetRepository_Expecter) GetArticleAuthors(ctx interface{}, hashIDs interface{}) *DatasetRepository_GetArticleAuthors_Call {
+	return &DatasetRepository_GetArticleAuthors_Call{Call: _e.mock.On("GetArticleAuthors", ctx, hashIDs)}
+}
+
+func (_c *DatasetRepository_GetArticleAuthors_Call) Run(run func(ctx context.Context, hashIDs []string)) *DatasetRepository_GetArticleAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticleAuthors_Call) Return(stringToAuthorRef map[string][]domain.AuthorRef, err error) *DatasetRepository_GetArticleAuthors_Call {
+	_c.Call.Return(stringToAuthorRef, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticleAuthors_Call) RunAndReturn(run func(ctx context.Context, hashIDs []string) (map[string][]domain.AuthorRef, error)) *DatasetRepository_GetArticleAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleNote provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetArticleNote(ctx context.Context, userID string, noteID string) (domain.ArticleNote, bool, error) {
+	ret := _mock.Called(ctx, userID, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleNote")
+	}
+
+	var r0 domain.ArticleNote
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.ArticleNote, bool, error)); ok {
+		return returnFunc(ctx, userID, noteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.ArticleNote); ok {
+		r0 = returnFunc(ctx, userID, noteID)
+	} else {
+		r0 = ret.Get(0).(domain.ArticleNote)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = returnFunc(ctx, userID, noteID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, userID, noteID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetArticleNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleNote'
+type DatasetRepository_GetArticleNote_Call struct {
+	*mock.Call
+}
+
+// GetArticleNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - noteID string
+func (_e *DatasetRepository_Expecter) GetArticleNote(ctx interface{}, userID interface{}, noteID interface{}) *DatasetRepository_GetArticleNote_Call {
+	return &DatasetRepository_GetArticleNote_Call{Call: _e.mock.On("GetArticleNote", ctx, userID, noteID)}
+}
+
+func (_c *DatasetRepository_GetArticleNote_Call) Run(run func(ctx context.Context, userID string, noteID string)) *DatasetRepository_GetArticleNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticleNote_Call) Return(articleNote domain.ArticleNote, b bool, err error) *DatasetRepository_GetArticleNote_Call {
+	_c.Call.Return(articleNote, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticleNote_Call) RunAndReturn(run func(ctx context.Context, userID string, noteID string) (domain.ArticleNote, bool, error)) *DatasetRepository_GetArticleNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticlePopularity provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetArticlePopularity(ctx context.Context, window domain.PopularityWindow, hashIDs []string) (map[string]domain.ArticlePopularity, error) {
+	ret := _mock.Called(ctx, window, hashIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticlePopularity")
+	}
+
+	var r0 map[string]domain.ArticlePopularity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PopularityWindow, []string) (map[string]domain.ArticlePopularity, error)); ok {
+		return returnFunc(ctx, window, hashIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PopularityWindow, []string) map[string]domain.ArticlePopularity); ok {
+		r0 = returnFunc(ctx, window, hashIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]domain.ArticlePopularity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PopularityWindow, []string) error); ok {
+		r1 = returnFunc(ctx, window, hashIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetArticlePopularity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticlePopularity'
+type DatasetRepository_GetArticlePopularity_Call struct {
+	*mock.Call
+}
+
+// GetArticlePopularity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window domain.PopularityWindow
+//   - hashIDs []string
+func (_e *DatasetRepository_Expecter) GetArticlePopularity(ctx interface{}, window interface{}, hashIDs interface{}) *DatasetRepository_GetArticlePopularity_Call {
+	return &DatasetRepository_GetArticlePopularity_Call{Call: _e.mock.On("GetArticlePopularity", ctx, window, hashIDs)}
+}
+
+func (_c *DatasetRepository_GetArticlePopularity_Call) Run(run func(ctx context.Context, window domain.PopularityWindow, hashIDs []string)) *DatasetRepository_GetArticlePopularity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PopularityWindow
+		if args[1] != nil {
+			arg1 = args[1].(domain.PopularityWindow)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticlePopularity_Call) Return(stringToArticlePopularity map[string]domain.ArticlePopularity, err error) *DatasetRepository_GetArticlePopularity_Call {
+	_c.Call.Return(stringToArticlePopularity, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticlePopularity_Call) RunAndReturn(run func(ctx context.Context, window domain.PopularityWindow, hashIDs []string) (map[string]domain.ArticlePopularity, error)) *DatasetRepository_GetArticlePopularity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticlePublishedDates provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetArticlePublishedDates(ctx context.Context, hashIDs []string) (map[string]time.Time, error) {
+	ret := _mock.Called(ctx, hashIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticlePublishedDates")
+	}
+
+	var r0 map[string]time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]time.Time, error)); ok {
+		return returnFunc(ctx, hashIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]time.Time); ok {
+		r0 = returnFunc(ctx, hashIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Time)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, hashIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetArticlePublishedDates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticlePublishedDates'
+type DatasetRepository_GetArticlePublishedDates_Call struct {
+	*mock.Call
+}
+
+// GetArticlePublishedDates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashIDs []string
+func (_e *DatasetRepository_Expecter) GetArticlePublishedDates(ctx interface{}, hashIDs interface{}) *DatasetRepository_GetArticlePublishedDates_Call {
+	return &DatasetRepository_GetArticlePublishedDates_Call{Call: _e.mock.On("GetArticlePublishedDates", ctx, hashIDs)}
+}
+
+func (_c *DatasetRepository_GetArticlePublishedDates_Call) Run(run func(ctx context.Context, hashIDs []string)) *DatasetRepository_GetArticlePublishedDates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticlePublishedDates_Call) Return(stringToTime map[string]time.Time, err error) *DatasetRepository_GetArticlePublishedDates_Call {
+	_c.Call.Return(stringToTime, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetArticlePublishedDates_Call) RunAndReturn(run func(ctx context.Context, hashIDs []string) (map[string]time.Time, error)) *DatasetRepository_GetArticlePublishedDates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthor provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetAuthor(ctx context.Context, authorID string) (domain.Author, bool, error) {
+	ret := _mock.Called(ctx, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthor")
+	}
+
+	var r0 domain.Author
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Author, bool, error)); ok {
+		return returnFunc(ctx, authorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Author); ok {
+		r0 = returnFunc(ctx, authorID)
+	} else {
+		r0 = ret.Get(0).(domain.Author)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, authorID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, authorID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthor'
+type DatasetRepository_GetAuthor_Call struct {
+	*mock.Call
+}
+
+// GetAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID string
+func (_e *DatasetRepository_Expecter) GetAuthor(ctx interface{}, authorID interface{}) *DatasetRepository_GetAuthor_Call {
+	return &DatasetRepository_GetAuthor_Call{Call: _e.mock.On("GetAuthor", ctx, authorID)}
+}
+
+func (_c *DatasetRepository_GetAuthor_Call) Run(run func(ctx context.Context, authorID string)) *DatasetRepository_GetAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetAuthor_Call) Return(author domain.Author, b bool, err error) *DatasetRepository_GetAuthor_Call {
+	_c.Call.Return(author, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetAuthor_Call) RunAndReturn(run func(ctx context.Context, authorID string) (domain.Author, bool, error)) *DatasetRepository_GetAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCanonicalArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetCanonicalArticleIDs(ctx context.Context, hashIDs []string) (map[string]string, error) {
+	ret := _mock.Called(ctx, hashIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCanonicalArticleIDs")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]string, error)); ok {
+		return returnFunc(ctx, hashIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]string); ok {
+		r0 = returnFunc(ctx, hashIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, hashIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetCanonicalArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCanonicalArticleIDs'
+type DatasetRepository_GetCanonicalArticleIDs_Call struct {
+	*mock.Call
+}
+
+// GetCanonicalArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashIDs []string
+func (_e *DatasetRepository_Expecter) GetCanonicalArticleIDs(ctx interface{}, hashIDs interface{}) *DatasetRepository_GetCanonicalArticleIDs_Call {
+	return &DatasetRepository_GetCanonicalArticleIDs_Call{Call: _e.mock.On("GetCanonicalArticleIDs", ctx, hashIDs)}
+}
+
+func (_c *DatasetRepository_GetCanonicalArticleIDs_Call) Run(run func(ctx context.Context, hashIDs []string)) *DatasetRepository_GetCanonicalArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetCanonicalArticleIDs_Call) Return(stringToString map[string]string, err error) *DatasetRepository_GetCanonicalArticleIDs_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetCanonicalArticleIDs_Call) RunAndReturn(run func(ctx context.Context, hashIDs []string) (map[string]string, error)) *DatasetRepository_GetCanonicalArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollection provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetCollection(ctx context.Context, userID string, collectionID string) (domain.Collection, bool, error) {
+	ret := _mock.Called(ctx, userID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollection")
+	}
+
+	var r0 domain.Collection
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Collection, bool, error)); ok {
+		return returnFunc(ctx, userID, collectionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Collection); ok {
+		r0 = returnFunc(ctx, userID, collectionID)
+	} else {
+		r0 = ret.Get(0).(domain.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = returnFunc(ctx, userID, collectionID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, userID, collectionID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollection'
+type DatasetRepository_GetCollection_Call struct {
+	*mock.Call
+}
+
+// GetCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - collectionID string
+func (_e *DatasetRepository_Expecter) GetCollection(ctx interface{}, userID interface{}, collectionID interface{}) *DatasetRepository_GetCollection_Call {
+	return &DatasetRepository_GetCollection_Call{Call: _e.mock.On("GetCollection", ctx, userID, collectionID)}
+}
+
+func (_c *DatasetRepository_GetCollection_Call) Run(run func(ctx context.Context, userID string, collectionID string)) *DatasetRepository_GetCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetCollection_Call) Return(collection domain.Collection, b bool, err error) *DatasetRepository_GetCollection_Call {
+	_c.Call.Return(collection, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetCollection_Call) RunAndReturn(run func(ctx context.Context, userID string, collectionID string) (domain.Collection, bool, error)) *DatasetRepository_GetCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionByShareToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetCollectionByShareToken(ctx context.Context, shareToken string) (domain.Collection, bool, error) {
+	ret := _mock.Called(ctx, shareToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionByShareToken")
+	}
+
+	var r0 domain.Collection
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Collection, bool, error)); ok {
+		return returnFunc(ctx, shareToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Collection); ok {
+		r0 = returnFunc(ctx, shareToken)
+	} else {
+		r0 = ret.Get(0).(domain.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, shareToken)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, shareToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetCollectionByShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionByShareToken'
+type DatasetRepository_GetCollectionByShareToken_Call struct {
+	*mock.Call
+}
+
+// GetCollectionByShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shareToken string
+func (_e *DatasetRepository_Expecter) GetCollectionByShareToken(ctx interface{}, shareToken interface{}) *DatasetRepository_GetCollectionByShareToken_Call {
+	return &DatasetRepository_GetCollectionByShareToken_Call{Call: _e.mock.On("GetCollectionByShareToken", ctx, shareToken)}
+}
+
+func (_c *DatasetRepository_GetCollectionByShareToken_Call) Run(run func(ctx context.Context, shareToken string)) *DatasetRepository_GetCollectionByShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetCollectionByShareToken_Call) Return(collection domain.Collection, b bool, err error) *DatasetRepository_GetCollectionByShareToken_Call {
+	_c.Call.Return(collection, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetCollectionByShareToken_Call) RunAndReturn(run func(ctx context.Context, shareToken string) (domain.Collection, bool, error)) *DatasetRepository_GetCollectionByShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDigestPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetDigestPreferences(ctx context.Context, userID string) (domain.DigestPreferences, bool, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDigestPreferences")
+	}
+
+	var r0 domain.DigestPreferences
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.DigestPreferences, bool, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.DigestPreferences); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.DigestPreferences)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetDigestPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDigestPreferences'
+type DatasetRepository_GetDigestPreferences_Call struct {
+	*mock.Call
+}
+
+// GetDigestPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetDigestPreferences(ctx interface{}, userID interface{}) *DatasetRepository_GetDigestPreferences_Call {
+	return &DatasetRepository_GetDigestPreferences_Call{Call: _e.mock.On("GetDigestPreferences", ctx, userID)}
+}
+
+func (_c *DatasetRepository_GetDigestPreferences_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetDigestPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetDigestPreferences_Call) Return(digestPreferences domain.DigestPreferences, b bool, err error) *DatasetRepository_GetDigestPreferences_Call {
+	_c.Call.Return(digestPreferences, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetDigestPreferences_Call) RunAndReturn(run func(ctx context.Context, userID string) (domain.DigestPreferences, bool, error)) *DatasetRepository_GetDigestPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetFollowPreferences(ctx context.Context, userID string) (domain.FollowPreferences, bool, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowPreferences")
+	}
+
+	var r0 domain.FollowPreferences
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.FollowPreferences, bool, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.FollowPreferences); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.FollowPreferences)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetFollowPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowPreferences'
+type DatasetRepository_GetFollowPreferences_Call struct {
+	*mock.Call
+}
+
+// GetFollowPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetFollowPreferences(ctx interface{}, userID interface{}) *DatasetRepository_GetFollowPreferences_Call {
+	return &DatasetRepository_GetFollowPreferences_Call{Call: _e.mock.On("GetFollowPreferences", ctx, userID)}
+}
+
+func (_c *DatasetRepository_GetFollowPreferences_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetFollowPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetFollowPreferences_Call) Return(followPreferences domain.FollowPreferences, b bool, err error) *DatasetRepository_GetFollowPreferences_Call {
+	_c.Call.Return(followPreferences, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetFollowPreferences_Call) RunAndReturn(run func(ctx context.Context, userID string) (domain.FollowPreferences, bool, error)) *DatasetRepository_GetFollowPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowPreferencesByFeedToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetFollowPreferencesByFeedToken(ctx context.Context, feedToken string) (domain.FollowPreferences, bool, error) {
+	ret := _mock.Called(ctx, feedToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowPreferencesByFeedToken")
+	}
+
+	var r0 domain.FollowPreferences
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.FollowPreferences, bool, error)); ok {
+		return returnFunc(ctx, feedToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.FollowPreferences); ok {
+		r0 = returnFunc(ctx, feedToken)
+	} else {
+		r0 = ret.Get(0).(domain.FollowPreferences)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, feedToken)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, feedToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetFollowPreferencesByFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowPreferencesByFeedToken'
+type DatasetRepository_GetFollowPreferencesByFeedToken_Call struct {
+	*mock.Call
+}
+
+// GetFollowPreferencesByFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feedToken string
+func (_e *DatasetRepository_Expecter) GetFollowPreferencesByFeedToken(ctx interface{}, feedToken interface{}) *DatasetRepository_GetFollowPreferencesByFeedToken_Call {
+	return &DatasetRepository_GetFollowPreferencesByFeedToken_Call{Call: _e.mock.On("GetFollowPreferencesByFeedToken", ctx, feedToken)}
+}
+
+func (_c *DatasetRepository_GetFollowPreferencesByFeedToken_Call) Run(run func(ctx context.Context, feedToken string)) *DatasetRepository_GetFollowPreferencesByFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetFollowPreferencesByFeedToken_Call) Return(followPreferences domain.FollowPreferences, b bool, err error) *DatasetRepository_GetFollowPreferencesByFeedToken_Call {
+	_c.Call.Return(followPreferences, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetFollowPreferencesByFeedToken_Call) RunAndReturn(run func(ctx context.Context, feedToken string) (domain.FollowPreferences, bool, error)) *DatasetRepository_GetFollowPreferencesByFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOnboardingInterests provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetOnboardingInterests(ctx context.Context, userID string) (domain.OnboardingInterests, bool, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOnboardingInterests")
+	}
+
+	var r0 domain.OnboardingInterests
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.OnboardingInterests, bool, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.OnboardingInterests); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.OnboardingInterests)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetOnboardingInterests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOnboardingInterests'
+type DatasetRepository_GetOnboardingInterests_Call struct {
+	*mock.Call
+}
+
+// GetOnboardingInterests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetOnboardingInterests(ctx interface{}, userID interface{}) *DatasetRepository_GetOnboardingInterests_Call {
+	return &DatasetRepository_GetOnboardingInterests_Call{Call: _e.mock.On("GetOnboardingInterests", ctx, userID)}
+}
+
+func (_c *DatasetRepository_GetOnboardingInterests_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetOnboardingInterests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetOnboardingInterests_Call) Return(onboardingInterests domain.OnboardingInterests, b bool, err error) *DatasetRepository_GetOnboardingInterests_Call {
+	_c.Call.Return(onboardingInterests, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetOnboardingInterests_Call) RunAndReturn(run func(ctx context.Context, userID string) (domain.OnboardingInterests, bool, error)) *DatasetRepository_GetOnboardingInterests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrecomputedRecommendationAge provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetPrecomputedRecommendationAge(ctx context.Context, userID string) (time.Time, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrecomputedRecommendationAge")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (time.Time, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) time.Time); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetPrecomputedRecommendationAge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrecomputedRecommendationAge'
+type DatasetRepository_GetPrecomputedRecommendationAge_Call struct {
+	*mock.Call
+}
+
+// GetPrecomputedRecommendationAge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetPrecomputedRecommendationAge(ctx interface{}, userID interface{}) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
+	return &DatasetRepository_GetPrecomputedRecommendationAge_Call{Call: _e.mock.On("GetPrecomputedRecommendationAge", ctx, userID)}
+}
+
+func (_c *DatasetRepository_GetPrecomputedRecommendationAge_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetPrecomputedRecommendationAge_Call) Return(time1 time.Time, err error) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
+	_c.Call.Return(time1, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetPrecomputedRecommendationAge_Call) RunAndReturn(run func(ctx context.Context, userID string) (time.Time, error)) *DatasetRepository_GetPrecomputedRecommendationAge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrecomputedRecommendations provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetPrecomputedRecommendations(ctx context.Context, userID string, limit int) ([]datasources.PrecomputedRecommendation, error) {
+	ret := _mock.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrecomputedRecommendations")
+	}
+
+	var r0 []datasources.PrecomputedRecommendation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]datasources.PrecomputedRecommendation, error)); ok {
+		return returnFunc(ctx, userID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []datasources.PrecomputedRecommendation); ok {
+		r0 = returnFunc(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasources.PrecomputedRecommendation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetPrecomputedRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrecomputedRecommendations'
+type DatasetRepository_GetPrecomputedRecommendations_Call struct {
+	*mock.Call
+}
+
+// GetPrecomputedRecommendations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - limit int
+func (_e *DatasetRepository_Expecter) GetPrecomputedRecommendations(ctx interface{}, userID interface{}, limit interface{}) *DatasetRepository_GetPrecomputedRecommendations_Call {
+	return &DatasetRepository_GetPrecomputedRecommendations_Call{Call: _e.mock.On("GetPrecomputedRecommendations", ctx, userID, limit)}
+}
+
+func (_c *DatasetRepository_GetPrecomputedRecommendations_Call) Run(run func(ctx context.Context, userID string, limit int)) *DatasetRepository_GetPrecomputedRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetPrecomputedRecommendations_Call) Return(precomputedRecommendations []datasources.PrecomputedRecommendation, err error) *DatasetRepository_GetPrecomputedRecommendations_Call {
+	_c.Call.Return(precomputedRecommendations, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetPrecomputedRecommendations_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int) ([]datasources.PrecomputedRecommendation, error)) *DatasetRepository_GetPrecomputedRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSavedSearch provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetSavedSearch(ctx context.Context, userID string, searchID string) (domain.SavedSearch, bool, error) {
+	ret := _mock.Called(ctx, userID, searchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSavedSearch")
+	}
+
+	var r0 domain.SavedSearch
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.SavedSearch, bool, error)); ok {
+		return returnFunc(ctx, userID, searchID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.SavedSearch); ok {
+		r0 = returnFunc(ctx, userID, searchID)
+	} else {
+		r0 = ret.Get(0).(domain.SavedSearch)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = returnFunc(ctx, userID, searchID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, userID, searchID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSavedSearch'
+type DatasetRepository_GetSavedSearch_Call struct {
+	*mock.Call
+}
+
+// GetSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - searchID string
+func (_e *DatasetRepository_Expecter) GetSavedSearch(ctx interface{}, userID interface{}, searchID interface{}) *DatasetRepository_GetSavedSearch_Call {
+	return &DatasetRepository_GetSavedSearch_Call{Call: _e.mock.On("GetSavedSearch", ctx, userID, searchID)}
+}
+
+func (_c *DatasetRepository_GetSavedSearch_Call) Run(run func(ctx context.Context, userID string, searchID string)) *DatasetRepository_GetSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetSavedSearch_Call) Return(savedSearch domain.SavedSearch, b bool, err error) *DatasetRepository_GetSavedSearch_Call {
+	_c.Call.Return(savedSearch, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetSavedSearch_Call) RunAndReturn(run func(ctx context.Context, userID string, searchID string) (domain.SavedSearch, bool, error)) *DatasetRepository_GetSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSavedSearchByFeedToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetSavedSearchByFeedToken(ctx context.Context, feedToken string) (domain.SavedSearch, bool, error) {
+	ret := _mock.Called(ctx, feedToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSavedSearchByFeedToken")
+	}
+
+	var r0 domain.SavedSearch
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.SavedSearch, bool, error)); ok {
+		return returnFunc(ctx, feedToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.SavedSearch); ok {
+		r0 = returnFunc(ctx, feedToken)
+	} else {
+		r0 = ret.Get(0).(domain.SavedSearch)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, feedToken)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, feedToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetSavedSearchByFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSavedSearchByFeedToken'
+type DatasetRepository_GetSavedSearchByFeedToken_Call struct {
+	*mock.Call
+}
+
+// GetSavedSearchByFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feedToken string
+func (_e *DatasetRepository_Expecter) GetSavedSearchByFeedToken(ctx interface{}, feedToken interface{}) *DatasetRepository_GetSavedSearchByFeedToken_Call {
+	return &DatasetRepository_GetSavedSearchByFeedToken_Call{Call: _e.mock.On("GetSavedSearchByFeedToken", ctx, feedToken)}
+}
+
+func (_c *DatasetRepository_GetSavedSearchByFeedToken_Call) Run(run func(ctx context.Context, feedToken string)) *DatasetRepository_GetSavedSearchByFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetSavedSearchByFeedToken_Call) Return(savedSearch domain.SavedSearch, b bool, err error) *DatasetRepository_GetSavedSearchByFeedToken_Call {
+	_c.Call.Return(savedSearch, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetSavedSearchByFeedToken_Call) RunAndReturn(run func(ctx context.Context, feedToken string) (domain.SavedSearch, bool, error)) *DatasetRepository_GetSavedSearchByFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetTagPreferences(ctx context.Context, userID string) (domain.TagPreferences, bool, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagPreferences")
+	}
+
+	var r0 domain.TagPreferences
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.TagPreferences, bool, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.TagPreferences); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.TagPreferences)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetTagPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagPreferences'
+type DatasetRepository_GetTagPreferences_Call struct {
+	*mock.Call
+}
+
+// GetTagPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetTagPreferences(ctx interface{}, userID interface{}) *DatasetRepository_GetTagPreferences_Call {
+	return &DatasetRepository_GetTagPreferences_Call{Call: _e.mock.On("GetTagPreferences", ctx, userID)}
+}
+
+func (_c *DatasetRepository_GetTagPreferences_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetTagPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetTagPreferences_Call) Return(tagPreferences domain.TagPreferences, b bool, err error) *DatasetRepository_GetTagPreferences_Call {
+	_c.Call.Return(tagPreferences, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetTagPreferences_Call) RunAndReturn(run func(ctx context.Context, userID string) (domain.TagPreferences, bool, error)) *DatasetRepository_GetTagPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserAPIToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetUserAPIToken(ctx context.Context, tokenID string, userID string) (domain.APIToken, bool, error) {
+	ret := _mock.Called(ctx, tokenID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAPIToken")
+	}
+
+	var r0 domain.APIToken
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.APIToken, bool, error)); ok {
+		return returnFunc(ctx, tokenID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.APIToken); ok {
+		r0 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.APIToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// DatasetRepository_GetUserAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAPIToken'
+type DatasetRepository_GetUserAPIToken_Call struct {
+	*mock.Call
+}
+
+// GetUserAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetUserAPIToken(ctx interface{}, tokenID interface{}, userID interface{}) *DatasetRepository_GetUserAPIToken_Call {
+	return &DatasetRepository_GetUserAPIToken_Call{Call: _e.mock.On("GetUserAPIToken", ctx, tokenID, userID)}
+}
+
+func (_c *DatasetRepository_GetUserAPIToken_Call) Run(run func(ctx context.Context, tokenID string, userID string)) *DatasetRepository_GetUserAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserAPIToken_Call) Return(aPIToken domain.APIToken, b bool, err error) *DatasetRepository_GetUserAPIToken_Call {
+	_c.Call.Return(aPIToken, b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) (domain.APIToken, bool, error)) *DatasetRepository_GetUserAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserArticleVectorsByType provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetUserArticleVectorsByType(ctx context.Context, userID string, ratingType domain.UserRatingType) ([]domain.UserArticleRating, error) {
+	ret := _mock.Called(ctx, userID, ratingType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserArticleVectorsByType")
+	}
+
+	var r0 []domain.UserArticleRating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) ([]domain.UserArticleRating, error)); ok {
+		return returnFunc(ctx, userID, ratingType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UserRatingType) []domain.UserArticleRating); ok {
+		r0 = returnFunc(ctx, userID, ratingType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserArticleRating)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UserRatingType) error); ok {
+		r1 = returnFunc(ctx, userID, ratingType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetUserArticleVectorsByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserArticleVectorsByType'
+type DatasetRepository_GetUserArticleVectorsByType_Call struct {
+	*mock.Call
+}
+
+// GetUserArticleVectorsByType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - ratingType domain.UserRatingType
+func (_e *DatasetRepository_Expecter) GetUserArticleVectorsByType(ctx interface{}, userID interface{}, ratingType interface{}) *DatasetRepository_GetUserArticleVectorsByType_Call {
+	return &DatasetRepository_GetUserArticleVectorsByType_Call{Call: _e.mock.On("GetUserArticleVectorsByType", ctx, userID, ratingType)}
+}
+
+func (_c *DatasetRepository_GetUserArticleVectorsByType_Call) Run(run func(ctx context.Context, userID string, ratingType domain.UserRatingType)) *DatasetRepository_GetUserArticleVectorsByType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.UserRatingType
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserRatingType)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserArticleVectorsByType_Call) Return(userArticleRatings []domain.UserArticleRating, err error) *DatasetRepository_GetUserArticleVectorsByType_Call {
+	_c.Call.Return(userArticleRatings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserArticleVectorsByType_Call) RunAndReturn(run func(ctx context.Context, userID string, ratingType domain.UserRatingType) ([]domain.UserArticleRating, error)) *DatasetRepository_GetUserArticleVectorsByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserInterestClusters provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetUserInterestClusters(ctx context.Context, userID string) ([]datasources.UserInterestCluster, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserInterestClusters")
+	}
+
+	var r0 []datasources.UserInterestCluster
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]datasources.UserInterestCluster, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []datasources.UserInterestCluster); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasources.UserInterestCluster)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetUserInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInterestClusters'
+type DatasetRepository_GetUserInterestClusters_Call struct {
+	*mock.Call
+}
+
+// GetUserInterestClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetUserInterestClusters(ctx interface{}, userID interface{}) *DatasetRepository_GetUserInterestClusters_Call {
+	return &DatasetRepository_GetUserInterestClusters_Call{Call: _e.mock.On("GetUserInterestClusters", ctx, userID)}
+}
+
+func (_c *DatasetRepository_GetUserInterestClusters_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetUserInterestClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserInterestClusters_Call) Return(userInterestClusters []datasources.UserInterestCluster, err error) *DatasetRepository_GetUserInterestClusters_Call {
+	_c.Call.Return(userInterestClusters, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]datasources.UserInterestCluster, error)) *DatasetRepository_GetUserInterestClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRecommendationState provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) GetUserRecommendationState(ctx context.Context, userID string) (datasources.UserRecommendationState, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRecommendationState")
+	}
+
+	var r0 datasources.UserRecommendationState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (datasources.UserRecommendationState, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) datasources.UserRecommendationState); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(datasources.UserRecommendationState)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_GetUserRecommendationState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRecommendationState'
+type DatasetRepository_GetUserRecommendationState_Call struct {
+	*mock.Call
+}
+
+// GetUserRecommendationState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) GetUserRecommendationState(ctx interface{}, userID interface{}) *DatasetRepository_GetUserRecommendationState_Call {
+	return &DatasetRepository_GetUserRecommendationState_Call{Call: _e.mock.On("GetUserRecommendationState", ctx, userID)}
+}
+
+func (_c *DatasetRepository_GetUserRecommendationState_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_GetUserRecommendationState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserRecommendationState_Call) Return(userRecommendationState datasources.UserRecommendationState, err error) *DatasetRepository_GetUserRecommendationState_Call {
+	_c.Call.Return(userRecommendationState, err)
+	return _c
+}
+
+func (_c *DatasetRepository_GetUserRecommendationState_Call) RunAndReturn(run func(ctx context.Context, userID string) (datasources.UserRecommendationState, error)) *DatasetRepository_GetUserRecommendationState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticleAuthorLists provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListArticleAuthorLists(ctx context.Context) ([]domain.ArticleAuthorList, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticleAuthorLists")
+	}
+
+	var r0 []domain.ArticleAuthorList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ArticleAuthorList, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ArticleAuthorList); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleAuthorList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListArticleAuthorLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticleAuthorLists'
+type DatasetRepository_ListArticleAuthorLists_Call struct {
+	*mock.Call
+}
+
+// ListArticleAuthorLists is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetRepository_Expecter) ListArticleAuthorLists(ctx interface{}) *DatasetRepository_ListArticleAuthorLists_Call {
+	return &DatasetRepository_ListArticleAuthorLists_Call{Call: _e.mock.On("ListArticleAuthorLists", ctx)}
+}
+
+func (_c *DatasetRepository_ListArticleAuthorLists_Call) Run(run func(ctx context.Context)) *DatasetRepository_ListArticleAuthorLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleAuthorLists_Call) Return(articleAuthorLists []domain.ArticleAuthorList, err error) *DatasetRepository_ListArticleAuthorLists_Call {
+	_c.Call.Return(articleAuthorLists, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleAuthorLists_Call) RunAndReturn(run func(ctx context.Context) ([]domain.ArticleAuthorList, error)) *DatasetRepository_ListArticleAuthorLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticleCategories provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListArticleCategories(ctx context.Context) ([]domain.ArticleCategory, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticleCategories")
+	}
+
+	var r0 []domain.ArticleCategory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ArticleCategory, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ArticleCategory); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleCategory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListArticleCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticleCategories'
+type DatasetRepository_ListArticleCategories_Call struct {
+	*mock.Call
+}
+
+// ListArticleCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetRepository_Expecter) ListArticleCategories(ctx interface{}) *DatasetRepository_ListArticleCategories_Call {
+	return &DatasetRepository_ListArticleCategories_Call{Call: _e.mock.On("ListArticleCategories", ctx)}
+}
+
+func (_c *DatasetRepository_ListArticleCategories_Call) Run(run func(ctx context.Context)) *DatasetRepository_ListArticleCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleCategories_Call) Return(articleCategorys []domain.ArticleCategory, err error) *DatasetRepository_ListArticleCategories_Call {
+	_c.Call.Return(articleCategorys, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleCategories_Call) RunAndReturn(run func(ctx context.Context) ([]domain.ArticleCategory, error)) *DatasetRepository_ListArticleCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticleCoLikes provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListArticleCoLikes(ctx context.Context, minCoLikes int64) ([]domain.ArticleCoLike, error) {
+	ret := _mock.Called(ctx, minCoLikes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticleCoLikes")
+	}
+
+	var r0 []domain.ArticleCoLike
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]domain.ArticleCoLike, error)); ok {
+		return returnFunc(ctx, minCoLikes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []domain.ArticleCoLike); ok {
+		r0 = returnFunc(ctx, minCoLikes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleCoLike)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, minCoLikes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListArticleCoLikes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticleCoLikes'
+type DatasetRepository_ListArticleCoLikes_Call struct {
+	*mock.Call
+}
+
+// ListArticleCoLikes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - minCoLikes int64
+func (_e *DatasetRepository_Expecter) ListArticleCoLikes(ctx interface{}, minCoLikes interface{}) *DatasetRepository_ListArticleCoLikes_Call {
+	return &DatasetRepository_ListArticleCoLikes_Call{Call: _e.mock.On("ListArticleCoLikes", ctx, minCoLikes)}
+}
+
+func (_c *DatasetRepository_ListArticleCoLikes_Call) Run(run func(ctx context.Context, minCoLikes int64)) *DatasetRepository_ListArticleCoLikes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleCoLikes_Call) Return(articleCoLikes []domain.ArticleCoLike, err error) *DatasetRepository_ListArticleCoLikes_Call {
+	_c.Call.Return(articleCoLikes, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleCoLikes_Call) RunAndReturn(run func(ctx context.Context, minCoLikes int64) ([]domain.ArticleCoLike, error)) *DatasetRepository_ListArticleCoLikes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticleDuplicates provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListArticleDuplicates(ctx context.Context) ([]domain.ArticleDuplicate, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticleDuplicates")
+	}
+
+	var r0 []domain.ArticleDuplicate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ArticleDuplicate, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ArticleDuplicate); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleDuplicate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListArticleDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticleDuplicates'
+type DatasetRepository_ListArticleDuplicates_Call struct {
+	*mock.Call
+}
+
+// ListArticleDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetRepository_Expecter) ListArticleDuplicates(ctx interface{}) *DatasetRepository_ListArticleDuplicates_Call {
+	return &DatasetRepository_ListArticleDuplicates_Call{Call: _e.mock.On("ListArticleDuplicates", ctx)}
+}
+
+func (_c *DatasetRepository_ListArticleDuplicates_Call) Run(run func(ctx context.Context)) *DatasetRepository_ListArticleDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleDuplicates_Call) Return(articleDuplicates []domain.ArticleDuplicate, err error) *DatasetRepository_ListArticleDuplicates_Call {
+	_c.Call.Return(articleDuplicates, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleDuplicates_Call) RunAndReturn(run func(ctx context.Context) ([]domain.ArticleDuplicate, error)) *DatasetRepository_ListArticleDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticleNotes provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListArticleNotes(ctx context.Context, userID string, articleHashID string) ([]domain.ArticleNote, error) {
+	ret := _mock.Called(ctx, userID, articleHashID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticleNotes")
+	}
+
+	var r0 []domain.ArticleNote
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.ArticleNote, error)); ok {
+		return returnFunc(ctx, userID, articleHashID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []domain.ArticleNote); ok {
+		r0 = returnFunc(ctx, userID, articleHashID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleNote)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, articleHashID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListArticleNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticleNotes'
+type DatasetRepository_ListArticleNotes_Call struct {
+	*mock.Call
+}
+
+// ListArticleNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+func (_e *DatasetRepository_Expecter) ListArticleNotes(ctx interface{}, userID interface{}, articleHashID interface{}) *DatasetRepository_ListArticleNotes_Call {
+	return &DatasetRepository_ListArticleNotes_Call{Call: _e.mock.On("ListArticleNotes", ctx, userID, articleHashID)}
+}
+
+func (_c *DatasetRepository_ListArticleNotes_Call) Run(run func(ctx context.Context, userID string, articleHashID string)) *DatasetRepository_ListArticleNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleNotes_Call) Return(articleNotes []domain.ArticleNote, err error) *DatasetRepository_ListArticleNotes_Call {
+	_c.Call.Return(articleNotes, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleNotes_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string) ([]domain.ArticleNote, error)) *DatasetRepository_ListArticleNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticleTags provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListArticleTags(ctx context.Context, userID string, articleHashID string) ([]string, error) {
+	ret := _mock.Called(ctx, userID, articleHashID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticleTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, userID, articleHashID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, userID, articleHashID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, articleHashID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListArticleTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticleTags'
+type DatasetRepository_ListArticleTags_Call struct {
+	*mock.Call
+}
+
+// ListArticleTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+func (_e *DatasetRepository_Expecter) ListArticleTags(ctx interface{}, userID interface{}, articleHashID interface{}) *DatasetRepository_ListArticleTags_Call {
+	return &DatasetRepository_ListArticleTags_Call{Call: _e.mock.On("ListArticleTags", ctx, userID, articleHashID)}
+}
+
+func (_c *DatasetRepository_ListArticleTags_Call) Run(run func(ctx context.Context, userID string, articleHashID string)) *DatasetRepository_ListArticleTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleTags_Call) Return(strings []string, err error) *DatasetRepository_ListArticleTags_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListArticleTags_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string) ([]string, error)) *DatasetRepository_ListArticleTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuthorArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListAuthorArticleIDs(ctx context.Context, authorID string, page int, pageSize int) ([]string, error) {
+	ret := _mock.Called(ctx, authorID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthorArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]string, error)); ok {
+		return returnFunc(ctx, authorID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []string); ok {
+		r0 = returnFunc(ctx, authorID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = returnFunc(ctx, authorID, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListAuthorArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthorArticleIDs'
+type DatasetRepository_ListAuthorArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListAuthorArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListAuthorArticleIDs(ctx interface{}, authorID interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListAuthorArticleIDs_Call {
+	return &DatasetRepository_ListAuthorArticleIDs_Call{Call: _e.mock.On("ListAuthorArticleIDs", ctx, authorID, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListAuthorArticleIDs_Call) Run(run func(ctx context.Context, authorID string, page int, pageSize int)) *DatasetRepository_ListAuthorArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListAuthorArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListAuthorArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListAuthorArticleIDs_Call) RunAndReturn(run func(ctx context.Context, authorID string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListAuthorArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuthorNames provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListAuthorNames(ctx context.Context) (map[string]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthorNames")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListAuthorNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthorNames'
+type DatasetRepository_ListAuthorNames_Call struct {
+	*mock.Call
+}
+
+// ListAuthorNames is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetRepository_Expecter) ListAuthorNames(ctx interface{}) *DatasetRepository_ListAuthorNames_Call {
+	return &DatasetRepository_ListAuthorNames_Call{Call: _e.mock.On("ListAuthorNames", ctx)}
+}
+
+func (_c *DatasetRepository_ListAuthorNames_Call) Run(run func(ctx context.Context)) *DatasetRepository_ListAuthorNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListAuthorNames_Call) Return(stringToString map[string]string, err error) *DatasetRepository_ListAuthorNames_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListAuthorNames_Call) RunAndReturn(run func(ctx context.Context) (map[string]string, error)) *DatasetRepository_ListAuthorNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategoryExploration provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListCategoryExploration(ctx context.Context, userID string, since time.Time) ([]domain.CategoryExploration, error) {
+	ret := _mock.Called(ctx, userID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategoryExploration")
+	}
+
+	var r0 []domain.CategoryExploration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]domain.CategoryExploration, error)); ok {
+		return returnFunc(ctx, userID, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []domain.CategoryExploration); ok {
+		r0 = returnFunc(ctx, userID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CategoryExploration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListCategoryExploration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategoryExploration'
+type DatasetRepository_ListCategoryExploration_Call struct {
+	*mock.Call
+}
+
+// ListCategoryExploration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - since time.Time
+func (_e *DatasetRepository_Expecter) ListCategoryExploration(ctx interface{}, userID interface{}, since interface{}) *DatasetRepository_ListCategoryExploration_Call {
+	return &DatasetRepository_ListCategoryExploration_Call{Call: _e.mock.On("ListCategoryExploration", ctx, userID, since)}
+}
+
+func (_c *DatasetRepository_ListCategoryExploration_Call) Run(run func(ctx context.Context, userID string, since time.Time)) *DatasetRepository_ListCategoryExploration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListCategoryExploration_Call) Return(categoryExplorations []domain.CategoryExploration, err error) *DatasetRepository_ListCategoryExploration_Call {
+	_c.Call.Return(categoryExplorations, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListCategoryExploration_Call) RunAndReturn(run func(ctx context.Context, userID string, since time.Time) ([]domain.CategoryExploration, error)) *DatasetRepository_ListCategoryExploration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCoAuthors provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListCoAuthors(ctx context.Context, authorID string, limit int) ([]domain.CoAuthor, error) {
+	ret := _mock.Called(ctx, authorID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCoAuthors")
+	}
+
+	var r0 []domain.CoAuthor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.CoAuthor, error)); ok {
+		return returnFunc(ctx, authorID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []domain.CoAuthor); ok {
+		r0 = returnFunc(ctx, authorID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CoAuthor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, authorID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListCoAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCoAuthors'
+type DatasetRepository_ListCoAuthors_Call struct {
+	*mock.Call
+}
+
+// ListCoAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID string
+//   - limit int
+func (_e *DatasetRepository_Expecter) ListCoAuthors(ctx interface{}, authorID interface{}, limit interface{}) *DatasetRepository_ListCoAuthors_Call {
+	return &DatasetRepository_ListCoAuthors_Call{Call: _e.mock.On("ListCoAuthors", ctx, authorID, limit)}
+}
+
+func (_c *DatasetRepository_ListCoAuthors_Call) Run(run func(ctx context.Context, authorID string, limit int)) *DatasetRepository_ListCoAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListCoAuthors_Call) Return(coAuthors []domain.CoAuthor, err error) *DatasetRepository_ListCoAuthors_Call {
+	_c.Call.Return(coAuthors, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListCoAuthors_Call) RunAndReturn(run func(ctx context.Context, authorID string, limit int) ([]domain.CoAuthor, error)) *DatasetRepository_ListCoAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCollaborativeCandidates provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListCollaborativeCandidates(ctx context.Context, userID string, limit int) ([]domain.SimilarArticle, error) {
+	ret := _mock.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollaborativeCandidates")
+	}
+
+	var r0 []domain.SimilarArticle
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.SimilarArticle, error)); ok {
+		return returnFunc(ctx, userID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []domain.SimilarArticle); ok {
+		r0 = returnFunc(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SimilarArticle)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListCollaborativeCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollaborativeCandidates'
+type DatasetRepository_ListCollaborativeCandidates_Call struct {
+	*mock.Call
+}
+
+// ListCollaborativeCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - limit int
+func (_e *DatasetRepository_Expecter) ListCollaborativeCandidates(ctx interface{}, userID interface{}, limit interface{}) *DatasetRepository_ListCollaborativeCandidates_Call {
+	return &DatasetRepository_ListCollaborativeCandidates_Call{Call: _e.mock.On("ListCollaborativeCandidates", ctx, userID, limit)}
+}
+
+func (_c *DatasetRepository_ListCollaborativeCandidates_Call) Run(run func(ctx context.Context, userID string, limit int)) *DatasetRepository_ListCollaborativeCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListCollaborativeCandidates_Call) Return(similarArticles []domain.SimilarArticle, err error) *DatasetRepository_ListCollaborativeCandidates_Call {
+	_c.Call.Return(similarArticles, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListCollaborativeCandidates_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int) ([]domain.SimilarArticle, error)) *DatasetRepository_ListCollaborativeCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCollectionArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListCollectionArticleIDs(ctx context.Context, collectionID string) ([]string, error) {
+	ret := _mock.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollectionArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, collectionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListCollectionArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionArticleIDs'
+type DatasetRepository_ListCollectionArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListCollectionArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID string
+func (_e *DatasetRepository_Expecter) ListCollectionArticleIDs(ctx interface{}, collectionID interface{}) *DatasetRepository_ListCollectionArticleIDs_Call {
+	return &DatasetRepository_ListCollectionArticleIDs_Call{Call: _e.mock.On("ListCollectionArticleIDs", ctx, collectionID)}
+}
+
+func (_c *DatasetRepository_ListCollectionArticleIDs_Call) Run(run func(ctx context.Context, collectionID string)) *DatasetRepository_ListCollectionArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListCollectionArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListCollectionArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListCollectionArticleIDs_Call) RunAndReturn(run func(ctx context.Context, collectionID string) ([]string, error)) *DatasetRepository_ListCollectionArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDislikedArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListDislikedArticleIDs(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error) {
+	ret := _mock.Called(ctx, userID, tags, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDislikedArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) ([]string, error)); ok {
+		return returnFunc(ctx, userID, tags, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) []string); ok {
+		r0 = returnFunc(ctx, userID, tags, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, tags, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListDislikedArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDislikedArticleIDs'
+type DatasetRepository_ListDislikedArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListDislikedArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - tags []string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListDislikedArticleIDs(ctx interface{}, userID interface{}, tags interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListDislikedArticleIDs_Call {
+	return &DatasetRepository_ListDislikedArticleIDs_Call{Call: _e.mock.On("ListDislikedArticleIDs", ctx, userID, tags, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListDislikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, tags []string, page int, pageSize int)) *DatasetRepository_ListDislikedArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListDislikedArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListDislikedArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListDislikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListDislikedArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnabledDigestPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListEnabledDigestPreferences(ctx context.Context) ([]domain.DigestPreferences, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnabledDigestPreferences")
+	}
+
+	var r0 []domain.DigestPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.DigestPreferences, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.DigestPreferences); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DigestPreferences)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListEnabledDigestPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnabledDigestPreferences'
+type DatasetRepository_ListEnabledDigestPreferences_Call struct {
+	*mock.Call
+}
+
+// ListEnabledDigestPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetRepository_Expecter) ListEnabledDigestPreferences(ctx interface{}) *DatasetRepository_ListEnabledDigestPreferences_Call {
+	return &DatasetRepository_ListEnabledDigestPreferences_Call{Call: _e.mock.On("ListEnabledDigestPreferences", ctx)}
+}
+
+func (_c *DatasetRepository_ListEnabledDigestPreferences_Call) Run(run func(ctx context.Context)) *DatasetRepository_ListEnabledDigestPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListEnabledDigestPreferences_Call) Return(digestPreferencess []domain.DigestPreferences, err error) *DatasetRepository_ListEnabledDigestPreferences_Call {
+	_c.Call.Return(digestPreferencess, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListEnabledDigestPreferences_Call) RunAndReturn(run func(ctx context.Context) ([]domain.DigestPreferences, error)) *DatasetRepository_ListEnabledDigestPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollows provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListFollows(ctx context.Context, userID string) ([]domain.Follow, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollows")
+	}
+
+	var r0 []domain.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Follow, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Follow); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollows'
+type DatasetRepository_ListFollows_Call struct {
+	*mock.Call
+}
+
+// ListFollows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListFollows(ctx interface{}, userID interface{}) *DatasetRepository_ListFollows_Call {
+	return &DatasetRepository_ListFollows_Call{Call: _e.mock.On("ListFollows", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListFollows_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListFollows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListFollows_Call) Return(follows []domain.Follow, err error) *DatasetRepository_ListFollows_Call {
+	_c.Call.Return(follows, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListFollows_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.Follow, error)) *DatasetRepository_ListFollows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHiddenArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListHiddenArticleIDs(ctx context.Context, userID string) ([]string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHiddenArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListHiddenArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHiddenArticleIDs'
+type DatasetRepository_ListHiddenArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListHiddenArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListHiddenArticleIDs(ctx interface{}, userID interface{}) *DatasetRepository_ListHiddenArticleIDs_Call {
+	return &DatasetRepository_ListHiddenArticleIDs_Call{Call: _e.mock.On("ListHiddenArticleIDs", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListHiddenArticleIDs_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListHiddenArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListHiddenArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListHiddenArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListHiddenArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *DatasetRepository_ListHiddenArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLatestArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListLatestArticleIDs(ctx context.Context, filters domain.ArticleFilters, options domain.ArticleListOptions) ([]string, error) {
+	ret := _mock.Called(ctx, filters, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLatestArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ArticleFilters, domain.ArticleListOptions) ([]string, error)); ok {
+		return returnFunc(ctx, filters, options)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ArticleFilters, domain.ArticleListOptions) []string); ok {
+		r0 = returnFunc(ctx, filters, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ArticleFilters, domain.ArticleListOptions) error); ok {
+		r1 = returnFunc(ctx, filters, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListLatestArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLatestArticleIDs'
+type DatasetRepository_ListLatestArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListLatestArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters domain.ArticleFilters
+//   - options domain.ArticleListOptions
+func (_e *DatasetRepository_Expecter) ListLatestArticleIDs(ctx interface{}, filters interface{}, options interface{}) *DatasetRepository_ListLatestArticleIDs_Call {
+	return &DatasetRepository_ListLatestArticleIDs_Call{Call: _e.mock.On("ListLatestArticleIDs", ctx, filters, options)}
+}
+
+func (_c *DatasetRepository_ListLatestArticleIDs_Call) Run(run func(ctx context.Context, filters domain.ArticleFilters, options domain.ArticleListOptions)) *DatasetRepository_ListLatestArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ArticleFilters
+		if args[1] != nil {
+			arg1 = args[1].(domain.ArticleFilters)
+		}
+		var arg2 domain.ArticleListOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ArticleListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListLatestArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListLatestArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListLatestArticleIDs_Call) RunAndReturn(run func(ctx context.Context, filters domain.ArticleFilters, options domain.ArticleListOptions) ([]string, error)) *DatasetRepository_ListLatestArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLikedArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListLikedArticleIDs(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error) {
+	ret := _mock.Called(ctx, userID, tags, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLikedArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) ([]string, error)); ok {
+		return returnFunc(ctx, userID, tags, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) []string); ok {
+		r0 = returnFunc(ctx, userID, tags, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, tags, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListLikedArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLikedArticleIDs'
+type DatasetRepository_ListLikedArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListLikedArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - tags []string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListLikedArticleIDs(ctx interface{}, userID interface{}, tags interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListLikedArticleIDs_Call {
+	return &DatasetRepository_ListLikedArticleIDs_Call{Call: _e.mock.On("ListLikedArticleIDs", ctx, userID, tags, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListLikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, tags []string, page int, pageSize int)) *DatasetRepository_ListLikedArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListLikedArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListLikedArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListLikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListLikedArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPopularArticles provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListPopularArticles(ctx context.Context, window domain.PopularityWindow, page int, pageSize int) ([]domain.ArticlePopularity, error) {
+	ret := _mock.Called(ctx, window, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPopularArticles")
+	}
+
+	var r0 []domain.ArticlePopularity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PopularityWindow, int, int) ([]domain.ArticlePopularity, error)); ok {
+		return returnFunc(ctx, window, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PopularityWindow, int, int) []domain.ArticlePopularity); ok {
+		r0 = returnFunc(ctx, window, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticlePopularity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PopularityWindow, int, int) error); ok {
+		r1 = returnFunc(ctx, window, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListPopularArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPopularArticles'
+type DatasetRepository_ListPopularArticles_Call struct {
+	*mock.Call
+}
+
+// ListPopularArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window domain.PopularityWindow
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListPopularArticles(ctx interface{}, window interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListPopularArticles_Call {
+	return &DatasetRepository_ListPopularArticles_Call{Call: _e.mock.On("ListPopularArticles", ctx, window, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListPopularArticles_Call) Run(run func(ctx context.Context, window domain.PopularityWindow, page int, pageSize int)) *DatasetRepository_ListPopularArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PopularityWindow
+		if args[1] != nil {
+			arg1 = args[1].(domain.PopularityWindow)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListPopularArticles_Call) Return(articlePopularitys []domain.ArticlePopularity, err error) *DatasetRepository_ListPopularArticles_Call {
+	_c.Call.Return(articlePopularitys, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListPopularArticles_Call) RunAndReturn(run func(ctx context.Context, window domain.PopularityWindow, page int, pageSize int) ([]domain.ArticlePopularity, error)) *DatasetRepository_ListPopularArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReadArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListReadArticleIDs(ctx context.Context, userID string) ([]string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReadArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListReadArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReadArticleIDs'
+type DatasetRepository_ListReadArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListReadArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListReadArticleIDs(ctx interface{}, userID interface{}) *DatasetRepository_ListReadArticleIDs_Call {
+	return &DatasetRepository_ListReadArticleIDs_Call{Call: _e.mock.On("ListReadArticleIDs", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListReadArticleIDs_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListReadArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListReadArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListReadArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListReadArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *DatasetRepository_ListReadArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTagCooccurringArticles provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListTagCooccurringArticles(ctx context.Context, userID string, limit int) ([]domain.TagCooccurrence, error) {
+	ret := _mock.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTagCooccurringArticles")
+	}
+
+	var r0 []domain.TagCooccurrence
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.TagCooccurrence, error)); ok {
+		return returnFunc(ctx, userID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []domain.TagCooccurrence); ok {
+		r0 = returnFunc(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TagCooccurrence)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListTagCooccurringArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTagCooccurringArticles'
+type DatasetRepository_ListTagCooccurringArticles_Call struct {
+	*mock.Call
+}
+
+// ListTagCooccurringArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - limit int
+func (_e *DatasetRepository_Expecter) ListTagCooccurringArticles(ctx interface{}, userID interface{}, limit interface{}) *DatasetRepository_ListTagCooccurringArticles_Call {
+	return &DatasetRepository_ListTagCooccurringArticles_Call{Call: _e.mock.On("ListTagCooccurringArticles", ctx, userID, limit)}
+}
+
+func (_c *DatasetRepository_ListTagCooccurringArticles_Call) Run(run func(ctx context.Context, userID string, limit int)) *DatasetRepository_ListTagCooccurringArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListTagCooccurringArticles_Call) Return(tagCooccurrences []domain.TagCooccurrence, err error) *DatasetRepository_ListTagCooccurringArticles_Call {
+	_c.Call.Return(tagCooccurrences, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListTagCooccurringArticles_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int) ([]domain.TagCooccurrence, error)) *DatasetRepository_ListTagCooccurringArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTaggedArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListTaggedArticleIDs(ctx context.Context, userID string, tag string, page int, pageSize int) ([]string, error) {
+	ret := _mock.Called(ctx, userID, tag, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTaggedArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, int) ([]string, error)); ok {
+		return returnFunc(ctx, userID, tag, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, int) []string); ok {
+		r0 = returnFunc(ctx, userID, tag, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, tag, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListTaggedArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTaggedArticleIDs'
+type DatasetRepository_ListTaggedArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListTaggedArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - tag string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListTaggedArticleIDs(ctx interface{}, userID interface{}, tag interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListTaggedArticleIDs_Call {
+	return &DatasetRepository_ListTaggedArticleIDs_Call{Call: _e.mock.On("ListTaggedArticleIDs", ctx, userID, tag, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListTaggedArticleIDs_Call) Run(run func(ctx context.Context, userID string, tag string, page int, pageSize int)) *DatasetRepository_ListTaggedArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListTaggedArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListTaggedArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListTaggedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, tag string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListTaggedArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListThumbsUpArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListThumbsUpArticleIDs(ctx context.Context, userID string) ([]string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListThumbsUpArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListThumbsUpArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListThumbsUpArticleIDs'
+type DatasetRepository_ListThumbsUpArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListThumbsUpArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListThumbsUpArticleIDs(ctx interface{}, userID interface{}) *DatasetRepository_ListThumbsUpArticleIDs_Call {
+	return &DatasetRepository_ListThumbsUpArticleIDs_Call{Call: _e.mock.On("ListThumbsUpArticleIDs", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListThumbsUpArticleIDs_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListThumbsUpArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListThumbsUpArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListThumbsUpArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListThumbsUpArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *DatasetRepository_ListThumbsUpArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnreviewedArticleIDs provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUnreviewedArticleIDs(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error) {
+	ret := _mock.Called(ctx, userID, tags, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnreviewedArticleIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) ([]string, error)); ok {
+		return returnFunc(ctx, userID, tags, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) []string); ok {
+		r0 = returnFunc(ctx, userID, tags, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, tags, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUnreviewedArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnreviewedArticleIDs'
+type DatasetRepository_ListUnreviewedArticleIDs_Call struct {
+	*mock.Call
+}
+
+// ListUnreviewedArticleIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - tags []string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListUnreviewedArticleIDs(ctx interface{}, userID interface{}, tags interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListUnreviewedArticleIDs_Call {
+	return &DatasetRepository_ListUnreviewedArticleIDs_Call{Call: _e.mock.On("ListUnreviewedArticleIDs", ctx, userID, tags, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) Run(run func(ctx context.Context, userID string, tags []string, page int, pageSize int)) *DatasetRepository_ListUnreviewedArticleIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListUnreviewedArticleIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListUnreviewedArticleIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserAPITokens provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUserAPITokens(ctx context.Context, userID string) ([]domain.APIToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAPITokens")
+	}
+
+	var r0 []domain.APIToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.APIToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.APIToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.APIToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUserAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAPITokens'
+type DatasetRepository_ListUserAPITokens_Call struct {
+	*mock.Call
+}
+
+// ListUserAPITokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListUserAPITokens(ctx interface{}, userID interface{}) *DatasetRepository_ListUserAPITokens_Call {
+	return &DatasetRepository_ListUserAPITokens_Call{Call: _e.mock.On("ListUserAPITokens", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListUserAPITokens_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListUserAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserAPITokens_Call) Return(aPITokens []domain.APIToken, err error) *DatasetRepository_ListUserAPITokens_Call {
+	_c.Call.Return(aPITokens, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserAPITokens_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.APIToken, error)) *DatasetRepository_ListUserAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserArticleNotes provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUserArticleNotes(ctx context.Context, userID string, page int, pageSize int) ([]domain.ArticleNote, error) {
+	ret := _mock.Called(ctx, userID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserArticleNotes")
+	}
+
+	var r0 []domain.ArticleNote
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]domain.ArticleNote, error)); ok {
+		return returnFunc(ctx, userID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []domain.ArticleNote); ok {
+		r0 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleNote)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUserArticleNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserArticleNotes'
+type DatasetRepository_ListUserArticleNotes_Call struct {
+	*mock.Call
+}
+
+// ListUserArticleNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListUserArticleNotes(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListUserArticleNotes_Call {
+	return &DatasetRepository_ListUserArticleNotes_Call{Call: _e.mock.On("ListUserArticleNotes", ctx, userID, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListUserArticleNotes_Call) Run(run func(ctx context.Context, userID string, page int, pageSize int)) *DatasetRepository_ListUserArticleNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserArticleNotes_Call) Return(articleNotes []domain.ArticleNote, err error) *DatasetRepository_ListUserArticleNotes_Call {
+	_c.Call.Return(articleNotes, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserArticleNotes_Call) RunAndReturn(run func(ctx context.Context, userID string, page int, pageSize int) ([]domain.ArticleNote, error)) *DatasetRepository_ListUserArticleNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserAuditEvents provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUserAuditEvents(ctx context.Context, userID string, page int, pageSize int) ([]domain.AuditEvent, error) {
+	ret := _mock.Called(ctx, userID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAuditEvents")
+	}
+
+	var r0 []domain.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]domain.AuditEvent, error)); ok {
+		return returnFunc(ctx, userID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []domain.AuditEvent); ok {
+		r0 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUserAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAuditEvents'
+type DatasetRepository_ListUserAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListUserAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) ListUserAuditEvents(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListUserAuditEvents_Call {
+	return &DatasetRepository_ListUserAuditEvents_Call{Call: _e.mock.On("ListUserAuditEvents", ctx, userID, page, pageSize)}
+}
+
+func (_c *DatasetRepository_ListUserAuditEvents_Call) Run(run func(ctx context.Context, userID string, page int, pageSize int)) *DatasetRepository_ListUserAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserAuditEvents_Call) Return(auditEvents []domain.AuditEvent, err error) *DatasetRepository_ListUserAuditEvents_Call {
+	_c.Call.Return(auditEvents, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserAuditEvents_Call) RunAndReturn(run func(ctx context.Context, userID string, page int, pageSize int) ([]domain.AuditEvent, error)) *DatasetRepository_ListUserAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserCollections provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUserCollections(ctx context.Context, userID string) ([]domain.Collection, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserCollections")
+	}
+
+	var r0 []domain.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Collection, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Collection); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Collection)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUserCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserCollections'
+type DatasetRepository_ListUserCollections_Call struct {
+	*mock.Call
+}
+
+// ListUserCollections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListUserCollections(ctx interface{}, userID interface{}) *DatasetRepository_ListUserCollections_Call {
+	return &DatasetRepository_ListUserCollections_Call{Call: _e.mock.On("ListUserCollections", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListUserCollections_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListUserCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserCollections_Call) Return(collections []domain.Collection, err error) *DatasetRepository_ListUserCollections_Call {
+	_c.Call.Return(collections, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserCollections_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.Collection, error)) *DatasetRepository_ListUserCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserSavedSearches provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUserSavedSearches(ctx context.Context, userID string) ([]domain.SavedSearch, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserSavedSearches")
+	}
+
+	var r0 []domain.SavedSearch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.SavedSearch, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.SavedSearch); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SavedSearch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUserSavedSearches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserSavedSearches'
+type DatasetRepository_ListUserSavedSearches_Call struct {
+	*mock.Call
+}
+
+// ListUserSavedSearches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListUserSavedSearches(ctx interface{}, userID interface{}) *DatasetRepository_ListUserSavedSearches_Call {
+	return &DatasetRepository_ListUserSavedSearches_Call{Call: _e.mock.On("ListUserSavedSearches", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListUserSavedSearches_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListUserSavedSearches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserSavedSearches_Call) Return(savedSearchs []domain.SavedSearch, err error) *DatasetRepository_ListUserSavedSearches_Call {
+	_c.Call.Return(savedSearchs, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserSavedSearches_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.SavedSearch, error)) *DatasetRepository_ListUserSavedSearches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserTags provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUserTags(ctx context.Context, userID string) ([]domain.TagCount, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserTags")
+	}
+
+	var r0 []domain.TagCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.TagCount, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.TagCount); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TagCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUserTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserTags'
+type DatasetRepository_ListUserTags_Call struct {
+	*mock.Call
+}
+
+// ListUserTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) ListUserTags(ctx interface{}, userID interface{}) *DatasetRepository_ListUserTags_Call {
+	return &DatasetRepository_ListUserTags_Call{Call: _e.mock.On("ListUserTags", ctx, userID)}
+}
+
+func (_c *DatasetRepository_ListUserTags_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListUserTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserTags_Call) Return(tagCounts []domain.TagCount, err error) *DatasetRepository_ListUserTags_Call {
+	_c.Call.Return(tagCounts, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUserTags_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.TagCount, error)) *DatasetRepository_ListUserTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsersNeedingRegeneration provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ListUsersNeedingRegeneration(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersNeedingRegeneration")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ListUsersNeedingRegeneration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersNeedingRegeneration'
+type DatasetRepository_ListUsersNeedingRegeneration_Call struct {
+	*mock.Call
+}
+
+// ListUsersNeedingRegeneration is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetRepository_Expecter) ListUsersNeedingRegeneration(ctx interface{}) *DatasetRepository_ListUsersNeedingRegeneration_Call {
+	return &DatasetRepository_ListUsersNeedingRegeneration_Call{Call: _e.mock.On("ListUsersNeedingRegeneration", ctx)}
+}
+
+func (_c *DatasetRepository_ListUsersNeedingRegeneration_Call) Run(run func(ctx context.Context)) *DatasetRepository_ListUsersNeedingRegeneration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ListUsersNeedingRegeneration_Call) Return(strings []string, err error) *DatasetRepository_ListUsersNeedingRegeneration_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ListUsersNeedingRegeneration_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *DatasetRepository_ListUsersNeedingRegeneration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDigestSent provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error {
+	ret := _mock.Called(ctx, userID, sentAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDigestSent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, sentAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_MarkDigestSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDigestSent'
+type DatasetRepository_MarkDigestSent_Call struct {
+	*mock.Call
+}
+
+// MarkDigestSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sentAt time.Time
+func (_e *DatasetRepository_Expecter) MarkDigestSent(ctx interface{}, userID interface{}, sentAt interface{}) *DatasetRepository_MarkDigestSent_Call {
+	return &DatasetRepository_MarkDigestSent_Call{Call: _e.mock.On("MarkDigestSent", ctx, userID, sentAt)}
+}
+
+func (_c *DatasetRepository_MarkDigestSent_Call) Run(run func(ctx context.Context, userID string, sentAt time.Time)) *DatasetRepository_MarkDigestSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_MarkDigestSent_Call) Return(err error) *DatasetRepository_MarkDigestSent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_MarkDigestSent_Call) RunAndReturn(run func(ctx context.Context, userID string, sentAt time.Time) error) *DatasetRepository_MarkDigestSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSavedSearchViewed provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) MarkSavedSearchViewed(ctx context.Context, userID string, searchID string, viewedAt time.Time) error {
+	ret := _mock.Called(ctx, userID, searchID, viewedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSavedSearchViewed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, searchID, viewedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_MarkSavedSearchViewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSavedSearchViewed'
+type DatasetRepository_MarkSavedSearchViewed_Call struct {
+	*mock.Call
+}
+
+// MarkSavedSearchViewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - searchID string
+//   - viewedAt time.Time
+func (_e *DatasetRepository_Expecter) MarkSavedSearchViewed(ctx interface{}, userID interface{}, searchID interface{}, viewedAt interface{}) *DatasetRepository_MarkSavedSearchViewed_Call {
+	return &DatasetRepository_MarkSavedSearchViewed_Call{Call: _e.mock.On("MarkSavedSearchViewed", ctx, userID, searchID, viewedAt)}
+}
+
+func (_c *DatasetRepository_MarkSavedSearchViewed_Call) Run(run func(ctx context.Context, userID string, searchID string, viewedAt time.Time)) *DatasetRepository_MarkSavedSearchViewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_MarkSavedSearchViewed_Call) Return(err error) *DatasetRepository_MarkSavedSearchViewed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_MarkSavedSearchViewed_Call) RunAndReturn(run func(ctx context.Context, userID string, searchID string, viewedAt time.Time) error) *DatasetRepository_MarkSavedSearchViewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkUserNeedsRegeneration provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) MarkUserNeedsRegeneration(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkUserNeedsRegeneration")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_MarkUserNeedsRegeneration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUserNeedsRegeneration'
+type DatasetRepository_MarkUserNeedsRegeneration_Call struct {
+	*mock.Call
+}
+
+// MarkUserNeedsRegeneration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) MarkUserNeedsRegeneration(ctx interface{}, userID interface{}) *DatasetRepository_MarkUserNeedsRegeneration_Call {
+	return &DatasetRepository_MarkUserNeedsRegeneration_Call{Call: _e.mock.On("MarkUserNeedsRegeneration", ctx, userID)}
+}
+
+func (_c *DatasetRepository_MarkUserNeedsRegeneration_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_MarkUserNeedsRegeneration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_MarkUserNeedsRegeneration_Call) Return(err error) *DatasetRepository_MarkUserNeedsRegeneration_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_MarkUserNeedsRegeneration_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_MarkUserNeedsRegeneration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkUserRegenerated provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) MarkUserRegenerated(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkUserRegenerated")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_MarkUserRegenerated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUserRegenerated'
+type DatasetRepository_MarkUserRegenerated_Call struct {
+	*mock.Call
+}
+
+// MarkUserRegenerated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *DatasetRepository_Expecter) MarkUserRegenerated(ctx interface{}, userID interface{}) *DatasetRepository_MarkUserRegenerated_Call {
+	return &DatasetRepository_MarkUserRegenerated_Call{Call: _e.mock.On("MarkUserRegenerated", ctx, userID)}
+}
+
+func (_c *DatasetRepository_MarkUserRegenerated_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_MarkUserRegenerated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_MarkUserRegenerated_Call) Return(err error) *DatasetRepository_MarkUserRegenerated_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_MarkUserRegenerated_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_MarkUserRegenerated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordArticleSignal provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) RecordArticleSignal(ctx context.Context, userID string, articleHashID string, signal domain.ArticleSignal, vector []float32) error {
+	ret := _mock.Called(ctx, userID, articleHashID, signal, vector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordArticleSignal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.ArticleSignal, []float32) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, signal, vector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_RecordArticleSignal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordArticleSignal'
+type DatasetRepository_RecordArticleSignal_Call struct {
+	*mock.Call
+}
+
+// RecordArticleSignal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+//   - signal domain.ArticleSignal
+//   - vector []float32
+func (_e *DatasetRepository_Expecter) RecordArticleSignal(ctx interface{}, userID interface{}, articleHashID interface{}, signal interface{}, vector interface{}) *DatasetRepository_RecordArticleSignal_Call {
+	return &DatasetRepository_RecordArticleSignal_Call{Call: _e.mock.On("RecordArticleSignal", ctx, userID, articleHashID, signal, vector)}
+}
+
+func (_c *DatasetRepository_RecordArticleSignal_Call) Run(run func(ctx context.Context, userID string, articleHashID string, signal domain.ArticleSignal, vector []float32)) *DatasetRepository_RecordArticleSignal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.ArticleSignal
+		if args[3] != nil {
+			arg3 = args[3].(domain.ArticleSignal)
+		}
+		var arg4 []float32
+		if args[4] != nil {
+			arg4 = args[4].([]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_RecordArticleSignal_Call) Return(err error) *DatasetRepository_RecordArticleSignal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_RecordArticleSignal_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, signal domain.ArticleSignal, vector []float32) error) *DatasetRepository_RecordArticleSignal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordRecommendationImpressions provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) RecordRecommendationImpressions(ctx context.Context, impressions []domain.RecommendationImpression) error {
+	ret := _mock.Called(ctx, impressions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordRecommendationImpressions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.RecommendationImpression) error); ok {
+		r0 = returnFunc(ctx, impressions)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_RecordRecommendationImpressions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordRecommendationImpressions'
+type DatasetRepository_RecordRecommendationImpressions_Call struct {
+	*mock.Call
+}
+
+// RecordRecommendationImpressions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - impressions []domain.RecommendationImpression
+func (_e *DatasetRepository_Expecter) RecordRecommendationImpressions(ctx interface{}, impressions interface{}) *DatasetRepository_RecordRecommendationImpressions_Call {
+	return &DatasetRepository_RecordRecommendationImpressions_Call{Call: _e.mock.On("RecordRecommendationImpressions", ctx, impressions)}
+}
+
+func (_c *DatasetRepository_RecordRecommendationImpressions_Call) Run(run func(ctx context.Context, impressions []domain.RecommendationImpression)) *DatasetRepository_RecordRecommendationImpressions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.RecommendationImpression
+		if args[1] != nil {
+			arg1 = args[1].([]domain.RecommendationImpression)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_RecordRecommendationImpressions_Call) Return(err error) *DatasetRepository_RecordRecommendationImpressions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_RecordRecommendationImpressions_Call) RunAndReturn(run func(ctx context.Context, impressions []domain.RecommendationImpression) error) *DatasetRepository_RecordRecommendationImpressions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCollectionArticle provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) RemoveCollectionArticle(ctx context.Context, collectionID string, articleHashID string) error {
+	ret := _mock.Called(ctx, collectionID, articleHashID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCollectionArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, collectionID, articleHashID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_RemoveCollectionArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCollectionArticle'
+type DatasetRepository_RemoveCollectionArticle_Call struct {
+	*mock.Call
+}
+
+// RemoveCollectionArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID string
+//   - articleHashID string
+func (_e *DatasetRepository_Expecter) RemoveCollectionArticle(ctx interface{}, collectionID interface{}, articleHashID interface{}) *DatasetRepository_RemoveCollectionArticle_Call {
+	return &DatasetRepository_RemoveCollectionArticle_Call{Call: _e.mock.On("RemoveCollectionArticle", ctx, collectionID, articleHashID)}
+}
+
+func (_c *DatasetRepository_RemoveCollectionArticle_Call) Run(run func(ctx context.Context, collectionID string, articleHashID string)) *DatasetRepository_RemoveCollectionArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_RemoveCollectionArticle_Call) Return(err error) *DatasetRepository_RemoveCollectionArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_RemoveCollectionArticle_Call) RunAndReturn(run func(ctx context.Context, collectionID string, articleHashID string) error) *DatasetRepository_RemoveCollectionArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameAPIToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) RenameAPIToken(ctx context.Context, tokenID string, userID string, name *string) error {
+	ret := _mock.Called(ctx, tokenID, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameAPIToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *string) error); ok {
+		r0 = returnFunc(ctx, tokenID, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_RenameAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameAPIToken'
+type DatasetRepository_RenameAPIToken_Call struct {
+	*mock.Call
+}
+
+// RenameAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID string
+//   - name *string
+func (_e *DatasetRepository_Expecter) RenameAPIToken(ctx interface{}, tokenID interface{}, userID interface{}, name interface{}) *DatasetRepository_RenameAPIToken_Call {
+	return &DatasetRepository_RenameAPIToken_Call{Call: _e.mock.On("RenameAPIToken", ctx, tokenID, userID, name)}
+}
+
+func (_c *DatasetRepository_RenameAPIToken_Call) Run(run func(ctx context.Context, tokenID string, userID string, name *string)) *DatasetRepository_RenameAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_RenameAPIToken_Call) Return(err error) *DatasetRepository_RenameAPIToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_RenameAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string, name *string) error) *DatasetRepository_RenameAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderCollectionArticles provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ReorderCollectionArticles(ctx context.Context, collectionID string, articleHashIDs []string) error {
+	ret := _mock.Called(ctx, collectionID, articleHashIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderCollectionArticles")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, collectionID, articleHashIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_ReorderCollectionArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderCollectionArticles'
+type DatasetRepository_ReorderCollectionArticles_Call struct {
+	*mock.Call
+}
+
+// ReorderCollectionArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID string
+//   - articleHashIDs []string
+func (_e *DatasetRepository_Expecter) ReorderCollectionArticles(ctx interface{}, collectionID interface{}, articleHashIDs interface{}) *DatasetRepository_ReorderCollectionArticles_Call {
+	return &DatasetRepository_ReorderCollectionArticles_Call{Call: _e.mock.On("ReorderCollectionArticles", ctx, collectionID, articleHashIDs)}
+}
+
+func (_c *DatasetRepository_ReorderCollectionArticles_Call) Run(run func(ctx context.Context, collectionID string, articleHashIDs []string)) *DatasetRepository_ReorderCollectionArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ReorderCollectionArticles_Call) Return(err error) *DatasetRepository_ReorderCollectionArticles_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_ReorderCollectionArticles_Call) RunAndReturn(run func(ctx context.Context, collectionID string, articleHashIDs []string) error) *DatasetRepository_ReorderCollectionArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceArticleDuplicates provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ReplaceArticleDuplicates(ctx context.Context, duplicates []domain.ArticleDuplicate) error {
+	ret := _mock.Called(ctx, duplicates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceArticleDuplicates")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ArticleDuplicate) error); ok {
+		r0 = returnFunc(ctx, duplicates)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_ReplaceArticleDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceArticleDuplicates'
+type DatasetRepository_ReplaceArticleDuplicates_Call struct {
+	*mock.Call
+}
+
+// ReplaceArticleDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - duplicates []domain.ArticleDuplicate
+func (_e *DatasetRepository_Expecter) ReplaceArticleDuplicates(ctx interface{}, duplicates interface{}) *DatasetRepository_ReplaceArticleDuplicates_Call {
+	return &DatasetRepository_ReplaceArticleDuplicates_Call{Call: _e.mock.On("ReplaceArticleDuplicates", ctx, duplicates)}
+}
+
+func (_c *DatasetRepository_ReplaceArticleDuplicates_Call) Run(run func(ctx context.Context, duplicates []domain.ArticleDuplicate)) *DatasetRepository_ReplaceArticleDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.ArticleDuplicate
+		if args[1] != nil {
+			arg1 = args[1].([]domain.ArticleDuplicate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceArticleDuplicates_Call) Return(err error) *DatasetRepository_ReplaceArticleDuplicates_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceArticleDuplicates_Call) RunAndReturn(run func(ctx context.Context, duplicates []domain.ArticleDuplicate) error) *DatasetRepository_ReplaceArticleDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceArticleNeighbours provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ReplaceArticleNeighbours(ctx context.Context, neighbours []domain.ArticleNeighbour) error {
+	ret := _mock.Called(ctx, neighbours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceArticleNeighbours")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ArticleNeighbour) error); ok {
+		r0 = returnFunc(ctx, neighbours)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_ReplaceArticleNeighbours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceArticleNeighbours'
+type DatasetRepository_ReplaceArticleNeighbours_Call struct {
+	*mock.Call
+}
+
+// ReplaceArticleNeighbours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - neighbours []domain.ArticleNeighbour
+func (_e *DatasetRepository_Expecter) ReplaceArticleNeighbours(ctx interface{}, neighbours interface{}) *DatasetRepository_ReplaceArticleNeighbours_Call {
+	return &DatasetRepository_ReplaceArticleNeighbours_Call{Call: _e.mock.On("ReplaceArticleNeighbours", ctx, neighbours)}
+}
+
+func (_c *DatasetRepository_ReplaceArticleNeighbours_Call) Run(run func(ctx context.Context, neighbours []domain.ArticleNeighbour)) *DatasetRepository_ReplaceArticleNeighbours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.ArticleNeighbour
+		if args[1] != nil {
+			arg1 = args[1].([]domain.ArticleNeighbour)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceArticleNeighbours_Call) Return(err error) *DatasetRepository_ReplaceArticleNeighbours_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceArticleNeighbours_Call) RunAndReturn(run func(ctx context.Context, neighbours []domain.ArticleNeighbour) error) *DatasetRepository_ReplaceArticleNeighbours_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceArticlePopularity provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ReplaceArticlePopularity(ctx context.Context, window domain.PopularityWindow, popularity []domain.ArticlePopularity) error {
+	ret := _mock.Called(ctx, window, popularity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceArticlePopularity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PopularityWindow, []domain.ArticlePopularity) error); ok {
+		r0 = returnFunc(ctx, window, popularity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_ReplaceArticlePopularity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceArticlePopularity'
+type DatasetRepository_ReplaceArticlePopularity_Call struct {
+	*mock.Call
+}
+
+// ReplaceArticlePopularity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window domain.PopularityWindow
+//   - popularity []domain.ArticlePopularity
+func (_e *DatasetRepository_Expecter) ReplaceArticlePopularity(ctx interface{}, window interface{}, popularity interface{}) *DatasetRepository_ReplaceArticlePopularity_Call {
+	return &DatasetRepository_ReplaceArticlePopularity_Call{Call: _e.mock.On("ReplaceArticlePopularity", ctx, window, popularity)}
+}
+
+func (_c *DatasetRepository_ReplaceArticlePopularity_Call) Run(run func(ctx context.Context, window domain.PopularityWindow, popularity []domain.ArticlePopularity)) *DatasetRepository_ReplaceArticlePopularity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PopularityWindow
+		if args[1] != nil {
+			arg1 = args[1].(domain.PopularityWindow)
+		}
+		var arg2 []domain.ArticlePopularity
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ArticlePopularity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceArticlePopularity_Call) Return(err error) *DatasetRepository_ReplaceArticlePopularity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceArticlePopularity_Call) RunAndReturn(run func(ctx context.Context, window domain.PopularityWindow, popularity []domain.ArticlePopularity) error) *DatasetRepository_ReplaceArticlePopularity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceAuthors provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ReplaceAuthors(ctx context.Context, authors []domain.Author, articleAuthors []domain.ArticleAuthor, renamedIDs map[string]string) error {
+	ret := _mock.Called(ctx, authors, articleAuthors, renamedIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceAuthors")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Author, []domain.ArticleAuthor, map[string]string) error); ok {
+		r0 = returnFunc(ctx, authors, articleAuthors, renamedIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_ReplaceAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceAuthors'
+type DatasetRepository_ReplaceAuthors_Call struct {
+	*mock.Call
+}
+
+// ReplaceAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authors []domain.Author
+//   - articleAuthors []domain.ArticleAuthor
+//   - renamedIDs map[string]string
+func (_e *DatasetRepository_Expecter) ReplaceAuthors(ctx interface{}, authors interface{}, articleAuthors interface{}, renamedIDs interface{}) *DatasetRepository_ReplaceAuthors_Call {
+	return &DatasetRepository_ReplaceAuthors_Call{Call: _e.mock.On("ReplaceAuthors", ctx, authors, articleAuthors, renamedIDs)}
+}
+
+func (_c *DatasetRepository_ReplaceAuthors_Call) Run(run func(ctx context.Context, authors []domain.Author, articleAuthors []domain.ArticleAuthor, renamedIDs map[string]string)) *DatasetRepository_ReplaceAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Author
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Author)
+		}
+		var arg2 []domain.ArticleAuthor
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ArticleAuthor)
+		}
+		var arg3 map[string]string
+		if args[3] != nil {
+			arg3 = args[3].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceAuthors_Call) Return(err error) *DatasetRepository_ReplaceAuthors_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_ReplaceAuthors_Call) RunAndReturn(run func(ctx context.Context, authors []domain.Author, articleAuthors []domain.ArticleAuthor, renamedIDs map[string]string) error) *DatasetRepository_ReplaceAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportExperimentArms provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) ReportExperimentArms(ctx context.Context, since time.Time) ([]domain.ExperimentArmReport, error) {
+	ret := _mock.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportExperimentArms")
+	}
+
+	var r0 []domain.ExperimentArmReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.ExperimentArmReport, error)); ok {
+		return returnFunc(ctx, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []domain.ExperimentArmReport); ok {
+		r0 = returnFunc(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ExperimentArmReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_ReportExperimentArms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExperimentArms'
+type DatasetRepository_ReportExperimentArms_Call struct {
+	*mock.Call
+}
+
+// ReportExperimentArms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+func (_e *DatasetRepository_Expecter) ReportExperimentArms(ctx interface{}, since interface{}) *DatasetRepository_ReportExperimentArms_Call {
+	return &DatasetRepository_ReportExperimentArms_Call{Call: _e.mock.On("ReportExperimentArms", ctx, since)}
+}
+
+func (_c *DatasetRepository_ReportExperimentArms_Call) Run(run func(ctx context.Context, since time.Time)) *DatasetRepository_ReportExperimentArms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_ReportExperimentArms_Call) Return(experimentArmReports []domain.ExperimentArmReport, err error) *DatasetRepository_ReportExperimentArms_Call {
+	_c.Call.Return(experimentArmReports, err)
+	return _c
+}
+
+func (_c *DatasetRepository_ReportExperimentArms_Call) RunAndReturn(run func(ctx context.Context, since time.Time) ([]domain.ExperimentArmReport, error)) *DatasetRepository_ReportExperimentArms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) RevokeAPIToken(ctx context.Context, tokenID string, userID string) (bool, error) {
+	ret := _mock.Called(ctx, tokenID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, tokenID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, tokenID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
+type DatasetRepository_RevokeAPIToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID string
+func (_e *DatasetRepository_Expecter) RevokeAPIToken(ctx interface{}, tokenID interface{}, userID interface{}) *DatasetRepository_RevokeAPIToken_Call {
+	return &DatasetRepository_RevokeAPIToken_Call{Call: _e.mock.On("RevokeAPIToken", ctx, tokenID, userID)}
+}
+
+func (_c *DatasetRepository_RevokeAPIToken_Call) Run(run func(ctx context.Context, tokenID string, userID string)) *DatasetRepository_RevokeAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_RevokeAPIToken_Call) Return(b bool, err error) *DatasetRepository_RevokeAPIToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_RevokeAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) (bool, error)) *DatasetRepository_RevokeAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeExpiredAPITokens provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) RevokeExpiredAPITokens(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeExpiredAPITokens")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_RevokeExpiredAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeExpiredAPITokens'
+type DatasetRepository_RevokeExpiredAPITokens_Call struct {
+	*mock.Call
+}
+
+// RevokeExpiredAPITokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetRepository_Expecter) RevokeExpiredAPITokens(ctx interface{}) *DatasetRepository_RevokeExpiredAPITokens_Call {
+	return &DatasetRepository_RevokeExpiredAPITokens_Call{Call: _e.mock.On("RevokeExpiredAPITokens", ctx)}
+}
+
+func (_c *DatasetRepository_RevokeExpiredAPITokens_Call) Run(run func(ctx context.Context)) *DatasetRepository_RevokeExpiredAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_RevokeExpiredAPITokens_Call) Return(n int64, err error) *DatasetRepository_RevokeExpiredAPITokens_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *DatasetRepository_RevokeExpiredAPITokens_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *DatasetRepository_RevokeExpiredAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAPIToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) RotateAPIToken(ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params datasources.CreateAPITokenParams) (bool, error) {
+	ret := _mock.Called(ctx, oldTokenID, oldExpiresAt, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateAPIToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, datasources.CreateAPITokenParams) (bool, error)); ok {
+		return returnFunc(ctx, oldTokenID, oldExpiresAt, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, datasources.CreateAPITokenParams) bool); ok {
+		r0 = returnFunc(ctx, oldTokenID, oldExpiresAt, params)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, datasources.CreateAPITokenParams) error); ok {
+		r1 = returnFunc(ctx, oldTokenID, oldExpiresAt, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_RotateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIToken'
+type DatasetRepository_RotateAPIToken_Call struct {
+	*mock.Call
+}
+
+// RotateAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldTokenID string
+//   - oldExpiresAt time.Time
+//   - params datasources.CreateAPITokenParams
+func (_e *DatasetRepository_Expecter) RotateAPIToken(ctx interface{}, oldTokenID interface{}, oldExpiresAt interface{}, params interface{}) *DatasetRepository_RotateAPIToken_Call {
+	return &DatasetRepository_RotateAPIToken_Call{Call: _e.mock.On("RotateAPIToken", ctx, oldTokenID, oldExpiresAt, params)}
+}
+
+func (_c *DatasetRepository_RotateAPIToken_Call) Run(run func(ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params datasources.CreateAPITokenParams)) *DatasetRepository_RotateAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 datasources.CreateAPITokenParams
+		if args[3] != nil {
+			arg3 = args[3].(datasources.CreateAPITokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_RotateAPIToken_Call) Return(b bool, err error) *DatasetRepository_RotateAPIToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *DatasetRepository_RotateAPIToken_Call) RunAndReturn(run func(ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params datasources.CreateAPITokenParams) (bool, error)) *DatasetRepository_RotateAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUserArticleNotes provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SearchUserArticleNotes(ctx context.Context, userID string, query string, page int, pageSize int) ([]domain.ArticleNote, error) {
+	ret := _mock.Called(ctx, userID, query, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUserArticleNotes")
+	}
+
+	var r0 []domain.ArticleNote
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, int) ([]domain.ArticleNote, error)); ok {
+		return returnFunc(ctx, userID, query, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, int) []domain.ArticleNote); ok {
+		r0 = returnFunc(ctx, userID, query, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleNote)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, query, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DatasetRepository_SearchUserArticleNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUserArticleNotes'
+type DatasetRepository_SearchUserArticleNotes_Call struct {
+	*mock.Call
+}
+
+// SearchUserArticleNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - query string
+//   - page int
+//   - pageSize int
+func (_e *DatasetRepository_Expecter) SearchUserArticleNotes(ctx interface{}, userID interface{}, query interface{}, page interface{}, pageSize interface{}) *DatasetRepository_SearchUserArticleNotes_Call {
+	return &DatasetRepository_SearchUserArticleNotes_Call{Call: _e.mock.On("SearchUserArticleNotes", ctx, userID, query, page, pageSize)}
+}
+
+func (_c *DatasetRepository_SearchUserArticleNotes_Call) Run(run func(ctx context.Context, userID string, query string, page int, pageSize int)) *DatasetRepository_SearchUserArticleNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_SearchUserArticleNotes_Call) Return(articleNotes []domain.ArticleNote, err error) *DatasetRepository_SearchUserArticleNotes_Call {
+	_c.Call.Return(articleNotes, err)
+	return _c
+}
+
+func (_c *DatasetRepository_SearchUserArticleNotes_Call) RunAndReturn(run func(ctx context.Context, userID string, query string, page int, pageSize int) ([]domain.ArticleNote, error)) *DatasetRepository_SearchUserArticleNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SeedUserInterestClusters provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SeedUserInterestClusters(ctx context.Context, userID string, seedVectors [][]float32) error {
+	ret := _mock.Called(ctx, userID, seedVectors)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SeedUserInterestClusters")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, [][]float32) error); ok {
+		r0 = returnFunc(ctx, userID, seedVectors)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_SeedUserInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeedUserInterestClusters'
+type DatasetRepository_SeedUserInterestClusters_Call struct {
+	*mock.Call
+}
+
+// SeedUserInterestClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - seedVectors [][]float32
+func (_e *DatasetRepository_Expecter) SeedUserInterestClusters(ctx interface{}, userID interface{}, seedVectors interface{}) *DatasetRepository_SeedUserInterestClusters_Call {
+	return &DatasetRepository_SeedUserInterestClusters_Call{Call: _e.mock.On("SeedUserInterestClusters", ctx, userID, seedVectors)}
+}
+
+func (_c *DatasetRepository_SeedUserInterestClusters_Call) Run(run func(ctx context.Context, userID string, seedVectors [][]float32)) *DatasetRepository_SeedUserInterestClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 [][]float32
+		if args[2] != nil {
+			arg2 = args[2].([][]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_SeedUserInterestClusters_Call) Return(err error) *DatasetRepository_SeedUserInterestClusters_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_SeedUserInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string, seedVectors [][]float32) error) *DatasetRepository_SeedUserInterestClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetArticleDismissed provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SetArticleDismissed(ctx context.Context, hashID string, userID string, dismissed bool) error {
+	ret := _mock.Called(ctx, hashID, userID, dismissed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArticleDismissed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = returnFunc(ctx, hashID, userID, dismissed)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_SetArticleDismissed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleDismissed'
+type DatasetRepository_SetArticleDismissed_Call struct {
+	*mock.Call
+}
+
+// SetArticleDismissed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashID string
+//   - userID string
+//   - dismissed bool
+func (_e *DatasetRepository_Expecter) SetArticleDismissed(ctx interface{}, hashID interface{}, userID interface{}, dismissed interface{}) *DatasetRepository_SetArticleDismissed_Call {
+	return &DatasetRepository_SetArticleDismissed_Call{Call: _e.mock.On("SetArticleDismissed", ctx, hashID, userID, dismissed)}
+}
+
+func (_c *DatasetRepository_SetArticleDismissed_Call) Run(run func(ctx context.Context, hashID string, userID string, dismissed bool)) *DatasetRepository_SetArticleDismissed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_SetArticleDismissed_Call) Return(err error) *DatasetRepository_SetArticleDismissed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_SetArticleDismissed_Call) RunAndReturn(run func(ctx context.Context, hashID string, userID string, dismissed bool) error) *DatasetRepository_SetArticleDismissed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetArticleGradedRating provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SetArticleGradedRating(ctx context.Context, userID string, articleHashID string, rating int, vector []float32) error {
+	ret := _mock.Called(ctx, userID, articleHashID, rating, vector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArticleGradedRating")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, []float32) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, rating, vector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_SetArticleGradedRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleGradedRating'
+type DatasetRepository_SetArticleGradedRating_Call struct {
+	*mock.Call
+}
+
+// SetArticleGradedRating is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+//   - rating int
+//   - vector []float32
+func (_e *DatasetRepository_Expecter) SetArticleGradedRating(ctx interface{}, userID interface{}, articleHashID interface{}, rating interface{}, vector interface{}) *DatasetRepository_SetArticleGradedRating_Call {
+	return &DatasetRepository_SetArticleGradedRating_Call{Call: _e.mock.On("SetArticleGradedRating", ctx, userID, articleHashID, rating, vector)}
+}
+
+func (_c *DatasetRepository_SetArticleGradedRating_Call) Run(run func(ctx context.Context, userID string, articleHashID string, rating int, vector []float32)) *DatasetRepository_SetArticleGradedRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 []float32
+		if args[4] != nil {
+			arg4 = args[4].([]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_SetArticleGradedRating_Call) Return(err error) *DatasetRepository_SetArticleGradedRating_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_SetArticleGradedRating_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, rating int, vector []float32) error) *DatasetRepository_SetArticleGradedRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetArticleRating provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SetArticleRating(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32) error {
+	ret := _mock.Called(ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArticleRating")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *bool, *bool, []float32) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_SetArticleRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleRating'
+type DatasetRepository_SetArticleRating_Call struct {
+	*mock.Call
+}
+
+// SetArticleRating is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+//   - thumbsUp *bool
+//   - thumbsDown *bool
+//   - vector []float32
+func (_e *DatasetRepository_Expecter) SetArticleRating(ctx interface{}, userID interface{}, articleHashID interface{}, thumbsUp interface{}, thumbsDown interface{}, vector interface{}) *DatasetRepository_SetArticleRating_Call {
+	return &DatasetRepository_SetArticleRating_Call{Call: _e.mock.On("SetArticleRating", ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)}
+}
+
+func (_c *DatasetRepository_SetArticleRating_Call) Run(run func(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32)) *DatasetRepository_SetArticleRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *bool
+		if args[3] != nil {
+			arg3 = args[3].(*bool)
+		}
+		var arg4 *bool
+		if args[4] != nil {
+			arg4 = args[4].(*bool)
+		}
+		var arg5 []float32
+		if args[5] != nil {
+			arg5 = args[5].([]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_SetArticleRating_Call) Return(err error) *DatasetRepository_SetArticleRating_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_SetArticleRating_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32) error) *DatasetRepository_SetArticleRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetArticleRead provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SetArticleRead(ctx context.Context, hashID string, userID string, read bool) error {
+	ret := _mock.Called(ctx, hashID, userID, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArticleRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = returnFunc(ctx, hashID, userID, read)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_SetArticleRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleRead'
+type DatasetRepository_SetArticleRead_Call struct {
+	*mock.Call
+}
+
+// SetArticleRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashID string
+//   - userID string
+//   - read bool
+func (_e *DatasetRepository_Expecter) SetArticleRead(ctx interface{}, hashID interface{}, userID interface{}, read interface{}) *DatasetRepository_SetArticleRead_Call {
+	return &DatasetRepository_SetArticleRead_Call{Call: _e.mock.On("SetArticleRead", ctx, hashID, userID, read)}
+}
+
+func (_c *DatasetRepository_SetArticleRead_Call) Run(run func(ctx context.Context, hashID string, userID string, read bool)) *DatasetRepository_SetArticleRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
 		}
-		var arg3 int
+		var arg3 bool
 		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg3 = args[3].(bool)
 		}
 		run(
 			arg0,
@@ -895,133 +7118,105 @@ func (_c *DatasetRepository_ListDislikedArticleIDs_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *DatasetRepository_ListDislikedArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListDislikedArticleIDs_Call {
-	_c.Call.Return(strings, err)
+func (_c *DatasetRepository_SetArticleRead_Call) Return(err error) *DatasetRepository_SetArticleRead_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListDislikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListDislikedArticleIDs_Call {
+func (_c *DatasetRepository_SetArticleRead_Call) RunAndReturn(run func(ctx context.Context, hashID string, userID string, read bool) error) *DatasetRepository_SetArticleRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListLatestArticleIDs provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListLatestArticleIDs(ctx context.Context, filters domain.ArticleFilters, options domain.ArticleListOptions) ([]string, error) {
-	ret := _mock.Called(ctx, filters, options)
+// SetOnboardingInterests provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SetOnboardingInterests(ctx context.Context, interests domain.OnboardingInterests) error {
+	ret := _mock.Called(ctx, interests)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListLatestArticleIDs")
+		panic("no return value specified for SetOnboardingInterests")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ArticleFilters, domain.ArticleListOptions) ([]string, error)); ok {
-		return returnFunc(ctx, filters, options)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ArticleFilters, domain.ArticleListOptions) []string); ok {
-		r0 = returnFunc(ctx, filters, options)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ArticleFilters, domain.ArticleListOptions) error); ok {
-		r1 = returnFunc(ctx, filters, options)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.OnboardingInterests) error); ok {
+		r0 = returnFunc(ctx, interests)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_ListLatestArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLatestArticleIDs'
-type DatasetRepository_ListLatestArticleIDs_Call struct {
+// DatasetRepository_SetOnboardingInterests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOnboardingInterests'
+type DatasetRepository_SetOnboardingInterests_Call struct {
 	*mock.Call
 }
 
-// ListLatestArticleIDs is a helper method to define mock.On call
+// SetOnboardingInterests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters domain.ArticleFilters
-//   - options domain.ArticleListOptions
-func (_e *DatasetRepository_Expecter) ListLatestArticleIDs(ctx interface{}, filters interface{}, options interface{}) *DatasetRepository_ListLatestArticleIDs_Call {
-	return &DatasetRepository_ListLatestArticleIDs_Call{Call: _e.mock.On("ListLatestArticleIDs", ctx, filters, options)}
+//   - interests domain.OnboardingInterests
+func (_e *DatasetRepository_Expecter) SetOnboardingInterests(ctx interface{}, interests interface{}) *DatasetRepository_SetOnboardingInterests_Call {
+	return &DatasetRepository_SetOnboardingInterests_Call{Call: _e.mock.On("SetOnboardingInterests", ctx, interests)}
 }
 
-func (_c *DatasetRepository_ListLatestArticleIDs_Call) Run(run func(ctx context.Context, filters domain.ArticleFilters, options domain.ArticleListOptions)) *DatasetRepository_ListLatestArticleIDs_Call {
+func (_c *DatasetRepository_SetOnboardingInterests_Call) Run(run func(ctx context.Context, interests domain.OnboardingInterests)) *DatasetRepository_SetOnboardingInterests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.ArticleFilters
+		var arg1 domain.OnboardingInterests
 		if args[1] != nil {
-			arg1 = args[1].(domain.ArticleFilters)
-		}
-		var arg2 domain.ArticleListOptions
-		if args[2] != nil {
-			arg2 = args[2].(domain.ArticleListOptions)
+			arg1 = args[1].(domain.OnboardingInterests)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_ListLatestArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListLatestArticleIDs_Call {
-	_c.Call.Return(strings, err)
+func (_c *DatasetRepository_SetOnboardingInterests_Call) Return(err error) *DatasetRepository_SetOnboardingInterests_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListLatestArticleIDs_Call) RunAndReturn(run func(ctx context.Context, filters domain.ArticleFilters, options domain.ArticleListOptions) ([]string, error)) *DatasetRepository_ListLatestArticleIDs_Call {
+func (_c *DatasetRepository_SetOnboardingInterests_Call) RunAndReturn(run func(ctx context.Context, interests domain.OnboardingInterests) error) *DatasetRepository_SetOnboardingInterests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListLikedArticleIDs provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListLikedArticleIDs(ctx context.Context, userID string, page int, pageSize int) ([]string, error) {
-	ret := _mock.Called(ctx, userID, page, pageSize)
+// SnoozeArticle provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) SnoozeArticle(ctx context.Context, hashID string, userID string, until time.Time) error {
+	ret := _mock.Called(ctx, hashID, userID, until)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListLikedArticleIDs")
+		panic("no return value specified for SnoozeArticle")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]string, error)); ok {
-		return returnFunc(ctx, userID, page, pageSize)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []string); ok {
-		r0 = returnFunc(ctx, userID, page, pageSize)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
-		r1 = returnFunc(ctx, userID, page, pageSize)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, hashID, userID, until)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_ListLikedArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLikedArticleIDs'
-type DatasetRepository_ListLikedArticleIDs_Call struct {
+// DatasetRepository_SnoozeArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnoozeArticle'
+type DatasetRepository_SnoozeArticle_Call struct {
 	*mock.Call
 }
 
-// ListLikedArticleIDs is a helper method to define mock.On call
+// SnoozeArticle is a helper method to define mock.On call
 //   - ctx context.Context
+//   - hashID string
 //   - userID string
-//   - page int
-//   - pageSize int
-func (_e *DatasetRepository_Expecter) ListLikedArticleIDs(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListLikedArticleIDs_Call {
-	return &DatasetRepository_ListLikedArticleIDs_Call{Call: _e.mock.On("ListLikedArticleIDs", ctx, userID, page, pageSize)}
+//   - until time.Time
+func (_e *DatasetRepository_Expecter) SnoozeArticle(ctx interface{}, hashID interface{}, userID interface{}, until interface{}) *DatasetRepository_SnoozeArticle_Call {
+	return &DatasetRepository_SnoozeArticle_Call{Call: _e.mock.On("SnoozeArticle", ctx, hashID, userID, until)}
 }
 
-func (_c *DatasetRepository_ListLikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, page int, pageSize int)) *DatasetRepository_ListLikedArticleIDs_Call {
+func (_c *DatasetRepository_SnoozeArticle_Call) Run(run func(ctx context.Context, hashID string, userID string, until time.Time)) *DatasetRepository_SnoozeArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1031,13 +7226,13 @@ func (_c *DatasetRepository_ListLikedArticleIDs_Call) Run(run func(ctx context.C
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
 		}
-		var arg3 int
+		var arg3 time.Time
 		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
@@ -1049,57 +7244,48 @@ func (_c *DatasetRepository_ListLikedArticleIDs_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *DatasetRepository_ListLikedArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListLikedArticleIDs_Call {
-	_c.Call.Return(strings, err)
+func (_c *DatasetRepository_SnoozeArticle_Call) Return(err error) *DatasetRepository_SnoozeArticle_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListLikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListLikedArticleIDs_Call {
+func (_c *DatasetRepository_SnoozeArticle_Call) RunAndReturn(run func(ctx context.Context, hashID string, userID string, until time.Time) error) *DatasetRepository_SnoozeArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListReadArticleIDs provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListReadArticleIDs(ctx context.Context, userID string) ([]string, error) {
-	ret := _mock.Called(ctx, userID)
+// TagArticle provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) TagArticle(ctx context.Context, userID string, articleHashID string, tag string) error {
+	ret := _mock.Called(ctx, userID, articleHashID, tag)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListReadArticleIDs")
+		panic("no return value specified for TagArticle")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, tag)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_ListReadArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReadArticleIDs'
-type DatasetRepository_ListReadArticleIDs_Call struct {
+// DatasetRepository_TagArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagArticle'
+type DatasetRepository_TagArticle_Call struct {
 	*mock.Call
 }
 
-// ListReadArticleIDs is a helper method to define mock.On call
+// TagArticle is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *DatasetRepository_Expecter) ListReadArticleIDs(ctx interface{}, userID interface{}) *DatasetRepository_ListReadArticleIDs_Call {
-	return &DatasetRepository_ListReadArticleIDs_Call{Call: _e.mock.On("ListReadArticleIDs", ctx, userID)}
+//   - articleHashID string
+//   - tag string
+func (_e *DatasetRepository_Expecter) TagArticle(ctx interface{}, userID interface{}, articleHashID interface{}, tag interface{}) *DatasetRepository_TagArticle_Call {
+	return &DatasetRepository_TagArticle_Call{Call: _e.mock.On("TagArticle", ctx, userID, articleHashID, tag)}
 }
 
-func (_c *DatasetRepository_ListReadArticleIDs_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListReadArticleIDs_Call {
+func (_c *DatasetRepository_TagArticle_Call) Run(run func(ctx context.Context, userID string, articleHashID string, tag string)) *DatasetRepository_TagArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1109,65 +7295,75 @@ func (_c *DatasetRepository_ListReadArticleIDs_Call) Run(run func(ctx context.Co
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_ListReadArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListReadArticleIDs_Call {
-	_c.Call.Return(strings, err)
+func (_c *DatasetRepository_TagArticle_Call) Return(err error) *DatasetRepository_TagArticle_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListReadArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *DatasetRepository_ListReadArticleIDs_Call {
+func (_c *DatasetRepository_TagArticle_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, tag string) error) *DatasetRepository_TagArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListThumbsUpArticleIDs provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListThumbsUpArticleIDs(ctx context.Context, userID string) ([]string, error) {
-	ret := _mock.Called(ctx, userID)
+// TakeRateLimitToken provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) TakeRateLimitToken(ctx context.Context, key string, limit domain.RateLimit, now time.Time) (domain.RateLimitDecision, error) {
+	ret := _mock.Called(ctx, key, limit, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListThumbsUpArticleIDs")
+		panic("no return value specified for TakeRateLimitToken")
 	}
 
-	var r0 []string
+	var r0 domain.RateLimitDecision
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.RateLimit, time.Time) (domain.RateLimitDecision, error)); ok {
+		return returnFunc(ctx, key, limit, now)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.RateLimit, time.Time) domain.RateLimitDecision); ok {
+		r0 = returnFunc(ctx, key, limit, now)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(domain.RateLimitDecision)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.RateLimit, time.Time) error); ok {
+		r1 = returnFunc(ctx, key, limit, now)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_ListThumbsUpArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListThumbsUpArticleIDs'
-type DatasetRepository_ListThumbsUpArticleIDs_Call struct {
+// DatasetRepository_TakeRateLimitToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeRateLimitToken'
+type DatasetRepository_TakeRateLimitToken_Call struct {
 	*mock.Call
 }
 
-// ListThumbsUpArticleIDs is a helper method to define mock.On call
+// TakeRateLimitToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) ListThumbsUpArticleIDs(ctx interface{}, userID interface{}) *DatasetRepository_ListThumbsUpArticleIDs_Call {
-	return &DatasetRepository_ListThumbsUpArticleIDs_Call{Call: _e.mock.On("ListThumbsUpArticleIDs", ctx, userID)}
+//   - key string
+//   - limit domain.RateLimit
+//   - now time.Time
+func (_e *DatasetRepository_Expecter) TakeRateLimitToken(ctx interface{}, key interface{}, limit interface{}, now interface{}) *DatasetRepository_TakeRateLimitToken_Call {
+	return &DatasetRepository_TakeRateLimitToken_Call{Call: _e.mock.On("TakeRateLimitToken", ctx, key, limit, now)}
 }
 
-func (_c *DatasetRepository_ListThumbsUpArticleIDs_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListThumbsUpArticleIDs_Call {
+func (_c *DatasetRepository_TakeRateLimitToken_Call) Run(run func(ctx context.Context, key string, limit domain.RateLimit, now time.Time)) *DatasetRepository_TakeRateLimitToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1177,67 +7373,66 @@ func (_c *DatasetRepository_ListThumbsUpArticleIDs_Call) Run(run func(ctx contex
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 domain.RateLimit
+		if args[2] != nil {
+			arg2 = args[2].(domain.RateLimit)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_ListThumbsUpArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListThumbsUpArticleIDs_Call {
-	_c.Call.Return(strings, err)
+func (_c *DatasetRepository_TakeRateLimitToken_Call) Return(rateLimitDecision domain.RateLimitDecision, err error) *DatasetRepository_TakeRateLimitToken_Call {
+	_c.Call.Return(rateLimitDecision, err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListThumbsUpArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *DatasetRepository_ListThumbsUpArticleIDs_Call {
+func (_c *DatasetRepository_TakeRateLimitToken_Call) RunAndReturn(run func(ctx context.Context, key string, limit domain.RateLimit, now time.Time) (domain.RateLimitDecision, error)) *DatasetRepository_TakeRateLimitToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUnreviewedArticleIDs provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListUnreviewedArticleIDs(ctx context.Context, userID string, page int, pageSize int) ([]string, error) {
-	ret := _mock.Called(ctx, userID, page, pageSize)
+// UnfollowItem provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UnfollowItem(ctx context.Context, userID string, followType domain.FollowType, target string) error {
+	ret := _mock.Called(ctx, userID, followType, target)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUnreviewedArticleIDs")
+		panic("no return value specified for UnfollowItem")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]string, error)); ok {
-		return returnFunc(ctx, userID, page, pageSize)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []string); ok {
-		r0 = returnFunc(ctx, userID, page, pageSize)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
-		r1 = returnFunc(ctx, userID, page, pageSize)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.FollowType, string) error); ok {
+		r0 = returnFunc(ctx, userID, followType, target)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_ListUnreviewedArticleIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnreviewedArticleIDs'
-type DatasetRepository_ListUnreviewedArticleIDs_Call struct {
+// DatasetRepository_UnfollowItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfollowItem'
+type DatasetRepository_UnfollowItem_Call struct {
 	*mock.Call
 }
 
-// ListUnreviewedArticleIDs is a helper method to define mock.On call
+// UnfollowItem is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - page int
-//   - pageSize int
-func (_e *DatasetRepository_Expecter) ListUnreviewedArticleIDs(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *DatasetRepository_ListUnreviewedArticleIDs_Call {
-	return &DatasetRepository_ListUnreviewedArticleIDs_Call{Call: _e.mock.On("ListUnreviewedArticleIDs", ctx, userID, page, pageSize)}
+//   - followType domain.FollowType
+//   - target string
+func (_e *DatasetRepository_Expecter) UnfollowItem(ctx interface{}, userID interface{}, followType interface{}, target interface{}) *DatasetRepository_UnfollowItem_Call {
+	return &DatasetRepository_UnfollowItem_Call{Call: _e.mock.On("UnfollowItem", ctx, userID, followType, target)}
 }
 
-func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) Run(run func(ctx context.Context, userID string, page int, pageSize int)) *DatasetRepository_ListUnreviewedArticleIDs_Call {
+func (_c *DatasetRepository_UnfollowItem_Call) Run(run func(ctx context.Context, userID string, followType domain.FollowType, target string)) *DatasetRepository_UnfollowItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1247,13 +7442,13 @@ func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) Run(run func(ctx cont
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 domain.FollowType
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(domain.FollowType)
 		}
-		var arg3 int
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
@@ -1265,57 +7460,55 @@ func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) Return(strings []string, err error) *DatasetRepository_ListUnreviewedArticleIDs_Call {
-	_c.Call.Return(strings, err)
+func (_c *DatasetRepository_UnfollowItem_Call) Return(err error) *DatasetRepository_UnfollowItem_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListUnreviewedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, page int, pageSize int) ([]string, error)) *DatasetRepository_ListUnreviewedArticleIDs_Call {
+func (_c *DatasetRepository_UnfollowItem_Call) RunAndReturn(run func(ctx context.Context, userID string, followType domain.FollowType, target string) error) *DatasetRepository_UnfollowItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUserAPITokens provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListUserAPITokens(ctx context.Context, userID string) ([]domain.APIToken, error) {
-	ret := _mock.Called(ctx, userID)
+// UnsubscribeDigest provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UnsubscribeDigest(ctx context.Context, unsubscribeToken string) (bool, error) {
+	ret := _mock.Called(ctx, unsubscribeToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUserAPITokens")
+		panic("no return value specified for UnsubscribeDigest")
 	}
 
-	var r0 []domain.APIToken
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.APIToken, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, unsubscribeToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.APIToken); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, unsubscribeToken)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.APIToken)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+		r1 = returnFunc(ctx, unsubscribeToken)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DatasetRepository_ListUserAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAPITokens'
-type DatasetRepository_ListUserAPITokens_Call struct {
+// DatasetRepository_UnsubscribeDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeDigest'
+type DatasetRepository_UnsubscribeDigest_Call struct {
 	*mock.Call
 }
 
-// ListUserAPITokens is a helper method to define mock.On call
+// UnsubscribeDigest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) ListUserAPITokens(ctx interface{}, userID interface{}) *DatasetRepository_ListUserAPITokens_Call {
-	return &DatasetRepository_ListUserAPITokens_Call{Call: _e.mock.On("ListUserAPITokens", ctx, userID)}
+//   - unsubscribeToken string
+func (_e *DatasetRepository_Expecter) UnsubscribeDigest(ctx interface{}, unsubscribeToken interface{}) *DatasetRepository_UnsubscribeDigest_Call {
+	return &DatasetRepository_UnsubscribeDigest_Call{Call: _e.mock.On("UnsubscribeDigest", ctx, unsubscribeToken)}
 }
 
-func (_c *DatasetRepository_ListUserAPITokens_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_ListUserAPITokens_Call {
+func (_c *DatasetRepository_UnsubscribeDigest_Call) Run(run func(ctx context.Context, unsubscribeToken string)) *DatasetRepository_UnsubscribeDigest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1333,108 +7526,115 @@ func (_c *DatasetRepository_ListUserAPITokens_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *DatasetRepository_ListUserAPITokens_Call) Return(aPITokens []domain.APIToken, err error) *DatasetRepository_ListUserAPITokens_Call {
-	_c.Call.Return(aPITokens, err)
+func (_c *DatasetRepository_UnsubscribeDigest_Call) Return(b bool, err error) *DatasetRepository_UnsubscribeDigest_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListUserAPITokens_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.APIToken, error)) *DatasetRepository_ListUserAPITokens_Call {
+func (_c *DatasetRepository_UnsubscribeDigest_Call) RunAndReturn(run func(ctx context.Context, unsubscribeToken string) (bool, error)) *DatasetRepository_UnsubscribeDigest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUsersNeedingRegeneration provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) ListUsersNeedingRegeneration(ctx context.Context) ([]string, error) {
-	ret := _mock.Called(ctx)
+// UntagArticle provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UntagArticle(ctx context.Context, userID string, articleHashID string, tag string) error {
+	ret := _mock.Called(ctx, userID, articleHashID, tag)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUsersNeedingRegeneration")
+		panic("no return value specified for UntagArticle")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, tag)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// DatasetRepository_ListUsersNeedingRegeneration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersNeedingRegeneration'
-type DatasetRepository_ListUsersNeedingRegeneration_Call struct {
+// DatasetRepository_UntagArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagArticle'
+type DatasetRepository_UntagArticle_Call struct {
 	*mock.Call
 }
 
-// ListUsersNeedingRegeneration is a helper method to define mock.On call
+// UntagArticle is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *DatasetRepository_Expecter) ListUsersNeedingRegeneration(ctx interface{}) *DatasetRepository_ListUsersNeedingRegeneration_Call {
-	return &DatasetRepository_ListUsersNeedingRegeneration_Call{Call: _e.mock.On("ListUsersNeedingRegeneration", ctx)}
+//   - userID string
+//   - articleHashID string
+//   - tag string
+func (_e *DatasetRepository_Expecter) UntagArticle(ctx interface{}, userID interface{}, articleHashID interface{}, tag interface{}) *DatasetRepository_UntagArticle_Call {
+	return &DatasetRepository_UntagArticle_Call{Call: _e.mock.On("UntagArticle", ctx, userID, articleHashID, tag)}
 }
 
-func (_c *DatasetRepository_ListUsersNeedingRegeneration_Call) Run(run func(ctx context.Context)) *DatasetRepository_ListUsersNeedingRegeneration_Call {
+func (_c *DatasetRepository_UntagArticle_Call) Run(run func(ctx context.Context, userID string, articleHashID string, tag string)) *DatasetRepository_UntagArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_ListUsersNeedingRegeneration_Call) Return(strings []string, err error) *DatasetRepository_ListUsersNeedingRegeneration_Call {
-	_c.Call.Return(strings, err)
+func (_c *DatasetRepository_UntagArticle_Call) Return(err error) *DatasetRepository_UntagArticle_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_ListUsersNeedingRegeneration_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *DatasetRepository_ListUsersNeedingRegeneration_Call {
+func (_c *DatasetRepository_UntagArticle_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, tag string) error) *DatasetRepository_UntagArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkUserNeedsRegeneration provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) MarkUserNeedsRegeneration(ctx context.Context, userID string) error {
-	ret := _mock.Called(ctx, userID)
+// UpdateAPITokenLastUsed provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UpdateAPITokenLastUsed(ctx context.Context, tokenID string) error {
+	ret := _mock.Called(ctx, tokenID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkUserNeedsRegeneration")
+		panic("no return value specified for UpdateAPITokenLastUsed")
 	}
 
 	var r0 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, userID)
+		r0 = returnFunc(ctx, tokenID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// DatasetRepository_MarkUserNeedsRegeneration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUserNeedsRegeneration'
-type DatasetRepository_MarkUserNeedsRegeneration_Call struct {
+// DatasetRepository_UpdateAPITokenLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAPITokenLastUsed'
+type DatasetRepository_UpdateAPITokenLastUsed_Call struct {
 	*mock.Call
 }
 
-// MarkUserNeedsRegeneration is a helper method to define mock.On call
+// UpdateAPITokenLastUsed is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) MarkUserNeedsRegeneration(ctx interface{}, userID interface{}) *DatasetRepository_MarkUserNeedsRegeneration_Call {
-	return &DatasetRepository_MarkUserNeedsRegeneration_Call{Call: _e.mock.On("MarkUserNeedsRegeneration", ctx, userID)}
+//   - tokenID string
+func (_e *DatasetRepository_Expecter) UpdateAPITokenLastUsed(ctx interface{}, tokenID interface{}) *DatasetRepository_UpdateAPITokenLastUsed_Call {
+	return &DatasetRepository_UpdateAPITokenLastUsed_Call{Call: _e.mock.On("UpdateAPITokenLastUsed", ctx, tokenID)}
 }
 
-func (_c *DatasetRepository_MarkUserNeedsRegeneration_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_MarkUserNeedsRegeneration_Call {
+func (_c *DatasetRepository_UpdateAPITokenLastUsed_Call) Run(run func(ctx context.Context, tokenID string)) *DatasetRepository_UpdateAPITokenLastUsed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1452,54 +7652,54 @@ func (_c *DatasetRepository_MarkUserNeedsRegeneration_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *DatasetRepository_MarkUserNeedsRegeneration_Call) Return(err error) *DatasetRepository_MarkUserNeedsRegeneration_Call {
+func (_c *DatasetRepository_UpdateAPITokenLastUsed_Call) Return(err error) *DatasetRepository_UpdateAPITokenLastUsed_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_MarkUserNeedsRegeneration_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_MarkUserNeedsRegeneration_Call {
+func (_c *DatasetRepository_UpdateAPITokenLastUsed_Call) RunAndReturn(run func(ctx context.Context, tokenID string) error) *DatasetRepository_UpdateAPITokenLastUsed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkUserRegenerated provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) MarkUserRegenerated(ctx context.Context, userID string) error {
-	ret := _mock.Called(ctx, userID)
+// UpdateArticleNote provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UpdateArticleNote(ctx context.Context, note domain.ArticleNote) error {
+	ret := _mock.Called(ctx, note)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkUserRegenerated")
+		panic("no return value specified for UpdateArticleNote")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ArticleNote) error); ok {
+		r0 = returnFunc(ctx, note)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// DatasetRepository_MarkUserRegenerated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUserRegenerated'
-type DatasetRepository_MarkUserRegenerated_Call struct {
+// DatasetRepository_UpdateArticleNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateArticleNote'
+type DatasetRepository_UpdateArticleNote_Call struct {
 	*mock.Call
 }
 
-// MarkUserRegenerated is a helper method to define mock.On call
+// UpdateArticleNote is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *DatasetRepository_Expecter) MarkUserRegenerated(ctx interface{}, userID interface{}) *DatasetRepository_MarkUserRegenerated_Call {
-	return &DatasetRepository_MarkUserRegenerated_Call{Call: _e.mock.On("MarkUserRegenerated", ctx, userID)}
+//   - note domain.ArticleNote
+func (_e *DatasetRepository_Expecter) UpdateArticleNote(ctx interface{}, note interface{}) *DatasetRepository_UpdateArticleNote_Call {
+	return &DatasetRepository_UpdateArticleNote_Call{Call: _e.mock.On("UpdateArticleNote", ctx, note)}
 }
 
-func (_c *DatasetRepository_MarkUserRegenerated_Call) Run(run func(ctx context.Context, userID string)) *DatasetRepository_MarkUserRegenerated_Call {
+func (_c *DatasetRepository_UpdateArticleNote_Call) Run(run func(ctx context.Context, note domain.ArticleNote)) *DatasetRepository_UpdateArticleNote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.ArticleNote
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.ArticleNote)
 		}
 		run(
 			arg0,
@@ -1509,267 +7709,225 @@ func (_c *DatasetRepository_MarkUserRegenerated_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *DatasetRepository_MarkUserRegenerated_Call) Return(err error) *DatasetRepository_MarkUserRegenerated_Call {
+func (_c *DatasetRepository_UpdateArticleNote_Call) Return(err error) *DatasetRepository_UpdateArticleNote_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_MarkUserRegenerated_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *DatasetRepository_MarkUserRegenerated_Call {
+func (_c *DatasetRepository_UpdateArticleNote_Call) RunAndReturn(run func(ctx context.Context, note domain.ArticleNote) error) *DatasetRepository_UpdateArticleNote_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokeAPIToken provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) RevokeAPIToken(ctx context.Context, tokenID string, userID string) error {
-	ret := _mock.Called(ctx, tokenID, userID)
+// UpdateCollection provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UpdateCollection(ctx context.Context, collection domain.Collection) error {
+	ret := _mock.Called(ctx, collection)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokeAPIToken")
+		panic("no return value specified for UpdateCollection")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, tokenID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Collection) error); ok {
+		r0 = returnFunc(ctx, collection)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// DatasetRepository_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
-type DatasetRepository_RevokeAPIToken_Call struct {
+// DatasetRepository_UpdateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollection'
+type DatasetRepository_UpdateCollection_Call struct {
 	*mock.Call
 }
 
-// RevokeAPIToken is a helper method to define mock.On call
+// UpdateCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenID string
-//   - userID string
-func (_e *DatasetRepository_Expecter) RevokeAPIToken(ctx interface{}, tokenID interface{}, userID interface{}) *DatasetRepository_RevokeAPIToken_Call {
-	return &DatasetRepository_RevokeAPIToken_Call{Call: _e.mock.On("RevokeAPIToken", ctx, tokenID, userID)}
+//   - collection domain.Collection
+func (_e *DatasetRepository_Expecter) UpdateCollection(ctx interface{}, collection interface{}) *DatasetRepository_UpdateCollection_Call {
+	return &DatasetRepository_UpdateCollection_Call{Call: _e.mock.On("UpdateCollection", ctx, collection)}
 }
 
-func (_c *DatasetRepository_RevokeAPIToken_Call) Run(run func(ctx context.Context, tokenID string, userID string)) *DatasetRepository_RevokeAPIToken_Call {
+func (_c *DatasetRepository_UpdateCollection_Call) Run(run func(ctx context.Context, collection domain.Collection)) *DatasetRepository_UpdateCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.Collection
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg1 = args[1].(domain.Collection)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_RevokeAPIToken_Call) Return(err error) *DatasetRepository_RevokeAPIToken_Call {
+func (_c *DatasetRepository_UpdateCollection_Call) Return(err error) *DatasetRepository_UpdateCollection_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_RevokeAPIToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, userID string) error) *DatasetRepository_RevokeAPIToken_Call {
+func (_c *DatasetRepository_UpdateCollection_Call) RunAndReturn(run func(ctx context.Context, collection domain.Collection) error) *DatasetRepository_UpdateCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetArticleRating provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) SetArticleRating(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32) error {
-	ret := _mock.Called(ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)
+// UpdateSavedSearch provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UpdateSavedSearch(ctx context.Context, search domain.SavedSearch) error {
+	ret := _mock.Called(ctx, search)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetArticleRating")
+		panic("no return value specified for UpdateSavedSearch")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *bool, *bool, []float32) error); ok {
-		r0 = returnFunc(ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SavedSearch) error); ok {
+		r0 = returnFunc(ctx, search)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// DatasetRepository_SetArticleRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleRating'
-type DatasetRepository_SetArticleRating_Call struct {
+// DatasetRepository_UpdateSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSavedSearch'
+type DatasetRepository_UpdateSavedSearch_Call struct {
 	*mock.Call
 }
 
-// SetArticleRating is a helper method to define mock.On call
+// UpdateSavedSearch is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - articleHashID string
-//   - thumbsUp *bool
-//   - thumbsDown *bool
-//   - vector []float32
-func (_e *DatasetRepository_Expecter) SetArticleRating(ctx interface{}, userID interface{}, articleHashID interface{}, thumbsUp interface{}, thumbsDown interface{}, vector interface{}) *DatasetRepository_SetArticleRating_Call {
-	return &DatasetRepository_SetArticleRating_Call{Call: _e.mock.On("SetArticleRating", ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)}
+//   - search domain.SavedSearch
+func (_e *DatasetRepository_Expecter) UpdateSavedSearch(ctx interface{}, search interface{}) *DatasetRepository_UpdateSavedSearch_Call {
+	return &DatasetRepository_UpdateSavedSearch_Call{Call: _e.mock.On("UpdateSavedSearch", ctx, search)}
 }
 
-func (_c *DatasetRepository_SetArticleRating_Call) Run(run func(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32)) *DatasetRepository_SetArticleRating_Call {
+func (_c *DatasetRepository_UpdateSavedSearch_Call) Run(run func(ctx context.Context, search domain.SavedSearch)) *DatasetRepository_UpdateSavedSearch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.SavedSearch
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 *bool
-		if args[3] != nil {
-			arg3 = args[3].(*bool)
-		}
-		var arg4 *bool
-		if args[4] != nil {
-			arg4 = args[4].(*bool)
-		}
-		var arg5 []float32
-		if args[5] != nil {
-			arg5 = args[5].([]float32)
+			arg1 = args[1].(domain.SavedSearch)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
-			arg4,
-			arg5,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_SetArticleRating_Call) Return(err error) *DatasetRepository_SetArticleRating_Call {
+func (_c *DatasetRepository_UpdateSavedSearch_Call) Return(err error) *DatasetRepository_UpdateSavedSearch_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_SetArticleRating_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32) error) *DatasetRepository_SetArticleRating_Call {
+func (_c *DatasetRepository_UpdateSavedSearch_Call) RunAndReturn(run func(ctx context.Context, search domain.SavedSearch) error) *DatasetRepository_UpdateSavedSearch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetArticleRead provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) SetArticleRead(ctx context.Context, hashID string, userID string, read bool) error {
-	ret := _mock.Called(ctx, hashID, userID, read)
+// UpsertDigestPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UpsertDigestPreferences(ctx context.Context, prefs domain.DigestPreferences) error {
+	ret := _mock.Called(ctx, prefs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetArticleRead")
+		panic("no return value specified for UpsertDigestPreferences")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
-		r0 = returnFunc(ctx, hashID, userID, read)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DigestPreferences) error); ok {
+		r0 = returnFunc(ctx, prefs)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// DatasetRepository_SetArticleRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleRead'
-type DatasetRepository_SetArticleRead_Call struct {
+// DatasetRepository_UpsertDigestPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertDigestPreferences'
+type DatasetRepository_UpsertDigestPreferences_Call struct {
 	*mock.Call
 }
 
-// SetArticleRead is a helper method to define mock.On call
+// UpsertDigestPreferences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - hashID string
-//   - userID string
-//   - read bool
-func (_e *DatasetRepository_Expecter) SetArticleRead(ctx interface{}, hashID interface{}, userID interface{}, read interface{}) *DatasetRepository_SetArticleRead_Call {
-	return &DatasetRepository_SetArticleRead_Call{Call: _e.mock.On("SetArticleRead", ctx, hashID, userID, read)}
+//   - prefs domain.DigestPreferences
+func (_e *DatasetRepository_Expecter) UpsertDigestPreferences(ctx interface{}, prefs interface{}) *DatasetRepository_UpsertDigestPreferences_Call {
+	return &DatasetRepository_UpsertDigestPreferences_Call{Call: _e.mock.On("UpsertDigestPreferences", ctx, prefs)}
 }
 
-func (_c *DatasetRepository_SetArticleRead_Call) Run(run func(ctx context.Context, hashID string, userID string, read bool)) *DatasetRepository_SetArticleRead_Call {
+func (_c *DatasetRepository_UpsertDigestPreferences_Call) Run(run func(ctx context.Context, prefs domain.DigestPreferences)) *DatasetRepository_UpsertDigestPreferences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.DigestPreferences
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 bool
-		if args[3] != nil {
-			arg3 = args[3].(bool)
+			arg1 = args[1].(domain.DigestPreferences)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *DatasetRepository_SetArticleRead_Call) Return(err error) *DatasetRepository_SetArticleRead_Call {
+func (_c *DatasetRepository_UpsertDigestPreferences_Call) Return(err error) *DatasetRepository_UpsertDigestPreferences_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_SetArticleRead_Call) RunAndReturn(run func(ctx context.Context, hashID string, userID string, read bool) error) *DatasetRepository_SetArticleRead_Call {
+func (_c *DatasetRepository_UpsertDigestPreferences_Call) RunAndReturn(run func(ctx context.Context, prefs domain.DigestPreferences) error) *DatasetRepository_UpsertDigestPreferences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAPITokenLastUsed provides a mock function for the type DatasetRepository
-func (_mock *DatasetRepository) UpdateAPITokenLastUsed(ctx context.Context, tokenID string) error {
-	ret := _mock.Called(ctx, tokenID)
+// UpsertFollowPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UpsertFollowPreferences(ctx context.Context, prefs domain.FollowPreferences) error {
+	ret := _mock.Called(ctx, prefs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAPITokenLastUsed")
+		panic("no return value specified for UpsertFollowPreferences")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, tokenID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.FollowPreferences) error); ok {
+		r0 = returnFunc(ctx, prefs)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// DatasetRepository_UpdateAPITokenLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAPITokenLastUsed'
-type DatasetRepository_UpdateAPITokenLastUsed_Call struct {
+// DatasetRepository_UpsertFollowPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertFollowPreferences'
+type DatasetRepository_UpsertFollowPreferences_Call struct {
 	*mock.Call
 }
 
-// UpdateAPITokenLastUsed is a helper method to define mock.On call
+// UpsertFollowPreferences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenID string
-func (_e *DatasetRepository_Expecter) UpdateAPITokenLastUsed(ctx interface{}, tokenID interface{}) *DatasetRepository_UpdateAPITokenLastUsed_Call {
-	return &DatasetRepository_UpdateAPITokenLastUsed_Call{Call: _e.mock.On("UpdateAPITokenLastUsed", ctx, tokenID)}
+//   - prefs domain.FollowPreferences
+func (_e *DatasetRepository_Expecter) UpsertFollowPreferences(ctx interface{}, prefs interface{}) *DatasetRepository_UpsertFollowPreferences_Call {
+	return &DatasetRepository_UpsertFollowPreferences_Call{Call: _e.mock.On("UpsertFollowPreferences", ctx, prefs)}
 }
 
-func (_c *DatasetRepository_UpdateAPITokenLastUsed_Call) Run(run func(ctx context.Context, tokenID string)) *DatasetRepository_UpdateAPITokenLastUsed_Call {
+func (_c *DatasetRepository_UpsertFollowPreferences_Call) Run(run func(ctx context.Context, prefs domain.FollowPreferences)) *DatasetRepository_UpsertFollowPreferences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.FollowPreferences
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.FollowPreferences)
 		}
 		run(
 			arg0,
@@ -1779,12 +7937,12 @@ func (_c *DatasetRepository_UpdateAPITokenLastUsed_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *DatasetRepository_UpdateAPITokenLastUsed_Call) Return(err error) *DatasetRepository_UpdateAPITokenLastUsed_Call {
+func (_c *DatasetRepository_UpsertFollowPreferences_Call) Return(err error) *DatasetRepository_UpsertFollowPreferences_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *DatasetRepository_UpdateAPITokenLastUsed_Call) RunAndReturn(run func(ctx context.Context, tokenID string) error) *DatasetRepository_UpdateAPITokenLastUsed_Call {
+func (_c *DatasetRepository_UpsertFollowPreferences_Call) RunAndReturn(run func(ctx context.Context, prefs domain.FollowPreferences) error) *DatasetRepository_UpsertFollowPreferences_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1813,19 +7971,25 @@ type DatasetRepository_UpsertPrecomputedRecommendation_Call struct {
 
 // UpsertPrecomputedRecommendation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - articleHashID string
-//   - score float64
-//   - source string
-//   - position int
-//   - generatedAt time.Time
+//   - params datasources.UpsertPrecomputedRecommendationParams
 func (_e *DatasetRepository_Expecter) UpsertPrecomputedRecommendation(ctx interface{}, params interface{}) *DatasetRepository_UpsertPrecomputedRecommendation_Call {
 	return &DatasetRepository_UpsertPrecomputedRecommendation_Call{Call: _e.mock.On("UpsertPrecomputedRecommendation", ctx, params)}
 }
 
 func (_c *DatasetRepository_UpsertPrecomputedRecommendation_Call) Run(run func(ctx context.Context, params datasources.UpsertPrecomputedRecommendationParams)) *DatasetRepository_UpsertPrecomputedRecommendation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(datasources.UpsertPrecomputedRecommendationParams))
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 datasources.UpsertPrecomputedRecommendationParams
+		if args[1] != nil {
+			arg1 = args[1].(datasources.UpsertPrecomputedRecommendationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
@@ -1840,6 +8004,63 @@ func (_c *DatasetRepository_UpsertPrecomputedRecommendation_Call) RunAndReturn(r
 	return _c
 }
 
+// UpsertTagPreferences provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) UpsertTagPreferences(ctx context.Context, prefs domain.TagPreferences) error {
+	ret := _mock.Called(ctx, prefs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertTagPreferences")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TagPreferences) error); ok {
+		r0 = returnFunc(ctx, prefs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_UpsertTagPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertTagPreferences'
+type DatasetRepository_UpsertTagPreferences_Call struct {
+	*mock.Call
+}
+
+// UpsertTagPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefs domain.TagPreferences
+func (_e *DatasetRepository_Expecter) UpsertTagPreferences(ctx interface{}, prefs interface{}) *DatasetRepository_UpsertTagPreferences_Call {
+	return &DatasetRepository_UpsertTagPreferences_Call{Call: _e.mock.On("UpsertTagPreferences", ctx, prefs)}
+}
+
+func (_c *DatasetRepository_UpsertTagPreferences_Call) Run(run func(ctx context.Context, prefs domain.TagPreferences)) *DatasetRepository_UpsertTagPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.TagPreferences
+		if args[1] != nil {
+			arg1 = args[1].(domain.TagPreferences)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_UpsertTagPreferences_Call) Return(err error) *DatasetRepository_UpsertTagPreferences_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_UpsertTagPreferences_Call) RunAndReturn(run func(ctx context.Context, prefs domain.TagPreferences) error) *DatasetRepository_UpsertTagPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertUserInterestCluster provides a mock function for the type DatasetRepository
 func (_mock *DatasetRepository) UpsertUserInterestCluster(ctx context.Context, userID string, clusterID int, centroidVector []float32, articleCount int) error {
 	ret := _mock.Called(ctx, userID, clusterID, centroidVector, articleCount)
@@ -1914,3 +8135,60 @@ func (_c *DatasetRepository_UpsertUserInterestCluster_Call) RunAndReturn(run fun
 	_c.Call.Return(run)
 	return _c
 }
+
+// WriteAuditEvent provides a mock function for the type DatasetRepository
+func (_mock *DatasetRepository) WriteAuditEvent(ctx context.Context, event domain.AuditEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteAuditEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DatasetRepository_WriteAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteAuditEvent'
+type DatasetRepository_WriteAuditEvent_Call struct {
+	*mock.Call
+}
+
+// WriteAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.AuditEvent
+func (_e *DatasetRepository_Expecter) WriteAuditEvent(ctx interface{}, event interface{}) *DatasetRepository_WriteAuditEvent_Call {
+	return &DatasetRepository_WriteAuditEvent_Call{Call: _e.mock.On("WriteAuditEvent", ctx, event)}
+}
+
+func (_c *DatasetRepository_WriteAuditEvent_Call) Run(run func(ctx context.Context, event domain.AuditEvent)) *DatasetRepository_WriteAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuditEvent
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuditEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DatasetRepository_WriteAuditEvent_Call) Return(err error) *DatasetRepository_WriteAuditEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DatasetRepository_WriteAuditEvent_Call) RunAndReturn(run func(ctx context.Context, event domain.AuditEvent) error) *DatasetRepository_WriteAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
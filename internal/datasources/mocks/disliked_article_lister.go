@@ -38,8 +38,8 @@ func (_m *DislikedArticleLister) EXPECT() *DislikedArticleLister_Expecter {
 }
 
 // ListDislikedArticleIDs provides a mock function for the type DislikedArticleLister
-func (_mock *DislikedArticleLister) ListDislikedArticleIDs(ctx context.Context, userID string, page int, pageSize int) ([]string, error) {
-	ret := _mock.Called(ctx, userID, page, pageSize)
+func (_mock *DislikedArticleLister) ListDislikedArticleIDs(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error) {
+	ret := _mock.Called(ctx, userID, tags, page, pageSize)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListDislikedArticleIDs")
@@ -47,18 +47,18 @@ func (_mock *DislikedArticleLister) ListDislikedArticleIDs(ctx context.Context,
 
 	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]string, error)); ok {
-		return returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) ([]string, error)); ok {
+		return returnFunc(ctx, userID, tags, page, pageSize)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []string); ok {
-		r0 = returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) []string); ok {
+		r0 = returnFunc(ctx, userID, tags, page, pageSize)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
-		r1 = returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, tags, page, pageSize)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,13 +73,14 @@ type DislikedArticleLister_ListDislikedArticleIDs_Call struct {
 // ListDislikedArticleIDs is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
+//   - tags []string
 //   - page int
 //   - pageSize int
-func (_e *DislikedArticleLister_Expecter) ListDislikedArticleIDs(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *DislikedArticleLister_ListDislikedArticleIDs_Call {
-	return &DislikedArticleLister_ListDislikedArticleIDs_Call{Call: _e.mock.On("ListDislikedArticleIDs", ctx, userID, page, pageSize)}
+func (_e *DislikedArticleLister_Expecter) ListDislikedArticleIDs(ctx interface{}, userID interface{}, tags interface{}, page interface{}, pageSize interface{}) *DislikedArticleLister_ListDislikedArticleIDs_Call {
+	return &DislikedArticleLister_ListDislikedArticleIDs_Call{Call: _e.mock.On("ListDislikedArticleIDs", ctx, userID, tags, page, pageSize)}
 }
 
-func (_c *DislikedArticleLister_ListDislikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, page int, pageSize int)) *DislikedArticleLister_ListDislikedArticleIDs_Call {
+func (_c *DislikedArticleLister_ListDislikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, tags []string, page int, pageSize int)) *DislikedArticleLister_ListDislikedArticleIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,19 +90,24 @@ func (_c *DislikedArticleLister_ListDislikedArticleIDs_Call) Run(run func(ctx co
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 []string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].([]string)
 		}
 		var arg3 int
 		if args[3] != nil {
 			arg3 = args[3].(int)
 		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -112,7 +118,7 @@ func (_c *DislikedArticleLister_ListDislikedArticleIDs_Call) Return(strings []st
 	return _c
 }
 
-func (_c *DislikedArticleLister_ListDislikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, page int, pageSize int) ([]string, error)) *DislikedArticleLister_ListDislikedArticleIDs_Call {
+func (_c *DislikedArticleLister_ListDislikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error)) *DislikedArticleLister_ListDislikedArticleIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
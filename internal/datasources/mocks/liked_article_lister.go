@@ -38,8 +38,8 @@ func (_m *LikedArticleLister) EXPECT() *LikedArticleLister_Expecter {
 }
 
 // ListLikedArticleIDs provides a mock function for the type LikedArticleLister
-func (_mock *LikedArticleLister) ListLikedArticleIDs(ctx context.Context, userID string, page int, pageSize int) ([]string, error) {
-	ret := _mock.Called(ctx, userID, page, pageSize)
+func (_mock *LikedArticleLister) ListLikedArticleIDs(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error) {
+	ret := _mock.Called(ctx, userID, tags, page, pageSize)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListLikedArticleIDs")
@@ -47,18 +47,18 @@ func (_mock *LikedArticleLister) ListLikedArticleIDs(ctx context.Context, userID
 
 	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]string, error)); ok {
-		return returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) ([]string, error)); ok {
+		return returnFunc(ctx, userID, tags, page, pageSize)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []string); ok {
-		r0 = returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, int) []string); ok {
+		r0 = returnFunc(ctx, userID, tags, page, pageSize)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
-		r1 = returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, tags, page, pageSize)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,13 +73,14 @@ type LikedArticleLister_ListLikedArticleIDs_Call struct {
 // ListLikedArticleIDs is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
+//   - tags []string
 //   - page int
 //   - pageSize int
-func (_e *LikedArticleLister_Expecter) ListLikedArticleIDs(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *LikedArticleLister_ListLikedArticleIDs_Call {
-	return &LikedArticleLister_ListLikedArticleIDs_Call{Call: _e.mock.On("ListLikedArticleIDs", ctx, userID, page, pageSize)}
+func (_e *LikedArticleLister_Expecter) ListLikedArticleIDs(ctx interface{}, userID interface{}, tags interface{}, page interface{}, pageSize interface{}) *LikedArticleLister_ListLikedArticleIDs_Call {
+	return &LikedArticleLister_ListLikedArticleIDs_Call{Call: _e.mock.On("ListLikedArticleIDs", ctx, userID, tags, page, pageSize)}
 }
 
-func (_c *LikedArticleLister_ListLikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, page int, pageSize int)) *LikedArticleLister_ListLikedArticleIDs_Call {
+func (_c *LikedArticleLister_ListLikedArticleIDs_Call) Run(run func(ctx context.Context, userID string, tags []string, page int, pageSize int)) *LikedArticleLister_ListLikedArticleIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,19 +90,24 @@ func (_c *LikedArticleLister_ListLikedArticleIDs_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 []string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].([]string)
 		}
 		var arg3 int
 		if args[3] != nil {
 			arg3 = args[3].(int)
 		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -112,7 +118,7 @@ func (_c *LikedArticleLister_ListLikedArticleIDs_Call) Return(strings []string,
 	return _c
 }
 
-func (_c *LikedArticleLister_ListLikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, page int, pageSize int) ([]string, error)) *LikedArticleLister_ListLikedArticleIDs_Call {
+func (_c *LikedArticleLister_ListLikedArticleIDs_Call) RunAndReturn(run func(ctx context.Context, userID string, tags []string, page int, pageSize int) ([]string, error)) *LikedArticleLister_ListLikedArticleIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
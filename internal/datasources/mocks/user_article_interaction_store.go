@@ -184,6 +184,156 @@ func (_c *UserArticleInteractionStore_GetUserArticleVectorsByType_Call) RunAndRe
 	return _c
 }
 
+// RecordArticleSignal provides a mock function for the type UserArticleInteractionStore
+func (_mock *UserArticleInteractionStore) RecordArticleSignal(ctx context.Context, userID string, articleHashID string, signal domain.ArticleSignal, vector []float32) error {
+	ret := _mock.Called(ctx, userID, articleHashID, signal, vector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordArticleSignal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.ArticleSignal, []float32) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, signal, vector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserArticleInteractionStore_RecordArticleSignal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordArticleSignal'
+type UserArticleInteractionStore_RecordArticleSignal_Call struct {
+	*mock.Call
+}
+
+// RecordArticleSignal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+//   - signal domain.ArticleSignal
+//   - vector []float32
+func (_e *UserArticleInteractionStore_Expecter) RecordArticleSignal(ctx interface{}, userID interface{}, articleHashID interface{}, signal interface{}, vector interface{}) *UserArticleInteractionStore_RecordArticleSignal_Call {
+	return &UserArticleInteractionStore_RecordArticleSignal_Call{Call: _e.mock.On("RecordArticleSignal", ctx, userID, articleHashID, signal, vector)}
+}
+
+func (_c *UserArticleInteractionStore_RecordArticleSignal_Call) Run(run func(ctx context.Context, userID string, articleHashID string, signal domain.ArticleSignal, vector []float32)) *UserArticleInteractionStore_RecordArticleSignal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.ArticleSignal
+		if args[3] != nil {
+			arg3 = args[3].(domain.ArticleSignal)
+		}
+		var arg4 []float32
+		if args[4] != nil {
+			arg4 = args[4].([]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *UserArticleInteractionStore_RecordArticleSignal_Call) Return(err error) *UserArticleInteractionStore_RecordArticleSignal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserArticleInteractionStore_RecordArticleSignal_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, signal domain.ArticleSignal, vector []float32) error) *UserArticleInteractionStore_RecordArticleSignal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetArticleGradedRating provides a mock function for the type UserArticleInteractionStore
+func (_mock *UserArticleInteractionStore) SetArticleGradedRating(ctx context.Context, userID string, articleHashID string, rating int, vector []float32) error {
+	ret := _mock.Called(ctx, userID, articleHashID, rating, vector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArticleGradedRating")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, []float32) error); ok {
+		r0 = returnFunc(ctx, userID, articleHashID, rating, vector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserArticleInteractionStore_SetArticleGradedRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArticleGradedRating'
+type UserArticleInteractionStore_SetArticleGradedRating_Call struct {
+	*mock.Call
+}
+
+// SetArticleGradedRating is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - articleHashID string
+//   - rating int
+//   - vector []float32
+func (_e *UserArticleInteractionStore_Expecter) SetArticleGradedRating(ctx interface{}, userID interface{}, articleHashID interface{}, rating interface{}, vector interface{}) *UserArticleInteractionStore_SetArticleGradedRating_Call {
+	return &UserArticleInteractionStore_SetArticleGradedRating_Call{Call: _e.mock.On("SetArticleGradedRating", ctx, userID, articleHashID, rating, vector)}
+}
+
+func (_c *UserArticleInteractionStore_SetArticleGradedRating_Call) Run(run func(ctx context.Context, userID string, articleHashID string, rating int, vector []float32)) *UserArticleInteractionStore_SetArticleGradedRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 []float32
+		if args[4] != nil {
+			arg4 = args[4].([]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *UserArticleInteractionStore_SetArticleGradedRating_Call) Return(err error) *UserArticleInteractionStore_SetArticleGradedRating_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserArticleInteractionStore_SetArticleGradedRating_Call) RunAndReturn(run func(ctx context.Context, userID string, articleHashID string, rating int, vector []float32) error) *UserArticleInteractionStore_SetArticleGradedRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetArticleRating provides a mock function for the type UserArticleInteractionStore
 func (_mock *UserArticleInteractionStore) SetArticleRating(ctx context.Context, userID string, articleHashID string, thumbsUp *bool, thumbsDown *bool, vector []float32) error {
 	ret := _mock.Called(ctx, userID, articleHashID, thumbsUp, thumbsDown, vector)
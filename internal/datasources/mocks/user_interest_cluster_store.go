@@ -38,6 +38,63 @@ func (_m *UserInterestClusterStore) EXPECT() *UserInterestClusterStore_Expecter
 	return &UserInterestClusterStore_Expecter{mock: &_m.Mock}
 }
 
+// DeleteUserComputedInterestClusters provides a mock function for the type UserInterestClusterStore
+func (_mock *UserInterestClusterStore) DeleteUserComputedInterestClusters(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserComputedInterestClusters")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserInterestClusterStore_DeleteUserComputedInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserComputedInterestClusters'
+type UserInterestClusterStore_DeleteUserComputedInterestClusters_Call struct {
+	*mock.Call
+}
+
+// DeleteUserComputedInterestClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserInterestClusterStore_Expecter) DeleteUserComputedInterestClusters(ctx interface{}, userID interface{}) *UserInterestClusterStore_DeleteUserComputedInterestClusters_Call {
+	return &UserInterestClusterStore_DeleteUserComputedInterestClusters_Call{Call: _e.mock.On("DeleteUserComputedInterestClusters", ctx, userID)}
+}
+
+func (_c *UserInterestClusterStore_DeleteUserComputedInterestClusters_Call) Run(run func(ctx context.Context, userID string)) *UserInterestClusterStore_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserInterestClusterStore_DeleteUserComputedInterestClusters_Call) Return(err error) *UserInterestClusterStore_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserInterestClusterStore_DeleteUserComputedInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *UserInterestClusterStore_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserInterestClusters provides a mock function for the type UserInterestClusterStore
 func (_mock *UserInterestClusterStore) DeleteUserInterestClusters(ctx context.Context, userID string) error {
 	ret := _mock.Called(ctx, userID)
@@ -163,6 +220,69 @@ func (_c *UserInterestClusterStore_GetUserInterestClusters_Call) RunAndReturn(ru
 	return _c
 }
 
+// SeedUserInterestClusters provides a mock function for the type UserInterestClusterStore
+func (_mock *UserInterestClusterStore) SeedUserInterestClusters(ctx context.Context, userID string, seedVectors [][]float32) error {
+	ret := _mock.Called(ctx, userID, seedVectors)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SeedUserInterestClusters")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, [][]float32) error); ok {
+		r0 = returnFunc(ctx, userID, seedVectors)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserInterestClusterStore_SeedUserInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeedUserInterestClusters'
+type UserInterestClusterStore_SeedUserInterestClusters_Call struct {
+	*mock.Call
+}
+
+// SeedUserInterestClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - seedVectors [][]float32
+func (_e *UserInterestClusterStore_Expecter) SeedUserInterestClusters(ctx interface{}, userID interface{}, seedVectors interface{}) *UserInterestClusterStore_SeedUserInterestClusters_Call {
+	return &UserInterestClusterStore_SeedUserInterestClusters_Call{Call: _e.mock.On("SeedUserInterestClusters", ctx, userID, seedVectors)}
+}
+
+func (_c *UserInterestClusterStore_SeedUserInterestClusters_Call) Run(run func(ctx context.Context, userID string, seedVectors [][]float32)) *UserInterestClusterStore_SeedUserInterestClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 [][]float32
+		if args[2] != nil {
+			arg2 = args[2].([][]float32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserInterestClusterStore_SeedUserInterestClusters_Call) Return(err error) *UserInterestClusterStore_SeedUserInterestClusters_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserInterestClusterStore_SeedUserInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string, seedVectors [][]float32) error) *UserInterestClusterStore_SeedUserInterestClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertUserInterestCluster provides a mock function for the type UserInterestClusterStore
 func (_mock *UserInterestClusterStore) UpsertUserInterestCluster(ctx context.Context, userID string, clusterID int, centroidVector []float32, articleCount int) error {
 	ret := _mock.Called(ctx, userID, clusterID, centroidVector, articleCount)
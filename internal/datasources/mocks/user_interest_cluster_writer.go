@@ -37,6 +37,63 @@ func (_m *UserInterestClusterWriter) EXPECT() *UserInterestClusterWriter_Expecte
 	return &UserInterestClusterWriter_Expecter{mock: &_m.Mock}
 }
 
+// DeleteUserComputedInterestClusters provides a mock function for the type UserInterestClusterWriter
+func (_mock *UserInterestClusterWriter) DeleteUserComputedInterestClusters(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserComputedInterestClusters")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserComputedInterestClusters'
+type UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call struct {
+	*mock.Call
+}
+
+// DeleteUserComputedInterestClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserInterestClusterWriter_Expecter) DeleteUserComputedInterestClusters(ctx interface{}, userID interface{}) *UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call {
+	return &UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call{Call: _e.mock.On("DeleteUserComputedInterestClusters", ctx, userID)}
+}
+
+func (_c *UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call) Run(run func(ctx context.Context, userID string)) *UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call) Return(err error) *UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *UserInterestClusterWriter_DeleteUserComputedInterestClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserInterestClusters provides a mock function for the type UserInterestClusterWriter
 func (_mock *UserInterestClusterWriter) DeleteUserInterestClusters(ctx context.Context, userID string) error {
 	ret := _mock.Called(ctx, userID)
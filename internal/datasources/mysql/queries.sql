@@ -34,6 +34,29 @@ LEFT JOIN user_article_interactions
         AND user_article_interactions.user_id = ?
 WHERE hash_id IN (sqlc.slice('hash_ids'));
 
+-- name: GetArticlePublishedDates :many
+SELECT hash_id, date_published
+FROM articles
+WHERE hash_id IN (sqlc.slice('hash_ids'))
+    AND date_published IS NOT NULL;
+
+-- name: FindArticlesByURLs :many
+SELECT hash_id, url
+FROM articles
+WHERE url IN (sqlc.slice('urls'));
+
+-- name: FindArticlesByNormalizedTitles :many
+SELECT hash_id, title
+FROM articles
+WHERE normalized_title IN (sqlc.slice('titles'));
+
+-- name: ListArticleCategories :many
+SELECT category, COUNT(*) AS article_count
+FROM articles
+WHERE category IS NOT NULL AND category <> ''
+GROUP BY category
+ORDER BY article_count DESC, category;
+
 -- name: ListThumbsUpArticleIDs :many
 SELECT article_hash_id FROM user_article_interactions
 WHERE user_id = ? AND thumbs_up = TRUE;
@@ -76,45 +99,103 @@ LIMIT ? OFFSET ?;
 SELECT article_hash_id FROM user_article_interactions
 WHERE user_id = ? AND have_read = TRUE;
 
+-- name: SetArticleDismissed :exec
+INSERT INTO user_article_interactions (
+        user_id,
+        article_hash_id,
+        have_read,
+        thumbs_up,
+        thumbs_down,
+        dismissed,
+        date_dismissed
+    ) VALUES (?, ?, FALSE, FALSE, FALSE, sqlc.arg(dismissed), sqlc.arg(date_dismissed))
+ON DUPLICATE KEY UPDATE
+    dismissed = sqlc.arg(dismissed),
+    date_dismissed = sqlc.arg(date_dismissed);
+
+-- name: SetArticleSnoozedUntil :exec
+INSERT INTO user_article_interactions (
+        user_id,
+        article_hash_id,
+        have_read,
+        thumbs_up,
+        thumbs_down,
+        snoozed_until
+    ) VALUES (?, ?, FALSE, FALSE, FALSE, sqlc.arg(snoozed_until))
+ON DUPLICATE KEY UPDATE
+    snoozed_until = sqlc.arg(snoozed_until);
+
+-- name: ListHiddenArticleIDs :many
+SELECT article_hash_id FROM user_article_interactions
+WHERE user_id = ? AND (dismissed = TRUE OR snoozed_until > NOW());
+
 -- ============================================
 -- User Article Interactions (unified table)
 -- ============================================
 
 -- name: GetUserArticleInteraction :one
-SELECT user_id, article_hash_id, have_read, thumbs_up, thumbs_down,
-       date_read, date_rated, `vector`
+SELECT have_read, thumbs_up, thumbs_down, rating, date_read, date_rated
 FROM user_article_interactions
 WHERE user_id = ? AND article_hash_id = ?;
 
 -- name: UpsertUserArticleInteraction :exec
 INSERT INTO user_article_interactions (
-    user_id, article_hash_id, have_read, thumbs_up, thumbs_down,
+    user_id, article_hash_id, have_read, thumbs_up, thumbs_down, rating,
     date_read, date_rated, `vector`
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     have_read = VALUES(have_read),
     thumbs_up = VALUES(thumbs_up),
     thumbs_down = VALUES(thumbs_down),
+    rating = VALUES(rating),
     date_read = VALUES(date_read),
     date_rated = VALUES(date_rated),
     `vector` = VALUES(`vector`);
 
--- name: GetUserArticleVectorsByThumbsUp :many
-SELECT article_hash_id, `vector`, date_rated
+-- name: RecordArticleEngagement :exec
+INSERT INTO user_article_interactions (
+        user_id,
+        article_hash_id,
+        have_read,
+        thumbs_up,
+        thumbs_down,
+        opened_link,
+        time_on_article_seconds,
+        saved_to_collection,
+        date_engaged,
+        `vector`
+    ) VALUES (
+        ?, ?, FALSE, FALSE, FALSE,
+        sqlc.arg(opened_link), sqlc.arg(time_on_article_seconds), sqlc.arg(saved_to_collection),
+        sqlc.arg(date_engaged), sqlc.arg(vector)
+    )
+ON DUPLICATE KEY UPDATE
+    opened_link = opened_link OR sqlc.arg(opened_link),
+    time_on_article_seconds = LEAST(time_on_article_seconds + sqlc.arg(time_on_article_seconds), 86400),
+    saved_to_collection = saved_to_collection OR sqlc.arg(saved_to_collection),
+    date_engaged = sqlc.arg(date_engaged),
+    `vector` = COALESCE(`vector`, sqlc.arg(vector));
+
+-- name: GetUserArticleVectorsByPositiveSignals :many
+SELECT article_hash_id, `vector`, thumbs_up, thumbs_down, rating, opened_link,
+       time_on_article_seconds, saved_to_collection, date_rated, date_engaged
 FROM user_article_interactions
-WHERE user_id = ? AND thumbs_up = TRUE AND `vector` IS NOT NULL
-ORDER BY date_rated DESC;
+WHERE user_id = ? AND thumbs_down = FALSE AND dismissed = FALSE AND `vector` IS NOT NULL
+    AND (thumbs_up = TRUE OR opened_link = TRUE OR time_on_article_seconds > 0 OR saved_to_collection = TRUE)
+ORDER BY COALESCE(date_rated, date_engaged) DESC;
 
 -- name: GetUserArticleVectorsByThumbsDown :many
-SELECT article_hash_id, `vector`, date_rated
+SELECT article_hash_id, `vector`, thumbs_up, thumbs_down, rating, opened_link,
+       time_on_article_seconds, saved_to_collection, date_rated, date_engaged
 FROM user_article_interactions
 WHERE user_id = ? AND thumbs_down = TRUE AND `vector` IS NOT NULL
 ORDER BY date_rated DESC;
 
--- name: CountUserArticleVectorsByThumbsUp :one
+-- name: CountUserArticleVectorsByPositiveSignals :one
 SELECT COUNT(*) as count
 FROM user_article_interactions
-WHERE user_id = ? AND thumbs_up = TRUE AND `vector` IS NOT NULL;
+WHERE user_id = ? AND thumbs_down = FALSE AND dismissed = FALSE AND `vector` IS NOT NULL
+    AND (thumbs_up = TRUE OR opened_link = TRUE OR time_on_article_seconds > 0 OR saved_to_collection = TRUE);
 
 -- name: CountUserArticleVectorsByThumbsDown :one
 SELECT COUNT(*) as count
@@ -131,10 +212,15 @@ VALUES (?, ?, ?, ?, NOW())
 ON DUPLICATE KEY UPDATE
     centroid_vector = VALUES(centroid_vector),
     article_count = VALUES(article_count),
+    provisional = FALSE,
     updated_at = NOW();
 
+-- name: InsertProvisionalUserInterestCluster :exec
+INSERT INTO user_interest_clusters (user_id, cluster_id, centroid_vector, article_count, provisional, updated_at)
+VALUES (?, ?, ?, 0, TRUE, NOW());
+
 -- name: GetUserInterestClusters :many
-SELECT cluster_id, centroid_vector, article_count, updated_at
+SELECT cluster_id, centroid_vector, article_count, provisional, updated_at
 FROM user_interest_clusters
 WHERE user_id = ?
 ORDER BY cluster_id;
@@ -143,16 +229,23 @@ ORDER BY cluster_id;
 DELETE FROM user_interest_clusters
 WHERE user_id = ?;
 
+-- name: DeleteUserComputedInterestClusters :exec
+DELETE FROM user_interest_clusters
+WHERE user_id = ? AND provisional = FALSE;
+
 -- ============================================
 -- Precomputed Recommendations
 -- ============================================
 
 -- name: UpsertPrecomputedRecommendation :exec
-INSERT INTO user_precomputed_recommendations (user_id, article_hash_id, score, source, position, generated_at)
-VALUES (?, ?, ?, ?, ?, ?)
+INSERT INTO user_precomputed_recommendations (
+    user_id, article_hash_id, score, source, experiment_arm, position, generated_at
+)
+VALUES (?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     score = VALUES(score),
     source = VALUES(source),
+    experiment_arm = VALUES(experiment_arm),
     position = VALUES(position),
     generated_at = VALUES(generated_at);
 
@@ -161,7 +254,7 @@ DELETE FROM user_precomputed_recommendations
 WHERE user_id = ?;
 
 -- name: GetPrecomputedRecommendations :many
-SELECT article_hash_id, score, source, position, generated_at
+SELECT article_hash_id, score, source, experiment_arm, position, generated_at
 FROM user_precomputed_recommendations
 WHERE user_id = ?
 ORDER BY position ASC
@@ -214,11 +307,12 @@ ORDER BY last_rating_at ASC;
 -- ============================================
 
 -- name: CreateAPIToken :exec
-INSERT INTO api_tokens (id, user_id, token_hash, token_prefix, name, created_at, expires_at)
-VALUES (?, ?, ?, ?, ?, NOW(), ?);
+INSERT INTO api_tokens (id, user_id, token_hash, token_prefix, name, created_at, expires_at, scopes)
+VALUES (?, ?, ?, ?, ?, NOW(), ?, ?);
 
 -- name: GetAPITokenByHash :one
-SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at
+SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at, scopes,
+    replaced_by_id
 FROM api_tokens
 WHERE token_hash = ?;
 
@@ -228,7 +322,8 @@ SET last_used_at = NOW()
 WHERE id = ?;
 
 -- name: ListUserAPITokens :many
-SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at
+SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at, scopes,
+    replaced_by_id
 FROM api_tokens
 WHERE user_id = ?
 ORDER BY created_at DESC;
@@ -236,9 +331,763 @@ ORDER BY created_at DESC;
 -- name: CountUserActiveAPITokens :one
 SELECT COUNT(*) as count
 FROM api_tokens
-WHERE user_id = ? AND revoked_at IS NULL;
+WHERE user_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW());
 
--- name: RevokeAPIToken :exec
+-- name: RevokeAPIToken :execrows
 UPDATE api_tokens
 SET revoked_at = NOW()
+WHERE id = ? AND user_id = ? AND revoked_at IS NULL;
+
+-- name: GetUserAPIToken :one
+SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at, scopes,
+    replaced_by_id
+FROM api_tokens
+WHERE id = ? AND user_id = ?;
+
+-- name: RenameAPIToken :exec
+UPDATE api_tokens
+SET name = ?
 WHERE id = ? AND user_id = ?;
+
+-- name: SetAPITokenReplacement :execrows
+UPDATE api_tokens
+SET replaced_by_id = ?, expires_at = ?
+WHERE id = ? AND user_id = ? AND replaced_by_id IS NULL AND revoked_at IS NULL;
+
+-- name: RevokeExpiredAPITokens :execrows
+UPDATE api_tokens
+SET revoked_at = expires_at
+WHERE revoked_at IS NULL AND expires_at <= NOW();
+
+-- ============================================
+-- Digest Preferences
+-- ============================================
+
+-- name: GetDigestPreferences :one
+SELECT user_id, email, pending_email, email_confirmation_token, frequency, categories, enabled, unsubscribe_token,
+    last_sent_at
+FROM user_digest_preferences
+WHERE user_id = ?;
+
+-- name: UpsertDigestPreferences :exec
+INSERT INTO user_digest_preferences (
+    user_id, email, pending_email, email_confirmation_token, frequency, categories, enabled, unsubscribe_token,
+    created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    email = VALUES(email),
+    pending_email = VALUES(pending_email),
+    email_confirmation_token = VALUES(email_confirmation_token),
+    frequency = VALUES(frequency),
+    categories = VALUES(categories),
+    enabled = VALUES(enabled),
+    updated_at = NOW();
+
+-- name: ListEnabledDigestPreferences :many
+SELECT user_id, email, pending_email, email_confirmation_token, frequency, categories, enabled, unsubscribe_token,
+    last_sent_at
+FROM user_digest_preferences
+WHERE enabled = TRUE;
+
+-- name: ConfirmDigestEmail :execrows
+UPDATE user_digest_preferences
+SET email = pending_email, pending_email = NULL, email_confirmation_token = NULL, updated_at = NOW()
+WHERE email_confirmation_token = ? AND pending_email IS NOT NULL;
+
+-- name: GetDigestUserIDByUnsubscribeToken :one
+SELECT user_id
+FROM user_digest_preferences
+WHERE unsubscribe_token = ?;
+
+-- name: DisableDigest :exec
+UPDATE user_digest_preferences
+SET enabled = FALSE, updated_at = NOW()
+WHERE user_id = ?;
+
+-- name: MarkDigestSent :exec
+UPDATE user_digest_preferences
+SET last_sent_at = ?
+WHERE user_id = ?;
+
+-- ============================================
+-- Saved Searches
+-- ============================================
+
+-- name: CreateSavedSearch :exec
+INSERT INTO saved_searches (id, user_id, name, kind, filters, query_text, query_vector, feed_token, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW());
+
+-- name: GetSavedSearch :one
+SELECT id, user_id, name, kind, filters, query_text, query_vector, feed_token, last_viewed_at, created_at, updated_at
+FROM saved_searches
+WHERE id = ? AND user_id = ?;
+
+-- name: GetSavedSearchByFeedToken :one
+SELECT id, user_id, name, kind, filters, query_text, query_vector, feed_token, last_viewed_at, created_at, updated_at
+FROM saved_searches
+WHERE feed_token = ?;
+
+-- name: ListUserSavedSearches :many
+SELECT id, user_id, name, kind, filters, query_text, query_vector, feed_token, last_viewed_at, created_at, updated_at
+FROM saved_searches
+WHERE user_id = ?
+ORDER BY created_at DESC;
+
+-- name: CountUserSavedSearches :one
+SELECT COUNT(*) as count
+FROM saved_searches
+WHERE user_id = ?;
+
+-- name: UpdateSavedSearch :exec
+UPDATE saved_searches
+SET name = ?, kind = ?, filters = ?, query_text = ?, query_vector = ?, updated_at = NOW()
+WHERE id = ? AND user_id = ?;
+
+-- name: DeleteSavedSearch :exec
+DELETE FROM saved_searches
+WHERE id = ? AND user_id = ?;
+
+-- name: MarkSavedSearchViewed :exec
+UPDATE saved_searches
+SET last_viewed_at = ?
+WHERE id = ? AND user_id = ?;
+
+-- ============================================
+-- Collections
+-- ============================================
+
+-- name: CreateCollection :exec
+INSERT INTO collections (id, user_id, name, is_public, share_token, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, NOW(), NOW());
+
+-- name: GetCollection :one
+SELECT c.id, c.user_id, c.name, c.is_public, c.share_token, c.created_at, c.updated_at,
+       COUNT(ca.article_hash_id) AS article_count
+FROM collections c
+LEFT JOIN collection_articles ca ON ca.collection_id = c.id
+WHERE c.id = ? AND c.user_id = ?
+GROUP BY c.id;
+
+-- name: GetCollectionByShareToken :one
+SELECT c.id, c.user_id, c.name, c.is_public, c.share_token, c.created_at, c.updated_at,
+       COUNT(ca.article_hash_id) AS article_count
+FROM collections c
+LEFT JOIN collection_articles ca ON ca.collection_id = c.id
+WHERE c.share_token = ?
+GROUP BY c.id;
+
+-- name: ListUserCollections :many
+SELECT c.id, c.user_id, c.name, c.is_public, c.share_token, c.created_at, c.updated_at,
+       COUNT(ca.article_hash_id) AS article_count
+FROM collections c
+LEFT JOIN collection_articles ca ON ca.collection_id = c.id
+WHERE c.user_id = ?
+GROUP BY c.id
+ORDER BY c.created_at DESC;
+
+-- name: CountUserCollections :one
+SELECT COUNT(*) as count
+FROM collections
+WHERE user_id = ?;
+
+-- name: UpdateCollection :exec
+UPDATE collections
+SET name = ?, is_public = ?, updated_at = NOW()
+WHERE id = ? AND user_id = ?;
+
+-- name: TouchCollection :exec
+UPDATE collections
+SET updated_at = NOW()
+WHERE id = ?;
+
+-- name: DeleteCollection :exec
+DELETE FROM collections
+WHERE id = ? AND user_id = ?;
+
+-- name: GetCollectionNextPosition :one
+SELECT CAST(COALESCE(MAX(position) + 1, 0) AS SIGNED) AS next_position
+FROM collection_articles
+WHERE collection_id = ?;
+
+-- name: AddCollectionArticle :exec
+INSERT IGNORE INTO collection_articles (collection_id, article_hash_id, position, added_at)
+VALUES (?, ?, ?, NOW());
+
+-- name: RemoveCollectionArticle :exec
+DELETE FROM collection_articles
+WHERE collection_id = ? AND article_hash_id = ?;
+
+-- name: ListCollectionArticleIDs :many
+SELECT article_hash_id
+FROM collection_articles
+WHERE collection_id = ?
+ORDER BY position, added_at;
+
+-- name: SetCollectionArticlePosition :exec
+UPDATE collection_articles
+SET position = ?
+WHERE collection_id = ? AND article_hash_id = ?;
+
+-- ============================================
+-- Article Notes
+-- ============================================
+
+-- name: CreateArticleNote :exec
+INSERT INTO article_notes (id, user_id, article_hash_id, body, quote, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, NOW(), NOW());
+
+-- name: GetArticleNote :one
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE id = ? AND user_id = ?;
+
+-- name: ListArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = ? AND article_hash_id = ?
+ORDER BY created_at;
+
+-- name: ListUserArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = ?
+ORDER BY updated_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: SearchUserArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = sqlc.arg(user_id)
+    AND MATCH (body, quote) AGAINST (sqlc.arg(query))
+ORDER BY MATCH (body, quote) AGAINST (sqlc.arg(query)) DESC, updated_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: UpdateArticleNote :exec
+UPDATE article_notes
+SET body = ?, quote = ?, updated_at = NOW()
+WHERE id = ? AND user_id = ?;
+
+-- name: DeleteArticleNote :exec
+DELETE FROM article_notes
+WHERE id = ? AND user_id = ?;
+
+-- ============================================
+-- User Article Tags
+-- ============================================
+
+-- name: TagArticle :exec
+INSERT IGNORE INTO user_article_tags (user_id, article_hash_id, tag, created_at)
+VALUES (?, ?, ?, NOW());
+
+-- name: UntagArticle :exec
+DELETE FROM user_article_tags
+WHERE user_id = ? AND article_hash_id = ? AND tag = ?;
+
+-- name: ListArticleTags :many
+SELECT tag FROM user_article_tags
+WHERE user_id = ? AND article_hash_id = ?
+ORDER BY tag;
+
+-- name: ListUserTags :many
+SELECT tag, COUNT(*) AS article_count
+FROM user_article_tags
+WHERE user_id = ?
+GROUP BY tag
+ORDER BY article_count DESC, tag;
+
+-- name: ListTaggedArticleIDs :many
+SELECT article_hash_id FROM user_article_tags
+WHERE user_id = ? AND tag = ?
+ORDER BY created_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListTagCooccurringArticles :many
+SELECT other.article_hash_id, COUNT(*) AS shared_count
+FROM user_article_tags mine
+JOIN user_article_tags other
+    ON other.tag = mine.tag AND other.user_id != mine.user_id
+JOIN user_tag_preferences sharing
+    ON sharing.user_id = other.user_id AND sharing.share_for_recommendations
+WHERE mine.user_id = sqlc.arg(user_id)
+    AND other.article_hash_id NOT IN (
+        SELECT article_hash_id FROM user_article_tags WHERE user_id = sqlc.arg(user_id)
+    )
+GROUP BY other.article_hash_id
+ORDER BY shared_count DESC
+LIMIT ?;
+
+-- name: GetTagPreferences :one
+SELECT user_id, share_for_recommendations
+FROM user_tag_preferences
+WHERE user_id = ?;
+
+-- name: UpsertTagPreferences :exec
+INSERT INTO user_tag_preferences (user_id, share_for_recommendations, created_at, updated_at)
+VALUES (?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    share_for_recommendations = VALUES(share_for_recommendations),
+    updated_at = NOW();
+
+-- ============================================
+-- User Follows
+-- ============================================
+
+-- name: FollowItem :exec
+INSERT IGNORE INTO user_follows (user_id, follow_type, target, created_at)
+VALUES (?, ?, ?, NOW());
+
+-- name: UnfollowItem :exec
+DELETE FROM user_follows
+WHERE user_id = ? AND follow_type = ? AND target = ?;
+
+-- name: ListUserFollows :many
+SELECT follow_type, target, created_at
+FROM user_follows
+WHERE user_id = ?
+ORDER BY follow_type, target;
+
+-- name: RenameFollowTarget :exec
+UPDATE IGNORE user_follows
+SET target = sqlc.arg(new_target)
+WHERE follow_type = sqlc.arg(follow_type) AND target = sqlc.arg(old_target);
+
+-- name: DeleteFollowTarget :exec
+DELETE FROM user_follows
+WHERE follow_type = ? AND target = ?;
+
+-- name: GetFollowPreferences :one
+SELECT user_id, include_in_recommendations, feed_token
+FROM user_follow_preferences
+WHERE user_id = ?;
+
+-- name: GetFollowPreferencesByFeedToken :one
+SELECT user_id, include_in_recommendations, feed_token
+FROM user_follow_preferences
+WHERE feed_token = ?;
+
+-- name: CreateFollowPreferences :exec
+INSERT IGNORE INTO user_follow_preferences (user_id, feed_token, created_at, updated_at)
+VALUES (?, ?, NOW(), NOW());
+
+-- name: UpsertFollowPreferences :exec
+INSERT INTO user_follow_preferences (user_id, include_in_recommendations, feed_token, created_at, updated_at)
+VALUES (?, ?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    include_in_recommendations = VALUES(include_in_recommendations),
+    updated_at = NOW();
+
+-- ============================================
+-- Audit Events
+-- ============================================
+
+-- name: InsertAuditEvent :exec
+INSERT INTO audit_events (user_id, event_type, outcome, token_id, ip_address, user_agent, detail, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, NOW());
+
+-- name: ListUserAuditEvents :many
+SELECT id, user_id, event_type, outcome, token_id, ip_address, user_agent, detail, created_at
+FROM audit_events
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- ============================================
+-- Rate Limit Buckets
+-- ============================================
+
+-- name: EnsureRateLimitBucket :exec
+INSERT IGNORE INTO rate_limit_buckets (bucket_key, tokens, updated_at)
+VALUES (?, ?, ?);
+
+-- name: GetRateLimitBucketForUpdate :one
+SELECT tokens, updated_at
+FROM rate_limit_buckets
+WHERE bucket_key = ?
+FOR UPDATE;
+
+-- name: UpdateRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET tokens = ?, updated_at = ?
+WHERE bucket_key = ?;
+
+-- name: DeleteRateLimitBucketsUpdatedBefore :execrows
+DELETE FROM rate_limit_buckets
+WHERE updated_at < ?;
+
+-- ============================================
+-- User Onboarding
+-- ============================================
+
+-- name: GetUserOnboardingInterests :one
+SELECT categories, interests, updated_at
+FROM user_onboarding_interests
+WHERE user_id = ?;
+
+-- name: UpsertUserOnboardingInterests :exec
+INSERT INTO user_onboarding_interests (user_id, categories, interests, created_at, updated_at)
+VALUES (?, ?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    categories = VALUES(categories),
+    interests = VALUES(interests),
+    updated_at = NOW();
+
+-- ============================================
+-- Article Popularity
+-- ============================================
+
+-- name: AggregateArticlePopularity :many
+SELECT article_hash_id,
+    COUNT(CASE WHEN thumbs_up = TRUE AND date_rated >= sqlc.arg(since) THEN 1 END) AS like_count,
+    COUNT(CASE WHEN thumbs_down = TRUE AND date_rated >= sqlc.arg(since) THEN 1 END) AS dislike_count,
+    COUNT(CASE WHEN have_read = TRUE AND date_read >= sqlc.arg(since) THEN 1 END) AS read_count,
+    COUNT(*) AS user_count
+FROM user_article_interactions
+WHERE date_rated >= sqlc.arg(since) OR date_read >= sqlc.arg(since)
+GROUP BY article_hash_id
+HAVING user_count >= sqlc.arg(min_users);
+
+-- name: DeleteArticlePopularityWindow :exec
+DELETE FROM article_popularity WHERE window_days = ?;
+
+-- name: InsertArticlePopularity :exec
+INSERT INTO article_popularity
+    (article_hash_id, window_days, like_count, dislike_count, read_count, user_count, score, computed_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, NOW());
+
+-- name: ListPopularArticles :many
+SELECT article_hash_id, like_count, dislike_count, read_count, user_count, score
+FROM article_popularity
+WHERE window_days = ?
+ORDER BY score DESC, article_hash_id
+LIMIT ? OFFSET ?;
+
+-- name: GetArticlePopularityByIDs :many
+SELECT article_hash_id, like_count, dislike_count, read_count, user_count, score
+FROM article_popularity
+WHERE window_days = ? AND article_hash_id IN (sqlc.slice(hash_ids));
+
+-- ============================================
+-- Article Neighbours
+-- ============================================
+
+-- name: ListArticleCoLikes :many
+SELECT a.article_hash_id, b.article_hash_id AS neighbour_hash_id, COUNT(*) AS co_like_count
+FROM user_article_interactions a
+JOIN user_article_interactions b
+    ON b.user_id = a.user_id AND b.article_hash_id != a.article_hash_id AND b.thumbs_up = TRUE
+WHERE a.thumbs_up = TRUE
+GROUP BY a.article_hash_id, b.article_hash_id
+HAVING co_like_count >= sqlc.arg(min_co_likes);
+
+-- name: CountArticleLikes :many
+SELECT article_hash_id, COUNT(*) AS like_count
+FROM user_article_interactions
+WHERE thumbs_up = TRUE
+GROUP BY article_hash_id;
+
+-- name: DeleteArticleNeighbours :exec
+DELETE FROM article_neighbours;
+
+-- name: InsertArticleNeighbour :exec
+INSERT INTO article_neighbours (article_hash_id, neighbour_hash_id, co_like_count, score, computed_at)
+VALUES (?, ?, ?, ?, NOW());
+
+-- name: DeleteArticleDuplicates :exec
+DELETE FROM article_duplicates;
+
+-- name: InsertArticleDuplicate :exec
+INSERT INTO article_duplicates (variant_hash_id, canonical_hash_id, detected_at)
+VALUES (?, ?, NOW());
+
+-- name: ListArticleDuplicates :many
+SELECT canonical_hash_id, variant_hash_id
+FROM article_duplicates
+ORDER BY canonical_hash_id, variant_hash_id;
+
+-- name: GetCanonicalArticleIDs :many
+SELECT variant_hash_id, canonical_hash_id
+FROM article_duplicates
+WHERE variant_hash_id IN (sqlc.slice('hash_ids'));
+
+-- name: ListArticleAlternates :many
+SELECT d.canonical_hash_id, a.hash_id, a.url, a.source
+FROM article_duplicates d
+JOIN articles a ON a.hash_id = d.variant_hash_id
+WHERE d.canonical_hash_id IN (sqlc.slice('hash_ids'))
+ORDER BY a.date_published, a.hash_id;
+
+-- name: ListArticleAuthorLists :many
+SELECT hash_id, authors
+FROM articles;
+
+-- name: ListAuthorNames :many
+SELECT id AS author_id, name
+FROM authors
+UNION ALL
+SELECT author_id, alias
+FROM author_aliases;
+
+-- name: DeleteAuthorAliases :exec
+DELETE FROM author_aliases;
+
+-- name: DeleteArticleAuthors :exec
+DELETE FROM article_authors;
+
+-- name: DeleteUncreditedAuthors :exec
+DELETE FROM authors
+WHERE id NOT IN (SELECT author_id FROM article_authors);
+
+-- name: UpsertAuthor :exec
+INSERT INTO authors (id, name)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE
+    name = VALUES(name);
+
+-- name: InsertAuthorAlias :exec
+INSERT INTO author_aliases (author_id, alias)
+VALUES (?, ?);
+
+-- name: InsertArticleAuthor :exec
+INSERT INTO article_authors (article_hash_id, author_id, position)
+VALUES (?, ?, ?);
+
+-- name: GetAuthor :one
+SELECT a.id, a.name,
+    (SELECT COUNT(*) FROM article_authors aa
+     WHERE aa.author_id = a.id
+        AND aa.article_hash_id NOT IN (SELECT variant_hash_id FROM article_duplicates)) AS article_count
+FROM authors a
+WHERE a.id = ?;
+
+-- name: ListAuthorAliases :many
+SELECT alias
+FROM author_aliases
+WHERE author_id = ?
+ORDER BY alias;
+
+-- name: ListAuthorArticleIDs :many
+SELECT aa.article_hash_id
+FROM article_authors aa
+JOIN articles a ON a.hash_id = aa.article_hash_id
+WHERE aa.author_id = ?
+    AND aa.article_hash_id NOT IN (SELECT variant_hash_id FROM article_duplicates)
+ORDER BY a.date_published DESC, aa.article_hash_id
+LIMIT ? OFFSET ?;
+
+-- name: ListCoAuthors :many
+SELECT a.id, a.name, COUNT(*) AS shared_articles
+FROM article_authors mine
+JOIN article_authors theirs
+    ON theirs.article_hash_id = mine.article_hash_id AND theirs.author_id <> mine.author_id
+JOIN authors a ON a.id = theirs.author_id
+WHERE mine.author_id = ?
+    AND mine.article_hash_id NOT IN (SELECT variant_hash_id FROM article_duplicates)
+GROUP BY a.id, a.name
+ORDER BY shared_articles DESC, a.name
+LIMIT ?;
+
+-- name: ListArticleAuthorRefs :many
+SELECT aa.article_hash_id, a.id, a.name
+FROM article_authors aa
+JOIN authors a ON a.id = aa.author_id
+WHERE aa.article_hash_id IN (sqlc.slice('hash_ids'))
+ORDER BY aa.article_hash_id, aa.position;
+
+-- name: ListCollaborativeCandidates :many
+SELECT n.neighbour_hash_id, SUM(n.score) AS score
+FROM user_article_interactions i
+JOIN article_neighbours n ON n.article_hash_id = i.article_hash_id
+WHERE i.user_id = sqlc.arg(user_id) AND i.thumbs_up = TRUE
+    AND n.neighbour_hash_id NOT IN (
+        SELECT article_hash_id FROM user_article_interactions
+        WHERE user_id = sqlc.arg(user_id) AND (thumbs_up = TRUE OR thumbs_down = TRUE)
+    )
+GROUP BY n.neighbour_hash_id
+ORDER BY score DESC, n.neighbour_hash_id
+LIMIT ?;
+
+-- ============================================
+-- Recommendation Experiments
+-- ============================================
+
+-- name: InsertRecommendationImpression :exec
+INSERT INTO recommendation_impressions
+    (user_id, article_hash_id, experiment_arm, source, position, served_at)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: ReportExperimentArms :many
+SELECT
+    i.experiment_arm,
+    COUNT(DISTINCT i.user_id) AS user_count,
+    COUNT(*) AS shown_count,
+    COUNT(CASE WHEN uai.date_read >= i.first_served_at THEN 1 END) AS read_count,
+    COUNT(CASE WHEN uai.thumbs_up AND uai.date_rated >= i.first_served_at THEN 1 END) AS liked_count
+FROM (
+    SELECT user_id, article_hash_id, experiment_arm, MIN(served_at) AS first_served_at
+    FROM recommendation_impressions
+    WHERE served_at >= ?
+    GROUP BY user_id, article_hash_id, experiment_arm
+) i
+LEFT JOIN user_article_interactions uai
+    ON uai.user_id = i.user_id AND uai.article_hash_id = i.article_hash_id
+GROUP BY i.experiment_arm
+ORDER BY i.experiment_arm;
+
+-- name: CountIgnoredRecommendations :many
+SELECT i.article_hash_id, COUNT(DISTINCT DATE(i.served_at)) AS shown_days
+FROM recommendation_impressions i
+LEFT JOIN user_article_interactions uai
+    ON uai.user_id = i.user_id AND uai.article_hash_id = i.article_hash_id
+WHERE i.user_id = sqlc.arg(user_id)
+    AND i.served_at >= sqlc.arg(since)
+    AND i.position < sqlc.arg(max_position)
+    AND COALESCE(uai.have_read, FALSE) = FALSE
+    AND COALESCE(uai.thumbs_up, FALSE) = FALSE
+    AND COALESCE(uai.thumbs_down, FALSE) = FALSE
+GROUP BY i.article_hash_id
+HAVING shown_days >= sqlc.arg(min_days);
+
+-- name: ListCategoryExploration :many
+SELECT
+    c.category,
+    COALESCE(e.engaged_count, 0) AS user_engaged,
+    COALESCE(x.shown_count, 0) AS explore_shown,
+    COALESCE(x.engaged_count, 0) AS explore_engaged
+FROM (
+    SELECT DISTINCT category FROM articles
+    WHERE category IS NOT NULL AND category <> ''
+) c
+LEFT JOIN (
+    SELECT a.category, COUNT(*) AS engaged_count
+    FROM user_article_interactions uai
+    JOIN articles a ON a.hash_id = uai.article_hash_id
+    WHERE uai.user_id = sqlc.arg(user_id)
+        AND uai.thumbs_down = FALSE
+        AND (uai.have_read OR uai.thumbs_up OR uai.opened_link
+            OR uai.time_on_article_seconds > 0 OR uai.saved_to_collection)
+    GROUP BY a.category
+) e ON e.category = c.category
+LEFT JOIN (
+    SELECT
+        a.category,
+        COUNT(*) AS shown_count,
+        COUNT(CASE WHEN uai.date_read >= i.first_served_at
+            OR (uai.thumbs_up AND uai.date_rated >= i.first_served_at)
+            OR uai.date_engaged >= i.first_served_at THEN 1 END) AS engaged_count
+    FROM (
+        SELECT article_hash_id, MIN(served_at) AS first_served_at
+        FROM recommendation_impressions
+        WHERE user_id = sqlc.arg(user_id) AND source = 'explore' AND served_at >= sqlc.arg(since)
+        GROUP BY article_hash_id
+    ) i
+    JOIN articles a ON a.hash_id = i.article_hash_id
+    LEFT JOIN user_article_interactions uai
+        ON uai.user_id = sqlc.arg(user_id) AND uai.article_hash_id = i.article_hash_id
+    GROUP BY a.category
+) x ON x.category = c.category
+ORDER BY c.category;
+
+-- ============================================
+-- User Data Export and Deletion
+-- ============================================
+
+-- name: ExportUserArticleInteractions :many
+SELECT article_hash_id, have_read, thumbs_up, thumbs_down, rating, opened_link,
+       time_on_article_seconds, saved_to_collection, dismissed, date_read, date_rated,
+       date_engaged, date_dismissed, snoozed_until
+FROM user_article_interactions
+WHERE user_id = ?
+ORDER BY article_hash_id;
+
+-- name: ExportUserPrecomputedRecommendations :many
+SELECT article_hash_id, score, source, experiment_arm, position, generated_at
+FROM user_precomputed_recommendations
+WHERE user_id = ?
+ORDER BY position;
+
+-- name: ExportUserRecommendationImpressions :many
+SELECT article_hash_id, experiment_arm, source, position, served_at
+FROM recommendation_impressions
+WHERE user_id = ?
+ORDER BY served_at, id;
+
+-- name: ExportUserCollectionArticles :many
+SELECT ca.collection_id, ca.article_hash_id, ca.position, ca.added_at
+FROM collection_articles ca
+JOIN collections c ON c.id = ca.collection_id
+WHERE c.user_id = ?
+ORDER BY ca.collection_id, ca.position;
+
+-- name: ExportUserArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = ?
+ORDER BY created_at;
+
+-- name: ExportUserArticleTags :many
+SELECT article_hash_id, tag, created_at
+FROM user_article_tags
+WHERE user_id = ?
+ORDER BY article_hash_id, tag;
+
+-- name: ExportUserAuditEvents :many
+SELECT id, user_id, event_type, outcome, token_id, ip_address, user_agent, detail, created_at
+FROM audit_events
+WHERE user_id = ?
+ORDER BY created_at, id;
+
+-- name: DeleteUserArticleInteractions :exec
+DELETE FROM user_article_interactions
+WHERE user_id = ?;
+
+-- name: DeleteUserRecommendationState :exec
+DELETE FROM user_recommendation_state
+WHERE user_id = ?;
+
+-- name: DeleteUserAPITokens :exec
+DELETE FROM api_tokens
+WHERE user_id = ?;
+
+-- name: DeleteUserDigestPreferences :exec
+DELETE FROM user_digest_preferences
+WHERE user_id = ?;
+
+-- name: DeleteUserSavedSearches :exec
+DELETE FROM saved_searches
+WHERE user_id = ?;
+
+-- name: DeleteUserCollections :exec
+DELETE FROM collections
+WHERE user_id = ?;
+
+-- name: DeleteUserArticleNotes :exec
+DELETE FROM article_notes
+WHERE user_id = ?;
+
+-- name: DeleteUserArticleTags :exec
+DELETE FROM user_article_tags
+WHERE user_id = ?;
+
+-- name: DeleteUserTagPreferences :exec
+DELETE FROM user_tag_preferences
+WHERE user_id = ?;
+
+-- name: DeleteUserFollows :exec
+DELETE FROM user_follows
+WHERE user_id = ?;
+
+-- name: DeleteUserFollowPreferences :exec
+DELETE FROM user_follow_preferences
+WHERE user_id = ?;
+
+-- name: DeleteUserAuditEvents :exec
+DELETE FROM audit_events
+WHERE user_id = ?;
+
+-- name: DeleteUserOnboardingInterests :exec
+DELETE FROM user_onboarding_interests
+WHERE user_id = ?;
+
+-- name: DeleteUserRecommendationImpressions :exec
+DELETE FROM recommendation_impressions
+WHERE user_id = ?;
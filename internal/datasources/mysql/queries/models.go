@@ -57,15 +57,17 @@ func (ns NullArticlesPineconeStatus) Value() (driver.Value, error) {
 }
 
 type ApiToken struct {
-	ID          string
-	UserID      string
-	TokenHash   string
-	TokenPrefix string
-	Name        sql.NullString
-	CreatedAt   time.Time
-	LastUsedAt  sql.NullTime
-	ExpiresAt   sql.NullTime
-	RevokedAt   sql.NullTime
+	ID           string
+	UserID       string
+	TokenHash    string
+	TokenPrefix  string
+	Name         sql.NullString
+	CreatedAt    time.Time
+	LastUsedAt   sql.NullTime
+	ExpiresAt    sql.NullTime
+	RevokedAt    sql.NullTime
+	Scopes       string
+	ReplacedByID sql.NullString
 }
 
 type Article struct {
@@ -100,6 +102,116 @@ type Article struct {
 	ThumbnailUrl   sql.NullString
 }
 
+type ArticleAuthor struct {
+	ArticleHashID string
+	AuthorID      string
+	Position      int32
+}
+
+type ArticleDuplicate struct {
+	VariantHashID   string
+	CanonicalHashID string
+	DetectedAt      time.Time
+}
+
+type ArticleNeighbour struct {
+	ArticleHashID   string
+	NeighbourHashID string
+	CoLikeCount     int64
+	Score           float64
+	ComputedAt      time.Time
+}
+
+type ArticleNote struct {
+	ID            string
+	UserID        string
+	ArticleHashID string
+	Body          string
+	Quote         sql.NullString
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type ArticlePopularity struct {
+	ArticleHashID string
+	WindowDays    int32
+	LikeCount     int64
+	DislikeCount  int64
+	ReadCount     int64
+	UserCount     int64
+	Score         float64
+	ComputedAt    time.Time
+}
+
+type AuditEvent struct {
+	ID        int64
+	UserID    sql.NullString
+	EventType string
+	Outcome   string
+	TokenID   sql.NullString
+	IpAddress string
+	UserAgent string
+	Detail    string
+	CreatedAt time.Time
+}
+
+type Author struct {
+	ID   string
+	Name string
+}
+
+type AuthorAlias struct {
+	AuthorID string
+	Alias    string
+}
+
+type Collection struct {
+	ID         string
+	UserID     string
+	Name       string
+	IsPublic   bool
+	ShareToken string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type CollectionArticle struct {
+	CollectionID  string
+	ArticleHashID string
+	Position      int32
+	AddedAt       time.Time
+}
+
+type RateLimitBucket struct {
+	BucketKey string
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+type RecommendationImpression struct {
+	ID            int64
+	UserID        string
+	ArticleHashID string
+	ExperimentArm string
+	Source        string
+	Position      int32
+	ServedAt      time.Time
+}
+
+type SavedSearch struct {
+	ID           string
+	UserID       string
+	Name         string
+	Kind         string
+	Filters      sql.NullString
+	QueryText    sql.NullString
+	QueryVector  sql.NullString
+	FeedToken    string
+	LastViewedAt sql.NullTime
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
 type Summary struct {
 	ID        int32
 	Text      string
@@ -108,14 +220,56 @@ type Summary struct {
 }
 
 type UserArticleInteraction struct {
+	UserID               string
+	ArticleHashID        string
+	HaveRead             bool
+	ThumbsUp             bool
+	ThumbsDown           bool
+	Rating               sql.NullInt32
+	OpenedLink           bool
+	TimeOnArticleSeconds int32
+	SavedToCollection    bool
+	Dismissed            bool
+	DateRead             sql.NullTime
+	DateRated            sql.NullTime
+	DateEngaged          sql.NullTime
+	DateDismissed        sql.NullTime
+	SnoozedUntil         sql.NullTime
+	Vector               sql.NullString
+}
+
+type UserArticleTag struct {
 	UserID        string
 	ArticleHashID string
-	HaveRead      bool
-	ThumbsUp      bool
-	ThumbsDown    bool
-	DateRead      sql.NullTime
-	DateRated     sql.NullTime
-	Vector        sql.NullString
+	Tag           string
+	CreatedAt     time.Time
+}
+
+type UserDigestPreference struct {
+	UserID           string
+	Email            string
+	Frequency        string
+	Categories       sql.NullString
+	Enabled          bool
+	UnsubscribeToken string
+	LastSentAt       sql.NullTime
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type UserFollow struct {
+	UserID     string
+	FollowType string
+	Target     string
+	CreatedAt  time.Time
+}
+
+type UserFollowPreference struct {
+	UserID                   string
+	IncludeInRecommendations bool
+	FeedToken                string
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
 }
 
 type UserInterestCluster struct {
@@ -124,6 +278,15 @@ type UserInterestCluster struct {
 	CentroidVector []byte
 	ArticleCount   int32
 	UpdatedAt      time.Time
+	Provisional    bool
+}
+
+type UserOnboardingInterest struct {
+	UserID     string
+	Categories sql.NullString
+	Interests  sql.NullString
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 type UserPrecomputedRecommendation struct {
@@ -131,6 +294,7 @@ type UserPrecomputedRecommendation struct {
 	ArticleHashID string
 	Score         float64
 	Source        string
+	ExperimentArm string
 	Position      int32
 	GeneratedAt   time.Time
 }
@@ -12,10 +12,195 @@ import (
 	"time"
 )
 
+const addCollectionArticle = `-- name: AddCollectionArticle :exec
+INSERT IGNORE INTO collection_articles (collection_id, article_hash_id, position, added_at)
+VALUES (?, ?, ?, NOW())
+`
+
+type AddCollectionArticleParams struct {
+	CollectionID  string
+	ArticleHashID string
+	Position      int32
+}
+
+func (q *Queries) AddCollectionArticle(ctx context.Context, arg AddCollectionArticleParams) error {
+	_, err := q.db.ExecContext(ctx, addCollectionArticle, arg.CollectionID, arg.ArticleHashID, arg.Position)
+	return err
+}
+
+const aggregateArticlePopularity = `-- name: AggregateArticlePopularity :many
+
+SELECT article_hash_id,
+    COUNT(CASE WHEN thumbs_up = TRUE AND date_rated >= ? THEN 1 END) AS like_count,
+    COUNT(CASE WHEN thumbs_down = TRUE AND date_rated >= ? THEN 1 END) AS dislike_count,
+    COUNT(CASE WHEN have_read = TRUE AND date_read >= ? THEN 1 END) AS read_count,
+    COUNT(*) AS user_count
+FROM user_article_interactions
+WHERE date_rated >= ? OR date_read >= ?
+GROUP BY article_hash_id
+HAVING user_count >= ?
+`
+
+type AggregateArticlePopularityParams struct {
+	Since    sql.NullTime
+	MinUsers int64
+}
+
+type AggregateArticlePopularityRow struct {
+	ArticleHashID string
+	LikeCount     int64
+	DislikeCount  int64
+	ReadCount     int64
+	UserCount     int64
+}
+
+// ============================================
+// Article Popularity
+// ============================================
+func (q *Queries) AggregateArticlePopularity(ctx context.Context, arg AggregateArticlePopularityParams) ([]AggregateArticlePopularityRow, error) {
+	rows, err := q.db.QueryContext(ctx, aggregateArticlePopularity,
+		arg.Since,
+		arg.Since,
+		arg.Since,
+		arg.Since,
+		arg.Since,
+		arg.MinUsers,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AggregateArticlePopularityRow
+	for rows.Next() {
+		var i AggregateArticlePopularityRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.LikeCount,
+			&i.DislikeCount,
+			&i.ReadCount,
+			&i.UserCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const confirmDigestEmail = `-- name: ConfirmDigestEmail :execrows
+UPDATE user_digest_preferences
+SET email = pending_email, pending_email = NULL, email_confirmation_token = NULL, updated_at = NOW()
+WHERE email_confirmation_token = ? AND pending_email IS NOT NULL
+`
+
+func (q *Queries) ConfirmDigestEmail(ctx context.Context, emailConfirmationToken sql.NullString) (int64, error) {
+	result, err := q.db.ExecContext(ctx, confirmDigestEmail, emailConfirmationToken)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countArticleLikes = `-- name: CountArticleLikes :many
+SELECT article_hash_id, COUNT(*) AS like_count
+FROM user_article_interactions
+WHERE thumbs_up = TRUE
+GROUP BY article_hash_id
+`
+
+type CountArticleLikesRow struct {
+	ArticleHashID string
+	LikeCount     int64
+}
+
+func (q *Queries) CountArticleLikes(ctx context.Context) ([]CountArticleLikesRow, error) {
+	rows, err := q.db.QueryContext(ctx, countArticleLikes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountArticleLikesRow
+	for rows.Next() {
+		var i CountArticleLikesRow
+		if err := rows.Scan(&i.ArticleHashID, &i.LikeCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countIgnoredRecommendations = `-- name: CountIgnoredRecommendations :many
+SELECT i.article_hash_id, COUNT(DISTINCT DATE(i.served_at)) AS shown_days
+FROM recommendation_impressions i
+LEFT JOIN user_article_interactions uai
+    ON uai.user_id = i.user_id AND uai.article_hash_id = i.article_hash_id
+WHERE i.user_id = ?
+    AND i.served_at >= ?
+    AND i.position < ?
+    AND COALESCE(uai.have_read, FALSE) = FALSE
+    AND COALESCE(uai.thumbs_up, FALSE) = FALSE
+    AND COALESCE(uai.thumbs_down, FALSE) = FALSE
+GROUP BY i.article_hash_id
+HAVING shown_days >= ?
+`
+
+type CountIgnoredRecommendationsParams struct {
+	UserID      string
+	Since       time.Time
+	MaxPosition int32
+	MinDays     int64
+}
+
+type CountIgnoredRecommendationsRow struct {
+	ArticleHashID string
+	ShownDays     int64
+}
+
+func (q *Queries) CountIgnoredRecommendations(ctx context.Context, arg CountIgnoredRecommendationsParams) ([]CountIgnoredRecommendationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countIgnoredRecommendations,
+		arg.UserID,
+		arg.Since,
+		arg.MaxPosition,
+		arg.MinDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountIgnoredRecommendationsRow
+	for rows.Next() {
+		var i CountIgnoredRecommendationsRow
+		if err := rows.Scan(&i.ArticleHashID, &i.ShownDays); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countUserActiveAPITokens = `-- name: CountUserActiveAPITokens :one
 SELECT COUNT(*) as count
 FROM api_tokens
-WHERE user_id = ? AND revoked_at IS NULL
+WHERE user_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())
 `
 
 func (q *Queries) CountUserActiveAPITokens(ctx context.Context, userID string) (int64, error) {
@@ -25,6 +210,20 @@ func (q *Queries) CountUserActiveAPITokens(ctx context.Context, userID string) (
 	return count, err
 }
 
+const countUserArticleVectorsByPositiveSignals = `-- name: CountUserArticleVectorsByPositiveSignals :one
+SELECT COUNT(*) as count
+FROM user_article_interactions
+WHERE user_id = ? AND thumbs_down = FALSE AND dismissed = FALSE AND ` + "`" + `vector` + "`" + ` IS NOT NULL
+    AND (thumbs_up = TRUE OR opened_link = TRUE OR time_on_article_seconds > 0 OR saved_to_collection = TRUE)
+`
+
+func (q *Queries) CountUserArticleVectorsByPositiveSignals(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserArticleVectorsByPositiveSignals, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countUserArticleVectorsByThumbsDown = `-- name: CountUserArticleVectorsByThumbsDown :one
 SELECT COUNT(*) as count
 FROM user_article_interactions
@@ -38,14 +237,27 @@ func (q *Queries) CountUserArticleVectorsByThumbsDown(ctx context.Context, userI
 	return count, err
 }
 
-const countUserArticleVectorsByThumbsUp = `-- name: CountUserArticleVectorsByThumbsUp :one
+const countUserCollections = `-- name: CountUserCollections :one
 SELECT COUNT(*) as count
-FROM user_article_interactions
-WHERE user_id = ? AND thumbs_up = TRUE AND ` + "`" + `vector` + "`" + ` IS NOT NULL
+FROM collections
+WHERE user_id = ?
+`
+
+func (q *Queries) CountUserCollections(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserCollections, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserSavedSearches = `-- name: CountUserSavedSearches :one
+SELECT COUNT(*) as count
+FROM saved_searches
+WHERE user_id = ?
 `
 
-func (q *Queries) CountUserArticleVectorsByThumbsUp(ctx context.Context, userID string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countUserArticleVectorsByThumbsUp, userID)
+func (q *Queries) CountUserSavedSearches(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserSavedSearches, userID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -53,8 +265,8 @@ func (q *Queries) CountUserArticleVectorsByThumbsUp(ctx context.Context, userID
 
 const createAPIToken = `-- name: CreateAPIToken :exec
 
-INSERT INTO api_tokens (id, user_id, token_hash, token_prefix, name, created_at, expires_at)
-VALUES (?, ?, ?, ?, ?, NOW(), ?)
+INSERT INTO api_tokens (id, user_id, token_hash, token_prefix, name, created_at, expires_at, scopes)
+VALUES (?, ?, ?, ?, ?, NOW(), ?, ?)
 `
 
 type CreateAPITokenParams struct {
@@ -64,6 +276,7 @@ type CreateAPITokenParams struct {
 	TokenPrefix string
 	Name        sql.NullString
 	ExpiresAt   sql.NullTime
+	Scopes      string
 }
 
 // ============================================
@@ -77,201 +290,2840 @@ func (q *Queries) CreateAPIToken(ctx context.Context, arg CreateAPITokenParams)
 		arg.TokenPrefix,
 		arg.Name,
 		arg.ExpiresAt,
+		arg.Scopes,
 	)
 	return err
 }
 
-const deleteUserInterestClusters = `-- name: DeleteUserInterestClusters :exec
-DELETE FROM user_interest_clusters
-WHERE user_id = ?
+const createArticleNote = `-- name: CreateArticleNote :exec
+
+INSERT INTO article_notes (id, user_id, article_hash_id, body, quote, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, NOW(), NOW())
 `
 
-func (q *Queries) DeleteUserInterestClusters(ctx context.Context, userID string) error {
-	_, err := q.db.ExecContext(ctx, deleteUserInterestClusters, userID)
+type CreateArticleNoteParams struct {
+	ID            string
+	UserID        string
+	ArticleHashID string
+	Body          string
+	Quote         sql.NullString
+}
+
+// ============================================
+// Article Notes
+// ============================================
+func (q *Queries) CreateArticleNote(ctx context.Context, arg CreateArticleNoteParams) error {
+	_, err := q.db.ExecContext(ctx, createArticleNote,
+		arg.ID,
+		arg.UserID,
+		arg.ArticleHashID,
+		arg.Body,
+		arg.Quote,
+	)
 	return err
 }
 
-const deleteUserPrecomputedRecommendations = `-- name: DeleteUserPrecomputedRecommendations :exec
-DELETE FROM user_precomputed_recommendations
-WHERE user_id = ?
+const createCollection = `-- name: CreateCollection :exec
+
+INSERT INTO collections (id, user_id, name, is_public, share_token, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, NOW(), NOW())
 `
 
-func (q *Queries) DeleteUserPrecomputedRecommendations(ctx context.Context, userID string) error {
-	_, err := q.db.ExecContext(ctx, deleteUserPrecomputedRecommendations, userID)
+type CreateCollectionParams struct {
+	ID         string
+	UserID     string
+	Name       string
+	IsPublic   bool
+	ShareToken string
+}
+
+// ============================================
+// Collections
+// ============================================
+func (q *Queries) CreateCollection(ctx context.Context, arg CreateCollectionParams) error {
+	_, err := q.db.ExecContext(ctx, createCollection,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.IsPublic,
+		arg.ShareToken,
+	)
 	return err
 }
 
-const fetchArticlesByID = `-- name: FetchArticlesByID :many
-SELECT
-    hash_id,
-    title,
-    url,
-    source,
-    LEFT(COALESCE(text, ''), 500) as text_start,
-    authors,
-    date_published,
-    summary,
-    key_points,
-    implication,
-    category,
-    thumbnail_url,
-    have_read,
-    thumbs_up,
-    thumbs_down
-FROM articles
-LEFT JOIN user_article_interactions
-    ON articles.hash_id = user_article_interactions.article_hash_id
-        AND user_article_interactions.user_id = ?
-WHERE hash_id IN (/*SLICE:hash_ids*/?)
+const createFollowPreferences = `-- name: CreateFollowPreferences :exec
+INSERT IGNORE INTO user_follow_preferences (user_id, feed_token, created_at, updated_at)
+VALUES (?, ?, NOW(), NOW())
 `
 
-type FetchArticlesByIDParams struct {
-	UserID  string
-	HashIds []string
+type CreateFollowPreferencesParams struct {
+	UserID    string
+	FeedToken string
 }
 
-type FetchArticlesByIDRow struct {
-	HashID        string
-	Title         sql.NullString
-	Url           sql.NullString
-	Source        sql.NullString
-	TextStart     string
-	Authors       string
-	DatePublished sql.NullTime
-	Summary       sql.NullString
-	KeyPoints     sql.NullString
-	Implication   sql.NullString
-	Category      sql.NullString
-	ThumbnailUrl  sql.NullString
-	HaveRead      sql.NullBool
-	ThumbsUp      sql.NullBool
-	ThumbsDown    sql.NullBool
+func (q *Queries) CreateFollowPreferences(ctx context.Context, arg CreateFollowPreferencesParams) error {
+	_, err := q.db.ExecContext(ctx, createFollowPreferences, arg.UserID, arg.FeedToken)
+	return err
 }
 
-func (q *Queries) FetchArticlesByID(ctx context.Context, arg FetchArticlesByIDParams) ([]FetchArticlesByIDRow, error) {
-	query := fetchArticlesByID
-	var queryParams []interface{}
-	queryParams = append(queryParams, arg.UserID)
-	if len(arg.HashIds) > 0 {
-		for _, v := range arg.HashIds {
-			queryParams = append(queryParams, v)
-		}
-		query = strings.Replace(query, "/*SLICE:hash_ids*/?", strings.Repeat(",?", len(arg.HashIds))[1:], 1)
-	} else {
-		query = strings.Replace(query, "/*SLICE:hash_ids*/?", "NULL", 1)
-	}
-	rows, err := q.db.QueryContext(ctx, query, queryParams...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []FetchArticlesByIDRow
-	for rows.Next() {
-		var i FetchArticlesByIDRow
-		if err := rows.Scan(
-			&i.HashID,
-			&i.Title,
-			&i.Url,
-			&i.Source,
-			&i.TextStart,
-			&i.Authors,
-			&i.DatePublished,
-			&i.Summary,
-			&i.KeyPoints,
-			&i.Implication,
-			&i.Category,
-			&i.ThumbnailUrl,
-			&i.HaveRead,
-			&i.ThumbsUp,
-			&i.ThumbsDown,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
+const createSavedSearch = `-- name: CreateSavedSearch :exec
 
-const getAPITokenByHash = `-- name: GetAPITokenByHash :one
-SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at
-FROM api_tokens
-WHERE token_hash = ?
+INSERT INTO saved_searches (id, user_id, name, kind, filters, query_text, query_vector, feed_token, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 `
 
-func (q *Queries) GetAPITokenByHash(ctx context.Context, tokenHash string) (ApiToken, error) {
-	row := q.db.QueryRowContext(ctx, getAPITokenByHash, tokenHash)
-	var i ApiToken
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.TokenHash,
-		&i.TokenPrefix,
-		&i.Name,
-		&i.CreatedAt,
-		&i.LastUsedAt,
-		&i.ExpiresAt,
-		&i.RevokedAt,
+type CreateSavedSearchParams struct {
+	ID          string
+	UserID      string
+	Name        string
+	Kind        string
+	Filters     sql.NullString
+	QueryText   sql.NullString
+	QueryVector sql.NullString
+	FeedToken   string
+}
+
+// ============================================
+// Saved Searches
+// ============================================
+func (q *Queries) CreateSavedSearch(ctx context.Context, arg CreateSavedSearchParams) error {
+	_, err := q.db.ExecContext(ctx, createSavedSearch,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Kind,
+		arg.Filters,
+		arg.QueryText,
+		arg.QueryVector,
+		arg.FeedToken,
 	)
-	return i, err
+	return err
 }
 
-const getPrecomputedRecommendationAge = `-- name: GetPrecomputedRecommendationAge :one
-SELECT generated_at
-FROM user_precomputed_recommendations
+const deleteArticleAuthors = `-- name: DeleteArticleAuthors :exec
+DELETE FROM article_authors
+`
+
+func (q *Queries) DeleteArticleAuthors(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteArticleAuthors)
+	return err
+}
+
+const deleteArticleDuplicates = `-- name: DeleteArticleDuplicates :exec
+DELETE FROM article_duplicates
+`
+
+func (q *Queries) DeleteArticleDuplicates(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteArticleDuplicates)
+	return err
+}
+
+const deleteArticleNeighbours = `-- name: DeleteArticleNeighbours :exec
+DELETE FROM article_neighbours
+`
+
+func (q *Queries) DeleteArticleNeighbours(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteArticleNeighbours)
+	return err
+}
+
+const deleteArticleNote = `-- name: DeleteArticleNote :exec
+DELETE FROM article_notes
+WHERE id = ? AND user_id = ?
+`
+
+type DeleteArticleNoteParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) DeleteArticleNote(ctx context.Context, arg DeleteArticleNoteParams) error {
+	_, err := q.db.ExecContext(ctx, deleteArticleNote, arg.ID, arg.UserID)
+	return err
+}
+
+const deleteArticlePopularityWindow = `-- name: DeleteArticlePopularityWindow :exec
+DELETE FROM article_popularity WHERE window_days = ?
+`
+
+func (q *Queries) DeleteArticlePopularityWindow(ctx context.Context, windowDays int32) error {
+	_, err := q.db.ExecContext(ctx, deleteArticlePopularityWindow, windowDays)
+	return err
+}
+
+const deleteAuthorAliases = `-- name: DeleteAuthorAliases :exec
+DELETE FROM author_aliases
+`
+
+func (q *Queries) DeleteAuthorAliases(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthorAliases)
+	return err
+}
+
+const deleteCollection = `-- name: DeleteCollection :exec
+DELETE FROM collections
+WHERE id = ? AND user_id = ?
+`
+
+type DeleteCollectionParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) DeleteCollection(ctx context.Context, arg DeleteCollectionParams) error {
+	_, err := q.db.ExecContext(ctx, deleteCollection, arg.ID, arg.UserID)
+	return err
+}
+
+const deleteFollowTarget = `-- name: DeleteFollowTarget :exec
+DELETE FROM user_follows
+WHERE follow_type = ? AND target = ?
+`
+
+type DeleteFollowTargetParams struct {
+	FollowType string
+	Target     string
+}
+
+func (q *Queries) DeleteFollowTarget(ctx context.Context, arg DeleteFollowTargetParams) error {
+	_, err := q.db.ExecContext(ctx, deleteFollowTarget, arg.FollowType, arg.Target)
+	return err
+}
+
+const deleteRateLimitBucketsUpdatedBefore = `-- name: DeleteRateLimitBucketsUpdatedBefore :execrows
+DELETE FROM rate_limit_buckets
+WHERE updated_at < ?
+`
+
+func (q *Queries) DeleteRateLimitBucketsUpdatedBefore(ctx context.Context, updatedAt time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRateLimitBucketsUpdatedBefore, updatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteSavedSearch = `-- name: DeleteSavedSearch :exec
+DELETE FROM saved_searches
+WHERE id = ? AND user_id = ?
+`
+
+type DeleteSavedSearchParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) DeleteSavedSearch(ctx context.Context, arg DeleteSavedSearchParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSavedSearch, arg.ID, arg.UserID)
+	return err
+}
+
+const deleteUncreditedAuthors = `-- name: DeleteUncreditedAuthors :exec
+DELETE FROM authors
+WHERE id NOT IN (SELECT author_id FROM article_authors)
+`
+
+func (q *Queries) DeleteUncreditedAuthors(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteUncreditedAuthors)
+	return err
+}
+
+const deleteUserAPITokens = `-- name: DeleteUserAPITokens :exec
+DELETE FROM api_tokens
 WHERE user_id = ?
-ORDER BY generated_at DESC
-LIMIT 1
 `
 
-func (q *Queries) GetPrecomputedRecommendationAge(ctx context.Context, userID string) (time.Time, error) {
-	row := q.db.QueryRowContext(ctx, getPrecomputedRecommendationAge, userID)
-	var generated_at time.Time
-	err := row.Scan(&generated_at)
-	return generated_at, err
+func (q *Queries) DeleteUserAPITokens(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserAPITokens, userID)
+	return err
+}
+
+const deleteUserArticleInteractions = `-- name: DeleteUserArticleInteractions :exec
+DELETE FROM user_article_interactions
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserArticleInteractions(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserArticleInteractions, userID)
+	return err
+}
+
+const deleteUserArticleNotes = `-- name: DeleteUserArticleNotes :exec
+DELETE FROM article_notes
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserArticleNotes(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserArticleNotes, userID)
+	return err
+}
+
+const deleteUserArticleTags = `-- name: DeleteUserArticleTags :exec
+DELETE FROM user_article_tags
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserArticleTags(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserArticleTags, userID)
+	return err
+}
+
+const deleteUserAuditEvents = `-- name: DeleteUserAuditEvents :exec
+DELETE FROM audit_events
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserAuditEvents(ctx context.Context, userID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, deleteUserAuditEvents, userID)
+	return err
+}
+
+const deleteUserCollections = `-- name: DeleteUserCollections :exec
+DELETE FROM collections
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserCollections(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserCollections, userID)
+	return err
+}
+
+const deleteUserComputedInterestClusters = `-- name: DeleteUserComputedInterestClusters :exec
+DELETE FROM user_interest_clusters
+WHERE user_id = ? AND provisional = FALSE
+`
+
+func (q *Queries) DeleteUserComputedInterestClusters(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserComputedInterestClusters, userID)
+	return err
+}
+
+const deleteUserDigestPreferences = `-- name: DeleteUserDigestPreferences :exec
+DELETE FROM user_digest_preferences
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserDigestPreferences(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserDigestPreferences, userID)
+	return err
+}
+
+const deleteUserFollowPreferences = `-- name: DeleteUserFollowPreferences :exec
+DELETE FROM user_follow_preferences
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserFollowPreferences(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserFollowPreferences, userID)
+	return err
+}
+
+const deleteUserFollows = `-- name: DeleteUserFollows :exec
+DELETE FROM user_follows
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserFollows(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserFollows, userID)
+	return err
+}
+
+const deleteUserInterestClusters = `-- name: DeleteUserInterestClusters :exec
+DELETE FROM user_interest_clusters
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserInterestClusters(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserInterestClusters, userID)
+	return err
+}
+
+const deleteUserOnboardingInterests = `-- name: DeleteUserOnboardingInterests :exec
+DELETE FROM user_onboarding_interests
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserOnboardingInterests(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserOnboardingInterests, userID)
+	return err
+}
+
+const deleteUserPrecomputedRecommendations = `-- name: DeleteUserPrecomputedRecommendations :exec
+DELETE FROM user_precomputed_recommendations
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserPrecomputedRecommendations(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserPrecomputedRecommendations, userID)
+	return err
+}
+
+const deleteUserRecommendationImpressions = `-- name: DeleteUserRecommendationImpressions :exec
+DELETE FROM recommendation_impressions
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserRecommendationImpressions(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserRecommendationImpressions, userID)
+	return err
+}
+
+const deleteUserRecommendationState = `-- name: DeleteUserRecommendationState :exec
+DELETE FROM user_recommendation_state
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserRecommendationState(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserRecommendationState, userID)
+	return err
+}
+
+const deleteUserSavedSearches = `-- name: DeleteUserSavedSearches :exec
+DELETE FROM saved_searches
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserSavedSearches(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserSavedSearches, userID)
+	return err
+}
+
+const deleteUserTagPreferences = `-- name: DeleteUserTagPreferences :exec
+DELETE FROM user_tag_preferences
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserTagPreferences(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserTagPreferences, userID)
+	return err
+}
+
+const disableDigest = `-- name: DisableDigest :exec
+UPDATE user_digest_preferences
+SET enabled = FALSE, updated_at = NOW()
+WHERE user_id = ?
+`
+
+func (q *Queries) DisableDigest(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, disableDigest, userID)
+	return err
+}
+
+const ensureRateLimitBucket = `-- name: EnsureRateLimitBucket :exec
+
+INSERT IGNORE INTO rate_limit_buckets (bucket_key, tokens, updated_at)
+VALUES (?, ?, ?)
+`
+
+type EnsureRateLimitBucketParams struct {
+	BucketKey string
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// ============================================
+// Rate Limit Buckets
+// ============================================
+func (q *Queries) EnsureRateLimitBucket(ctx context.Context, arg EnsureRateLimitBucketParams) error {
+	_, err := q.db.ExecContext(ctx, ensureRateLimitBucket, arg.BucketKey, arg.Tokens, arg.UpdatedAt)
+	return err
+}
+
+const exportUserArticleInteractions = `-- name: ExportUserArticleInteractions :many
+
+SELECT article_hash_id, have_read, thumbs_up, thumbs_down, rating, opened_link,
+       time_on_article_seconds, saved_to_collection, dismissed, date_read, date_rated,
+       date_engaged, date_dismissed, snoozed_until
+FROM user_article_interactions
+WHERE user_id = ?
+ORDER BY article_hash_id
+`
+
+type ExportUserArticleInteractionsRow struct {
+	ArticleHashID        string
+	HaveRead             bool
+	ThumbsUp             bool
+	ThumbsDown           bool
+	Rating               sql.NullInt32
+	OpenedLink           bool
+	TimeOnArticleSeconds int32
+	SavedToCollection    bool
+	Dismissed            bool
+	DateRead             sql.NullTime
+	DateRated            sql.NullTime
+	DateEngaged          sql.NullTime
+	DateDismissed        sql.NullTime
+	SnoozedUntil         sql.NullTime
+}
+
+// ============================================
+// User Data Export and Deletion
+// ============================================
+func (q *Queries) ExportUserArticleInteractions(ctx context.Context, userID string) ([]ExportUserArticleInteractionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, exportUserArticleInteractions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportUserArticleInteractionsRow
+	for rows.Next() {
+		var i ExportUserArticleInteractionsRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.HaveRead,
+			&i.ThumbsUp,
+			&i.ThumbsDown,
+			&i.Rating,
+			&i.OpenedLink,
+			&i.TimeOnArticleSeconds,
+			&i.SavedToCollection,
+			&i.Dismissed,
+			&i.DateRead,
+			&i.DateRated,
+			&i.DateEngaged,
+			&i.DateDismissed,
+			&i.SnoozedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportUserArticleNotes = `-- name: ExportUserArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = ?
+ORDER BY created_at
+`
+
+func (q *Queries) ExportUserArticleNotes(ctx context.Context, userID string) ([]ArticleNote, error) {
+	rows, err := q.db.QueryContext(ctx, exportUserArticleNotes, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArticleNote
+	for rows.Next() {
+		var i ArticleNote
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ArticleHashID,
+			&i.Body,
+			&i.Quote,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportUserArticleTags = `-- name: ExportUserArticleTags :many
+SELECT article_hash_id, tag, created_at
+FROM user_article_tags
+WHERE user_id = ?
+ORDER BY article_hash_id, tag
+`
+
+type ExportUserArticleTagsRow struct {
+	ArticleHashID string
+	Tag           string
+	CreatedAt     time.Time
+}
+
+func (q *Queries) ExportUserArticleTags(ctx context.Context, userID string) ([]ExportUserArticleTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, exportUserArticleTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportUserArticleTagsRow
+	for rows.Next() {
+		var i ExportUserArticleTagsRow
+		if err := rows.Scan(&i.ArticleHashID, &i.Tag, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportUserAuditEvents = `-- name: ExportUserAuditEvents :many
+SELECT id, user_id, event_type, outcome, token_id, ip_address, user_agent, detail, created_at
+FROM audit_events
+WHERE user_id = ?
+ORDER BY created_at, id
+`
+
+func (q *Queries) ExportUserAuditEvents(ctx context.Context, userID sql.NullString) ([]AuditEvent, error) {
+	rows, err := q.db.QueryContext(ctx, exportUserAuditEvents, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.EventType,
+			&i.Outcome,
+			&i.TokenID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Detail,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportUserCollectionArticles = `-- name: ExportUserCollectionArticles :many
+SELECT ca.collection_id, ca.article_hash_id, ca.position, ca.added_at
+FROM collection_articles ca
+JOIN collections c ON c.id = ca.collection_id
+WHERE c.user_id = ?
+ORDER BY ca.collection_id, ca.position
+`
+
+func (q *Queries) ExportUserCollectionArticles(ctx context.Context, userID string) ([]CollectionArticle, error) {
+	rows, err := q.db.QueryContext(ctx, exportUserCollectionArticles, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CollectionArticle
+	for rows.Next() {
+		var i CollectionArticle
+		if err := rows.Scan(
+			&i.CollectionID,
+			&i.ArticleHashID,
+			&i.Position,
+			&i.AddedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportUserPrecomputedRecommendations = `-- name: ExportUserPrecomputedRecommendations :many
+SELECT article_hash_id, score, source, experiment_arm, position, generated_at
+FROM user_precomputed_recommendations
+WHERE user_id = ?
+ORDER BY position
+`
+
+type ExportUserPrecomputedRecommendationsRow struct {
+	ArticleHashID string
+	Score         float64
+	Source        string
+	ExperimentArm string
+	Position      int32
+	GeneratedAt   time.Time
+}
+
+func (q *Queries) ExportUserPrecomputedRecommendations(ctx context.Context, userID string) ([]ExportUserPrecomputedRecommendationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, exportUserPrecomputedRecommendations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportUserPrecomputedRecommendationsRow
+	for rows.Next() {
+		var i ExportUserPrecomputedRecommendationsRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.Score,
+			&i.Source,
+			&i.ExperimentArm,
+			&i.Position,
+			&i.GeneratedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportUserRecommendationImpressions = `-- name: ExportUserRecommendationImpressions :many
+SELECT article_hash_id, experiment_arm, source, position, served_at
+FROM recommendation_impressions
+WHERE user_id = ?
+ORDER BY served_at, id
+`
+
+type ExportUserRecommendationImpressionsRow struct {
+	ArticleHashID string
+	ExperimentArm string
+	Source        string
+	Position      int32
+	ServedAt      time.Time
+}
+
+func (q *Queries) ExportUserRecommendationImpressions(ctx context.Context, userID string) ([]ExportUserRecommendationImpressionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, exportUserRecommendationImpressions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportUserRecommendationImpressionsRow
+	for rows.Next() {
+		var i ExportUserRecommendationImpressionsRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.ExperimentArm,
+			&i.Source,
+			&i.Position,
+			&i.ServedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchArticlesByID = `-- name: FetchArticlesByID :many
+SELECT
+    hash_id,
+    title,
+    url,
+    source,
+    LEFT(COALESCE(text, ''), 500) as text_start,
+    authors,
+    date_published,
+    summary,
+    key_points,
+    implication,
+    category,
+    thumbnail_url,
+    have_read,
+    thumbs_up,
+    thumbs_down
+FROM articles
+LEFT JOIN user_article_interactions
+    ON articles.hash_id = user_article_interactions.article_hash_id
+        AND user_article_interactions.user_id = ?
+WHERE hash_id IN (/*SLICE:hash_ids*/?)
+`
+
+type FetchArticlesByIDParams struct {
+	UserID  string
+	HashIds []string
+}
+
+type FetchArticlesByIDRow struct {
+	HashID        string
+	Title         sql.NullString
+	Url           sql.NullString
+	Source        sql.NullString
+	TextStart     string
+	Authors       string
+	DatePublished sql.NullTime
+	Summary       sql.NullString
+	KeyPoints     sql.NullString
+	Implication   sql.NullString
+	Category      sql.NullString
+	ThumbnailUrl  sql.NullString
+	HaveRead      sql.NullBool
+	ThumbsUp      sql.NullBool
+	ThumbsDown    sql.NullBool
+}
+
+func (q *Queries) FetchArticlesByID(ctx context.Context, arg FetchArticlesByIDParams) ([]FetchArticlesByIDRow, error) {
+	query := fetchArticlesByID
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.UserID)
+	if len(arg.HashIds) > 0 {
+		for _, v := range arg.HashIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", strings.Repeat(",?", len(arg.HashIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchArticlesByIDRow
+	for rows.Next() {
+		var i FetchArticlesByIDRow
+		if err := rows.Scan(
+			&i.HashID,
+			&i.Title,
+			&i.Url,
+			&i.Source,
+			&i.TextStart,
+			&i.Authors,
+			&i.DatePublished,
+			&i.Summary,
+			&i.KeyPoints,
+			&i.Implication,
+			&i.Category,
+			&i.ThumbnailUrl,
+			&i.HaveRead,
+			&i.ThumbsUp,
+			&i.ThumbsDown,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findArticlesByNormalizedTitles = `-- name: FindArticlesByNormalizedTitles :many
+SELECT hash_id, title
+FROM articles
+WHERE normalized_title IN (/*SLICE:titles*/?)
+`
+
+type FindArticlesByNormalizedTitlesRow struct {
+	HashID string
+	Title  sql.NullString
+}
+
+func (q *Queries) FindArticlesByNormalizedTitles(ctx context.Context, titles []string) ([]FindArticlesByNormalizedTitlesRow, error) {
+	query := findArticlesByNormalizedTitles
+	var queryParams []interface{}
+	if len(titles) > 0 {
+		for _, v := range titles {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:titles*/?", strings.Repeat(",?", len(titles))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:titles*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindArticlesByNormalizedTitlesRow
+	for rows.Next() {
+		var i FindArticlesByNormalizedTitlesRow
+		if err := rows.Scan(&i.HashID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findArticlesByURLs = `-- name: FindArticlesByURLs :many
+SELECT hash_id, url
+FROM articles
+WHERE url IN (/*SLICE:urls*/?)
+`
+
+type FindArticlesByURLsRow struct {
+	HashID string
+	Url    sql.NullString
+}
+
+func (q *Queries) FindArticlesByURLs(ctx context.Context, urls []string) ([]FindArticlesByURLsRow, error) {
+	query := findArticlesByURLs
+	var queryParams []interface{}
+	if len(urls) > 0 {
+		for _, v := range urls {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:urls*/?", strings.Repeat(",?", len(urls))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:urls*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindArticlesByURLsRow
+	for rows.Next() {
+		var i FindArticlesByURLsRow
+		if err := rows.Scan(&i.HashID, &i.Url); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const followItem = `-- name: FollowItem :exec
+
+INSERT IGNORE INTO user_follows (user_id, follow_type, target, created_at)
+VALUES (?, ?, ?, NOW())
+`
+
+type FollowItemParams struct {
+	UserID     string
+	FollowType string
+	Target     string
+}
+
+// ============================================
+// User Follows
+// ============================================
+func (q *Queries) FollowItem(ctx context.Context, arg FollowItemParams) error {
+	_, err := q.db.ExecContext(ctx, followItem, arg.UserID, arg.FollowType, arg.Target)
+	return err
+}
+
+const getAPITokenByHash = `-- name: GetAPITokenByHash :one
+SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at, scopes,
+    replaced_by_id
+FROM api_tokens
+WHERE token_hash = ?
+`
+
+func (q *Queries) GetAPITokenByHash(ctx context.Context, tokenHash string) (ApiToken, error) {
+	row := q.db.QueryRowContext(ctx, getAPITokenByHash, tokenHash)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.TokenPrefix,
+		&i.Name,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.Scopes,
+		&i.ReplacedByID,
+	)
+	return i, err
+}
+
+const getArticleNote = `-- name: GetArticleNote :one
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE id = ? AND user_id = ?
+`
+
+type GetArticleNoteParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) GetArticleNote(ctx context.Context, arg GetArticleNoteParams) (ArticleNote, error) {
+	row := q.db.QueryRowContext(ctx, getArticleNote, arg.ID, arg.UserID)
+	var i ArticleNote
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ArticleHashID,
+		&i.Body,
+		&i.Quote,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getArticlePopularityByIDs = `-- name: GetArticlePopularityByIDs :many
+SELECT article_hash_id, like_count, dislike_count, read_count, user_count, score
+FROM article_popularity
+WHERE window_days = ? AND article_hash_id IN (/*SLICE:hash_ids*/?)
+`
+
+type GetArticlePopularityByIDsParams struct {
+	WindowDays int32
+	HashIds    []string
+}
+
+type GetArticlePopularityByIDsRow struct {
+	ArticleHashID string
+	LikeCount     int64
+	DislikeCount  int64
+	ReadCount     int64
+	UserCount     int64
+	Score         float64
+}
+
+func (q *Queries) GetArticlePopularityByIDs(ctx context.Context, arg GetArticlePopularityByIDsParams) ([]GetArticlePopularityByIDsRow, error) {
+	query := getArticlePopularityByIDs
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.WindowDays)
+	if len(arg.HashIds) > 0 {
+		for _, v := range arg.HashIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", strings.Repeat(",?", len(arg.HashIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArticlePopularityByIDsRow
+	for rows.Next() {
+		var i GetArticlePopularityByIDsRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.LikeCount,
+			&i.DislikeCount,
+			&i.ReadCount,
+			&i.UserCount,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArticlePublishedDates = `-- name: GetArticlePublishedDates :many
+SELECT hash_id, date_published
+FROM articles
+WHERE hash_id IN (/*SLICE:hash_ids*/?)
+    AND date_published IS NOT NULL
+`
+
+type GetArticlePublishedDatesRow struct {
+	HashID        string
+	DatePublished sql.NullTime
+}
+
+func (q *Queries) GetArticlePublishedDates(ctx context.Context, hashIds []string) ([]GetArticlePublishedDatesRow, error) {
+	query := getArticlePublishedDates
+	var queryParams []interface{}
+	if len(hashIds) > 0 {
+		for _, v := range hashIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", strings.Repeat(",?", len(hashIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArticlePublishedDatesRow
+	for rows.Next() {
+		var i GetArticlePublishedDatesRow
+		if err := rows.Scan(&i.HashID, &i.DatePublished); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT a.id, a.name,
+    (SELECT COUNT(*) FROM article_authors aa
+     WHERE aa.author_id = a.id
+        AND aa.article_hash_id NOT IN (SELECT variant_hash_id FROM article_duplicates)) AS article_count
+FROM authors a
+WHERE a.id = ?
+`
+
+type GetAuthorRow struct {
+	ID           string
+	Name         string
+	ArticleCount int64
+}
+
+func (q *Queries) GetAuthor(ctx context.Context, id string) (GetAuthorRow, error) {
+	row := q.db.QueryRowContext(ctx, getAuthor, id)
+	var i GetAuthorRow
+	err := row.Scan(&i.ID, &i.Name, &i.ArticleCount)
+	return i, err
+}
+
+const getCanonicalArticleIDs = `-- name: GetCanonicalArticleIDs :many
+SELECT variant_hash_id, canonical_hash_id
+FROM article_duplicates
+WHERE variant_hash_id IN (/*SLICE:hash_ids*/?)
+`
+
+type GetCanonicalArticleIDsRow struct {
+	VariantHashID   string
+	CanonicalHashID string
+}
+
+func (q *Queries) GetCanonicalArticleIDs(ctx context.Context, hashIds []string) ([]GetCanonicalArticleIDsRow, error) {
+	query := getCanonicalArticleIDs
+	var queryParams []interface{}
+	if len(hashIds) > 0 {
+		for _, v := range hashIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", strings.Repeat(",?", len(hashIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCanonicalArticleIDsRow
+	for rows.Next() {
+		var i GetCanonicalArticleIDsRow
+		if err := rows.Scan(&i.VariantHashID, &i.CanonicalHashID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCollection = `-- name: GetCollection :one
+SELECT c.id, c.user_id, c.name, c.is_public, c.share_token, c.created_at, c.updated_at,
+       COUNT(ca.article_hash_id) AS article_count
+FROM collections c
+LEFT JOIN collection_articles ca ON ca.collection_id = c.id
+WHERE c.id = ? AND c.user_id = ?
+GROUP BY c.id
+`
+
+type GetCollectionParams struct {
+	ID     string
+	UserID string
+}
+
+type GetCollectionRow struct {
+	ID           string
+	UserID       string
+	Name         string
+	IsPublic     bool
+	ShareToken   string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	ArticleCount int64
+}
+
+func (q *Queries) GetCollection(ctx context.Context, arg GetCollectionParams) (GetCollectionRow, error) {
+	row := q.db.QueryRowContext(ctx, getCollection, arg.ID, arg.UserID)
+	var i GetCollectionRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.IsPublic,
+		&i.ShareToken,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ArticleCount,
+	)
+	return i, err
+}
+
+const getCollectionByShareToken = `-- name: GetCollectionByShareToken :one
+SELECT c.id, c.user_id, c.name, c.is_public, c.share_token, c.created_at, c.updated_at,
+       COUNT(ca.article_hash_id) AS article_count
+FROM collections c
+LEFT JOIN collection_articles ca ON ca.collection_id = c.id
+WHERE c.share_token = ?
+GROUP BY c.id
+`
+
+type GetCollectionByShareTokenRow struct {
+	ID           string
+	UserID       string
+	Name         string
+	IsPublic     bool
+	ShareToken   string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	ArticleCount int64
+}
+
+func (q *Queries) GetCollectionByShareToken(ctx context.Context, shareToken string) (GetCollectionByShareTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, getCollectionByShareToken, shareToken)
+	var i GetCollectionByShareTokenRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.IsPublic,
+		&i.ShareToken,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ArticleCount,
+	)
+	return i, err
+}
+
+const getCollectionNextPosition = `-- name: GetCollectionNextPosition :one
+SELECT CAST(COALESCE(MAX(position) + 1, 0) AS SIGNED) AS next_position
+FROM collection_articles
+WHERE collection_id = ?
+`
+
+func (q *Queries) GetCollectionNextPosition(ctx context.Context, collectionID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getCollectionNextPosition, collectionID)
+	var next_position int64
+	err := row.Scan(&next_position)
+	return next_position, err
+}
+
+const getDigestPreferences = `-- name: GetDigestPreferences :one
+
+SELECT user_id, email, pending_email, email_confirmation_token, frequency, categories, enabled, unsubscribe_token,
+    last_sent_at
+FROM user_digest_preferences
+WHERE user_id = ?
+`
+
+type GetDigestPreferencesRow struct {
+	UserID                 string
+	Email                  string
+	PendingEmail           sql.NullString
+	EmailConfirmationToken sql.NullString
+	Frequency              string
+	Categories             sql.NullString
+	Enabled                bool
+	UnsubscribeToken       string
+	LastSentAt             sql.NullTime
+}
+
+// ============================================
+// Digest Preferences
+// ============================================
+func (q *Queries) GetDigestPreferences(ctx context.Context, userID string) (GetDigestPreferencesRow, error) {
+	row := q.db.QueryRowContext(ctx, getDigestPreferences, userID)
+	var i GetDigestPreferencesRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Email,
+		&i.PendingEmail,
+		&i.EmailConfirmationToken,
+		&i.Frequency,
+		&i.Categories,
+		&i.Enabled,
+		&i.UnsubscribeToken,
+		&i.LastSentAt,
+	)
+	return i, err
+}
+
+const getDigestUserIDByUnsubscribeToken = `-- name: GetDigestUserIDByUnsubscribeToken :one
+SELECT user_id
+FROM user_digest_preferences
+WHERE unsubscribe_token = ?
+`
+
+func (q *Queries) GetDigestUserIDByUnsubscribeToken(ctx context.Context, unsubscribeToken string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getDigestUserIDByUnsubscribeToken, unsubscribeToken)
+	var user_id string
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const getFollowPreferences = `-- name: GetFollowPreferences :one
+SELECT user_id, include_in_recommendations, feed_token
+FROM user_follow_preferences
+WHERE user_id = ?
+`
+
+type GetFollowPreferencesRow struct {
+	UserID                   string
+	IncludeInRecommendations bool
+	FeedToken                string
+}
+
+func (q *Queries) GetFollowPreferences(ctx context.Context, userID string) (GetFollowPreferencesRow, error) {
+	row := q.db.QueryRowContext(ctx, getFollowPreferences, userID)
+	var i GetFollowPreferencesRow
+	err := row.Scan(&i.UserID, &i.IncludeInRecommendations, &i.FeedToken)
+	return i, err
+}
+
+const getFollowPreferencesByFeedToken = `-- name: GetFollowPreferencesByFeedToken :one
+SELECT user_id, include_in_recommendations, feed_token
+FROM user_follow_preferences
+WHERE feed_token = ?
+`
+
+type GetFollowPreferencesByFeedTokenRow struct {
+	UserID                   string
+	IncludeInRecommendations bool
+	FeedToken                string
+}
+
+func (q *Queries) GetFollowPreferencesByFeedToken(ctx context.Context, feedToken string) (GetFollowPreferencesByFeedTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, getFollowPreferencesByFeedToken, feedToken)
+	var i GetFollowPreferencesByFeedTokenRow
+	err := row.Scan(&i.UserID, &i.IncludeInRecommendations, &i.FeedToken)
+	return i, err
+}
+
+const getPrecomputedRecommendationAge = `-- name: GetPrecomputedRecommendationAge :one
+SELECT generated_at
+FROM user_precomputed_recommendations
+WHERE user_id = ?
+ORDER BY generated_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetPrecomputedRecommendationAge(ctx context.Context, userID string) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getPrecomputedRecommendationAge, userID)
+	var generated_at time.Time
+	err := row.Scan(&generated_at)
+	return generated_at, err
+}
+
+const getPrecomputedRecommendations = `-- name: GetPrecomputedRecommendations :many
+SELECT article_hash_id, score, source, experiment_arm, position, generated_at
+FROM user_precomputed_recommendations
+WHERE user_id = ?
+ORDER BY position ASC
+LIMIT ?
+`
+
+type GetPrecomputedRecommendationsParams struct {
+	UserID string
+	Limit  int32
+}
+
+type GetPrecomputedRecommendationsRow struct {
+	ArticleHashID string
+	Score         float64
+	Source        string
+	ExperimentArm string
+	Position      int32
+	GeneratedAt   time.Time
+}
+
+func (q *Queries) GetPrecomputedRecommendations(ctx context.Context, arg GetPrecomputedRecommendationsParams) ([]GetPrecomputedRecommendationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPrecomputedRecommendations, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPrecomputedRecommendationsRow
+	for rows.Next() {
+		var i GetPrecomputedRecommendationsRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.Score,
+			&i.Source,
+			&i.ExperimentArm,
+			&i.Position,
+			&i.GeneratedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRateLimitBucketForUpdate = `-- name: GetRateLimitBucketForUpdate :one
+SELECT tokens, updated_at
+FROM rate_limit_buckets
+WHERE bucket_key = ?
+FOR UPDATE
+`
+
+type GetRateLimitBucketForUpdateRow struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+func (q *Queries) GetRateLimitBucketForUpdate(ctx context.Context, bucketKey string) (GetRateLimitBucketForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getRateLimitBucketForUpdate, bucketKey)
+	var i GetRateLimitBucketForUpdateRow
+	err := row.Scan(&i.Tokens, &i.UpdatedAt)
+	return i, err
+}
+
+const getSavedSearch = `-- name: GetSavedSearch :one
+SELECT id, user_id, name, kind, filters, query_text, query_vector, feed_token, last_viewed_at, created_at, updated_at
+FROM saved_searches
+WHERE id = ? AND user_id = ?
+`
+
+type GetSavedSearchParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) GetSavedSearch(ctx context.Context, arg GetSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRowContext(ctx, getSavedSearch, arg.ID, arg.UserID)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Kind,
+		&i.Filters,
+		&i.QueryText,
+		&i.QueryVector,
+		&i.FeedToken,
+		&i.LastViewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSavedSearchByFeedToken = `-- name: GetSavedSearchByFeedToken :one
+SELECT id, user_id, name, kind, filters, query_text, query_vector, feed_token, last_viewed_at, created_at, updated_at
+FROM saved_searches
+WHERE feed_token = ?
+`
+
+func (q *Queries) GetSavedSearchByFeedToken(ctx context.Context, feedToken string) (SavedSearch, error) {
+	row := q.db.QueryRowContext(ctx, getSavedSearchByFeedToken, feedToken)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Kind,
+		&i.Filters,
+		&i.QueryText,
+		&i.QueryVector,
+		&i.FeedToken,
+		&i.LastViewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTagPreferences = `-- name: GetTagPreferences :one
+SELECT user_id, share_for_recommendations
+FROM user_tag_preferences
+WHERE user_id = ?
+`
+
+type GetTagPreferencesRow struct {
+	UserID                  string
+	ShareForRecommendations bool
+}
+
+func (q *Queries) GetTagPreferences(ctx context.Context, userID string) (GetTagPreferencesRow, error) {
+	row := q.db.QueryRowContext(ctx, getTagPreferences, userID)
+	var i GetTagPreferencesRow
+	err := row.Scan(&i.UserID, &i.ShareForRecommendations)
+	return i, err
+}
+
+const getUserAPIToken = `-- name: GetUserAPIToken :one
+SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at, scopes,
+    replaced_by_id
+FROM api_tokens
+WHERE id = ? AND user_id = ?
+`
+
+type GetUserAPITokenParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) GetUserAPIToken(ctx context.Context, arg GetUserAPITokenParams) (ApiToken, error) {
+	row := q.db.QueryRowContext(ctx, getUserAPIToken, arg.ID, arg.UserID)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.TokenPrefix,
+		&i.Name,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.Scopes,
+		&i.ReplacedByID,
+	)
+	return i, err
+}
+
+const getUserArticleInteraction = `-- name: GetUserArticleInteraction :one
+
+SELECT have_read, thumbs_up, thumbs_down, rating, date_read, date_rated
+FROM user_article_interactions
+WHERE user_id = ? AND article_hash_id = ?
+`
+
+type GetUserArticleInteractionParams struct {
+	UserID        string
+	ArticleHashID string
+}
+
+type GetUserArticleInteractionRow struct {
+	HaveRead   bool
+	ThumbsUp   bool
+	ThumbsDown bool
+	Rating     sql.NullInt32
+	DateRead   sql.NullTime
+	DateRated  sql.NullTime
+}
+
+// ============================================
+// User Article Interactions (unified table)
+// ============================================
+func (q *Queries) GetUserArticleInteraction(ctx context.Context, arg GetUserArticleInteractionParams) (GetUserArticleInteractionRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserArticleInteraction, arg.UserID, arg.ArticleHashID)
+	var i GetUserArticleInteractionRow
+	err := row.Scan(
+		&i.HaveRead,
+		&i.ThumbsUp,
+		&i.ThumbsDown,
+		&i.Rating,
+		&i.DateRead,
+		&i.DateRated,
+	)
+	return i, err
+}
+
+const getUserArticleVectorsByPositiveSignals = `-- name: GetUserArticleVectorsByPositiveSignals :many
+SELECT article_hash_id, ` + "`" + `vector` + "`" + `, thumbs_up, thumbs_down, rating, opened_link,
+       time_on_article_seconds, saved_to_collection, date_rated, date_engaged
+FROM user_article_interactions
+WHERE user_id = ? AND thumbs_down = FALSE AND dismissed = FALSE AND ` + "`" + `vector` + "`" + ` IS NOT NULL
+    AND (thumbs_up = TRUE OR opened_link = TRUE OR time_on_article_seconds > 0 OR saved_to_collection = TRUE)
+ORDER BY COALESCE(date_rated, date_engaged) DESC
+`
+
+type GetUserArticleVectorsByPositiveSignalsRow struct {
+	ArticleHashID        string
+	Vector               sql.NullString
+	ThumbsUp             bool
+	ThumbsDown           bool
+	Rating               sql.NullInt32
+	OpenedLink           bool
+	TimeOnArticleSeconds int32
+	SavedToCollection    bool
+	DateRated            sql.NullTime
+	DateEngaged          sql.NullTime
+}
+
+func (q *Queries) GetUserArticleVectorsByPositiveSignals(ctx context.Context, userID string) ([]GetUserArticleVectorsByPositiveSignalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserArticleVectorsByPositiveSignals, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserArticleVectorsByPositiveSignalsRow
+	for rows.Next() {
+		var i GetUserArticleVectorsByPositiveSignalsRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.Vector,
+			&i.ThumbsUp,
+			&i.ThumbsDown,
+			&i.Rating,
+			&i.OpenedLink,
+			&i.TimeOnArticleSeconds,
+			&i.SavedToCollection,
+			&i.DateRated,
+			&i.DateEngaged,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserArticleVectorsByThumbsDown = `-- name: GetUserArticleVectorsByThumbsDown :many
+SELECT article_hash_id, ` + "`" + `vector` + "`" + `, thumbs_up, thumbs_down, rating, opened_link,
+       time_on_article_seconds, saved_to_collection, date_rated, date_engaged
+FROM user_article_interactions
+WHERE user_id = ? AND thumbs_down = TRUE AND ` + "`" + `vector` + "`" + ` IS NOT NULL
+ORDER BY date_rated DESC
+`
+
+type GetUserArticleVectorsByThumbsDownRow struct {
+	ArticleHashID        string
+	Vector               sql.NullString
+	ThumbsUp             bool
+	ThumbsDown           bool
+	Rating               sql.NullInt32
+	OpenedLink           bool
+	TimeOnArticleSeconds int32
+	SavedToCollection    bool
+	DateRated            sql.NullTime
+	DateEngaged          sql.NullTime
+}
+
+func (q *Queries) GetUserArticleVectorsByThumbsDown(ctx context.Context, userID string) ([]GetUserArticleVectorsByThumbsDownRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserArticleVectorsByThumbsDown, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserArticleVectorsByThumbsDownRow
+	for rows.Next() {
+		var i GetUserArticleVectorsByThumbsDownRow
+		if err := rows.Scan(
+			&i.ArticleHashID,
+			&i.Vector,
+			&i.ThumbsUp,
+			&i.ThumbsDown,
+			&i.Rating,
+			&i.OpenedLink,
+			&i.TimeOnArticleSeconds,
+			&i.SavedToCollection,
+			&i.DateRated,
+			&i.DateEngaged,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserInterestClusters = `-- name: GetUserInterestClusters :many
+SELECT cluster_id, centroid_vector, article_count, provisional, updated_at
+FROM user_interest_clusters
+WHERE user_id = ?
+ORDER BY cluster_id
+`
+
+type GetUserInterestClustersRow struct {
+	ClusterID      int32
+	CentroidVector []byte
+	ArticleCount   int32
+	Provisional    bool
+	UpdatedAt      time.Time
+}
+
+func (q *Queries) GetUserInterestClusters(ctx context.Context, userID string) ([]GetUserInterestClustersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserInterestClusters, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserInterestClustersRow
+	for rows.Next() {
+		var i GetUserInterestClustersRow
+		if err := rows.Scan(
+			&i.ClusterID,
+			&i.CentroidVector,
+			&i.ArticleCount,
+			&i.Provisional,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserOnboardingInterests = `-- name: GetUserOnboardingInterests :one
+
+SELECT categories, interests, updated_at
+FROM user_onboarding_interests
+WHERE user_id = ?
+`
+
+type GetUserOnboardingInterestsRow struct {
+	Categories sql.NullString
+	Interests  sql.NullString
+	UpdatedAt  time.Time
+}
+
+// ============================================
+// User Onboarding
+// ============================================
+func (q *Queries) GetUserOnboardingInterests(ctx context.Context, userID string) (GetUserOnboardingInterestsRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserOnboardingInterests, userID)
+	var i GetUserOnboardingInterestsRow
+	err := row.Scan(&i.Categories, &i.Interests, &i.UpdatedAt)
+	return i, err
+}
+
+const getUserRecommendationState = `-- name: GetUserRecommendationState :one
+
+SELECT last_generated_at, last_rating_at, needs_regeneration
+FROM user_recommendation_state
+WHERE user_id = ?
+`
+
+type GetUserRecommendationStateRow struct {
+	LastGeneratedAt   sql.NullTime
+	LastRatingAt      sql.NullTime
+	NeedsRegeneration bool
+}
+
+// ============================================
+// User Recommendation State
+// ============================================
+func (q *Queries) GetUserRecommendationState(ctx context.Context, userID string) (GetUserRecommendationStateRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserRecommendationState, userID)
+	var i GetUserRecommendationStateRow
+	err := row.Scan(&i.LastGeneratedAt, &i.LastRatingAt, &i.NeedsRegeneration)
+	return i, err
+}
+
+const insertArticle = `-- name: InsertArticle :exec
+INSERT INTO articles (
+                      hash_id,
+                      title,
+                      url,
+                      source,
+                      text,
+                      authors,
+                      date_published,
+                      date_created,
+                      pinecone_status,
+                      date_checked) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertArticleParams struct {
+	HashID         string
+	Title          sql.NullString
+	Url            sql.NullString
+	Source         sql.NullString
+	Text           sql.NullString
+	Authors        string
+	DatePublished  sql.NullTime
+	DateCreated    time.Time
+	PineconeStatus ArticlesPineconeStatus
+	DateChecked    time.Time
+}
+
+func (q *Queries) InsertArticle(ctx context.Context, arg InsertArticleParams) error {
+	_, err := q.db.ExecContext(ctx, insertArticle,
+		arg.HashID,
+		arg.Title,
+		arg.Url,
+		arg.Source,
+		arg.Text,
+		arg.Authors,
+		arg.DatePublished,
+		arg.DateCreated,
+		arg.PineconeStatus,
+		arg.DateChecked,
+	)
+	return err
+}
+
+const insertArticleAuthor = `-- name: InsertArticleAuthor :exec
+INSERT INTO article_authors (article_hash_id, author_id, position)
+VALUES (?, ?, ?)
+`
+
+type InsertArticleAuthorParams struct {
+	ArticleHashID string
+	AuthorID      string
+	Position      int32
+}
+
+func (q *Queries) InsertArticleAuthor(ctx context.Context, arg InsertArticleAuthorParams) error {
+	_, err := q.db.ExecContext(ctx, insertArticleAuthor, arg.ArticleHashID, arg.AuthorID, arg.Position)
+	return err
+}
+
+const insertArticleDuplicate = `-- name: InsertArticleDuplicate :exec
+INSERT INTO article_duplicates (variant_hash_id, canonical_hash_id, detected_at)
+VALUES (?, ?, NOW())
+`
+
+type InsertArticleDuplicateParams struct {
+	VariantHashID   string
+	CanonicalHashID string
+}
+
+func (q *Queries) InsertArticleDuplicate(ctx context.Context, arg InsertArticleDuplicateParams) error {
+	_, err := q.db.ExecContext(ctx, insertArticleDuplicate, arg.VariantHashID, arg.CanonicalHashID)
+	return err
+}
+
+const insertArticleNeighbour = `-- name: InsertArticleNeighbour :exec
+INSERT INTO article_neighbours (article_hash_id, neighbour_hash_id, co_like_count, score, computed_at)
+VALUES (?, ?, ?, ?, NOW())
+`
+
+type InsertArticleNeighbourParams struct {
+	ArticleHashID   string
+	NeighbourHashID string
+	CoLikeCount     int64
+	Score           float64
+}
+
+func (q *Queries) InsertArticleNeighbour(ctx context.Context, arg InsertArticleNeighbourParams) error {
+	_, err := q.db.ExecContext(ctx, insertArticleNeighbour,
+		arg.ArticleHashID,
+		arg.NeighbourHashID,
+		arg.CoLikeCount,
+		arg.Score,
+	)
+	return err
+}
+
+const insertArticlePopularity = `-- name: InsertArticlePopularity :exec
+INSERT INTO article_popularity
+    (article_hash_id, window_days, like_count, dislike_count, read_count, user_count, score, computed_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, NOW())
+`
+
+type InsertArticlePopularityParams struct {
+	ArticleHashID string
+	WindowDays    int32
+	LikeCount     int64
+	DislikeCount  int64
+	ReadCount     int64
+	UserCount     int64
+	Score         float64
+}
+
+func (q *Queries) InsertArticlePopularity(ctx context.Context, arg InsertArticlePopularityParams) error {
+	_, err := q.db.ExecContext(ctx, insertArticlePopularity,
+		arg.ArticleHashID,
+		arg.WindowDays,
+		arg.LikeCount,
+		arg.DislikeCount,
+		arg.ReadCount,
+		arg.UserCount,
+		arg.Score,
+	)
+	return err
+}
+
+const insertAuditEvent = `-- name: InsertAuditEvent :exec
+
+INSERT INTO audit_events (user_id, event_type, outcome, token_id, ip_address, user_agent, detail, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, NOW())
+`
+
+type InsertAuditEventParams struct {
+	UserID    sql.NullString
+	EventType string
+	Outcome   string
+	TokenID   sql.NullString
+	IpAddress string
+	UserAgent string
+	Detail    string
+}
+
+// ============================================
+// Audit Events
+// ============================================
+func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertAuditEvent,
+		arg.UserID,
+		arg.EventType,
+		arg.Outcome,
+		arg.TokenID,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.Detail,
+	)
+	return err
+}
+
+const insertAuthorAlias = `-- name: InsertAuthorAlias :exec
+INSERT INTO author_aliases (author_id, alias)
+VALUES (?, ?)
+`
+
+type InsertAuthorAliasParams struct {
+	AuthorID string
+	Alias    string
+}
+
+func (q *Queries) InsertAuthorAlias(ctx context.Context, arg InsertAuthorAliasParams) error {
+	_, err := q.db.ExecContext(ctx, insertAuthorAlias, arg.AuthorID, arg.Alias)
+	return err
+}
+
+const insertProvisionalUserInterestCluster = `-- name: InsertProvisionalUserInterestCluster :exec
+INSERT INTO user_interest_clusters (user_id, cluster_id, centroid_vector, article_count, provisional, updated_at)
+VALUES (?, ?, ?, 0, TRUE, NOW())
+`
+
+type InsertProvisionalUserInterestClusterParams struct {
+	UserID         string
+	ClusterID      int32
+	CentroidVector []byte
+}
+
+func (q *Queries) InsertProvisionalUserInterestCluster(ctx context.Context, arg InsertProvisionalUserInterestClusterParams) error {
+	_, err := q.db.ExecContext(ctx, insertProvisionalUserInterestCluster, arg.UserID, arg.ClusterID, arg.CentroidVector)
+	return err
+}
+
+const insertRecommendationImpression = `-- name: InsertRecommendationImpression :exec
+
+INSERT INTO recommendation_impressions
+    (user_id, article_hash_id, experiment_arm, source, position, served_at)
+VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type InsertRecommendationImpressionParams struct {
+	UserID        string
+	ArticleHashID string
+	ExperimentArm string
+	Source        string
+	Position      int32
+	ServedAt      time.Time
+}
+
+// ============================================
+// Recommendation Experiments
+// ============================================
+func (q *Queries) InsertRecommendationImpression(ctx context.Context, arg InsertRecommendationImpressionParams) error {
+	_, err := q.db.ExecContext(ctx, insertRecommendationImpression,
+		arg.UserID,
+		arg.ArticleHashID,
+		arg.ExperimentArm,
+		arg.Source,
+		arg.Position,
+		arg.ServedAt,
+	)
+	return err
+}
+
+const listArticleAlternates = `-- name: ListArticleAlternates :many
+SELECT d.canonical_hash_id, a.hash_id, a.url, a.source
+FROM article_duplicates d
+JOIN articles a ON a.hash_id = d.variant_hash_id
+WHERE d.canonical_hash_id IN (/*SLICE:hash_ids*/?)
+ORDER BY a.date_published, a.hash_id
+`
+
+type ListArticleAlternatesRow struct {
+	CanonicalHashID string
+	HashID          string
+	Url             sql.NullString
+	Source          sql.NullString
+}
+
+func (q *Queries) ListArticleAlternates(ctx context.Context, hashIds []string) ([]ListArticleAlternatesRow, error) {
+	query := listArticleAlternates
+	var queryParams []interface{}
+	if len(hashIds) > 0 {
+		for _, v := range hashIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", strings.Repeat(",?", len(hashIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleAlternatesRow
+	for rows.Next() {
+		var i ListArticleAlternatesRow
+		if err := rows.Scan(
+			&i.CanonicalHashID,
+			&i.HashID,
+			&i.Url,
+			&i.Source,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleAuthorLists = `-- name: ListArticleAuthorLists :many
+SELECT hash_id, authors
+FROM articles
+`
+
+type ListArticleAuthorListsRow struct {
+	HashID  string
+	Authors string
+}
+
+func (q *Queries) ListArticleAuthorLists(ctx context.Context) ([]ListArticleAuthorListsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleAuthorLists)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleAuthorListsRow
+	for rows.Next() {
+		var i ListArticleAuthorListsRow
+		if err := rows.Scan(&i.HashID, &i.Authors); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleAuthorRefs = `-- name: ListArticleAuthorRefs :many
+SELECT aa.article_hash_id, a.id, a.name
+FROM article_authors aa
+JOIN authors a ON a.id = aa.author_id
+WHERE aa.article_hash_id IN (/*SLICE:hash_ids*/?)
+ORDER BY aa.article_hash_id, aa.position
+`
+
+type ListArticleAuthorRefsRow struct {
+	ArticleHashID string
+	ID            string
+	Name          string
+}
+
+func (q *Queries) ListArticleAuthorRefs(ctx context.Context, hashIds []string) ([]ListArticleAuthorRefsRow, error) {
+	query := listArticleAuthorRefs
+	var queryParams []interface{}
+	if len(hashIds) > 0 {
+		for _, v := range hashIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", strings.Repeat(",?", len(hashIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:hash_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleAuthorRefsRow
+	for rows.Next() {
+		var i ListArticleAuthorRefsRow
+		if err := rows.Scan(&i.ArticleHashID, &i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleCategories = `-- name: ListArticleCategories :many
+SELECT category, COUNT(*) AS article_count
+FROM articles
+WHERE category IS NOT NULL AND category <> ''
+GROUP BY category
+ORDER BY article_count DESC, category
+`
+
+type ListArticleCategoriesRow struct {
+	Category     sql.NullString
+	ArticleCount int64
+}
+
+func (q *Queries) ListArticleCategories(ctx context.Context) ([]ListArticleCategoriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleCategoriesRow
+	for rows.Next() {
+		var i ListArticleCategoriesRow
+		if err := rows.Scan(&i.Category, &i.ArticleCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleCoLikes = `-- name: ListArticleCoLikes :many
+
+SELECT a.article_hash_id, b.article_hash_id AS neighbour_hash_id, COUNT(*) AS co_like_count
+FROM user_article_interactions a
+JOIN user_article_interactions b
+    ON b.user_id = a.user_id AND b.article_hash_id != a.article_hash_id AND b.thumbs_up = TRUE
+WHERE a.thumbs_up = TRUE
+GROUP BY a.article_hash_id, b.article_hash_id
+HAVING co_like_count >= ?
+`
+
+type ListArticleCoLikesRow struct {
+	ArticleHashID   string
+	NeighbourHashID string
+	CoLikeCount     int64
+}
+
+// ============================================
+// Article Neighbours
+// ============================================
+func (q *Queries) ListArticleCoLikes(ctx context.Context, minCoLikes int64) ([]ListArticleCoLikesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleCoLikes, minCoLikes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleCoLikesRow
+	for rows.Next() {
+		var i ListArticleCoLikesRow
+		if err := rows.Scan(&i.ArticleHashID, &i.NeighbourHashID, &i.CoLikeCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleDuplicates = `-- name: ListArticleDuplicates :many
+SELECT canonical_hash_id, variant_hash_id
+FROM article_duplicates
+ORDER BY canonical_hash_id, variant_hash_id
+`
+
+type ListArticleDuplicatesRow struct {
+	CanonicalHashID string
+	VariantHashID   string
+}
+
+func (q *Queries) ListArticleDuplicates(ctx context.Context) ([]ListArticleDuplicatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleDuplicates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleDuplicatesRow
+	for rows.Next() {
+		var i ListArticleDuplicatesRow
+		if err := rows.Scan(&i.CanonicalHashID, &i.VariantHashID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleNotes = `-- name: ListArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = ? AND article_hash_id = ?
+ORDER BY created_at
+`
+
+type ListArticleNotesParams struct {
+	UserID        string
+	ArticleHashID string
+}
+
+func (q *Queries) ListArticleNotes(ctx context.Context, arg ListArticleNotesParams) ([]ArticleNote, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleNotes, arg.UserID, arg.ArticleHashID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArticleNote
+	for rows.Next() {
+		var i ArticleNote
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ArticleHashID,
+			&i.Body,
+			&i.Quote,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleTags = `-- name: ListArticleTags :many
+SELECT tag FROM user_article_tags
+WHERE user_id = ? AND article_hash_id = ?
+ORDER BY tag
+`
+
+type ListArticleTagsParams struct {
+	UserID        string
+	ArticleHashID string
+}
+
+func (q *Queries) ListArticleTags(ctx context.Context, arg ListArticleTagsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleTags, arg.UserID, arg.ArticleHashID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorAliases = `-- name: ListAuthorAliases :many
+SELECT alias
+FROM author_aliases
+WHERE author_id = ?
+ORDER BY alias
+`
+
+func (q *Queries) ListAuthorAliases(ctx context.Context, authorID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorAliases, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var alias string
+		if err := rows.Scan(&alias); err != nil {
+			return nil, err
+		}
+		items = append(items, alias)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorArticleIDs = `-- name: ListAuthorArticleIDs :many
+SELECT aa.article_hash_id
+FROM article_authors aa
+JOIN articles a ON a.hash_id = aa.article_hash_id
+WHERE aa.author_id = ?
+    AND aa.article_hash_id NOT IN (SELECT variant_hash_id FROM article_duplicates)
+ORDER BY a.date_published DESC, aa.article_hash_id
+LIMIT ? OFFSET ?
+`
+
+type ListAuthorArticleIDsParams struct {
+	AuthorID string
+	Limit    int32
+	Offset   int32
+}
+
+func (q *Queries) ListAuthorArticleIDs(ctx context.Context, arg ListAuthorArticleIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorArticleIDs, arg.AuthorID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_hash_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorNames = `-- name: ListAuthorNames :many
+SELECT id AS author_id, name
+FROM authors
+UNION ALL
+SELECT author_id, alias
+FROM author_aliases
+`
+
+type ListAuthorNamesRow struct {
+	AuthorID string
+	Name     string
+}
+
+func (q *Queries) ListAuthorNames(ctx context.Context) ([]ListAuthorNamesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorNamesRow
+	for rows.Next() {
+		var i ListAuthorNamesRow
+		if err := rows.Scan(&i.AuthorID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategoryExploration = `-- name: ListCategoryExploration :many
+SELECT
+    c.category,
+    COALESCE(e.engaged_count, 0) AS user_engaged,
+    COALESCE(x.shown_count, 0) AS explore_shown,
+    COALESCE(x.engaged_count, 0) AS explore_engaged
+FROM (
+    SELECT DISTINCT category FROM articles
+    WHERE category IS NOT NULL AND category <> ''
+) c
+LEFT JOIN (
+    SELECT a.category, COUNT(*) AS engaged_count
+    FROM user_article_interactions uai
+    JOIN articles a ON a.hash_id = uai.article_hash_id
+    WHERE uai.user_id = ?
+        AND uai.thumbs_down = FALSE
+        AND (uai.have_read OR uai.thumbs_up OR uai.opened_link
+            OR uai.time_on_article_seconds > 0 OR uai.saved_to_collection)
+    GROUP BY a.category
+) e ON e.category = c.category
+LEFT JOIN (
+    SELECT
+        a.category,
+        COUNT(*) AS shown_count,
+        COUNT(CASE WHEN uai.date_read >= i.first_served_at
+            OR (uai.thumbs_up AND uai.date_rated >= i.first_served_at)
+            OR uai.date_engaged >= i.first_served_at THEN 1 END) AS engaged_count
+    FROM (
+        SELECT article_hash_id, MIN(served_at) AS first_served_at
+        FROM recommendation_impressions
+        WHERE user_id = ? AND source = 'explore' AND served_at >= ?
+        GROUP BY article_hash_id
+    ) i
+    JOIN articles a ON a.hash_id = i.article_hash_id
+    LEFT JOIN user_article_interactions uai
+        ON uai.user_id = ? AND uai.article_hash_id = i.article_hash_id
+    GROUP BY a.category
+) x ON x.category = c.category
+ORDER BY c.category
+`
+
+type ListCategoryExplorationParams struct {
+	UserID string
+	Since  time.Time
+}
+
+type ListCategoryExplorationRow struct {
+	Category       sql.NullString
+	UserEngaged    int64
+	ExploreShown   int64
+	ExploreEngaged int64
+}
+
+func (q *Queries) ListCategoryExploration(ctx context.Context, arg ListCategoryExplorationParams) ([]ListCategoryExplorationRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCategoryExploration,
+		arg.UserID,
+		arg.UserID,
+		arg.Since,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryExplorationRow
+	for rows.Next() {
+		var i ListCategoryExplorationRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.UserEngaged,
+			&i.ExploreShown,
+			&i.ExploreEngaged,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCoAuthors = `-- name: ListCoAuthors :many
+SELECT a.id, a.name, COUNT(*) AS shared_articles
+FROM article_authors mine
+JOIN article_authors theirs
+    ON theirs.article_hash_id = mine.article_hash_id AND theirs.author_id <> mine.author_id
+JOIN authors a ON a.id = theirs.author_id
+WHERE mine.author_id = ?
+    AND mine.article_hash_id NOT IN (SELECT variant_hash_id FROM article_duplicates)
+GROUP BY a.id, a.name
+ORDER BY shared_articles DESC, a.name
+LIMIT ?
+`
+
+type ListCoAuthorsParams struct {
+	AuthorID string
+	Limit    int32
+}
+
+type ListCoAuthorsRow struct {
+	ID             string
+	Name           string
+	SharedArticles int64
+}
+
+func (q *Queries) ListCoAuthors(ctx context.Context, arg ListCoAuthorsParams) ([]ListCoAuthorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCoAuthors, arg.AuthorID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCoAuthorsRow
+	for rows.Next() {
+		var i ListCoAuthorsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.SharedArticles); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCollaborativeCandidates = `-- name: ListCollaborativeCandidates :many
+SELECT n.neighbour_hash_id, SUM(n.score) AS score
+FROM user_article_interactions i
+JOIN article_neighbours n ON n.article_hash_id = i.article_hash_id
+WHERE i.user_id = ? AND i.thumbs_up = TRUE
+    AND n.neighbour_hash_id NOT IN (
+        SELECT article_hash_id FROM user_article_interactions
+        WHERE user_id = ? AND (thumbs_up = TRUE OR thumbs_down = TRUE)
+    )
+GROUP BY n.neighbour_hash_id
+ORDER BY score DESC, n.neighbour_hash_id
+LIMIT ?
+`
+
+type ListCollaborativeCandidatesParams struct {
+	UserID string
+	Limit  int32
+}
+
+type ListCollaborativeCandidatesRow struct {
+	NeighbourHashID string
+	Score           float64
+}
+
+func (q *Queries) ListCollaborativeCandidates(ctx context.Context, arg ListCollaborativeCandidatesParams) ([]ListCollaborativeCandidatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCollaborativeCandidates, arg.UserID, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCollaborativeCandidatesRow
+	for rows.Next() {
+		var i ListCollaborativeCandidatesRow
+		if err := rows.Scan(&i.NeighbourHashID, &i.Score); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCollectionArticleIDs = `-- name: ListCollectionArticleIDs :many
+SELECT article_hash_id
+FROM collection_articles
+WHERE collection_id = ?
+ORDER BY position, added_at
+`
+
+func (q *Queries) ListCollectionArticleIDs(ctx context.Context, collectionID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listCollectionArticleIDs, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_hash_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDislikedArticleIDs = `-- name: ListDislikedArticleIDs :many
+SELECT article_hash_id FROM user_article_interactions
+WHERE user_id = ? AND thumbs_down = TRUE
+ORDER BY date_rated DESC
+LIMIT ? OFFSET ?
+`
+
+type ListDislikedArticleIDsParams struct {
+	UserID string
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) ListDislikedArticleIDs(ctx context.Context, arg ListDislikedArticleIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listDislikedArticleIDs, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_hash_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEnabledDigestPreferences = `-- name: ListEnabledDigestPreferences :many
+SELECT user_id, email, pending_email, email_confirmation_token, frequency, categories, enabled, unsubscribe_token,
+    last_sent_at
+FROM user_digest_preferences
+WHERE enabled = TRUE
+`
+
+type ListEnabledDigestPreferencesRow struct {
+	UserID                 string
+	Email                  string
+	PendingEmail           sql.NullString
+	EmailConfirmationToken sql.NullString
+	Frequency              string
+	Categories             sql.NullString
+	Enabled                bool
+	UnsubscribeToken       string
+	LastSentAt             sql.NullTime
+}
+
+func (q *Queries) ListEnabledDigestPreferences(ctx context.Context) ([]ListEnabledDigestPreferencesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEnabledDigestPreferences)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEnabledDigestPreferencesRow
+	for rows.Next() {
+		var i ListEnabledDigestPreferencesRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Email,
+			&i.PendingEmail,
+			&i.EmailConfirmationToken,
+			&i.Frequency,
+			&i.Categories,
+			&i.Enabled,
+			&i.UnsubscribeToken,
+			&i.LastSentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHiddenArticleIDs = `-- name: ListHiddenArticleIDs :many
+SELECT article_hash_id FROM user_article_interactions
+WHERE user_id = ? AND (dismissed = TRUE OR snoozed_until > NOW())
+`
+
+func (q *Queries) ListHiddenArticleIDs(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listHiddenArticleIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_hash_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLikedArticleIDs = `-- name: ListLikedArticleIDs :many
+SELECT article_hash_id FROM user_article_interactions
+WHERE user_id = ? AND thumbs_up = TRUE
+ORDER BY date_rated DESC
+LIMIT ? OFFSET ?
+`
+
+type ListLikedArticleIDsParams struct {
+	UserID string
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) ListLikedArticleIDs(ctx context.Context, arg ListLikedArticleIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listLikedArticleIDs, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_hash_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getPrecomputedRecommendations = `-- name: GetPrecomputedRecommendations :many
-SELECT article_hash_id, score, source, position, generated_at
-FROM user_precomputed_recommendations
-WHERE user_id = ?
-ORDER BY position ASC
-LIMIT ?
+const listPopularArticles = `-- name: ListPopularArticles :many
+SELECT article_hash_id, like_count, dislike_count, read_count, user_count, score
+FROM article_popularity
+WHERE window_days = ?
+ORDER BY score DESC, article_hash_id
+LIMIT ? OFFSET ?
 `
 
-type GetPrecomputedRecommendationsParams struct {
-	UserID string
-	Limit  int32
+type ListPopularArticlesParams struct {
+	WindowDays int32
+	Limit      int32
+	Offset     int32
 }
 
-type GetPrecomputedRecommendationsRow struct {
+type ListPopularArticlesRow struct {
 	ArticleHashID string
+	LikeCount     int64
+	DislikeCount  int64
+	ReadCount     int64
+	UserCount     int64
 	Score         float64
-	Source        string
-	Position      int32
-	GeneratedAt   time.Time
 }
 
-func (q *Queries) GetPrecomputedRecommendations(ctx context.Context, arg GetPrecomputedRecommendationsParams) ([]GetPrecomputedRecommendationsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPrecomputedRecommendations, arg.UserID, arg.Limit)
+func (q *Queries) ListPopularArticles(ctx context.Context, arg ListPopularArticlesParams) ([]ListPopularArticlesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPopularArticles, arg.WindowDays, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPrecomputedRecommendationsRow
+	var items []ListPopularArticlesRow
 	for rows.Next() {
-		var i GetPrecomputedRecommendationsRow
+		var i ListPopularArticlesRow
 		if err := rows.Scan(
 			&i.ArticleHashID,
+			&i.LikeCount,
+			&i.DislikeCount,
+			&i.ReadCount,
+			&i.UserCount,
 			&i.Score,
-			&i.Source,
-			&i.Position,
-			&i.GeneratedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -286,61 +3138,70 @@ func (q *Queries) GetPrecomputedRecommendations(ctx context.Context, arg GetPrec
 	return items, nil
 }
 
-const getUserArticleInteraction = `-- name: GetUserArticleInteraction :one
-
-SELECT user_id, article_hash_id, have_read, thumbs_up, thumbs_down,
-       date_read, date_rated, ` + "`" + `vector` + "`" + `
-FROM user_article_interactions
-WHERE user_id = ? AND article_hash_id = ?
+const listReadArticleIDs = `-- name: ListReadArticleIDs :many
+SELECT article_hash_id FROM user_article_interactions
+WHERE user_id = ? AND have_read = TRUE
 `
 
-type GetUserArticleInteractionParams struct {
-	UserID        string
-	ArticleHashID string
-}
-
-// ============================================
-// User Article Interactions (unified table)
-// ============================================
-func (q *Queries) GetUserArticleInteraction(ctx context.Context, arg GetUserArticleInteractionParams) (UserArticleInteraction, error) {
-	row := q.db.QueryRowContext(ctx, getUserArticleInteraction, arg.UserID, arg.ArticleHashID)
-	var i UserArticleInteraction
-	err := row.Scan(
-		&i.UserID,
-		&i.ArticleHashID,
-		&i.HaveRead,
-		&i.ThumbsUp,
-		&i.ThumbsDown,
-		&i.DateRead,
-		&i.DateRated,
-		&i.Vector,
-	)
-	return i, err
+func (q *Queries) ListReadArticleIDs(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listReadArticleIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_hash_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getUserArticleVectorsByThumbsDown = `-- name: GetUserArticleVectorsByThumbsDown :many
-SELECT article_hash_id, ` + "`" + `vector` + "`" + `, date_rated
-FROM user_article_interactions
-WHERE user_id = ? AND thumbs_down = TRUE AND ` + "`" + `vector` + "`" + ` IS NOT NULL
-ORDER BY date_rated DESC
+const listTagCooccurringArticles = `-- name: ListTagCooccurringArticles :many
+SELECT other.article_hash_id, COUNT(*) AS shared_count
+FROM user_article_tags mine
+JOIN user_article_tags other
+    ON other.tag = mine.tag AND other.user_id != mine.user_id
+JOIN user_tag_preferences sharing
+    ON sharing.user_id = other.user_id AND sharing.share_for_recommendations
+WHERE mine.user_id = ?
+    AND other.article_hash_id NOT IN (
+        SELECT article_hash_id FROM user_article_tags WHERE user_id = ?
+    )
+GROUP BY other.article_hash_id
+ORDER BY shared_count DESC
+LIMIT ?
 `
 
-type GetUserArticleVectorsByThumbsDownRow struct {
+type ListTagCooccurringArticlesParams struct {
+	UserID string
+	Limit  int32
+}
+
+type ListTagCooccurringArticlesRow struct {
 	ArticleHashID string
-	Vector        sql.NullString
-	DateRated     sql.NullTime
+	SharedCount   int64
 }
 
-func (q *Queries) GetUserArticleVectorsByThumbsDown(ctx context.Context, userID string) ([]GetUserArticleVectorsByThumbsDownRow, error) {
-	rows, err := q.db.QueryContext(ctx, getUserArticleVectorsByThumbsDown, userID)
+func (q *Queries) ListTagCooccurringArticles(ctx context.Context, arg ListTagCooccurringArticlesParams) ([]ListTagCooccurringArticlesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTagCooccurringArticles, arg.UserID, arg.UserID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetUserArticleVectorsByThumbsDownRow
+	var items []ListTagCooccurringArticlesRow
 	for rows.Next() {
-		var i GetUserArticleVectorsByThumbsDownRow
-		if err := rows.Scan(&i.ArticleHashID, &i.Vector, &i.DateRated); err != nil {
+		var i ListTagCooccurringArticlesRow
+		if err := rows.Scan(&i.ArticleHashID, &i.SharedCount); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -354,32 +3215,38 @@ func (q *Queries) GetUserArticleVectorsByThumbsDown(ctx context.Context, userID
 	return items, nil
 }
 
-const getUserArticleVectorsByThumbsUp = `-- name: GetUserArticleVectorsByThumbsUp :many
-SELECT article_hash_id, ` + "`" + `vector` + "`" + `, date_rated
-FROM user_article_interactions
-WHERE user_id = ? AND thumbs_up = TRUE AND ` + "`" + `vector` + "`" + ` IS NOT NULL
-ORDER BY date_rated DESC
+const listTaggedArticleIDs = `-- name: ListTaggedArticleIDs :many
+SELECT article_hash_id FROM user_article_tags
+WHERE user_id = ? AND tag = ?
+ORDER BY created_at DESC
+LIMIT ? OFFSET ?
 `
 
-type GetUserArticleVectorsByThumbsUpRow struct {
-	ArticleHashID string
-	Vector        sql.NullString
-	DateRated     sql.NullTime
+type ListTaggedArticleIDsParams struct {
+	UserID string
+	Tag    string
+	Limit  int32
+	Offset int32
 }
 
-func (q *Queries) GetUserArticleVectorsByThumbsUp(ctx context.Context, userID string) ([]GetUserArticleVectorsByThumbsUpRow, error) {
-	rows, err := q.db.QueryContext(ctx, getUserArticleVectorsByThumbsUp, userID)
+func (q *Queries) ListTaggedArticleIDs(ctx context.Context, arg ListTaggedArticleIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listTaggedArticleIDs,
+		arg.UserID,
+		arg.Tag,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetUserArticleVectorsByThumbsUpRow
+	var items []string
 	for rows.Next() {
-		var i GetUserArticleVectorsByThumbsUpRow
-		if err := rows.Scan(&i.ArticleHashID, &i.Vector, &i.DateRated); err != nil {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, article_hash_id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -390,38 +3257,24 @@ func (q *Queries) GetUserArticleVectorsByThumbsUp(ctx context.Context, userID st
 	return items, nil
 }
 
-const getUserInterestClusters = `-- name: GetUserInterestClusters :many
-SELECT cluster_id, centroid_vector, article_count, updated_at
-FROM user_interest_clusters
-WHERE user_id = ?
-ORDER BY cluster_id
+const listThumbsUpArticleIDs = `-- name: ListThumbsUpArticleIDs :many
+SELECT article_hash_id FROM user_article_interactions
+WHERE user_id = ? AND thumbs_up = TRUE
 `
 
-type GetUserInterestClustersRow struct {
-	ClusterID      int32
-	CentroidVector []byte
-	ArticleCount   int32
-	UpdatedAt      time.Time
-}
-
-func (q *Queries) GetUserInterestClusters(ctx context.Context, userID string) ([]GetUserInterestClustersRow, error) {
-	rows, err := q.db.QueryContext(ctx, getUserInterestClusters, userID)
+func (q *Queries) ListThumbsUpArticleIDs(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listThumbsUpArticleIDs, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetUserInterestClustersRow
+	var items []string
 	for rows.Next() {
-		var i GetUserInterestClustersRow
-		if err := rows.Scan(
-			&i.ClusterID,
-			&i.CentroidVector,
-			&i.ArticleCount,
-			&i.UpdatedAt,
-		); err != nil {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, article_hash_id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -432,98 +3285,123 @@ func (q *Queries) GetUserInterestClusters(ctx context.Context, userID string) ([
 	return items, nil
 }
 
-const getUserRecommendationState = `-- name: GetUserRecommendationState :one
-
-SELECT last_generated_at, last_rating_at, needs_regeneration
-FROM user_recommendation_state
+const listUnreviewedArticleIDs = `-- name: ListUnreviewedArticleIDs :many
+SELECT article_hash_id FROM user_article_interactions
 WHERE user_id = ?
+    AND have_read = TRUE
+    AND thumbs_up = FALSE
+    AND thumbs_down = FALSE
+ORDER BY date_read DESC
+LIMIT ? OFFSET ?
 `
 
-type GetUserRecommendationStateRow struct {
-	LastGeneratedAt   sql.NullTime
-	LastRatingAt      sql.NullTime
-	NeedsRegeneration bool
+type ListUnreviewedArticleIDsParams struct {
+	UserID string
+	Limit  int32
+	Offset int32
 }
 
-// ============================================
-// User Recommendation State
-// ============================================
-func (q *Queries) GetUserRecommendationState(ctx context.Context, userID string) (GetUserRecommendationStateRow, error) {
-	row := q.db.QueryRowContext(ctx, getUserRecommendationState, userID)
-	var i GetUserRecommendationStateRow
-	err := row.Scan(&i.LastGeneratedAt, &i.LastRatingAt, &i.NeedsRegeneration)
-	return i, err
+func (q *Queries) ListUnreviewedArticleIDs(ctx context.Context, arg ListUnreviewedArticleIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listUnreviewedArticleIDs, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_hash_id string
+		if err := rows.Scan(&article_hash_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_hash_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const insertArticle = `-- name: InsertArticle :exec
-INSERT INTO articles (
-                      hash_id,
-                      title,
-                      url,
-                      source,
-                      text,
-                      authors,
-                      date_published,
-                      date_created,
-                      pinecone_status,
-                      date_checked) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+const listUserAPITokens = `-- name: ListUserAPITokens :many
+SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at, scopes,
+    replaced_by_id
+FROM api_tokens
+WHERE user_id = ?
+ORDER BY created_at DESC
 `
 
-type InsertArticleParams struct {
-	HashID         string
-	Title          sql.NullString
-	Url            sql.NullString
-	Source         sql.NullString
-	Text           sql.NullString
-	Authors        string
-	DatePublished  sql.NullTime
-	DateCreated    time.Time
-	PineconeStatus ArticlesPineconeStatus
-	DateChecked    time.Time
-}
-
-func (q *Queries) InsertArticle(ctx context.Context, arg InsertArticleParams) error {
-	_, err := q.db.ExecContext(ctx, insertArticle,
-		arg.HashID,
-		arg.Title,
-		arg.Url,
-		arg.Source,
-		arg.Text,
-		arg.Authors,
-		arg.DatePublished,
-		arg.DateCreated,
-		arg.PineconeStatus,
-		arg.DateChecked,
-	)
-	return err
+func (q *Queries) ListUserAPITokens(ctx context.Context, userID string) ([]ApiToken, error) {
+	rows, err := q.db.QueryContext(ctx, listUserAPITokens, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiToken
+	for rows.Next() {
+		var i ApiToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TokenHash,
+			&i.TokenPrefix,
+			&i.Name,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.Scopes,
+			&i.ReplacedByID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const listDislikedArticleIDs = `-- name: ListDislikedArticleIDs :many
-SELECT article_hash_id FROM user_article_interactions
-WHERE user_id = ? AND thumbs_down = TRUE
-ORDER BY date_rated DESC
+const listUserArticleNotes = `-- name: ListUserArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = ?
+ORDER BY updated_at DESC
 LIMIT ? OFFSET ?
 `
 
-type ListDislikedArticleIDsParams struct {
+type ListUserArticleNotesParams struct {
 	UserID string
 	Limit  int32
 	Offset int32
 }
 
-func (q *Queries) ListDislikedArticleIDs(ctx context.Context, arg ListDislikedArticleIDsParams) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, listDislikedArticleIDs, arg.UserID, arg.Limit, arg.Offset)
+func (q *Queries) ListUserArticleNotes(ctx context.Context, arg ListUserArticleNotesParams) ([]ArticleNote, error) {
+	rows, err := q.db.QueryContext(ctx, listUserArticleNotes, arg.UserID, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ArticleNote
 	for rows.Next() {
-		var article_hash_id string
-		if err := rows.Scan(&article_hash_id); err != nil {
+		var i ArticleNote
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ArticleHashID,
+			&i.Body,
+			&i.Quote,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, article_hash_id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -534,32 +3412,43 @@ func (q *Queries) ListDislikedArticleIDs(ctx context.Context, arg ListDislikedAr
 	return items, nil
 }
 
-const listLikedArticleIDs = `-- name: ListLikedArticleIDs :many
-SELECT article_hash_id FROM user_article_interactions
-WHERE user_id = ? AND thumbs_up = TRUE
-ORDER BY date_rated DESC
+const listUserAuditEvents = `-- name: ListUserAuditEvents :many
+SELECT id, user_id, event_type, outcome, token_id, ip_address, user_agent, detail, created_at
+FROM audit_events
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC
 LIMIT ? OFFSET ?
 `
 
-type ListLikedArticleIDsParams struct {
-	UserID string
+type ListUserAuditEventsParams struct {
+	UserID sql.NullString
 	Limit  int32
 	Offset int32
 }
 
-func (q *Queries) ListLikedArticleIDs(ctx context.Context, arg ListLikedArticleIDsParams) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, listLikedArticleIDs, arg.UserID, arg.Limit, arg.Offset)
+func (q *Queries) ListUserAuditEvents(ctx context.Context, arg ListUserAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listUserAuditEvents, arg.UserID, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []AuditEvent
 	for rows.Next() {
-		var article_hash_id string
-		if err := rows.Scan(&article_hash_id); err != nil {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.EventType,
+			&i.Outcome,
+			&i.TokenID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Detail,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, article_hash_id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -570,24 +3459,49 @@ func (q *Queries) ListLikedArticleIDs(ctx context.Context, arg ListLikedArticleI
 	return items, nil
 }
 
-const listReadArticleIDs = `-- name: ListReadArticleIDs :many
-SELECT article_hash_id FROM user_article_interactions
-WHERE user_id = ? AND have_read = TRUE
+const listUserCollections = `-- name: ListUserCollections :many
+SELECT c.id, c.user_id, c.name, c.is_public, c.share_token, c.created_at, c.updated_at,
+       COUNT(ca.article_hash_id) AS article_count
+FROM collections c
+LEFT JOIN collection_articles ca ON ca.collection_id = c.id
+WHERE c.user_id = ?
+GROUP BY c.id
+ORDER BY c.created_at DESC
 `
 
-func (q *Queries) ListReadArticleIDs(ctx context.Context, userID string) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, listReadArticleIDs, userID)
+type ListUserCollectionsRow struct {
+	ID           string
+	UserID       string
+	Name         string
+	IsPublic     bool
+	ShareToken   string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	ArticleCount int64
+}
+
+func (q *Queries) ListUserCollections(ctx context.Context, userID string) ([]ListUserCollectionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserCollections, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ListUserCollectionsRow
 	for rows.Next() {
-		var article_hash_id string
-		if err := rows.Scan(&article_hash_id); err != nil {
+		var i ListUserCollectionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.IsPublic,
+			&i.ShareToken,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ArticleCount,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, article_hash_id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -598,24 +3512,32 @@ func (q *Queries) ListReadArticleIDs(ctx context.Context, userID string) ([]stri
 	return items, nil
 }
 
-const listThumbsUpArticleIDs = `-- name: ListThumbsUpArticleIDs :many
-SELECT article_hash_id FROM user_article_interactions
-WHERE user_id = ? AND thumbs_up = TRUE
+const listUserFollows = `-- name: ListUserFollows :many
+SELECT follow_type, target, created_at
+FROM user_follows
+WHERE user_id = ?
+ORDER BY follow_type, target
 `
 
-func (q *Queries) ListThumbsUpArticleIDs(ctx context.Context, userID string) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, listThumbsUpArticleIDs, userID)
+type ListUserFollowsRow struct {
+	FollowType string
+	Target     string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) ListUserFollows(ctx context.Context, userID string) ([]ListUserFollowsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserFollows, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ListUserFollowsRow
 	for rows.Next() {
-		var article_hash_id string
-		if err := rows.Scan(&article_hash_id); err != nil {
+		var i ListUserFollowsRow
+		if err := rows.Scan(&i.FollowType, &i.Target, &i.CreatedAt); err != nil {
 			return nil, err
 		}
-		items = append(items, article_hash_id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -626,35 +3548,104 @@ func (q *Queries) ListThumbsUpArticleIDs(ctx context.Context, userID string) ([]
 	return items, nil
 }
 
-const listUnreviewedArticleIDs = `-- name: ListUnreviewedArticleIDs :many
-SELECT article_hash_id FROM user_article_interactions
+const listUserSavedSearches = `-- name: ListUserSavedSearches :many
+SELECT id, user_id, name, kind, filters, query_text, query_vector, feed_token, last_viewed_at, created_at, updated_at
+FROM saved_searches
 WHERE user_id = ?
-    AND have_read = TRUE
-    AND thumbs_up = FALSE
-    AND thumbs_down = FALSE
-ORDER BY date_read DESC
-LIMIT ? OFFSET ?
+ORDER BY created_at DESC
 `
 
-type ListUnreviewedArticleIDsParams struct {
-	UserID string
-	Limit  int32
-	Offset int32
+func (q *Queries) ListUserSavedSearches(ctx context.Context, userID string) ([]SavedSearch, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSavedSearches, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SavedSearch
+	for rows.Next() {
+		var i SavedSearch
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Kind,
+			&i.Filters,
+			&i.QueryText,
+			&i.QueryVector,
+			&i.FeedToken,
+			&i.LastViewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) ListUnreviewedArticleIDs(ctx context.Context, arg ListUnreviewedArticleIDsParams) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, listUnreviewedArticleIDs, arg.UserID, arg.Limit, arg.Offset)
+const listUserTags = `-- name: ListUserTags :many
+SELECT tag, COUNT(*) AS article_count
+FROM user_article_tags
+WHERE user_id = ?
+GROUP BY tag
+ORDER BY article_count DESC, tag
+`
+
+type ListUserTagsRow struct {
+	Tag          string
+	ArticleCount int64
+}
+
+func (q *Queries) ListUserTags(ctx context.Context, userID string) ([]ListUserTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserTagsRow
+	for rows.Next() {
+		var i ListUserTagsRow
+		if err := rows.Scan(&i.Tag, &i.ArticleCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersNeedingRegeneration = `-- name: ListUsersNeedingRegeneration :many
+SELECT user_id
+FROM user_recommendation_state
+WHERE needs_regeneration = TRUE
+ORDER BY last_rating_at ASC
+`
+
+func (q *Queries) ListUsersNeedingRegeneration(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersNeedingRegeneration)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	var items []string
 	for rows.Next() {
-		var article_hash_id string
-		if err := rows.Scan(&article_hash_id); err != nil {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
 			return nil, err
 		}
-		items = append(items, article_hash_id)
+		items = append(items, user_id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -665,32 +3656,207 @@ func (q *Queries) ListUnreviewedArticleIDs(ctx context.Context, arg ListUnreview
 	return items, nil
 }
 
-const listUserAPITokens = `-- name: ListUserAPITokens :many
-SELECT id, user_id, token_hash, token_prefix, name, created_at, last_used_at, expires_at, revoked_at
-FROM api_tokens
+const markDigestSent = `-- name: MarkDigestSent :exec
+UPDATE user_digest_preferences
+SET last_sent_at = ?
 WHERE user_id = ?
-ORDER BY created_at DESC
 `
 
-func (q *Queries) ListUserAPITokens(ctx context.Context, userID string) ([]ApiToken, error) {
-	rows, err := q.db.QueryContext(ctx, listUserAPITokens, userID)
+type MarkDigestSentParams struct {
+	LastSentAt sql.NullTime
+	UserID     string
+}
+
+func (q *Queries) MarkDigestSent(ctx context.Context, arg MarkDigestSentParams) error {
+	_, err := q.db.ExecContext(ctx, markDigestSent, arg.LastSentAt, arg.UserID)
+	return err
+}
+
+const markSavedSearchViewed = `-- name: MarkSavedSearchViewed :exec
+UPDATE saved_searches
+SET last_viewed_at = ?
+WHERE id = ? AND user_id = ?
+`
+
+type MarkSavedSearchViewedParams struct {
+	LastViewedAt sql.NullTime
+	ID           string
+	UserID       string
+}
+
+func (q *Queries) MarkSavedSearchViewed(ctx context.Context, arg MarkSavedSearchViewedParams) error {
+	_, err := q.db.ExecContext(ctx, markSavedSearchViewed, arg.LastViewedAt, arg.ID, arg.UserID)
+	return err
+}
+
+const markUserNeedsRegeneration = `-- name: MarkUserNeedsRegeneration :exec
+INSERT INTO user_recommendation_state (user_id, last_rating_at, needs_regeneration)
+VALUES (?, NOW(), TRUE)
+ON DUPLICATE KEY UPDATE
+    last_rating_at = NOW(),
+    needs_regeneration = TRUE
+`
+
+func (q *Queries) MarkUserNeedsRegeneration(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, markUserNeedsRegeneration, userID)
+	return err
+}
+
+const markUserRegenerated = `-- name: MarkUserRegenerated :exec
+UPDATE user_recommendation_state
+SET last_generated_at = NOW(), needs_regeneration = FALSE
+WHERE user_id = ?
+`
+
+func (q *Queries) MarkUserRegenerated(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, markUserRegenerated, userID)
+	return err
+}
+
+const recordArticleEngagement = `-- name: RecordArticleEngagement :exec
+INSERT INTO user_article_interactions (
+        user_id,
+        article_hash_id,
+        have_read,
+        thumbs_up,
+        thumbs_down,
+        opened_link,
+        time_on_article_seconds,
+        saved_to_collection,
+        date_engaged,
+        ` + "`" + `vector` + "`" + `
+    ) VALUES (
+        ?, ?, FALSE, FALSE, FALSE,
+        ?, ?, ?,
+        ?, ?
+    )
+ON DUPLICATE KEY UPDATE
+    opened_link = opened_link OR ?,
+    time_on_article_seconds = LEAST(time_on_article_seconds + ?, 86400),
+    saved_to_collection = saved_to_collection OR ?,
+    date_engaged = ?,
+    ` + "`" + `vector` + "`" + ` = COALESCE(` + "`" + `vector` + "`" + `, ?)
+`
+
+type RecordArticleEngagementParams struct {
+	UserID               string
+	ArticleHashID        string
+	OpenedLink           bool
+	TimeOnArticleSeconds int32
+	SavedToCollection    bool
+	DateEngaged          sql.NullTime
+	Vector               sql.NullString
+}
+
+func (q *Queries) RecordArticleEngagement(ctx context.Context, arg RecordArticleEngagementParams) error {
+	_, err := q.db.ExecContext(ctx, recordArticleEngagement,
+		arg.UserID,
+		arg.ArticleHashID,
+		arg.OpenedLink,
+		arg.TimeOnArticleSeconds,
+		arg.SavedToCollection,
+		arg.DateEngaged,
+		arg.Vector,
+		arg.OpenedLink,
+		arg.TimeOnArticleSeconds,
+		arg.SavedToCollection,
+		arg.DateEngaged,
+		arg.Vector,
+	)
+	return err
+}
+
+const removeCollectionArticle = `-- name: RemoveCollectionArticle :exec
+DELETE FROM collection_articles
+WHERE collection_id = ? AND article_hash_id = ?
+`
+
+type RemoveCollectionArticleParams struct {
+	CollectionID  string
+	ArticleHashID string
+}
+
+func (q *Queries) RemoveCollectionArticle(ctx context.Context, arg RemoveCollectionArticleParams) error {
+	_, err := q.db.ExecContext(ctx, removeCollectionArticle, arg.CollectionID, arg.ArticleHashID)
+	return err
+}
+
+const renameAPIToken = `-- name: RenameAPIToken :exec
+UPDATE api_tokens
+SET name = ?
+WHERE id = ? AND user_id = ?
+`
+
+type RenameAPITokenParams struct {
+	Name   sql.NullString
+	ID     string
+	UserID string
+}
+
+func (q *Queries) RenameAPIToken(ctx context.Context, arg RenameAPITokenParams) error {
+	_, err := q.db.ExecContext(ctx, renameAPIToken, arg.Name, arg.ID, arg.UserID)
+	return err
+}
+
+const renameFollowTarget = `-- name: RenameFollowTarget :exec
+UPDATE IGNORE user_follows
+SET target = ?
+WHERE follow_type = ? AND target = ?
+`
+
+type RenameFollowTargetParams struct {
+	NewTarget  string
+	FollowType string
+	OldTarget  string
+}
+
+func (q *Queries) RenameFollowTarget(ctx context.Context, arg RenameFollowTargetParams) error {
+	_, err := q.db.ExecContext(ctx, renameFollowTarget, arg.NewTarget, arg.FollowType, arg.OldTarget)
+	return err
+}
+
+const reportExperimentArms = `-- name: ReportExperimentArms :many
+SELECT
+    i.experiment_arm,
+    COUNT(DISTINCT i.user_id) AS user_count,
+    COUNT(*) AS shown_count,
+    COUNT(CASE WHEN uai.date_read >= i.first_served_at THEN 1 END) AS read_count,
+    COUNT(CASE WHEN uai.thumbs_up AND uai.date_rated >= i.first_served_at THEN 1 END) AS liked_count
+FROM (
+    SELECT user_id, article_hash_id, experiment_arm, MIN(served_at) AS first_served_at
+    FROM recommendation_impressions
+    WHERE served_at >= ?
+    GROUP BY user_id, article_hash_id, experiment_arm
+) i
+LEFT JOIN user_article_interactions uai
+    ON uai.user_id = i.user_id AND uai.article_hash_id = i.article_hash_id
+GROUP BY i.experiment_arm
+ORDER BY i.experiment_arm
+`
+
+type ReportExperimentArmsRow struct {
+	ExperimentArm string
+	UserCount     int64
+	ShownCount    int64
+	ReadCount     int64
+	LikedCount    int64
+}
+
+func (q *Queries) ReportExperimentArms(ctx context.Context, servedAt time.Time) ([]ReportExperimentArmsRow, error) {
+	rows, err := q.db.QueryContext(ctx, reportExperimentArms, servedAt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ApiToken
+	var items []ReportExperimentArmsRow
 	for rows.Next() {
-		var i ApiToken
+		var i ReportExperimentArmsRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.TokenHash,
-			&i.TokenPrefix,
-			&i.Name,
-			&i.CreatedAt,
-			&i.LastUsedAt,
-			&i.ExpiresAt,
-			&i.RevokedAt,
+			&i.ExperimentArm,
+			&i.UserCount,
+			&i.ShownCount,
+			&i.ReadCount,
+			&i.LikedCount,
 		); err != nil {
 			return nil, err
 		}
@@ -705,26 +3871,82 @@ func (q *Queries) ListUserAPITokens(ctx context.Context, userID string) ([]ApiTo
 	return items, nil
 }
 
-const listUsersNeedingRegeneration = `-- name: ListUsersNeedingRegeneration :many
-SELECT user_id
-FROM user_recommendation_state
-WHERE needs_regeneration = TRUE
-ORDER BY last_rating_at ASC
+const revokeAPIToken = `-- name: RevokeAPIToken :execrows
+UPDATE api_tokens
+SET revoked_at = NOW()
+WHERE id = ? AND user_id = ? AND revoked_at IS NULL
 `
 
-func (q *Queries) ListUsersNeedingRegeneration(ctx context.Context) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, listUsersNeedingRegeneration)
+type RevokeAPITokenParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) RevokeAPIToken(ctx context.Context, arg RevokeAPITokenParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeAPIToken, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeExpiredAPITokens = `-- name: RevokeExpiredAPITokens :execrows
+UPDATE api_tokens
+SET revoked_at = expires_at
+WHERE revoked_at IS NULL AND expires_at <= NOW()
+`
+
+func (q *Queries) RevokeExpiredAPITokens(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeExpiredAPITokens)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const searchUserArticleNotes = `-- name: SearchUserArticleNotes :many
+SELECT id, user_id, article_hash_id, body, quote, created_at, updated_at
+FROM article_notes
+WHERE user_id = ?
+    AND MATCH (body, quote) AGAINST (?)
+ORDER BY MATCH (body, quote) AGAINST (?) DESC, updated_at DESC
+LIMIT ? OFFSET ?
+`
+
+type SearchUserArticleNotesParams struct {
+	UserID string
+	Query  string
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) SearchUserArticleNotes(ctx context.Context, arg SearchUserArticleNotesParams) ([]ArticleNote, error) {
+	rows, err := q.db.QueryContext(ctx, searchUserArticleNotes,
+		arg.UserID,
+		arg.Query,
+		arg.Query,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ArticleNote
 	for rows.Next() {
-		var user_id string
-		if err := rows.Scan(&user_id); err != nil {
+		var i ArticleNote
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ArticleHashID,
+			&i.Body,
+			&i.Quote,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, user_id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -735,43 +3957,63 @@ func (q *Queries) ListUsersNeedingRegeneration(ctx context.Context) ([]string, e
 	return items, nil
 }
 
-const markUserNeedsRegeneration = `-- name: MarkUserNeedsRegeneration :exec
-INSERT INTO user_recommendation_state (user_id, last_rating_at, needs_regeneration)
-VALUES (?, NOW(), TRUE)
-ON DUPLICATE KEY UPDATE
-    last_rating_at = NOW(),
-    needs_regeneration = TRUE
+const setAPITokenReplacement = `-- name: SetAPITokenReplacement :execrows
+UPDATE api_tokens
+SET replaced_by_id = ?, expires_at = ?
+WHERE id = ? AND user_id = ? AND replaced_by_id IS NULL AND revoked_at IS NULL
 `
 
-func (q *Queries) MarkUserNeedsRegeneration(ctx context.Context, userID string) error {
-	_, err := q.db.ExecContext(ctx, markUserNeedsRegeneration, userID)
-	return err
+type SetAPITokenReplacementParams struct {
+	ReplacedByID sql.NullString
+	ExpiresAt    sql.NullTime
+	ID           string
+	UserID       string
 }
 
-const markUserRegenerated = `-- name: MarkUserRegenerated :exec
-UPDATE user_recommendation_state
-SET last_generated_at = NOW(), needs_regeneration = FALSE
-WHERE user_id = ?
-`
-
-func (q *Queries) MarkUserRegenerated(ctx context.Context, userID string) error {
-	_, err := q.db.ExecContext(ctx, markUserRegenerated, userID)
-	return err
+func (q *Queries) SetAPITokenReplacement(ctx context.Context, arg SetAPITokenReplacementParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setAPITokenReplacement,
+		arg.ReplacedByID,
+		arg.ExpiresAt,
+		arg.ID,
+		arg.UserID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const revokeAPIToken = `-- name: RevokeAPIToken :exec
-UPDATE api_tokens
-SET revoked_at = NOW()
-WHERE id = ? AND user_id = ?
+const setArticleDismissed = `-- name: SetArticleDismissed :exec
+INSERT INTO user_article_interactions (
+        user_id,
+        article_hash_id,
+        have_read,
+        thumbs_up,
+        thumbs_down,
+        dismissed,
+        date_dismissed
+    ) VALUES (?, ?, FALSE, FALSE, FALSE, ?, ?)
+ON DUPLICATE KEY UPDATE
+    dismissed = ?,
+    date_dismissed = ?
 `
 
-type RevokeAPITokenParams struct {
-	ID     string
-	UserID string
+type SetArticleDismissedParams struct {
+	UserID        string
+	ArticleHashID string
+	Dismissed     bool
+	DateDismissed sql.NullTime
 }
 
-func (q *Queries) RevokeAPIToken(ctx context.Context, arg RevokeAPITokenParams) error {
-	_, err := q.db.ExecContext(ctx, revokeAPIToken, arg.ID, arg.UserID)
+func (q *Queries) SetArticleDismissed(ctx context.Context, arg SetArticleDismissedParams) error {
+	_, err := q.db.ExecContext(ctx, setArticleDismissed,
+		arg.UserID,
+		arg.ArticleHashID,
+		arg.Dismissed,
+		arg.DateDismissed,
+		arg.Dismissed,
+		arg.DateDismissed,
+	)
 	return err
 }
 
@@ -808,6 +4050,115 @@ func (q *Queries) SetArticleRead(ctx context.Context, arg SetArticleReadParams)
 	return err
 }
 
+const setArticleSnoozedUntil = `-- name: SetArticleSnoozedUntil :exec
+INSERT INTO user_article_interactions (
+        user_id,
+        article_hash_id,
+        have_read,
+        thumbs_up,
+        thumbs_down,
+        snoozed_until
+    ) VALUES (?, ?, FALSE, FALSE, FALSE, ?)
+ON DUPLICATE KEY UPDATE
+    snoozed_until = ?
+`
+
+type SetArticleSnoozedUntilParams struct {
+	UserID        string
+	ArticleHashID string
+	SnoozedUntil  sql.NullTime
+}
+
+func (q *Queries) SetArticleSnoozedUntil(ctx context.Context, arg SetArticleSnoozedUntilParams) error {
+	_, err := q.db.ExecContext(ctx, setArticleSnoozedUntil,
+		arg.UserID,
+		arg.ArticleHashID,
+		arg.SnoozedUntil,
+		arg.SnoozedUntil,
+	)
+	return err
+}
+
+const setCollectionArticlePosition = `-- name: SetCollectionArticlePosition :exec
+UPDATE collection_articles
+SET position = ?
+WHERE collection_id = ? AND article_hash_id = ?
+`
+
+type SetCollectionArticlePositionParams struct {
+	Position      int32
+	CollectionID  string
+	ArticleHashID string
+}
+
+func (q *Queries) SetCollectionArticlePosition(ctx context.Context, arg SetCollectionArticlePositionParams) error {
+	_, err := q.db.ExecContext(ctx, setCollectionArticlePosition, arg.Position, arg.CollectionID, arg.ArticleHashID)
+	return err
+}
+
+const tagArticle = `-- name: TagArticle :exec
+
+INSERT IGNORE INTO user_article_tags (user_id, article_hash_id, tag, created_at)
+VALUES (?, ?, ?, NOW())
+`
+
+type TagArticleParams struct {
+	UserID        string
+	ArticleHashID string
+	Tag           string
+}
+
+// ============================================
+// User Article Tags
+// ============================================
+func (q *Queries) TagArticle(ctx context.Context, arg TagArticleParams) error {
+	_, err := q.db.ExecContext(ctx, tagArticle, arg.UserID, arg.ArticleHashID, arg.Tag)
+	return err
+}
+
+const touchCollection = `-- name: TouchCollection :exec
+UPDATE collections
+SET updated_at = NOW()
+WHERE id = ?
+`
+
+func (q *Queries) TouchCollection(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, touchCollection, id)
+	return err
+}
+
+const unfollowItem = `-- name: UnfollowItem :exec
+DELETE FROM user_follows
+WHERE user_id = ? AND follow_type = ? AND target = ?
+`
+
+type UnfollowItemParams struct {
+	UserID     string
+	FollowType string
+	Target     string
+}
+
+func (q *Queries) UnfollowItem(ctx context.Context, arg UnfollowItemParams) error {
+	_, err := q.db.ExecContext(ctx, unfollowItem, arg.UserID, arg.FollowType, arg.Target)
+	return err
+}
+
+const untagArticle = `-- name: UntagArticle :exec
+DELETE FROM user_article_tags
+WHERE user_id = ? AND article_hash_id = ? AND tag = ?
+`
+
+type UntagArticleParams struct {
+	UserID        string
+	ArticleHashID string
+	Tag           string
+}
+
+func (q *Queries) UntagArticle(ctx context.Context, arg UntagArticleParams) error {
+	_, err := q.db.ExecContext(ctx, untagArticle, arg.UserID, arg.ArticleHashID, arg.Tag)
+	return err
+}
+
 const updateAPITokenLastUsed = `-- name: UpdateAPITokenLastUsed :exec
 UPDATE api_tokens
 SET last_used_at = NOW()
@@ -819,13 +4170,184 @@ func (q *Queries) UpdateAPITokenLastUsed(ctx context.Context, id string) error {
 	return err
 }
 
+const updateArticleNote = `-- name: UpdateArticleNote :exec
+UPDATE article_notes
+SET body = ?, quote = ?, updated_at = NOW()
+WHERE id = ? AND user_id = ?
+`
+
+type UpdateArticleNoteParams struct {
+	Body   string
+	Quote  sql.NullString
+	ID     string
+	UserID string
+}
+
+func (q *Queries) UpdateArticleNote(ctx context.Context, arg UpdateArticleNoteParams) error {
+	_, err := q.db.ExecContext(ctx, updateArticleNote,
+		arg.Body,
+		arg.Quote,
+		arg.ID,
+		arg.UserID,
+	)
+	return err
+}
+
+const updateCollection = `-- name: UpdateCollection :exec
+UPDATE collections
+SET name = ?, is_public = ?, updated_at = NOW()
+WHERE id = ? AND user_id = ?
+`
+
+type UpdateCollectionParams struct {
+	Name     string
+	IsPublic bool
+	ID       string
+	UserID   string
+}
+
+func (q *Queries) UpdateCollection(ctx context.Context, arg UpdateCollectionParams) error {
+	_, err := q.db.ExecContext(ctx, updateCollection,
+		arg.Name,
+		arg.IsPublic,
+		arg.ID,
+		arg.UserID,
+	)
+	return err
+}
+
+const updateRateLimitBucket = `-- name: UpdateRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET tokens = ?, updated_at = ?
+WHERE bucket_key = ?
+`
+
+type UpdateRateLimitBucketParams struct {
+	Tokens    float64
+	UpdatedAt time.Time
+	BucketKey string
+}
+
+func (q *Queries) UpdateRateLimitBucket(ctx context.Context, arg UpdateRateLimitBucketParams) error {
+	_, err := q.db.ExecContext(ctx, updateRateLimitBucket, arg.Tokens, arg.UpdatedAt, arg.BucketKey)
+	return err
+}
+
+const updateSavedSearch = `-- name: UpdateSavedSearch :exec
+UPDATE saved_searches
+SET name = ?, kind = ?, filters = ?, query_text = ?, query_vector = ?, updated_at = NOW()
+WHERE id = ? AND user_id = ?
+`
+
+type UpdateSavedSearchParams struct {
+	Name        string
+	Kind        string
+	Filters     sql.NullString
+	QueryText   sql.NullString
+	QueryVector sql.NullString
+	ID          string
+	UserID      string
+}
+
+func (q *Queries) UpdateSavedSearch(ctx context.Context, arg UpdateSavedSearchParams) error {
+	_, err := q.db.ExecContext(ctx, updateSavedSearch,
+		arg.Name,
+		arg.Kind,
+		arg.Filters,
+		arg.QueryText,
+		arg.QueryVector,
+		arg.ID,
+		arg.UserID,
+	)
+	return err
+}
+
+const upsertAuthor = `-- name: UpsertAuthor :exec
+INSERT INTO authors (id, name)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE
+    name = VALUES(name)
+`
+
+type UpsertAuthorParams struct {
+	ID   string
+	Name string
+}
+
+func (q *Queries) UpsertAuthor(ctx context.Context, arg UpsertAuthorParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAuthor, arg.ID, arg.Name)
+	return err
+}
+
+const upsertDigestPreferences = `-- name: UpsertDigestPreferences :exec
+INSERT INTO user_digest_preferences (
+    user_id, email, pending_email, email_confirmation_token, frequency, categories, enabled, unsubscribe_token,
+    created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    email = VALUES(email),
+    pending_email = VALUES(pending_email),
+    email_confirmation_token = VALUES(email_confirmation_token),
+    frequency = VALUES(frequency),
+    categories = VALUES(categories),
+    enabled = VALUES(enabled),
+    updated_at = NOW()
+`
+
+type UpsertDigestPreferencesParams struct {
+	UserID                 string
+	Email                  string
+	PendingEmail           sql.NullString
+	EmailConfirmationToken sql.NullString
+	Frequency              string
+	Categories             sql.NullString
+	Enabled                bool
+	UnsubscribeToken       string
+}
+
+func (q *Queries) UpsertDigestPreferences(ctx context.Context, arg UpsertDigestPreferencesParams) error {
+	_, err := q.db.ExecContext(ctx, upsertDigestPreferences,
+		arg.UserID,
+		arg.Email,
+		arg.PendingEmail,
+		arg.EmailConfirmationToken,
+		arg.Frequency,
+		arg.Categories,
+		arg.Enabled,
+		arg.UnsubscribeToken,
+	)
+	return err
+}
+
+const upsertFollowPreferences = `-- name: UpsertFollowPreferences :exec
+INSERT INTO user_follow_preferences (user_id, include_in_recommendations, feed_token, created_at, updated_at)
+VALUES (?, ?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    include_in_recommendations = VALUES(include_in_recommendations),
+    updated_at = NOW()
+`
+
+type UpsertFollowPreferencesParams struct {
+	UserID                   string
+	IncludeInRecommendations bool
+	FeedToken                string
+}
+
+func (q *Queries) UpsertFollowPreferences(ctx context.Context, arg UpsertFollowPreferencesParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFollowPreferences, arg.UserID, arg.IncludeInRecommendations, arg.FeedToken)
+	return err
+}
+
 const upsertPrecomputedRecommendation = `-- name: UpsertPrecomputedRecommendation :exec
 
-INSERT INTO user_precomputed_recommendations (user_id, article_hash_id, score, source, position, generated_at)
-VALUES (?, ?, ?, ?, ?, ?)
+INSERT INTO user_precomputed_recommendations (
+    user_id, article_hash_id, score, source, experiment_arm, position, generated_at
+)
+VALUES (?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     score = VALUES(score),
     source = VALUES(source),
+    experiment_arm = VALUES(experiment_arm),
     position = VALUES(position),
     generated_at = VALUES(generated_at)
 `
@@ -835,6 +4357,7 @@ type UpsertPrecomputedRecommendationParams struct {
 	ArticleHashID string
 	Score         float64
 	Source        string
+	ExperimentArm string
 	Position      int32
 	GeneratedAt   time.Time
 }
@@ -848,21 +4371,41 @@ func (q *Queries) UpsertPrecomputedRecommendation(ctx context.Context, arg Upser
 		arg.ArticleHashID,
 		arg.Score,
 		arg.Source,
+		arg.ExperimentArm,
 		arg.Position,
 		arg.GeneratedAt,
 	)
 	return err
 }
 
+const upsertTagPreferences = `-- name: UpsertTagPreferences :exec
+INSERT INTO user_tag_preferences (user_id, share_for_recommendations, created_at, updated_at)
+VALUES (?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    share_for_recommendations = VALUES(share_for_recommendations),
+    updated_at = NOW()
+`
+
+type UpsertTagPreferencesParams struct {
+	UserID                  string
+	ShareForRecommendations bool
+}
+
+func (q *Queries) UpsertTagPreferences(ctx context.Context, arg UpsertTagPreferencesParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTagPreferences, arg.UserID, arg.ShareForRecommendations)
+	return err
+}
+
 const upsertUserArticleInteraction = `-- name: UpsertUserArticleInteraction :exec
 INSERT INTO user_article_interactions (
-    user_id, article_hash_id, have_read, thumbs_up, thumbs_down,
+    user_id, article_hash_id, have_read, thumbs_up, thumbs_down, rating,
     date_read, date_rated, ` + "`" + `vector` + "`" + `
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     have_read = VALUES(have_read),
     thumbs_up = VALUES(thumbs_up),
     thumbs_down = VALUES(thumbs_down),
+    rating = VALUES(rating),
     date_read = VALUES(date_read),
     date_rated = VALUES(date_rated),
     ` + "`" + `vector` + "`" + ` = VALUES(` + "`" + `vector` + "`" + `)
@@ -874,6 +4417,7 @@ type UpsertUserArticleInteractionParams struct {
 	HaveRead      bool
 	ThumbsUp      bool
 	ThumbsDown    bool
+	Rating        sql.NullInt32
 	DateRead      sql.NullTime
 	DateRated     sql.NullTime
 	Vector        sql.NullString
@@ -886,6 +4430,7 @@ func (q *Queries) UpsertUserArticleInteraction(ctx context.Context, arg UpsertUs
 		arg.HaveRead,
 		arg.ThumbsUp,
 		arg.ThumbsDown,
+		arg.Rating,
 		arg.DateRead,
 		arg.DateRated,
 		arg.Vector,
@@ -900,6 +4445,7 @@ VALUES (?, ?, ?, ?, NOW())
 ON DUPLICATE KEY UPDATE
     centroid_vector = VALUES(centroid_vector),
     article_count = VALUES(article_count),
+    provisional = FALSE,
     updated_at = NOW()
 `
 
@@ -923,6 +4469,26 @@ func (q *Queries) UpsertUserInterestCluster(ctx context.Context, arg UpsertUserI
 	return err
 }
 
+const upsertUserOnboardingInterests = `-- name: UpsertUserOnboardingInterests :exec
+INSERT INTO user_onboarding_interests (user_id, categories, interests, created_at, updated_at)
+VALUES (?, ?, ?, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+    categories = VALUES(categories),
+    interests = VALUES(interests),
+    updated_at = NOW()
+`
+
+type UpsertUserOnboardingInterestsParams struct {
+	UserID     string
+	Categories sql.NullString
+	Interests  sql.NullString
+}
+
+func (q *Queries) UpsertUserOnboardingInterests(ctx context.Context, arg UpsertUserOnboardingInterestsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUserOnboardingInterests, arg.UserID, arg.Categories, arg.Interests)
+	return err
+}
+
 const upsertUserRecommendationState = `-- name: UpsertUserRecommendationState :exec
 INSERT INTO user_recommendation_state (user_id, last_generated_at, last_rating_at, needs_regeneration)
 VALUES (?, ?, ?, ?)
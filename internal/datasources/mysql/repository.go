@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/huandu/go-sqlbuilder"
@@ -38,20 +39,66 @@ func (r *Repository) SetArticleRead(ctx context.Context, hashID, userID string,
 	})
 }
 
+func (r *Repository) SetArticleDismissed(ctx context.Context, hashID, userID string, dismissed bool) error {
+	var dateDismissed sql.NullTime
+	if dismissed {
+		dateDismissed = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+	return r.queries.SetArticleDismissed(ctx, queries.SetArticleDismissedParams{
+		UserID:        userID,
+		ArticleHashID: hashID,
+		Dismissed:     dismissed,
+		DateDismissed: dateDismissed,
+	})
+}
+
+// SnoozeArticle hides an article from a user's recommendations until a time.
+// The zero time clears the snooze.
+func (r *Repository) SnoozeArticle(ctx context.Context, hashID, userID string, until time.Time) error {
+	var snoozedUntil sql.NullTime
+	if !until.IsZero() {
+		snoozedUntil = sql.NullTime{Time: until, Valid: true}
+	}
+	return r.queries.SetArticleSnoozedUntil(ctx, queries.SetArticleSnoozedUntilParams{
+		UserID:        userID,
+		ArticleHashID: hashID,
+		SnoozedUntil:  snoozedUntil,
+	})
+}
+
 // interactionState captures the current state of a user-article interaction.
 type interactionState struct {
 	currentHaveRead   bool
 	currentDateRead   sql.NullTime
 	currentThumbsUp   bool
 	currentThumbsDown bool
+	currentRating     sql.NullInt32
 	currentDateRated  sql.NullTime
 }
 
 // SetArticleRating atomically sets thumbs up/down.
 // nil pointers mean "don't change". Setting either to true forces the other to false.
+// A graded rating no longer matching the thumbs is cleared.
 func (r *Repository) SetArticleRating(
 	ctx context.Context, userID, articleHashID string,
 	thumbsUp, thumbsDown *bool, vector []float32,
+) error {
+	return r.setArticleRating(ctx, userID, articleHashID, thumbsUp, thumbsDown, nil, vector)
+}
+
+// SetArticleGradedRating atomically sets a 1-5 rating along with its matching thumbs.
+// Zero clears the rating and both thumbs.
+func (r *Repository) SetArticleGradedRating(
+	ctx context.Context, userID, articleHashID string, rating int, vector []float32,
+) error {
+	thumbsUp, thumbsDown := domain.ThumbsForRating(rating)
+	return r.setArticleRating(ctx, userID, articleHashID, &thumbsUp, &thumbsDown, &rating, vector)
+}
+
+// setArticleRating atomically updates thumbs and the graded rating, where nil means "don't change".
+func (r *Repository) setArticleRating(
+	ctx context.Context, userID, articleHashID string,
+	thumbsUp, thumbsDown *bool, rating *int, vector []float32,
 ) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -66,7 +113,9 @@ func (r *Repository) SetArticleRating(
 		return err
 	}
 
-	if err := r.upsertInteraction(ctx, qtx, userID, articleHashID, thumbsUp, thumbsDown, vector, state); err != nil {
+	if err := r.upsertInteraction(
+		ctx, qtx, userID, articleHashID, thumbsUp, thumbsDown, rating, vector, state,
+	); err != nil {
 		return err
 	}
 
@@ -96,6 +145,7 @@ func (r *Repository) getCurrentInteractionState(
 		currentDateRead:   current.DateRead,
 		currentThumbsUp:   current.ThumbsUp,
 		currentThumbsDown: current.ThumbsDown,
+		currentRating:     current.Rating,
 		currentDateRated:  current.DateRated,
 	}, nil
 }
@@ -122,10 +172,27 @@ func resolveRating(thumbsUp, thumbsDown *bool, state interactionState) (up, down
 	return up, down
 }
 
+// resolveGradedRating merges a graded rating update with existing state. nil means "don't change"
+// and zero clears the rating. A rating no longer matching the resolved thumbs is cleared.
+func resolveGradedRating(rating *int, up, down bool, state interactionState) sql.NullInt32 {
+	resolved := state.currentRating
+	if rating != nil {
+		resolved = sql.NullInt32{Int32: int32(*rating), Valid: *rating != 0} //nolint:gosec // ratings are 0-5
+	}
+
+	if resolved.Valid {
+		wantUp, wantDown := domain.ThumbsForRating(int(resolved.Int32))
+		if wantUp != up || wantDown != down {
+			return sql.NullInt32{}
+		}
+	}
+	return resolved
+}
+
 // upsertInteraction stores the interaction record.
 func (r *Repository) upsertInteraction(
 	ctx context.Context, qtx *queries.Queries, userID, articleHashID string,
-	thumbsUp, thumbsDown *bool, vector []float32, state interactionState,
+	thumbsUp, thumbsDown *bool, rating *int, vector []float32, state interactionState,
 ) error {
 	var vectorStr sql.NullString
 	if vector != nil {
@@ -133,9 +200,10 @@ func (r *Repository) upsertInteraction(
 	}
 
 	resolvedUp, resolvedDown := resolveRating(thumbsUp, thumbsDown, state)
+	resolvedRating := resolveGradedRating(rating, resolvedUp, resolvedDown, state)
 
 	dateRated := state.currentDateRated
-	if resolvedUp || resolvedDown {
+	if resolvedUp || resolvedDown || resolvedRating.Valid {
 		dateRated = sql.NullTime{Time: time.Now(), Valid: true}
 	}
 
@@ -145,6 +213,7 @@ func (r *Repository) upsertInteraction(
 		HaveRead:      state.currentHaveRead,
 		ThumbsUp:      resolvedUp,
 		ThumbsDown:    resolvedDown,
+		Rating:        resolvedRating,
 		DateRead:      state.currentDateRead,
 		DateRated:     dateRated,
 		Vector:        vectorStr,
@@ -154,13 +223,54 @@ func (r *Repository) upsertInteraction(
 	return nil
 }
 
+// RecordArticleSignal records an implicit signal of interest in an article, storing its vector
+// if the interaction has none yet. Time on article accumulates across signals.
+func (r *Repository) RecordArticleSignal(
+	ctx context.Context, userID, articleHashID string, signal domain.ArticleSignal, vector []float32,
+) error {
+	var vectorStr sql.NullString
+	if vector != nil {
+		vectorStr = sql.NullString{String: string(float32SliceToBytes(vector)), Valid: true}
+	}
+
+	params := queries.RecordArticleEngagementParams{
+		UserID:        userID,
+		ArticleHashID: articleHashID,
+		DateEngaged:   sql.NullTime{Time: time.Now(), Valid: true},
+		Vector:        vectorStr,
+	}
+	switch signal.Type {
+	case domain.ArticleSignalOpenedLink:
+		params.OpenedLink = true
+	case domain.ArticleSignalTimeOnArticle:
+		params.TimeOnArticleSeconds = int32(signal.Duration / time.Second) //nolint:gosec // validated to at most hours
+	case domain.ArticleSignalSavedToCollection:
+		params.SavedToCollection = true
+	default:
+		return fmt.Errorf("%w: unknown type %q", domain.ErrInvalidArticleSignal, signal.Type)
+	}
+
+	return r.queries.RecordArticleEngagement(ctx, params)
+}
+
 func (r *Repository) ListThumbsUpArticleIDs(ctx context.Context, userID string) ([]string, error) {
 	return r.queries.ListThumbsUpArticleIDs(ctx, userID)
 }
 
 func (r *Repository) ListUnreviewedArticleIDs(
-	ctx context.Context, userID string, page, pageSize int,
+	ctx context.Context, userID string, tags []string, page, pageSize int,
 ) ([]string, error) {
+	if len(tags) > 0 {
+		return r.listTaggedInteractionArticleIDs(ctx, userID, tags, "date_read", page, pageSize,
+			func(sb *sqlbuilder.SelectBuilder) []string {
+				return []string{
+					sb.Equal("have_read", true),
+					sb.Equal("thumbs_up", false),
+					sb.Equal("thumbs_down", false),
+				}
+			})
+	}
+
 	limit, offset := paginationToLimitOffset(page, pageSize)
 	return r.queries.ListUnreviewedArticleIDs(ctx, queries.ListUnreviewedArticleIDsParams{
 		UserID: userID,
@@ -170,8 +280,15 @@ func (r *Repository) ListUnreviewedArticleIDs(
 }
 
 func (r *Repository) ListLikedArticleIDs(
-	ctx context.Context, userID string, page, pageSize int,
+	ctx context.Context, userID string, tags []string, page, pageSize int,
 ) ([]string, error) {
+	if len(tags) > 0 {
+		return r.listTaggedInteractionArticleIDs(ctx, userID, tags, "date_rated", page, pageSize,
+			func(sb *sqlbuilder.SelectBuilder) []string {
+				return []string{sb.Equal("thumbs_up", true)}
+			})
+	}
+
 	limit, offset := paginationToLimitOffset(page, pageSize)
 	return r.queries.ListLikedArticleIDs(ctx, queries.ListLikedArticleIDsParams{
 		UserID: userID,
@@ -181,8 +298,15 @@ func (r *Repository) ListLikedArticleIDs(
 }
 
 func (r *Repository) ListDislikedArticleIDs(
-	ctx context.Context, userID string, page, pageSize int,
+	ctx context.Context, userID string, tags []string, page, pageSize int,
 ) ([]string, error) {
+	if len(tags) > 0 {
+		return r.listTaggedInteractionArticleIDs(ctx, userID, tags, "date_rated", page, pageSize,
+			func(sb *sqlbuilder.SelectBuilder) []string {
+				return []string{sb.Equal("thumbs_down", true)}
+			})
+	}
+
 	limit, offset := paginationToLimitOffset(page, pageSize)
 	return r.queries.ListDislikedArticleIDs(ctx, queries.ListDislikedArticleIDsParams{
 		UserID: userID,
@@ -191,10 +315,66 @@ func (r *Repository) ListDislikedArticleIDs(
 	})
 }
 
+// listTaggedInteractionArticleIDs lists article IDs from a user's interactions matching the
+// given state conditions, restricted to articles the user has given every one of the tags.
+// The tags must be normalized and distinct.
+func (r *Repository) listTaggedInteractionArticleIDs(
+	ctx context.Context,
+	userID string,
+	tags []string,
+	orderBy string,
+	page, pageSize int,
+	stateConds func(sb *sqlbuilder.SelectBuilder) []string,
+) ([]string, error) {
+	tagged := sqlbuilder.Select("article_hash_id")
+	tagged.From("user_article_tags")
+	tagged.Where(
+		tagged.Equal("user_id", userID),
+		tagged.In("tag", sqlbuilder.List(tags)),
+	)
+	tagged.GroupBy("article_hash_id")
+	tagged.Having(tagged.Equal("COUNT(*)", len(tags)))
+
+	sb := sqlbuilder.Select("article_hash_id")
+	sb.From("user_article_interactions")
+	sb.Where(sb.Equal("user_id", userID), sb.In("article_hash_id", tagged))
+	sb.Where(stateConds(sb)...)
+	sb.OrderBy(orderBy).Desc()
+
+	limit, offset := paginationToLimitOffset(page, pageSize)
+	sb.Limit(int(limit))
+	sb.Offset(int(offset))
+
+	query, args := sb.Build()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("running tagged articles query: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	articleIDs := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning tagged articles: %w", err)
+		}
+		articleIDs = append(articleIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return articleIDs, nil
+}
+
 func (r *Repository) ListReadArticleIDs(ctx context.Context, userID string) ([]string, error) {
 	return r.queries.ListReadArticleIDs(ctx, userID)
 }
 
+func (r *Repository) ListHiddenArticleIDs(ctx context.Context, userID string) ([]string, error) {
+	return r.queries.ListHiddenArticleIDs(ctx, userID)
+}
+
 func New(db *sql.DB) *Repository {
 	return &Repository{db: db, queries: queries.New(db)}
 }
@@ -304,6 +484,34 @@ func (r *Repository) FetchArticlesByID(
 		}
 	}
 
+	alternates, err := r.queries.ListArticleAlternates(ctx, hashIDs)
+	if err != nil {
+		return nil, fmt.Errorf("listing article alternates: %w", err)
+	}
+	for _, alternate := range alternates {
+		article, ok := articleMap[alternate.CanonicalHashID]
+		if !ok {
+			continue
+		}
+		article.Alternates = append(article.Alternates, domain.ArticleAlternate{
+			HashID: alternate.HashID,
+			Link:   alternate.Url.String,
+			Source: alternate.Source.String,
+		})
+		articleMap[alternate.CanonicalHashID] = article
+	}
+
+	authors, err := r.GetArticleAuthors(ctx, hashIDs)
+	if err != nil {
+		return nil, err
+	}
+	for hashID, refs := range authors {
+		if article, ok := articleMap[hashID]; ok {
+			article.StructuredAuthors = refs
+			articleMap[hashID] = article
+		}
+	}
+
 	// Build results in the same order as the input hashIDs
 	articles := make([]domain.Article, 0, len(hashIDs))
 	for _, hashID := range hashIDs {
@@ -315,6 +523,69 @@ func (r *Repository) FetchArticlesByID(
 	return articles, nil
 }
 
+// findArticlesBatchSize bounds the number of placeholders in each URL or title lookup.
+const findArticlesBatchSize = 500
+
+func (r *Repository) FindArticlesByURLs(ctx context.Context, urls []string) (map[string]string, error) {
+	matches := make(map[string]string)
+	for start := 0; start < len(urls); start += findArticlesBatchSize {
+		end := min(start+findArticlesBatchSize, len(urls))
+		rows, err := r.queries.FindArticlesByURLs(ctx, urls[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("finding articles by URL: %w", err)
+		}
+		for _, row := range rows {
+			matches[row.Url.String] = row.HashID
+		}
+	}
+	return matches, nil
+}
+
+func (r *Repository) GetArticlePublishedDates(ctx context.Context, hashIDs []string) (map[string]time.Time, error) {
+	if len(hashIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.queries.GetArticlePublishedDates(ctx, hashIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting article published dates: %w", err)
+	}
+
+	dates := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		dates[row.HashID] = row.DatePublished.Time
+	}
+	return dates, nil
+}
+
+// FindArticlesByNormalizedTitles finds articles by their normalized titles, in batches.
+// Candidates are looked up by the database's normalized_title column, then their titles are
+// normalized again here, so only titles matching exactly by domain.NormalizeTitle are returned.
+func (r *Repository) FindArticlesByNormalizedTitles(
+	ctx context.Context, titles []string,
+) (map[string]string, error) {
+	wanted := make(map[string]bool, len(titles))
+	for _, title := range titles {
+		wanted[title] = true
+	}
+
+	matches := make(map[string]string)
+	for start := 0; start < len(titles); start += findArticlesBatchSize {
+		end := min(start+findArticlesBatchSize, len(titles))
+		rows, err := r.queries.FindArticlesByNormalizedTitles(ctx, titles[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("finding articles by title: %w", err)
+		}
+		for _, row := range rows {
+			normalized := domain.NormalizeTitle(row.Title.String)
+			if _, ok := matches[normalized]; !ok && wanted[normalized] {
+				matches[normalized] = row.HashID
+			}
+		}
+	}
+	return matches, nil
+}
+
 func (r *Repository) TotalMatchingArticles(
 	ctx context.Context,
 	filters domain.ArticleFilters,
@@ -382,9 +653,39 @@ func buildArticlesConditions(sb *sqlbuilder.SelectBuilder, filters domain.Articl
 		conds = append(conds, cond)
 	}
 
+	if !filters.Followed.IsEmpty() {
+		conds = append(conds, buildFollowedCondition(sb, filters.Followed))
+	}
+
+	// Duplicates are listed once, as their canonical article
+	conds = append(conds, "hash_id NOT IN (SELECT variant_hash_id FROM article_duplicates)")
+
 	return conds
 }
 
+// buildFollowedCondition matches articles by any of the followed authors, or in any of the
+// followed sources or categories.
+func buildFollowedCondition(sb *sqlbuilder.SelectBuilder, followed domain.FollowedItems) string {
+	var anyOf []string
+
+	if len(followed.AuthorIDs) > 0 {
+		authored := sqlbuilder.Select("article_hash_id")
+		authored.From("article_authors")
+		authored.Where(authored.In("author_id", sqlbuilder.List(followed.AuthorIDs)))
+		anyOf = append(anyOf, sb.In("hash_id", authored))
+	}
+
+	if len(followed.Sources) > 0 {
+		anyOf = append(anyOf, sb.In("source", sqlbuilder.List(followed.Sources)))
+	}
+
+	if len(followed.Categories) > 0 {
+		anyOf = append(anyOf, sb.In("category", sqlbuilder.List(followed.Categories)))
+	}
+
+	return sb.Or(anyOf...)
+}
+
 func buildArticlesOrder(options domain.ArticleListOptions) ([]string, error) {
 	if len(options.Ordering) == 0 {
 		return []string{"date_published DESC"}, nil
@@ -402,6 +703,10 @@ func buildArticlesOrder(options domain.ArticleListOptions) ([]string, error) {
 			col = "source"
 		case domain.ArticleOrderingFieldTitle:
 			col = "title"
+		case domain.ArticleOrderingFieldPopularity:
+			col = fmt.Sprintf("COALESCE((SELECT score FROM article_popularity"+
+				" WHERE article_hash_id = articles.hash_id AND window_days = %d), 0)",
+				int(domain.PopularityOrderingWindow))
 		default:
 			return nil, fmt.Errorf("unknown ordering field: %s", ordering.Field)
 		}
@@ -457,13 +762,15 @@ func paginationToLimitOffset(page, pageSize int) (limit, offset int32) {
 // User Article Interaction Store Implementation
 // ============================================
 
-// GetUserArticleVectorsByType retrieves article vectors for a user filtered by rating type.
+// GetUserArticleVectorsByType retrieves article vectors for a user filtered by rating type,
+// along with the signals used to weight them. Thumbs up vectors include every article the user
+// engaged with positively, rated or not.
 func (r *Repository) GetUserArticleVectorsByType(
 	ctx context.Context, userID string, ratingType domain.UserRatingType,
 ) ([]domain.UserArticleRating, error) {
 	switch ratingType {
 	case domain.RatingTypeThumbsUp:
-		rows, err := r.queries.GetUserArticleVectorsByThumbsUp(ctx, userID)
+		rows, err := r.queries.GetUserArticleVectorsByPositiveSignals(ctx, userID)
 		if err != nil {
 			return nil, fmt.Errorf("fetching thumbs up vectors: %w", err)
 		}
@@ -484,32 +791,60 @@ func (r *Repository) GetUserArticleVectorsByType(
 type vectorRow interface {
 	getArticleHashID() string
 	getVector() sql.NullString
-	getDateRated() sql.NullTime
+	getRatedAt() sql.NullTime
+	getSignals() domain.ArticleSignals
 }
 
-type thumbsUpRow struct {
-	queries.GetUserArticleVectorsByThumbsUpRow
+type positiveSignalsRow struct {
+	queries.GetUserArticleVectorsByPositiveSignalsRow
 }
 
-func (r thumbsUpRow) getArticleHashID() string   { return r.ArticleHashID }
-func (r thumbsUpRow) getVector() sql.NullString  { return r.Vector }
-func (r thumbsUpRow) getDateRated() sql.NullTime { return r.DateRated }
+func (r positiveSignalsRow) getArticleHashID() string  { return r.ArticleHashID }
+func (r positiveSignalsRow) getVector() sql.NullString { return r.Vector }
+func (r positiveSignalsRow) getRatedAt() sql.NullTime {
+	if r.DateRated.Valid {
+		return r.DateRated
+	}
+	return r.DateEngaged
+}
+func (r positiveSignalsRow) getSignals() domain.ArticleSignals {
+	return articleSignals(r.ThumbsUp, r.ThumbsDown, r.Rating, r.OpenedLink,
+		r.TimeOnArticleSeconds, r.SavedToCollection)
+}
 
 type thumbsDownRow struct {
 	queries.GetUserArticleVectorsByThumbsDownRow
 }
 
-func (r thumbsDownRow) getArticleHashID() string   { return r.ArticleHashID }
-func (r thumbsDownRow) getVector() sql.NullString  { return r.Vector }
-func (r thumbsDownRow) getDateRated() sql.NullTime { return r.DateRated }
+func (r thumbsDownRow) getArticleHashID() string  { return r.ArticleHashID }
+func (r thumbsDownRow) getVector() sql.NullString { return r.Vector }
+func (r thumbsDownRow) getRatedAt() sql.NullTime  { return r.DateRated }
+func (r thumbsDownRow) getSignals() domain.ArticleSignals {
+	return articleSignals(r.ThumbsUp, r.ThumbsDown, r.Rating, r.OpenedLink,
+		r.TimeOnArticleSeconds, r.SavedToCollection)
+}
+
+// articleSignals builds the signals for an interaction row.
+func articleSignals(
+	thumbsUp, thumbsDown bool, rating sql.NullInt32, openedLink bool, timeOnArticleSeconds int32, saved bool,
+) domain.ArticleSignals {
+	return domain.ArticleSignals{
+		ThumbsUp:          thumbsUp,
+		ThumbsDown:        thumbsDown,
+		Rating:            int(rating.Int32),
+		OpenedLink:        openedLink,
+		TimeOnArticle:     time.Duration(timeOnArticleSeconds) * time.Second,
+		SavedToCollection: saved,
+	}
+}
 
 func convertVectorRows(
-	rows []queries.GetUserArticleVectorsByThumbsUpRow,
+	rows []queries.GetUserArticleVectorsByPositiveSignalsRow,
 	ratingType domain.UserRatingType,
 ) ([]domain.UserArticleRating, error) {
 	adapted := make([]vectorRow, len(rows))
 	for i := range rows {
-		adapted[i] = thumbsUpRow{rows[i]}
+		adapted[i] = positiveSignalsRow{rows[i]}
 	}
 	return convertVectorRowSlice(adapted, ratingType)
 }
@@ -543,7 +878,8 @@ func convertVectorRowSlice(
 			ArticleHashID: row.getArticleHashID(),
 			Vector:        vector,
 			RatingType:    ratingType,
-			RatedAt:       row.getDateRated().Time,
+			RatedAt:       row.getRatedAt().Time,
+			Signals:       row.getSignals(),
 		})
 	}
 	return result, nil
@@ -555,7 +891,7 @@ func (r *Repository) CountUserArticleVectorsByType(
 ) (int64, error) {
 	switch ratingType {
 	case domain.RatingTypeThumbsUp:
-		return r.queries.CountUserArticleVectorsByThumbsUp(ctx, userID)
+		return r.queries.CountUserArticleVectorsByPositiveSignals(ctx, userID)
 	case domain.RatingTypeThumbsDown:
 		return r.queries.CountUserArticleVectorsByThumbsDown(ctx, userID)
 	default:
@@ -599,6 +935,7 @@ func (r *Repository) GetUserInterestClusters(
 			ClusterID:      int(row.ClusterID),
 			CentroidVector: vector,
 			ArticleCount:   int(row.ArticleCount),
+			Provisional:    row.Provisional,
 			UpdatedAt:      row.UpdatedAt,
 		})
 	}
@@ -611,199 +948,2289 @@ func (r *Repository) DeleteUserInterestClusters(ctx context.Context, userID stri
 	return r.queries.DeleteUserInterestClusters(ctx, userID)
 }
 
-// ============================================
-// Precomputed Recommendation Store Implementation
-// ============================================
-
-// UpsertPrecomputedRecommendation stores or updates a precomputed recommendation.
-func (r *Repository) UpsertPrecomputedRecommendation(
-	ctx context.Context, params datasources.UpsertPrecomputedRecommendationParams,
-) error {
-	return r.queries.UpsertPrecomputedRecommendation(ctx, queries.UpsertPrecomputedRecommendationParams{
-		UserID:        params.UserID,
-		ArticleHashID: params.ArticleHashID,
-		Score:         params.Score,
-		Source:        params.Source,
-		Position:      int32(params.Position), //nolint:gosec // positions are small
-		GeneratedAt:   params.GeneratedAt,
-	})
-}
-
-// DeleteUserPrecomputedRecommendations removes all precomputed recommendations for a user.
-func (r *Repository) DeleteUserPrecomputedRecommendations(ctx context.Context, userID string) error {
-	return r.queries.DeleteUserPrecomputedRecommendations(ctx, userID)
+// DeleteUserComputedInterestClusters removes a user's clusters computed from ratings, keeping provisional ones.
+func (r *Repository) DeleteUserComputedInterestClusters(ctx context.Context, userID string) error {
+	return r.queries.DeleteUserComputedInterestClusters(ctx, userID)
 }
 
-// GetPrecomputedRecommendations retrieves precomputed recommendations for a user, ordered by position.
-func (r *Repository) GetPrecomputedRecommendations(
-	ctx context.Context, userID string, limit int,
-) ([]datasources.PrecomputedRecommendation, error) {
-	rows, err := r.queries.GetPrecomputedRecommendations(ctx, queries.GetPrecomputedRecommendationsParams{
-		UserID: userID,
-		Limit:  int32(limit), //nolint:gosec // limits are small
-	})
+// SeedUserInterestClusters replaces all of a user's interest clusters with provisional ones.
+func (r *Repository) SeedUserInterestClusters(ctx context.Context, userID string, seedVectors [][]float32) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("fetching precomputed recommendations: %w", err)
-	}
-
-	result := make([]datasources.PrecomputedRecommendation, 0, len(rows))
-	for _, row := range rows {
-		result = append(result, datasources.PrecomputedRecommendation{
-			ArticleHashID: row.ArticleHashID,
-			Score:         row.Score,
-			Source:        row.Source,
-			Position:      int(row.Position),
-			GeneratedAt:   row.GeneratedAt,
-		})
+		return fmt.Errorf("starting transaction: %w", err)
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	return result, nil
-}
+	qtx := r.queries.WithTx(tx)
 
-// GetPrecomputedRecommendationAge returns when recommendations were last generated for a user.
-// Returns zero time if no recommendations exist.
-func (r *Repository) GetPrecomputedRecommendationAge(ctx context.Context, userID string) (time.Time, error) {
-	generatedAt, err := r.queries.GetPrecomputedRecommendationAge(ctx, userID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return time.Time{}, nil
+	if err := qtx.DeleteUserInterestClusters(ctx, userID); err != nil {
+		return fmt.Errorf("deleting existing clusters: %w", err)
+	}
+	for i, vector := range seedVectors {
+		if err := qtx.InsertProvisionalUserInterestCluster(ctx, queries.InsertProvisionalUserInterestClusterParams{
+			UserID:         userID,
+			ClusterID:      int32(i), //nolint:gosec // seed counts are small
+			CentroidVector: float32SliceToBytes(vector),
+		}); err != nil {
+			return fmt.Errorf("inserting provisional cluster %d: %w", i, err)
 		}
-		return time.Time{}, fmt.Errorf("fetching recommendation age: %w", err)
 	}
-	return generatedAt, nil
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
 }
 
 // ============================================
-// User Recommendation State Store Implementation
+// Onboarding Store Implementation
 // ============================================
 
-// GetUserRecommendationState retrieves the recommendation state for a user.
-func (r *Repository) GetUserRecommendationState(
+// GetOnboardingInterests retrieves the interests a user picked when onboarding.
+func (r *Repository) GetOnboardingInterests(
 	ctx context.Context, userID string,
-) (datasources.UserRecommendationState, error) {
-	row, err := r.queries.GetUserRecommendationState(ctx, userID)
+) (domain.OnboardingInterests, bool, error) {
+	row, err := r.queries.GetUserOnboardingInterests(ctx, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return datasources.UserRecommendationState{}, nil
+			return domain.OnboardingInterests{}, false, nil
 		}
-		return datasources.UserRecommendationState{}, fmt.Errorf("fetching recommendation state: %w", err)
+		return domain.OnboardingInterests{}, false, fmt.Errorf("fetching onboarding interests: %w", err)
 	}
 
-	return datasources.UserRecommendationState{
-		LastGeneratedAt:   row.LastGeneratedAt.Time,
-		LastRatingAt:      row.LastRatingAt.Time,
-		NeedsRegeneration: row.NeedsRegeneration,
-	}, nil
-}
-
-// MarkUserNeedsRegeneration marks a user as needing recommendation regeneration.
-func (r *Repository) MarkUserNeedsRegeneration(ctx context.Context, userID string) error {
-	return r.queries.MarkUserNeedsRegeneration(ctx, userID)
+	return convertOnboardingInterests(ctx, userID, row), true, nil
 }
 
-// MarkUserRegenerated marks a user's recommendations as regenerated.
-func (r *Repository) MarkUserRegenerated(ctx context.Context, userID string) error {
-	return r.queries.MarkUserRegenerated(ctx, userID)
-}
+// SetOnboardingInterests stores the interests a user picked when onboarding.
+func (r *Repository) SetOnboardingInterests(ctx context.Context, interests domain.OnboardingInterests) error {
+	categories, err := encodeStringList(interests.Categories)
+	if err != nil {
+		return fmt.Errorf("encoding onboarding categories: %w", err)
+	}
+	freeText, err := encodeStringList(interests.Interests)
+	if err != nil {
+		return fmt.Errorf("encoding onboarding interests: %w", err)
+	}
 
-// ListUsersNeedingRegeneration returns user IDs that need recommendation regeneration.
-func (r *Repository) ListUsersNeedingRegeneration(ctx context.Context) ([]string, error) {
-	return r.queries.ListUsersNeedingRegeneration(ctx)
+	return r.queries.UpsertUserOnboardingInterests(ctx, queries.UpsertUserOnboardingInterestsParams{
+		UserID:     interests.UserID,
+		Categories: categories,
+		Interests:  freeText,
+	})
 }
 
-// ============================================
-// API Token Store Implementation
-// ============================================
-
-// CreateAPIToken creates a new API token.
-func (r *Repository) CreateAPIToken(ctx context.Context, params datasources.CreateAPITokenParams) error {
-	var nameStr sql.NullString
-	if params.Name != nil {
-		nameStr = sql.NullString{String: *params.Name, Valid: true}
+// ListArticleCategories lists the categories articles are in, largest first.
+func (r *Repository) ListArticleCategories(ctx context.Context) ([]domain.ArticleCategory, error) {
+	rows, err := r.queries.ListArticleCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing article categories: %w", err)
 	}
 
-	var expiresAtTime sql.NullTime
-	if params.ExpiresAt != nil {
-		expiresAtTime = sql.NullTime{Time: *params.ExpiresAt, Valid: true}
+	categories := make([]domain.ArticleCategory, 0, len(rows))
+	for _, row := range rows {
+		categories = append(categories, domain.ArticleCategory{
+			Name:         row.Category.String,
+			ArticleCount: row.ArticleCount,
+		})
 	}
+	return categories, nil
+}
 
-	return r.queries.CreateAPIToken(ctx, queries.CreateAPITokenParams{
-		ID:          params.ID,
-		UserID:      params.UserID,
-		TokenHash:   params.TokenHash,
-		TokenPrefix: params.TokenPrefix,
-		Name:        nameStr,
-		ExpiresAt:   expiresAtTime,
-	})
+func convertOnboardingInterests(
+	ctx context.Context, userID string, row queries.GetUserOnboardingInterestsRow,
+) domain.OnboardingInterests {
+	return domain.OnboardingInterests{
+		UserID:     userID,
+		Categories: decodeStringList(ctx, userID, row.Categories),
+		Interests:  decodeStringList(ctx, userID, row.Interests),
+		UpdatedAt:  row.UpdatedAt,
+	}
 }
 
-// GetAPITokenByHash retrieves an API token by its hash.
-func (r *Repository) GetAPITokenByHash(ctx context.Context, tokenHash string) (domain.APIToken, error) {
-	row, err := r.queries.GetAPITokenByHash(ctx, tokenHash)
+// encodeStringList encodes a list of strings as a JSON column value, or NULL if it's empty.
+func encodeStringList(values []string) (sql.NullString, error) {
+	if len(values) == 0 {
+		return sql.NullString{}, nil
+	}
+	encoded, err := json.Marshal(values)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return domain.APIToken{}, fmt.Errorf("token not found: %w", err)
-		}
-		return domain.APIToken{}, fmt.Errorf("fetching token by hash: %w", err)
+		return sql.NullString{}, err
 	}
+	return sql.NullString{String: string(encoded), Valid: true}, nil
+}
 
-	return convertAPIToken(row), nil
+// decodeStringList decodes a JSON list column, returning an empty list if it's NULL or malformed.
+func decodeStringList(ctx context.Context, userID string, value sql.NullString) []string {
+	values := []string{}
+	if value.Valid && value.String != "" {
+		if err := json.Unmarshal([]byte(value.String), &values); err != nil {
+			logger := domain.LoggerFromContext(ctx)
+			logger.WarnContext(ctx, "failed to parse string list JSON", "user_id", userID, "error", err)
+		}
+	}
+	return values
 }
 
-// UpdateAPITokenLastUsed updates the last_used_at timestamp for a token.
-func (r *Repository) UpdateAPITokenLastUsed(ctx context.Context, tokenID string) error {
-	return r.queries.UpdateAPITokenLastUsed(ctx, tokenID)
+// ============================================
+// Article Popularity Implementation
+// ============================================
+
+// AggregateArticlePopularity counts interactions per article since a time, across all users,
+// leaving out articles with interactions from fewer than minUsers distinct users.
+func (r *Repository) AggregateArticlePopularity(
+	ctx context.Context, since time.Time, minUsers int64,
+) ([]domain.ArticlePopularity, error) {
+	rows, err := r.queries.AggregateArticlePopularity(ctx, queries.AggregateArticlePopularityParams{
+		Since:    sql.NullTime{Time: since, Valid: true},
+		MinUsers: minUsers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aggregating article popularity: %w", err)
+	}
+
+	popularity := make([]domain.ArticlePopularity, 0, len(rows))
+	for _, row := range rows {
+		popularity = append(popularity, domain.ArticlePopularity{
+			HashID:       row.ArticleHashID,
+			LikeCount:    row.LikeCount,
+			DislikeCount: row.DislikeCount,
+			ReadCount:    row.ReadCount,
+			UserCount:    row.UserCount,
+		})
+	}
+	return popularity, nil
 }
 
-// ListUserAPITokens lists all tokens for a user.
-func (r *Repository) ListUserAPITokens(ctx context.Context, userID string) ([]domain.APIToken, error) {
-	rows, err := r.queries.ListUserAPITokens(ctx, userID)
+// ReplaceArticlePopularity replaces the stored popularity of all articles in a window.
+func (r *Repository) ReplaceArticlePopularity(
+	ctx context.Context, window domain.PopularityWindow, popularity []domain.ArticlePopularity,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("listing user API tokens: %w", err)
+		return fmt.Errorf("starting transaction: %w", err)
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	tokens := make([]domain.APIToken, 0, len(rows))
+	qtx := r.queries.WithTx(tx)
+
+	windowDays := int32(window) //nolint:gosec // windows are a few days
+	if err := qtx.DeleteArticlePopularityWindow(ctx, windowDays); err != nil {
+		return fmt.Errorf("deleting existing popularity: %w", err)
+	}
+	for _, p := range popularity {
+		if err := qtx.InsertArticlePopularity(ctx, queries.InsertArticlePopularityParams{
+			ArticleHashID: p.HashID,
+			WindowDays:    windowDays,
+			LikeCount:     p.LikeCount,
+			DislikeCount:  p.DislikeCount,
+			ReadCount:     p.ReadCount,
+			UserCount:     p.UserCount,
+			Score:         p.Score,
+		}); err != nil {
+			return fmt.Errorf("inserting popularity for article %s: %w", p.HashID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListPopularArticles lists the articles with the highest popularity score in a window.
+func (r *Repository) ListPopularArticles(
+	ctx context.Context, window domain.PopularityWindow, page, pageSize int,
+) ([]domain.ArticlePopularity, error) {
+	limit, offset := paginationToLimitOffset(page, pageSize)
+	rows, err := r.queries.ListPopularArticles(ctx, queries.ListPopularArticlesParams{
+		WindowDays: int32(window), //nolint:gosec // windows are a few days
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing popular articles: %w", err)
+	}
+
+	popularity := make([]domain.ArticlePopularity, 0, len(rows))
 	for _, row := range rows {
-		tokens = append(tokens, convertAPIToken(row))
+		popularity = append(popularity, domain.ArticlePopularity{
+			HashID:       row.ArticleHashID,
+			Window:       window,
+			LikeCount:    row.LikeCount,
+			DislikeCount: row.DislikeCount,
+			ReadCount:    row.ReadCount,
+			UserCount:    row.UserCount,
+			Score:        row.Score,
+		})
 	}
+	return popularity, nil
+}
 
-	return tokens, nil
+// GetArticlePopularity returns the stored popularity of the given articles in a window, by hash ID.
+func (r *Repository) GetArticlePopularity(
+	ctx context.Context, window domain.PopularityWindow, hashIDs []string,
+) (map[string]domain.ArticlePopularity, error) {
+	popularity := make(map[string]domain.ArticlePopularity, len(hashIDs))
+	if len(hashIDs) == 0 {
+		return popularity, nil
+	}
+
+	rows, err := r.queries.GetArticlePopularityByIDs(ctx, queries.GetArticlePopularityByIDsParams{
+		WindowDays: int32(window), //nolint:gosec // windows are a few days
+		HashIds:    hashIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching article popularity: %w", err)
+	}
+
+	for _, row := range rows {
+		popularity[row.ArticleHashID] = domain.ArticlePopularity{
+			HashID:       row.ArticleHashID,
+			Window:       window,
+			LikeCount:    row.LikeCount,
+			DislikeCount: row.DislikeCount,
+			ReadCount:    row.ReadCount,
+			UserCount:    row.UserCount,
+			Score:        row.Score,
+		}
+	}
+	return popularity, nil
 }
 
-// CountUserActiveAPITokens counts active tokens for a user.
-func (r *Repository) CountUserActiveAPITokens(ctx context.Context, userID string) (int64, error) {
-	return r.queries.CountUserActiveAPITokens(ctx, userID)
+// ============================================
+// Article Neighbour Implementation
+// ============================================
+
+// ListArticleCoLikes lists pairs of articles liked by at least minCoLikes of the same users.
+func (r *Repository) ListArticleCoLikes(ctx context.Context, minCoLikes int64) ([]domain.ArticleCoLike, error) {
+	rows, err := r.queries.ListArticleCoLikes(ctx, minCoLikes)
+	if err != nil {
+		return nil, fmt.Errorf("listing article co-likes: %w", err)
+	}
+
+	coLikes := make([]domain.ArticleCoLike, 0, len(rows))
+	for _, row := range rows {
+		coLikes = append(coLikes, domain.ArticleCoLike{
+			HashID:          row.ArticleHashID,
+			NeighbourHashID: row.NeighbourHashID,
+			Count:           row.CoLikeCount,
+		})
+	}
+	return coLikes, nil
 }
 
-// RevokeAPIToken revokes a token.
-func (r *Repository) RevokeAPIToken(ctx context.Context, tokenID, userID string) error {
-	return r.queries.RevokeAPIToken(ctx, queries.RevokeAPITokenParams{
-		ID:     tokenID,
+// CountArticleLikes counts how many users have given each article a thumbs up.
+func (r *Repository) CountArticleLikes(ctx context.Context) (map[string]int64, error) {
+	rows, err := r.queries.CountArticleLikes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting article likes: %w", err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.ArticleHashID] = row.LikeCount
+	}
+	return counts, nil
+}
+
+// ReplaceArticleNeighbours replaces all stored article neighbours.
+func (r *Repository) ReplaceArticleNeighbours(ctx context.Context, neighbours []domain.ArticleNeighbour) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteArticleNeighbours(ctx); err != nil {
+		return fmt.Errorf("deleting existing neighbours: %w", err)
+	}
+	for _, n := range neighbours {
+		if err := qtx.InsertArticleNeighbour(ctx, queries.InsertArticleNeighbourParams{
+			ArticleHashID:   n.HashID,
+			NeighbourHashID: n.NeighbourHashID,
+			CoLikeCount:     n.CoLikeCount,
+			Score:           n.Score,
+		}); err != nil {
+			return fmt.Errorf("inserting neighbour %s of article %s: %w", n.NeighbourHashID, n.HashID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListCollaborativeCandidates finds neighbours of the articles a user has liked,
+// excluding articles the user has rated.
+func (r *Repository) ListCollaborativeCandidates(
+	ctx context.Context, userID string, limit int,
+) ([]domain.SimilarArticle, error) {
+	rows, err := r.queries.ListCollaborativeCandidates(ctx, queries.ListCollaborativeCandidatesParams{
 		UserID: userID,
+		Limit:  int32(limit), //nolint:gosec // limits are small
 	})
+	if err != nil {
+		return nil, fmt.Errorf("listing collaborative candidates: %w", err)
+	}
+
+	candidates := make([]domain.SimilarArticle, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, domain.SimilarArticle{HashID: row.NeighbourHashID, Score: row.Score})
+	}
+	return candidates, nil
 }
 
-func convertAPIToken(row queries.ApiToken) domain.APIToken {
-	token := domain.APIToken{
-		ID:        row.ID,
-		UserID:    row.UserID,
-		TokenHash: row.TokenHash,
-		Prefix:    row.TokenPrefix,
-		CreatedAt: row.CreatedAt,
+// ============================================
+// Article Duplicate Implementation
+// ============================================
+
+// ListArticleDuplicates lists every stored duplicate article.
+func (r *Repository) ListArticleDuplicates(ctx context.Context) ([]domain.ArticleDuplicate, error) {
+	rows, err := r.queries.ListArticleDuplicates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing article duplicates: %w", err)
 	}
 
-	if row.Name.Valid {
-		token.Name = &row.Name.String
+	duplicates := make([]domain.ArticleDuplicate, 0, len(rows))
+	for _, row := range rows {
+		duplicates = append(duplicates, domain.ArticleDuplicate{
+			CanonicalHashID: row.CanonicalHashID,
+			VariantHashID:   row.VariantHashID,
+		})
 	}
-	if row.LastUsedAt.Valid {
-		token.LastUsedAt = &row.LastUsedAt.Time
+	return duplicates, nil
+}
+
+// ReplaceArticleDuplicates replaces all stored duplicate articles.
+func (r *Repository) ReplaceArticleDuplicates(ctx context.Context, duplicates []domain.ArticleDuplicate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
 	}
-	if row.ExpiresAt.Valid {
-		token.ExpiresAt = &row.ExpiresAt.Time
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteArticleDuplicates(ctx); err != nil {
+		return fmt.Errorf("deleting existing duplicates: %w", err)
 	}
-	if row.RevokedAt.Valid {
-		token.RevokedAt = &row.RevokedAt.Time
+	for _, d := range duplicates {
+		if err := qtx.InsertArticleDuplicate(ctx, queries.InsertArticleDuplicateParams{
+			VariantHashID:   d.VariantHashID,
+			CanonicalHashID: d.CanonicalHashID,
+		}); err != nil {
+			return fmt.Errorf("inserting duplicate %s of article %s: %w", d.VariantHashID, d.CanonicalHashID, err)
+		}
 	}
 
-	return token
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetCanonicalArticleIDs looks up the canonical article of each given article that is a duplicate.
+func (r *Repository) GetCanonicalArticleIDs(ctx context.Context, hashIDs []string) (map[string]string, error) {
+	if len(hashIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.queries.GetCanonicalArticleIDs(ctx, hashIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting canonical article IDs: %w", err)
+	}
+
+	canonical := make(map[string]string, len(rows))
+	for _, row := range rows {
+		canonical[row.VariantHashID] = row.CanonicalHashID
+	}
+	return canonical, nil
+}
+
+// ============================================
+// Author Implementation
+// ============================================
+
+// ListArticleAuthorLists lists every article's free-text author list.
+func (r *Repository) ListArticleAuthorLists(ctx context.Context) ([]domain.ArticleAuthorList, error) {
+	rows, err := r.queries.ListArticleAuthorLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing article author lists: %w", err)
+	}
+
+	lists := make([]domain.ArticleAuthorList, 0, len(rows))
+	for _, row := range rows {
+		lists = append(lists, domain.ArticleAuthorList{HashID: row.HashID, Authors: row.Authors})
+	}
+	return lists, nil
+}
+
+// ListAuthorNames maps every stored author name and alias to its author's ID.
+func (r *Repository) ListAuthorNames(ctx context.Context) (map[string]string, error) {
+	rows, err := r.queries.ListAuthorNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing author names: %w", err)
+	}
+
+	ids := make(map[string]string, len(rows))
+	for _, row := range rows {
+		ids[row.Name] = row.AuthorID
+	}
+	return ids, nil
+}
+
+// ReplaceAuthors replaces all stored authors, their aliases and the articles they're credited on.
+// Authors are updated in place, so those still credited keep their rows, and those no longer
+// credited on any article are deleted. Follows of each author ID in renamedIDs move to its new ID.
+func (r *Repository) ReplaceAuthors(
+	ctx context.Context,
+	authors []domain.Author,
+	articleAuthors []domain.ArticleAuthor,
+	renamedIDs map[string]string,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteAuthorAliases(ctx); err != nil {
+		return fmt.Errorf("deleting existing author aliases: %w", err)
+	}
+	if err := qtx.DeleteArticleAuthors(ctx); err != nil {
+		return fmt.Errorf("deleting existing article authors: %w", err)
+	}
+	for _, author := range authors {
+		if err := qtx.UpsertAuthor(ctx, queries.UpsertAuthorParams{
+			ID:   author.ID,
+			Name: author.Name,
+		}); err != nil {
+			return fmt.Errorf("upserting author %s: %w", author.ID, err)
+		}
+		for _, alias := range author.Aliases {
+			if err := qtx.InsertAuthorAlias(ctx, queries.InsertAuthorAliasParams{
+				AuthorID: author.ID,
+				Alias:    alias,
+			}); err != nil {
+				return fmt.Errorf("inserting alias %s of author %s: %w", alias, author.ID, err)
+			}
+		}
+	}
+	for _, aa := range articleAuthors {
+		if err := qtx.InsertArticleAuthor(ctx, queries.InsertArticleAuthorParams{
+			ArticleHashID: aa.ArticleHashID,
+			AuthorID:      aa.AuthorID,
+			Position:      int32(aa.Position), //nolint:gosec // author lists are short
+		}); err != nil {
+			return fmt.Errorf("inserting author %s of article %s: %w", aa.AuthorID, aa.ArticleHashID, err)
+		}
+	}
+	if err := qtx.DeleteUncreditedAuthors(ctx); err != nil {
+		return fmt.Errorf("deleting uncredited authors: %w", err)
+	}
+	for oldID, newID := range renamedIDs {
+		if err := qtx.RenameFollowTarget(ctx, queries.RenameFollowTargetParams{
+			NewTarget:  newID,
+			FollowType: string(domain.FollowTypeAuthor),
+			OldTarget:  oldID,
+		}); err != nil {
+			return fmt.Errorf("moving follows of author %s to %s: %w", oldID, newID, err)
+		}
+		// Left behind for users who already followed the new ID
+		if err := qtx.DeleteFollowTarget(ctx, queries.DeleteFollowTargetParams{
+			FollowType: string(domain.FollowTypeAuthor),
+			Target:     oldID,
+		}); err != nil {
+			return fmt.Errorf("deleting follows of author %s: %w", oldID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuthor retrieves an author with their aliases and how many articles they're credited on.
+func (r *Repository) GetAuthor(ctx context.Context, authorID string) (domain.Author, bool, error) {
+	row, err := r.queries.GetAuthor(ctx, authorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Author{}, false, nil
+		}
+		return domain.Author{}, false, fmt.Errorf("fetching author: %w", err)
+	}
+
+	aliases, err := r.queries.ListAuthorAliases(ctx, authorID)
+	if err != nil {
+		return domain.Author{}, false, fmt.Errorf("listing author aliases: %w", err)
+	}
+
+	return domain.Author{
+		ID:           row.ID,
+		Name:         row.Name,
+		Aliases:      aliases,
+		ArticleCount: row.ArticleCount,
+	}, true, nil
+}
+
+// ListAuthorArticleIDs lists the articles an author is credited on, newest first.
+func (r *Repository) ListAuthorArticleIDs(
+	ctx context.Context, authorID string, page, pageSize int,
+) ([]string, error) {
+	limit, offset := paginationToLimitOffset(page, pageSize)
+	ids, err := r.queries.ListAuthorArticleIDs(ctx, queries.ListAuthorArticleIDsParams{
+		AuthorID: authorID,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing author articles: %w", err)
+	}
+	return ids, nil
+}
+
+// ListCoAuthors lists the authors who have written the most articles with an author.
+func (r *Repository) ListCoAuthors(ctx context.Context, authorID string, limit int) ([]domain.CoAuthor, error) {
+	rows, err := r.queries.ListCoAuthors(ctx, queries.ListCoAuthorsParams{
+		AuthorID: authorID,
+		Limit:    int32(limit), //nolint:gosec // limits are small
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing co-authors: %w", err)
+	}
+
+	coAuthors := make([]domain.CoAuthor, 0, len(rows))
+	for _, row := range rows {
+		coAuthors = append(coAuthors, domain.CoAuthor{
+			ID:             row.ID,
+			Name:           row.Name,
+			SharedArticles: row.SharedArticles,
+		})
+	}
+	return coAuthors, nil
+}
+
+// GetArticleAuthors looks up the authors credited on each of the given articles, in order.
+func (r *Repository) GetArticleAuthors(
+	ctx context.Context, hashIDs []string,
+) (map[string][]domain.AuthorRef, error) {
+	if len(hashIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.queries.ListArticleAuthorRefs(ctx, hashIDs)
+	if err != nil {
+		return nil, fmt.Errorf("listing article authors: %w", err)
+	}
+
+	authors := make(map[string][]domain.AuthorRef)
+	for _, row := range rows {
+		authors[row.ArticleHashID] = append(authors[row.ArticleHashID], domain.AuthorRef{ID: row.ID, Name: row.Name})
+	}
+	return authors, nil
+}
+
+// ============================================
+// Recommendation Impression Implementation
+// ============================================
+
+// RecordRecommendationImpressions records recommended articles shown to users.
+func (r *Repository) RecordRecommendationImpressions(
+	ctx context.Context, impressions []domain.RecommendationImpression,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	for _, impression := range impressions {
+		if err := qtx.InsertRecommendationImpression(ctx, queries.InsertRecommendationImpressionParams{
+			UserID:        impression.UserID,
+			ArticleHashID: impression.ArticleHashID,
+			ExperimentArm: impression.ExperimentArm,
+			Source:        impression.Source,
+			Position:      int32(impression.Position), //nolint:gosec // positions are small
+			ServedAt:      impression.ServedAt,
+		}); err != nil {
+			return fmt.Errorf("inserting impression of article %s: %w", impression.ArticleHashID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CountIgnoredRecommendations counts the distinct days on which each article was shown to a user
+// in the top positions since a time, leaving out articles the user has read or rated.
+func (r *Repository) CountIgnoredRecommendations(
+	ctx context.Context, userID string, since time.Time, maxPosition, minDays int,
+) (map[string]int, error) {
+	rows, err := r.queries.CountIgnoredRecommendations(ctx, queries.CountIgnoredRecommendationsParams{
+		UserID:      userID,
+		Since:       since,
+		MaxPosition: int32(maxPosition), //nolint:gosec // positions are small
+		MinDays:     int64(minDays),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("counting ignored recommendations: %w", err)
+	}
+
+	days := make(map[string]int, len(rows))
+	for _, row := range rows {
+		days[row.ArticleHashID] = int(row.ShownDays)
+	}
+	return days, nil
+}
+
+// ListCategoryExploration lists every article category with how much the user has explored it,
+// counting only exploration recommendations shown since a time.
+func (r *Repository) ListCategoryExploration(
+	ctx context.Context, userID string, since time.Time,
+) ([]domain.CategoryExploration, error) {
+	rows, err := r.queries.ListCategoryExploration(ctx, queries.ListCategoryExplorationParams{
+		UserID: userID,
+		Since:  since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing category exploration: %w", err)
+	}
+
+	categories := make([]domain.CategoryExploration, 0, len(rows))
+	for _, row := range rows {
+		categories = append(categories, domain.CategoryExploration{
+			Category:       row.Category.String,
+			UserEngaged:    row.UserEngaged,
+			ExploreShown:   row.ExploreShown,
+			ExploreEngaged: row.ExploreEngaged,
+		})
+	}
+	return categories, nil
+}
+
+// ReportExperimentArms compares experiment arms by how users responded to recommendations
+// shown to them since a time.
+func (r *Repository) ReportExperimentArms(
+	ctx context.Context, since time.Time,
+) ([]domain.ExperimentArmReport, error) {
+	rows, err := r.queries.ReportExperimentArms(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("reporting experiment arms: %w", err)
+	}
+
+	reports := make([]domain.ExperimentArmReport, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, domain.NewExperimentArmReport(
+			row.ExperimentArm, row.UserCount, row.ShownCount, row.ReadCount, row.LikedCount,
+		))
+	}
+	return reports, nil
+}
+
+// ============================================
+// Precomputed Recommendation Store Implementation
+// ============================================
+
+// UpsertPrecomputedRecommendation stores or updates a precomputed recommendation.
+func (r *Repository) UpsertPrecomputedRecommendation(
+	ctx context.Context, params datasources.UpsertPrecomputedRecommendationParams,
+) error {
+	return r.queries.UpsertPrecomputedRecommendation(ctx, queries.UpsertPrecomputedRecommendationParams{
+		UserID:        params.UserID,
+		ArticleHashID: params.ArticleHashID,
+		Score:         params.Score,
+		Source:        params.Source,
+		ExperimentArm: params.ExperimentArm,
+		Position:      int32(params.Position), //nolint:gosec // positions are small
+		GeneratedAt:   params.GeneratedAt,
+	})
+}
+
+// DeleteUserPrecomputedRecommendations removes all precomputed recommendations for a user.
+func (r *Repository) DeleteUserPrecomputedRecommendations(ctx context.Context, userID string) error {
+	return r.queries.DeleteUserPrecomputedRecommendations(ctx, userID)
+}
+
+// GetPrecomputedRecommendations retrieves precomputed recommendations for a user, ordered by position.
+func (r *Repository) GetPrecomputedRecommendations(
+	ctx context.Context, userID string, limit int,
+) ([]datasources.PrecomputedRecommendation, error) {
+	rows, err := r.queries.GetPrecomputedRecommendations(ctx, queries.GetPrecomputedRecommendationsParams{
+		UserID: userID,
+		Limit:  int32(limit), //nolint:gosec // limits are small
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching precomputed recommendations: %w", err)
+	}
+
+	result := make([]datasources.PrecomputedRecommendation, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, datasources.PrecomputedRecommendation{
+			ArticleHashID: row.ArticleHashID,
+			Score:         row.Score,
+			Source:        row.Source,
+			ExperimentArm: row.ExperimentArm,
+			Position:      int(row.Position),
+			GeneratedAt:   row.GeneratedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// GetPrecomputedRecommendationAge returns when recommendations were last generated for a user.
+// Returns zero time if no recommendations exist.
+func (r *Repository) GetPrecomputedRecommendationAge(ctx context.Context, userID string) (time.Time, error) {
+	generatedAt, err := r.queries.GetPrecomputedRecommendationAge(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("fetching recommendation age: %w", err)
+	}
+	return generatedAt, nil
+}
+
+// ============================================
+// User Recommendation State Store Implementation
+// ============================================
+
+// GetUserRecommendationState retrieves the recommendation state for a user.
+func (r *Repository) GetUserRecommendationState(
+	ctx context.Context, userID string,
+) (datasources.UserRecommendationState, error) {
+	row, err := r.queries.GetUserRecommendationState(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return datasources.UserRecommendationState{}, nil
+		}
+		return datasources.UserRecommendationState{}, fmt.Errorf("fetching recommendation state: %w", err)
+	}
+
+	return datasources.UserRecommendationState{
+		LastGeneratedAt:   row.LastGeneratedAt.Time,
+		LastRatingAt:      row.LastRatingAt.Time,
+		NeedsRegeneration: row.NeedsRegeneration,
+	}, nil
+}
+
+// MarkUserNeedsRegeneration marks a user as needing recommendation regeneration.
+func (r *Repository) MarkUserNeedsRegeneration(ctx context.Context, userID string) error {
+	return r.queries.MarkUserNeedsRegeneration(ctx, userID)
+}
+
+// MarkUserRegenerated marks a user's recommendations as regenerated.
+func (r *Repository) MarkUserRegenerated(ctx context.Context, userID string) error {
+	return r.queries.MarkUserRegenerated(ctx, userID)
+}
+
+// ListUsersNeedingRegeneration returns user IDs that need recommendation regeneration.
+func (r *Repository) ListUsersNeedingRegeneration(ctx context.Context) ([]string, error) {
+	return r.queries.ListUsersNeedingRegeneration(ctx)
+}
+
+// ============================================
+// API Token Store Implementation
+// ============================================
+
+// CreateAPIToken creates a new API token.
+func (r *Repository) CreateAPIToken(ctx context.Context, params datasources.CreateAPITokenParams) error {
+	return createAPIToken(ctx, r.queries, params)
+}
+
+func createAPIToken(ctx context.Context, q *queries.Queries, params datasources.CreateAPITokenParams) error {
+	var expiresAtTime sql.NullTime
+	if params.ExpiresAt != nil {
+		expiresAtTime = sql.NullTime{Time: *params.ExpiresAt, Valid: true}
+	}
+
+	return q.CreateAPIToken(ctx, queries.CreateAPITokenParams{
+		ID:          params.ID,
+		UserID:      params.UserID,
+		TokenHash:   params.TokenHash,
+		TokenPrefix: params.TokenPrefix,
+		Name:        apiTokenName(params.Name),
+		ExpiresAt:   expiresAtTime,
+		Scopes:      joinAPITokenScopes(params.Scopes),
+	})
+}
+
+// GetAPITokenByHash retrieves an API token by its hash.
+func (r *Repository) GetAPITokenByHash(ctx context.Context, tokenHash string) (domain.APIToken, error) {
+	row, err := r.queries.GetAPITokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.APIToken{}, fmt.Errorf("token not found: %w", err)
+		}
+		return domain.APIToken{}, fmt.Errorf("fetching token by hash: %w", err)
+	}
+
+	return convertAPIToken(row), nil
+}
+
+// UpdateAPITokenLastUsed updates the last_used_at timestamp for a token.
+func (r *Repository) UpdateAPITokenLastUsed(ctx context.Context, tokenID string) error {
+	return r.queries.UpdateAPITokenLastUsed(ctx, tokenID)
+}
+
+// ListUserAPITokens lists all tokens for a user.
+func (r *Repository) ListUserAPITokens(ctx context.Context, userID string) ([]domain.APIToken, error) {
+	rows, err := r.queries.ListUserAPITokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing user API tokens: %w", err)
+	}
+
+	tokens := make([]domain.APIToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, convertAPIToken(row))
+	}
+
+	return tokens, nil
+}
+
+// CountUserActiveAPITokens counts active tokens for a user.
+func (r *Repository) CountUserActiveAPITokens(ctx context.Context, userID string) (int64, error) {
+	return r.queries.CountUserActiveAPITokens(ctx, userID)
+}
+
+// RevokeAPIToken revokes a token, returning false if the user has no such unrevoked token.
+func (r *Repository) RevokeAPIToken(ctx context.Context, tokenID, userID string) (bool, error) {
+	rows, err := r.queries.RevokeAPIToken(ctx, queries.RevokeAPITokenParams{
+		ID:     tokenID,
+		UserID: userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// GetUserAPIToken retrieves one of a user's API tokens by ID.
+func (r *Repository) GetUserAPIToken(ctx context.Context, tokenID, userID string) (domain.APIToken, bool, error) {
+	row, err := r.queries.GetUserAPIToken(ctx, queries.GetUserAPITokenParams{
+		ID:     tokenID,
+		UserID: userID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.APIToken{}, false, nil
+	}
+	if err != nil {
+		return domain.APIToken{}, false, fmt.Errorf("fetching API token: %w", err)
+	}
+
+	return convertAPIToken(row), true, nil
+}
+
+// RenameAPIToken sets or, if name is nil, clears a token's name.
+func (r *Repository) RenameAPIToken(ctx context.Context, tokenID, userID string, name *string) error {
+	return r.queries.RenameAPIToken(ctx, queries.RenameAPITokenParams{
+		Name:   apiTokenName(name),
+		ID:     tokenID,
+		UserID: userID,
+	})
+}
+
+// RotateAPIToken atomically creates the replacement token and sets the old token
+// to expire at oldExpiresAt, recording which token replaced it. Returns false, creating
+// nothing, if the old token has already been rotated or revoked.
+func (r *Repository) RotateAPIToken(
+	ctx context.Context, oldTokenID string, oldExpiresAt time.Time, params datasources.CreateAPITokenParams,
+) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	// Claiming the old token first locks its row, so of two concurrent rotations
+	// only one finds it unreplaced.
+	rows, err := qtx.SetAPITokenReplacement(ctx, queries.SetAPITokenReplacementParams{
+		ReplacedByID: sql.NullString{String: params.ID, Valid: true},
+		ExpiresAt:    sql.NullTime{Time: oldExpiresAt, Valid: true},
+		ID:           oldTokenID,
+		UserID:       params.UserID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("setting token replacement: %w", err)
+	}
+	if rows == 0 {
+		return false, nil
+	}
+
+	if err := createAPIToken(ctx, qtx, params); err != nil {
+		return false, fmt.Errorf("creating replacement token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// RevokeExpiredAPITokens marks every expired token as revoked at its expiry time,
+// returning how many were marked.
+func (r *Repository) RevokeExpiredAPITokens(ctx context.Context) (int64, error) {
+	return r.queries.RevokeExpiredAPITokens(ctx)
+}
+
+func apiTokenName(name *string) sql.NullString {
+	if name == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *name, Valid: true}
+}
+
+func convertAPIToken(row queries.ApiToken) domain.APIToken {
+	token := domain.APIToken{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		TokenHash: row.TokenHash,
+		Prefix:    row.TokenPrefix,
+		CreatedAt: row.CreatedAt,
+		Scopes:    splitAPITokenScopes(row.Scopes),
+	}
+
+	if row.Name.Valid {
+		token.Name = &row.Name.String
+	}
+	if row.LastUsedAt.Valid {
+		token.LastUsedAt = &row.LastUsedAt.Time
+	}
+	if row.ExpiresAt.Valid {
+		token.ExpiresAt = &row.ExpiresAt.Time
+	}
+	if row.RevokedAt.Valid {
+		token.RevokedAt = &row.RevokedAt.Time
+	}
+	if row.ReplacedByID.Valid {
+		token.ReplacedByID = &row.ReplacedByID.String
+	}
+
+	return token
+}
+
+func joinAPITokenScopes(scopes []domain.APITokenScope) string {
+	names := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		names = append(names, string(scope))
+	}
+	return strings.Join(names, ",")
+}
+
+// splitAPITokenScopes parses the stored scopes column. Unknown scopes, such as ones
+// removed since the token was created, are dropped rather than failing authentication.
+func splitAPITokenScopes(column string) []domain.APITokenScope {
+	scopes := []domain.APITokenScope{}
+	for _, name := range strings.Split(column, ",") {
+		if scope := domain.APITokenScope(name); scope.IsValid() {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// ============================================
+// Digest Preferences Store Implementation
+// ============================================
+
+// GetDigestPreferences retrieves a user's digest preferences.
+func (r *Repository) GetDigestPreferences(
+	ctx context.Context, userID string,
+) (domain.DigestPreferences, bool, error) {
+	row, err := r.queries.GetDigestPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.DigestPreferences{}, false, nil
+		}
+		return domain.DigestPreferences{}, false, fmt.Errorf("fetching digest preferences: %w", err)
+	}
+
+	return convertDigestPreferences(ctx, queries.ListEnabledDigestPreferencesRow(row)), true, nil
+}
+
+// UpsertDigestPreferences stores a user's digest preferences.
+func (r *Repository) UpsertDigestPreferences(ctx context.Context, prefs domain.DigestPreferences) error {
+	var categories sql.NullString
+	if len(prefs.Categories) > 0 {
+		encoded, err := json.Marshal(prefs.Categories)
+		if err != nil {
+			return fmt.Errorf("encoding digest categories: %w", err)
+		}
+		categories = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	return r.queries.UpsertDigestPreferences(ctx, queries.UpsertDigestPreferencesParams{
+		UserID:                 prefs.UserID,
+		Email:                  prefs.Email,
+		PendingEmail:           nullString(prefs.PendingEmail),
+		EmailConfirmationToken: nullString(prefs.EmailConfirmationToken),
+		Frequency:              string(prefs.Frequency),
+		Categories:             categories,
+		Enabled:                prefs.Enabled,
+		UnsubscribeToken:       prefs.UnsubscribeToken,
+	})
+}
+
+// ListEnabledDigestPreferences lists preferences for all users with digests enabled.
+func (r *Repository) ListEnabledDigestPreferences(ctx context.Context) ([]domain.DigestPreferences, error) {
+	rows, err := r.queries.ListEnabledDigestPreferences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing enabled digest preferences: %w", err)
+	}
+
+	result := make([]domain.DigestPreferences, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, convertDigestPreferences(ctx, row))
+	}
+
+	return result, nil
+}
+
+// UnsubscribeDigest disables digests for the user owning an unsubscribe token.
+func (r *Repository) UnsubscribeDigest(ctx context.Context, unsubscribeToken string) (bool, error) {
+	userID, err := r.queries.GetDigestUserIDByUnsubscribeToken(ctx, unsubscribeToken)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("looking up unsubscribe token: %w", err)
+	}
+
+	if err := r.queries.DisableDigest(ctx, userID); err != nil {
+		return false, fmt.Errorf("disabling digest: %w", err)
+	}
+
+	return true, nil
+}
+
+// ConfirmDigestEmail makes the pending digest address matching a confirmation token the
+// address digests are sent to.
+func (r *Repository) ConfirmDigestEmail(ctx context.Context, confirmationToken string) (bool, error) {
+	confirmed, err := r.queries.ConfirmDigestEmail(ctx, sql.NullString{String: confirmationToken, Valid: true})
+	if err != nil {
+		return false, fmt.Errorf("confirming digest email: %w", err)
+	}
+
+	return confirmed > 0, nil
+}
+
+// MarkDigestSent records when a digest was last sent to a user.
+func (r *Repository) MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error {
+	return r.queries.MarkDigestSent(ctx, queries.MarkDigestSentParams{
+		LastSentAt: sql.NullTime{Time: sentAt, Valid: true},
+		UserID:     userID,
+	})
+}
+
+// nullString stores an empty string as NULL, for optional columns under a unique index.
+func nullString(value string) sql.NullString {
+	if value == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: value, Valid: true}
+}
+
+func convertDigestPreferences(
+	ctx context.Context, row queries.ListEnabledDigestPreferencesRow,
+) domain.DigestPreferences {
+	prefs := domain.DigestPreferences{
+		UserID:                 row.UserID,
+		Email:                  row.Email,
+		PendingEmail:           row.PendingEmail.String,
+		EmailConfirmationToken: row.EmailConfirmationToken.String,
+		Frequency:              domain.DigestFrequency(row.Frequency),
+		Enabled:                row.Enabled,
+		UnsubscribeToken:       row.UnsubscribeToken,
+	}
+
+	if row.Categories.Valid && row.Categories.String != "" {
+		if err := json.Unmarshal([]byte(row.Categories.String), &prefs.Categories); err != nil {
+			logger := domain.LoggerFromContext(ctx)
+			logger.WarnContext(ctx, "failed to parse digest categories JSON",
+				"user_id", row.UserID, "error", err)
+		}
+	}
+	if row.LastSentAt.Valid {
+		prefs.LastSentAt = &row.LastSentAt.Time
+	}
+
+	return prefs
+}
+
+// ============================================
+// Saved Search Store Implementation
+// ============================================
+
+// CreateSavedSearch stores a new saved search.
+func (r *Repository) CreateSavedSearch(ctx context.Context, search domain.SavedSearch) error {
+	filters, queryText, queryVector, err := savedSearchQueryColumns(search)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.CreateSavedSearch(ctx, queries.CreateSavedSearchParams{
+		ID:          search.ID,
+		UserID:      search.UserID,
+		Name:        search.Name,
+		Kind:        string(search.Kind),
+		Filters:     filters,
+		QueryText:   queryText,
+		QueryVector: queryVector,
+		FeedToken:   search.FeedToken,
+	})
+}
+
+// GetSavedSearch retrieves one of a user's saved searches.
+func (r *Repository) GetSavedSearch(
+	ctx context.Context, userID, searchID string,
+) (domain.SavedSearch, bool, error) {
+	row, err := r.queries.GetSavedSearch(ctx, queries.GetSavedSearchParams{
+		ID:     searchID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.SavedSearch{}, false, nil
+		}
+		return domain.SavedSearch{}, false, fmt.Errorf("fetching saved search: %w", err)
+	}
+
+	return convertSavedSearch(ctx, row), true, nil
+}
+
+// GetSavedSearchByFeedToken retrieves a saved search by its RSS feed token.
+func (r *Repository) GetSavedSearchByFeedToken(
+	ctx context.Context, feedToken string,
+) (domain.SavedSearch, bool, error) {
+	row, err := r.queries.GetSavedSearchByFeedToken(ctx, feedToken)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.SavedSearch{}, false, nil
+		}
+		return domain.SavedSearch{}, false, fmt.Errorf("fetching saved search by feed token: %w", err)
+	}
+
+	return convertSavedSearch(ctx, row), true, nil
+}
+
+// ListUserSavedSearches lists all saved searches for a user.
+func (r *Repository) ListUserSavedSearches(ctx context.Context, userID string) ([]domain.SavedSearch, error) {
+	rows, err := r.queries.ListUserSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing user saved searches: %w", err)
+	}
+
+	searches := make([]domain.SavedSearch, 0, len(rows))
+	for _, row := range rows {
+		searches = append(searches, convertSavedSearch(ctx, row))
+	}
+
+	return searches, nil
+}
+
+// CountUserSavedSearches counts saved searches for a user.
+func (r *Repository) CountUserSavedSearches(ctx context.Context, userID string) (int64, error) {
+	return r.queries.CountUserSavedSearches(ctx, userID)
+}
+
+// UpdateSavedSearch replaces the name and query of an existing saved search.
+func (r *Repository) UpdateSavedSearch(ctx context.Context, search domain.SavedSearch) error {
+	filters, queryText, queryVector, err := savedSearchQueryColumns(search)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.UpdateSavedSearch(ctx, queries.UpdateSavedSearchParams{
+		Name:        search.Name,
+		Kind:        string(search.Kind),
+		Filters:     filters,
+		QueryText:   queryText,
+		QueryVector: queryVector,
+		ID:          search.ID,
+		UserID:      search.UserID,
+	})
+}
+
+// DeleteSavedSearch deletes one of a user's saved searches.
+func (r *Repository) DeleteSavedSearch(ctx context.Context, userID, searchID string) error {
+	return r.queries.DeleteSavedSearch(ctx, queries.DeleteSavedSearchParams{
+		ID:     searchID,
+		UserID: userID,
+	})
+}
+
+// MarkSavedSearchViewed records when a user last viewed new results for a saved search.
+func (r *Repository) MarkSavedSearchViewed(
+	ctx context.Context, userID, searchID string, viewedAt time.Time,
+) error {
+	return r.queries.MarkSavedSearchViewed(ctx, queries.MarkSavedSearchViewedParams{
+		LastViewedAt: sql.NullTime{Time: viewedAt, Valid: true},
+		ID:           searchID,
+		UserID:       userID,
+	})
+}
+
+func savedSearchQueryColumns(
+	search domain.SavedSearch,
+) (filters, queryText, queryVector sql.NullString, err error) {
+	if search.Filters != nil {
+		encoded, marshalErr := json.Marshal(search.Filters)
+		if marshalErr != nil {
+			return filters, queryText, queryVector, fmt.Errorf("encoding saved search filters: %w", marshalErr)
+		}
+		filters = sql.NullString{String: string(encoded), Valid: true}
+	}
+	if search.QueryText != "" {
+		queryText = sql.NullString{String: search.QueryText, Valid: true}
+	}
+	if len(search.QueryVector) > 0 {
+		queryVector = sql.NullString{String: string(float32SliceToBytes(search.QueryVector)), Valid: true}
+	}
+
+	return filters, queryText, queryVector, nil
+}
+
+func convertSavedSearch(ctx context.Context, row queries.SavedSearch) domain.SavedSearch {
+	search := domain.SavedSearch{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		Name:      row.Name,
+		Kind:      domain.SavedSearchKind(row.Kind),
+		QueryText: row.QueryText.String,
+		FeedToken: row.FeedToken,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+
+	logger := domain.LoggerFromContext(ctx)
+	if row.Filters.Valid && row.Filters.String != "" {
+		var filters domain.ArticleFilters
+		if err := json.Unmarshal([]byte(row.Filters.String), &filters); err != nil {
+			logger.WarnContext(ctx, "failed to parse saved search filters JSON",
+				"saved_search_id", row.ID, "error", err)
+		} else {
+			search.Filters = &filters
+		}
+	}
+	if row.QueryVector.Valid {
+		vector, err := bytesToFloat32Slice([]byte(row.QueryVector.String))
+		if err != nil {
+			logger.WarnContext(ctx, "failed to parse saved search query vector",
+				"saved_search_id", row.ID, "error", err)
+		} else {
+			search.QueryVector = vector
+		}
+	}
+	if row.LastViewedAt.Valid {
+		search.LastViewedAt = &row.LastViewedAt.Time
+	}
+
+	return search
+}
+
+// ============================================
+// Collection Store Implementation
+// ============================================
+
+// CreateCollection stores a new, empty collection.
+func (r *Repository) CreateCollection(ctx context.Context, collection domain.Collection) error {
+	return r.queries.CreateCollection(ctx, queries.CreateCollectionParams{
+		ID:         collection.ID,
+		UserID:     collection.UserID,
+		Name:       collection.Name,
+		IsPublic:   collection.IsPublic,
+		ShareToken: collection.ShareToken,
+	})
+}
+
+// GetCollection retrieves one of a user's collections.
+func (r *Repository) GetCollection(
+	ctx context.Context, userID, collectionID string,
+) (domain.Collection, bool, error) {
+	row, err := r.queries.GetCollection(ctx, queries.GetCollectionParams{
+		ID:     collectionID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Collection{}, false, nil
+		}
+		return domain.Collection{}, false, fmt.Errorf("fetching collection: %w", err)
+	}
+
+	return convertCollection(queries.ListUserCollectionsRow(row)), true, nil
+}
+
+// GetCollectionByShareToken retrieves a collection by its share token.
+func (r *Repository) GetCollectionByShareToken(
+	ctx context.Context, shareToken string,
+) (domain.Collection, bool, error) {
+	row, err := r.queries.GetCollectionByShareToken(ctx, shareToken)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Collection{}, false, nil
+		}
+		return domain.Collection{}, false, fmt.Errorf("fetching collection by share token: %w", err)
+	}
+
+	return convertCollection(queries.ListUserCollectionsRow(row)), true, nil
+}
+
+// ListUserCollections lists all collections for a user.
+func (r *Repository) ListUserCollections(ctx context.Context, userID string) ([]domain.Collection, error) {
+	rows, err := r.queries.ListUserCollections(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing user collections: %w", err)
+	}
+
+	collections := make([]domain.Collection, 0, len(rows))
+	for _, row := range rows {
+		collections = append(collections, convertCollection(row))
+	}
+
+	return collections, nil
+}
+
+// CountUserCollections counts collections for a user.
+func (r *Repository) CountUserCollections(ctx context.Context, userID string) (int64, error) {
+	return r.queries.CountUserCollections(ctx, userID)
+}
+
+// UpdateCollection updates the name and visibility of a collection.
+func (r *Repository) UpdateCollection(ctx context.Context, collection domain.Collection) error {
+	return r.queries.UpdateCollection(ctx, queries.UpdateCollectionParams{
+		Name:     collection.Name,
+		IsPublic: collection.IsPublic,
+		ID:       collection.ID,
+		UserID:   collection.UserID,
+	})
+}
+
+// DeleteCollection deletes one of a user's collections; its article list is removed by cascade.
+func (r *Repository) DeleteCollection(ctx context.Context, userID, collectionID string) error {
+	return r.queries.DeleteCollection(ctx, queries.DeleteCollectionParams{
+		ID:     collectionID,
+		UserID: userID,
+	})
+}
+
+// AddCollectionArticle appends an article to the end of a collection.
+func (r *Repository) AddCollectionArticle(ctx context.Context, collectionID, articleHashID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	position, err := qtx.GetCollectionNextPosition(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("getting next collection position: %w", err)
+	}
+
+	if err := qtx.AddCollectionArticle(ctx, queries.AddCollectionArticleParams{
+		CollectionID:  collectionID,
+		ArticleHashID: articleHashID,
+		Position:      int32(position), //nolint:gosec // positions are small
+	}); err != nil {
+		return fmt.Errorf("adding collection article: %w", err)
+	}
+
+	if err := qtx.TouchCollection(ctx, collectionID); err != nil {
+		return fmt.Errorf("touching collection: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveCollectionArticle removes an article from a collection.
+func (r *Repository) RemoveCollectionArticle(ctx context.Context, collectionID, articleHashID string) error {
+	if err := r.queries.RemoveCollectionArticle(ctx, queries.RemoveCollectionArticleParams{
+		CollectionID:  collectionID,
+		ArticleHashID: articleHashID,
+	}); err != nil {
+		return fmt.Errorf("removing collection article: %w", err)
+	}
+
+	return r.queries.TouchCollection(ctx, collectionID)
+}
+
+// ListCollectionArticleIDs lists the article IDs in a collection in order.
+func (r *Repository) ListCollectionArticleIDs(ctx context.Context, collectionID string) ([]string, error) {
+	return r.queries.ListCollectionArticleIDs(ctx, collectionID)
+}
+
+// ReorderCollectionArticles sets the order of articles in a collection.
+func (r *Repository) ReorderCollectionArticles(
+	ctx context.Context, collectionID string, articleHashIDs []string,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	for i, hashID := range articleHashIDs {
+		if err := qtx.SetCollectionArticlePosition(ctx, queries.SetCollectionArticlePositionParams{
+			Position:      int32(i), //nolint:gosec // positions are small
+			CollectionID:  collectionID,
+			ArticleHashID: hashID,
+		}); err != nil {
+			return fmt.Errorf("setting collection article position: %w", err)
+		}
+	}
+
+	if err := qtx.TouchCollection(ctx, collectionID); err != nil {
+		return fmt.Errorf("touching collection: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func convertCollection(row queries.ListUserCollectionsRow) domain.Collection {
+	return domain.Collection{
+		ID:           row.ID,
+		UserID:       row.UserID,
+		Name:         row.Name,
+		IsPublic:     row.IsPublic,
+		ShareToken:   row.ShareToken,
+		ArticleCount: int(row.ArticleCount),
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
+
+// ============================================
+// Article Note Store Implementation
+// ============================================
+
+// CreateArticleNote stores a new note.
+func (r *Repository) CreateArticleNote(ctx context.Context, note domain.ArticleNote) error {
+	return r.queries.CreateArticleNote(ctx, queries.CreateArticleNoteParams{
+		ID:            note.ID,
+		UserID:        note.UserID,
+		ArticleHashID: note.ArticleHashID,
+		Body:          note.Body,
+		Quote:         noteQuoteColumn(note.Quote),
+	})
+}
+
+// GetArticleNote retrieves one of a user's notes.
+func (r *Repository) GetArticleNote(
+	ctx context.Context, userID, noteID string,
+) (domain.ArticleNote, bool, error) {
+	row, err := r.queries.GetArticleNote(ctx, queries.GetArticleNoteParams{
+		ID:     noteID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ArticleNote{}, false, nil
+		}
+		return domain.ArticleNote{}, false, fmt.Errorf("fetching article note: %w", err)
+	}
+
+	return convertArticleNote(row), true, nil
+}
+
+// ListArticleNotes lists a user's notes on a single article, oldest first.
+func (r *Repository) ListArticleNotes(
+	ctx context.Context, userID, articleHashID string,
+) ([]domain.ArticleNote, error) {
+	rows, err := r.queries.ListArticleNotes(ctx, queries.ListArticleNotesParams{
+		UserID:        userID,
+		ArticleHashID: articleHashID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing article notes: %w", err)
+	}
+
+	return convertArticleNotes(rows), nil
+}
+
+// ListUserArticleNotes lists a user's notes across all articles, most recently updated first.
+func (r *Repository) ListUserArticleNotes(
+	ctx context.Context, userID string, page, pageSize int,
+) ([]domain.ArticleNote, error) {
+	limit, offset := paginationToLimitOffset(page, pageSize)
+	rows, err := r.queries.ListUserArticleNotes(ctx, queries.ListUserArticleNotesParams{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing user article notes: %w", err)
+	}
+
+	return convertArticleNotes(rows), nil
+}
+
+// SearchUserArticleNotes runs a full-text search over a user's notes and highlighted quotes.
+func (r *Repository) SearchUserArticleNotes(
+	ctx context.Context, userID, query string, page, pageSize int,
+) ([]domain.ArticleNote, error) {
+	limit, offset := paginationToLimitOffset(page, pageSize)
+	rows, err := r.queries.SearchUserArticleNotes(ctx, queries.SearchUserArticleNotesParams{
+		UserID: userID,
+		Query:  query,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("searching user article notes: %w", err)
+	}
+
+	return convertArticleNotes(rows), nil
+}
+
+// UpdateArticleNote replaces the body and quote of one of a user's notes.
+func (r *Repository) UpdateArticleNote(ctx context.Context, note domain.ArticleNote) error {
+	return r.queries.UpdateArticleNote(ctx, queries.UpdateArticleNoteParams{
+		Body:   note.Body,
+		Quote:  noteQuoteColumn(note.Quote),
+		ID:     note.ID,
+		UserID: note.UserID,
+	})
+}
+
+// DeleteArticleNote deletes one of a user's notes.
+func (r *Repository) DeleteArticleNote(ctx context.Context, userID, noteID string) error {
+	return r.queries.DeleteArticleNote(ctx, queries.DeleteArticleNoteParams{
+		ID:     noteID,
+		UserID: userID,
+	})
+}
+
+func noteQuoteColumn(quote string) sql.NullString {
+	if quote == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: quote, Valid: true}
+}
+
+func convertArticleNotes(rows []queries.ArticleNote) []domain.ArticleNote {
+	notes := make([]domain.ArticleNote, 0, len(rows))
+	for _, row := range rows {
+		notes = append(notes, convertArticleNote(row))
+	}
+	return notes
+}
+
+func convertArticleNote(row queries.ArticleNote) domain.ArticleNote {
+	return domain.ArticleNote{
+		ID:            row.ID,
+		UserID:        row.UserID,
+		ArticleHashID: row.ArticleHashID,
+		Body:          row.Body,
+		Quote:         row.Quote.String,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+// ============================================
+// User Tag Store Implementation
+// ============================================
+
+// TagArticle adds a tag to one of a user's articles. Adding a tag twice is a no-op.
+func (r *Repository) TagArticle(ctx context.Context, userID, articleHashID, tag string) error {
+	return r.queries.TagArticle(ctx, queries.TagArticleParams{
+		UserID:        userID,
+		ArticleHashID: articleHashID,
+		Tag:           tag,
+	})
+}
+
+// UntagArticle removes a tag from one of a user's articles.
+func (r *Repository) UntagArticle(ctx context.Context, userID, articleHashID, tag string) error {
+	return r.queries.UntagArticle(ctx, queries.UntagArticleParams{
+		UserID:        userID,
+		ArticleHashID: articleHashID,
+		Tag:           tag,
+	})
+}
+
+// ListArticleTags lists the tags a user has given an article, alphabetically.
+func (r *Repository) ListArticleTags(ctx context.Context, userID, articleHashID string) ([]string, error) {
+	tags, err := r.queries.ListArticleTags(ctx, queries.ListArticleTagsParams{
+		UserID:        userID,
+		ArticleHashID: articleHashID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing article tags: %w", err)
+	}
+	return tags, nil
+}
+
+// ListUserTags lists all of a user's tags with their article counts, most used first.
+func (r *Repository) ListUserTags(ctx context.Context, userID string) ([]domain.TagCount, error) {
+	rows, err := r.queries.ListUserTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing user tags: %w", err)
+	}
+
+	counts := make([]domain.TagCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, domain.TagCount{Tag: row.Tag, Count: row.ArticleCount})
+	}
+	return counts, nil
+}
+
+// ListTaggedArticleIDs lists the articles a user has given a tag, most recently tagged first.
+func (r *Repository) ListTaggedArticleIDs(
+	ctx context.Context, userID, tag string, page, pageSize int,
+) ([]string, error) {
+	limit, offset := paginationToLimitOffset(page, pageSize)
+	ids, err := r.queries.ListTaggedArticleIDs(ctx, queries.ListTaggedArticleIDsParams{
+		UserID: userID,
+		Tag:    tag,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing tagged articles: %w", err)
+	}
+	return ids, nil
+}
+
+// ListTagCooccurringArticles finds articles other users have given the same tags as the user,
+// excluding articles the user has already tagged. Only users sharing their tags are counted.
+func (r *Repository) ListTagCooccurringArticles(
+	ctx context.Context, userID string, limit int,
+) ([]domain.TagCooccurrence, error) {
+	rows, err := r.queries.ListTagCooccurringArticles(ctx, queries.ListTagCooccurringArticlesParams{
+		UserID: userID,
+		Limit:  int32(limit), //nolint:gosec // limits are small
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing tag co-occurring articles: %w", err)
+	}
+
+	results := make([]domain.TagCooccurrence, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, domain.TagCooccurrence{HashID: row.ArticleHashID, Count: row.SharedCount})
+	}
+	return results, nil
+}
+
+// GetTagPreferences retrieves a user's tag preferences.
+func (r *Repository) GetTagPreferences(ctx context.Context, userID string) (domain.TagPreferences, bool, error) {
+	row, err := r.queries.GetTagPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TagPreferences{UserID: userID}, false, nil
+		}
+		return domain.TagPreferences{}, false, fmt.Errorf("fetching tag preferences: %w", err)
+	}
+
+	return convertTagPreferences(row), true, nil
+}
+
+func convertTagPreferences(row queries.GetTagPreferencesRow) domain.TagPreferences {
+	return domain.TagPreferences{
+		UserID:                  row.UserID,
+		ShareForRecommendations: row.ShareForRecommendations,
+	}
+}
+
+// UpsertTagPreferences stores a user's tag preferences.
+func (r *Repository) UpsertTagPreferences(ctx context.Context, prefs domain.TagPreferences) error {
+	return r.queries.UpsertTagPreferences(ctx, queries.UpsertTagPreferencesParams{
+		UserID:                  prefs.UserID,
+		ShareForRecommendations: prefs.ShareForRecommendations,
+	})
+}
+
+// ============================================
+// Follow Store Implementation
+// ============================================
+
+// FollowItem adds an author, source or category to those a user follows.
+// Following something twice is a no-op.
+func (r *Repository) FollowItem(
+	ctx context.Context, userID string, followType domain.FollowType, target string,
+) error {
+	return r.queries.FollowItem(ctx, queries.FollowItemParams{
+		UserID:     userID,
+		FollowType: string(followType),
+		Target:     target,
+	})
+}
+
+// UnfollowItem removes an author, source or category from those a user follows.
+func (r *Repository) UnfollowItem(
+	ctx context.Context, userID string, followType domain.FollowType, target string,
+) error {
+	return r.queries.UnfollowItem(ctx, queries.UnfollowItemParams{
+		UserID:     userID,
+		FollowType: string(followType),
+		Target:     target,
+	})
+}
+
+// ListFollows lists everything a user follows, grouped by type.
+func (r *Repository) ListFollows(ctx context.Context, userID string) ([]domain.Follow, error) {
+	rows, err := r.queries.ListUserFollows(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing follows: %w", err)
+	}
+
+	return convertFollows(rows), nil
+}
+
+func convertFollows(rows []queries.ListUserFollowsRow) []domain.Follow {
+	follows := make([]domain.Follow, 0, len(rows))
+	for _, row := range rows {
+		follows = append(follows, domain.Follow{
+			Type:      domain.FollowType(row.FollowType),
+			Target:    row.Target,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return follows
+}
+
+// GetFollowPreferences retrieves a user's follow preferences.
+func (r *Repository) GetFollowPreferences(
+	ctx context.Context, userID string,
+) (domain.FollowPreferences, bool, error) {
+	row, err := r.queries.GetFollowPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FollowPreferences{}, false, nil
+		}
+		return domain.FollowPreferences{}, false, fmt.Errorf("fetching follow preferences: %w", err)
+	}
+
+	return convertFollowPreferences(row), true, nil
+}
+
+// GetFollowPreferencesByFeedToken retrieves the follow preferences owning an RSS feed token.
+func (r *Repository) GetFollowPreferencesByFeedToken(
+	ctx context.Context, feedToken string,
+) (domain.FollowPreferences, bool, error) {
+	row, err := r.queries.GetFollowPreferencesByFeedToken(ctx, feedToken)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FollowPreferences{}, false, nil
+		}
+		return domain.FollowPreferences{}, false, fmt.Errorf("fetching follow preferences by feed token: %w", err)
+	}
+
+	return convertFollowPreferences(queries.GetFollowPreferencesRow(row)), true, nil
+}
+
+// CreateFollowPreferences creates a user's default follow preferences, leaving existing ones unchanged.
+func (r *Repository) CreateFollowPreferences(ctx context.Context, userID, feedToken string) error {
+	return r.queries.CreateFollowPreferences(ctx, queries.CreateFollowPreferencesParams{
+		UserID:    userID,
+		FeedToken: feedToken,
+	})
+}
+
+func convertFollowPreferences(row queries.GetFollowPreferencesRow) domain.FollowPreferences {
+	return domain.FollowPreferences{
+		UserID:                   row.UserID,
+		IncludeInRecommendations: row.IncludeInRecommendations,
+		FeedToken:                row.FeedToken,
+	}
+}
+
+// UpsertFollowPreferences stores a user's follow preferences, keeping any existing feed token.
+func (r *Repository) UpsertFollowPreferences(ctx context.Context, prefs domain.FollowPreferences) error {
+	return r.queries.UpsertFollowPreferences(ctx, queries.UpsertFollowPreferencesParams{
+		UserID:                   prefs.UserID,
+		IncludeInRecommendations: prefs.IncludeInRecommendations,
+		FeedToken:                prefs.FeedToken,
+	})
+}
+
+// ============================================
+// Audit Event Store Implementation
+// ============================================
+
+// WriteAuditEvent appends an event to the audit log.
+func (r *Repository) WriteAuditEvent(ctx context.Context, event domain.AuditEvent) error {
+	var userID sql.NullString
+	if event.UserID != "" {
+		userID = sql.NullString{String: event.UserID, Valid: true}
+	}
+
+	var tokenID sql.NullString
+	if event.TokenID != nil {
+		tokenID = sql.NullString{String: *event.TokenID, Valid: true}
+	}
+
+	if err := r.queries.InsertAuditEvent(ctx, queries.InsertAuditEventParams{
+		UserID:    userID,
+		EventType: string(event.Type),
+		Outcome:   string(event.Outcome),
+		TokenID:   tokenID,
+		IpAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+		Detail:    event.Detail,
+	}); err != nil {
+		return fmt.Errorf("inserting audit event: %w", err)
+	}
+	return nil
+}
+
+// ListUserAuditEvents lists the audit events for a user's account, most recent first.
+func (r *Repository) ListUserAuditEvents(
+	ctx context.Context, userID string, page, pageSize int,
+) ([]domain.AuditEvent, error) {
+	limit, offset := paginationToLimitOffset(page, pageSize)
+	rows, err := r.queries.ListUserAuditEvents(ctx, queries.ListUserAuditEventsParams{
+		UserID: sql.NullString{String: userID, Valid: true},
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing user audit events: %w", err)
+	}
+
+	return convertAuditEvents(rows), nil
+}
+
+func convertAuditEvents(rows []queries.AuditEvent) []domain.AuditEvent {
+	events := make([]domain.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		event := domain.AuditEvent{
+			ID:        row.ID,
+			UserID:    row.UserID.String,
+			Type:      domain.AuditEventType(row.EventType),
+			Outcome:   domain.AuditOutcome(row.Outcome),
+			IPAddress: row.IpAddress,
+			UserAgent: row.UserAgent,
+			Detail:    row.Detail,
+			CreatedAt: row.CreatedAt,
+		}
+		if row.TokenID.Valid {
+			event.TokenID = &row.TokenID.String
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// ============================================
+// Rate Limit Store Implementation
+// ============================================
+
+// TakeRateLimitToken takes a token from the key's bucket if one is available.
+// The bucket row is locked for the duration so concurrent instances can't both take its last token.
+func (r *Repository) TakeRateLimitToken(
+	ctx context.Context, key string, limit domain.RateLimit, now time.Time,
+) (domain.RateLimitDecision, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.RateLimitDecision{}, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	// New buckets start full
+	if err := qtx.EnsureRateLimitBucket(ctx, queries.EnsureRateLimitBucketParams{
+		BucketKey: key,
+		Tokens:    float64(limit.Requests),
+		UpdatedAt: now,
+	}); err != nil {
+		return domain.RateLimitDecision{}, fmt.Errorf("creating rate limit bucket: %w", err)
+	}
+
+	row, err := qtx.GetRateLimitBucketForUpdate(ctx, key)
+	if err != nil {
+		return domain.RateLimitDecision{}, fmt.Errorf("fetching rate limit bucket: %w", err)
+	}
+
+	bucket, decision := domain.RateLimitBucket{Tokens: row.Tokens, UpdatedAt: row.UpdatedAt}.Take(limit, now)
+
+	if err := qtx.UpdateRateLimitBucket(ctx, queries.UpdateRateLimitBucketParams{
+		Tokens:    bucket.Tokens,
+		UpdatedAt: bucket.UpdatedAt,
+		BucketKey: key,
+	}); err != nil {
+		return domain.RateLimitDecision{}, fmt.Errorf("updating rate limit bucket: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.RateLimitDecision{}, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return decision, nil
+}
+
+// DeleteRateLimitBucketsUpdatedBefore deletes buckets last updated before the given time,
+// returning how many were deleted.
+func (r *Repository) DeleteRateLimitBucketsUpdatedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteRateLimitBucketsUpdatedBefore(ctx, before)
+}
+
+// ============================================
+// User Data Store Implementation
+// ============================================
+
+// ExportUserData gathers everything stored about a user. It reads in a single read-only
+// transaction so the export is consistent if the user changes their data meanwhile.
+func (r *Repository) ExportUserData(ctx context.Context, userID string) (domain.UserDataExport, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return domain.UserDataExport{}, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+	now := time.Now()
+	export := domain.UserDataExport{UserID: userID, ExportedAt: now}
+
+	if export.Interactions, err = exportInteractions(ctx, qtx, userID); err != nil {
+		return domain.UserDataExport{}, err
+	}
+	if export.InterestClusters, err = exportInterestClusters(ctx, qtx, userID); err != nil {
+		return domain.UserDataExport{}, err
+	}
+	if export.Recommendations, err = exportRecommendations(ctx, qtx, userID); err != nil {
+		return domain.UserDataExport{}, err
+	}
+	if export.Impressions, err = exportImpressions(ctx, qtx, userID); err != nil {
+		return domain.UserDataExport{}, err
+	}
+	if export.RecommendationState, err = exportRecommendationState(ctx, qtx, userID); err != nil {
+		return domain.UserDataExport{}, err
+	}
+	if export.APITokens, err = exportAPITokens(ctx, qtx, userID, now); err != nil {
+		return domain.UserDataExport{}, err
+	}
+
+	prefs, err := qtx.GetDigestPreferences(ctx, userID)
+	if err == nil {
+		converted := convertDigestPreferences(ctx, queries.ListEnabledDigestPreferencesRow(prefs))
+		export.DigestPreferences = &converted
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return domain.UserDataExport{}, fmt.Errorf("fetching digest preferences: %w", err)
+	}
+
+	onboarding, err := qtx.GetUserOnboardingInterests(ctx, userID)
+	if err == nil {
+		converted := convertOnboardingInterests(ctx, userID, onboarding)
+		export.OnboardingInterests = &converted
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return domain.UserDataExport{}, fmt.Errorf("fetching onboarding interests: %w", err)
+	}
+
+	searchRows, err := qtx.ListUserSavedSearches(ctx, userID)
+	if err != nil {
+		return domain.UserDataExport{}, fmt.Errorf("listing saved searches: %w", err)
+	}
+	export.SavedSearches = make([]domain.SavedSearch, 0, len(searchRows))
+	for _, row := range searchRows {
+		export.SavedSearches = append(export.SavedSearches, convertSavedSearch(ctx, row))
+	}
+
+	if export.Collections, err = exportCollections(ctx, qtx, userID); err != nil {
+		return domain.UserDataExport{}, err
+	}
+
+	noteRows, err := qtx.ExportUserArticleNotes(ctx, userID)
+	if err != nil {
+		return domain.UserDataExport{}, fmt.Errorf("listing notes: %w", err)
+	}
+	export.Notes = convertArticleNotes(noteRows)
+
+	tagRows, err := qtx.ExportUserArticleTags(ctx, userID)
+	if err != nil {
+		return domain.UserDataExport{}, fmt.Errorf("listing tags: %w", err)
+	}
+	export.Tags = make([]domain.ExportedTag, 0, len(tagRows))
+	for _, row := range tagRows {
+		export.Tags = append(export.Tags, domain.ExportedTag{
+			ArticleHashID: row.ArticleHashID,
+			Tag:           row.Tag,
+			CreatedAt:     row.CreatedAt,
+		})
+	}
+
+	tagPrefs, err := qtx.GetTagPreferences(ctx, userID)
+	if err == nil {
+		converted := convertTagPreferences(tagPrefs)
+		export.TagPreferences = &converted
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return domain.UserDataExport{}, fmt.Errorf("fetching tag preferences: %w", err)
+	}
+
+	if export.Follows, export.FollowPreferences, err = exportFollows(ctx, qtx, userID); err != nil {
+		return domain.UserDataExport{}, err
+	}
+
+	auditRows, err := qtx.ExportUserAuditEvents(ctx, sql.NullString{String: userID, Valid: true})
+	if err != nil {
+		return domain.UserDataExport{}, fmt.Errorf("listing audit events: %w", err)
+	}
+	export.AuditEvents = convertAuditEvents(auditRows)
+
+	return export, nil
+}
+
+// exportFollows lists everything a user follows, along with their follow preferences if set.
+func exportFollows(
+	ctx context.Context, q *queries.Queries, userID string,
+) ([]domain.Follow, *domain.FollowPreferences, error) {
+	rows, err := q.ListUserFollows(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing follows: %w", err)
+	}
+	follows := convertFollows(rows)
+
+	prefsRow, err := q.GetFollowPreferences(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return follows, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching follow preferences: %w", err)
+	}
+	prefs := convertFollowPreferences(prefsRow)
+	return follows, &prefs, nil
+}
+
+func exportInteractions(
+	ctx context.Context, q *queries.Queries, userID string,
+) ([]domain.ExportedInteraction, error) {
+	rows, err := q.ExportUserArticleInteractions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing interactions: %w", err)
+	}
+
+	interactions := make([]domain.ExportedInteraction, 0, len(rows))
+	for _, row := range rows {
+		interactions = append(interactions, domain.ExportedInteraction{
+			ArticleHashID:        row.ArticleHashID,
+			HaveRead:             row.HaveRead,
+			ThumbsUp:             row.ThumbsUp,
+			ThumbsDown:           row.ThumbsDown,
+			Rating:               int(row.Rating.Int32),
+			OpenedLink:           row.OpenedLink,
+			TimeOnArticleSeconds: int(row.TimeOnArticleSeconds),
+			SavedToCollection:    row.SavedToCollection,
+			Dismissed:            row.Dismissed,
+			DateRead:             nullTimePtr(row.DateRead),
+			DateRated:            nullTimePtr(row.DateRated),
+			DateEngaged:          nullTimePtr(row.DateEngaged),
+			DateDismissed:        nullTimePtr(row.DateDismissed),
+			SnoozedUntil:         nullTimePtr(row.SnoozedUntil),
+		})
+	}
+	return interactions, nil
+}
+
+func exportInterestClusters(
+	ctx context.Context, q *queries.Queries, userID string,
+) ([]domain.ExportedInterestCluster, error) {
+	rows, err := q.GetUserInterestClusters(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing interest clusters: %w", err)
+	}
+
+	clusters := make([]domain.ExportedInterestCluster, 0, len(rows))
+	for _, row := range rows {
+		clusters = append(clusters, domain.ExportedInterestCluster{
+			ClusterID:    int(row.ClusterID),
+			ArticleCount: int(row.ArticleCount),
+			Provisional:  row.Provisional,
+			UpdatedAt:    row.UpdatedAt,
+		})
+	}
+	return clusters, nil
+}
+
+func exportRecommendations(
+	ctx context.Context, q *queries.Queries, userID string,
+) ([]domain.ExportedRecommendation, error) {
+	rows, err := q.ExportUserPrecomputedRecommendations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing precomputed recommendations: %w", err)
+	}
+
+	recommendations := make([]domain.ExportedRecommendation, 0, len(rows))
+	for _, row := range rows {
+		recommendations = append(recommendations, domain.ExportedRecommendation{
+			ArticleHashID: row.ArticleHashID,
+			Score:         row.Score,
+			Source:        row.Source,
+			ExperimentArm: row.ExperimentArm,
+			Position:      int(row.Position),
+			GeneratedAt:   row.GeneratedAt,
+		})
+	}
+	return recommendations, nil
+}
+
+func exportImpressions(
+	ctx context.Context, q *queries.Queries, userID string,
+) ([]domain.ExportedImpression, error) {
+	rows, err := q.ExportUserRecommendationImpressions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing recommendation impressions: %w", err)
+	}
+
+	impressions := make([]domain.ExportedImpression, 0, len(rows))
+	for _, row := range rows {
+		impressions = append(impressions, domain.ExportedImpression{
+			ArticleHashID: row.ArticleHashID,
+			ExperimentArm: row.ExperimentArm,
+			Source:        row.Source,
+			Position:      int(row.Position),
+			ServedAt:      row.ServedAt,
+		})
+	}
+	return impressions, nil
+}
+
+func exportRecommendationState(
+	ctx context.Context, q *queries.Queries, userID string,
+) (*domain.ExportedRecommendationState, error) {
+	row, err := q.GetUserRecommendationState(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching recommendation state: %w", err)
+	}
+
+	return &domain.ExportedRecommendationState{
+		LastGeneratedAt:   nullTimePtr(row.LastGeneratedAt),
+		LastRatingAt:      nullTimePtr(row.LastRatingAt),
+		NeedsRegeneration: row.NeedsRegeneration,
+	}, nil
+}
+
+func exportAPITokens(
+	ctx context.Context, q *queries.Queries, userID string, now time.Time,
+) ([]domain.ExportedAPIToken, error) {
+	rows, err := q.ListUserAPITokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing API tokens: %w", err)
+	}
+
+	tokens := make([]domain.ExportedAPIToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, domain.NewExportedAPIToken(convertAPIToken(row), now))
+	}
+	return tokens, nil
+}
+
+func exportCollections(
+	ctx context.Context, q *queries.Queries, userID string,
+) ([]domain.ExportedCollection, error) {
+	rows, err := q.ListUserCollections(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing collections: %w", err)
+	}
+
+	articleRows, err := q.ExportUserCollectionArticles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing collection articles: %w", err)
+	}
+	articleIDs := make(map[string][]string, len(rows))
+	for _, row := range articleRows {
+		articleIDs[row.CollectionID] = append(articleIDs[row.CollectionID], row.ArticleHashID)
+	}
+
+	collections := make([]domain.ExportedCollection, 0, len(rows))
+	for _, row := range rows {
+		ids := articleIDs[row.ID]
+		if ids == nil {
+			ids = []string{}
+		}
+		collections = append(collections, domain.ExportedCollection{
+			Collection:     convertCollection(row),
+			ArticleHashIDs: ids,
+		})
+	}
+	return collections, nil
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// DeleteUserData removes every row belonging to a user. Collection articles are removed
+// along with their collections by the foreign key cascade.
+func (r *Repository) DeleteUserData(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := r.queries.WithTx(tx)
+
+	deletes := []struct {
+		table string
+		fn    func(context.Context, string) error
+	}{
+		{"user_article_interactions", qtx.DeleteUserArticleInteractions},
+		{"user_interest_clusters", qtx.DeleteUserInterestClusters},
+		{"user_precomputed_recommendations", qtx.DeleteUserPrecomputedRecommendations},
+		{"recommendation_impressions", qtx.DeleteUserRecommendationImpressions},
+		{"user_recommendation_state", qtx.DeleteUserRecommendationState},
+		{"api_tokens", qtx.DeleteUserAPITokens},
+		{"user_digest_preferences", qtx.DeleteUserDigestPreferences},
+		{"saved_searches", qtx.DeleteUserSavedSearches},
+		{"collections", qtx.DeleteUserCollections},
+		{"article_notes", qtx.DeleteUserArticleNotes},
+		{"user_article_tags", qtx.DeleteUserArticleTags},
+		{"user_tag_preferences", qtx.DeleteUserTagPreferences},
+		{"user_follows", qtx.DeleteUserFollows},
+		{"user_follow_preferences", qtx.DeleteUserFollowPreferences},
+		{"user_onboarding_interests", qtx.DeleteUserOnboardingInterests},
+	}
+	for _, d := range deletes {
+		if err := d.fn(ctx, userID); err != nil {
+			return fmt.Errorf("deleting from %s: %w", d.table, err)
+		}
+	}
+
+	if err := qtx.DeleteUserAuditEvents(ctx, sql.NullString{String: userID, Valid: true}); err != nil {
+		return fmt.Errorf("deleting from audit_events: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
 }
@@ -75,7 +75,28 @@ func teardownTestDB(t *testing.T, db *sql.DB) {
 		t.Skip("skipping MySQL integration tests in short mode")
 	}
 
-	_, err := db.ExecContext(t.Context(), "DELETE FROM user_article_interactions")
+	_, err := db.ExecContext(t.Context(), "DELETE FROM article_popularity")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), "DELETE FROM article_neighbours")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), "DELETE FROM article_duplicates")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), "DELETE FROM authors")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), "DELETE FROM recommendation_impressions")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), "DELETE FROM user_article_interactions")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), "DELETE FROM user_article_tags")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(t.Context(), "DELETE FROM user_tag_preferences")
 	require.NoError(t, err)
 
 	_, err = db.ExecContext(t.Context(), "DELETE FROM articles")
@@ -574,3 +595,643 @@ func TestRepository_FetchArticlesByID(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_GetArticlePublishedDates(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	_, err := db.ExecContext(ctx, "UPDATE articles SET date_published = NULL WHERE hash_id = ?", testArticleHash2)
+	require.NoError(t, err)
+
+	// Articles without a publication date, and unknown articles, are left out
+	dates, err := sut.GetArticlePublishedDates(ctx, []string{testArticleHash1, testArticleHash2, "does-not-exist"})
+	require.NoError(t, err)
+	require.Len(t, dates, 1)
+	assert.True(t, dates[testArticleHash1].Equal(time.Date(2024, 4, 27, 11, 13, 6, 0, time.UTC)))
+
+	dates, err = sut.GetArticlePublishedDates(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, dates)
+}
+
+func TestRepository_FindArticlesByNormalizedTitles(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+
+	matches, err := sut.FindArticlesByNormalizedTitles(t.Context(), []string{
+		"refusal in llms is mediated by a single direction",
+		"constructability",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"refusal in llms is mediated by a single direction": testArticleHash1,
+	}, matches)
+}
+
+func TestRepository_ArticlePopularity(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	// Enough users like article 1 to pass the threshold; only one user dislikes article 2
+	for _, userID := range []string{"pop-user-1", "pop-user-2", "pop-user-3", "pop-user-4"} {
+		err := sut.SetArticleRating(ctx, userID, testArticleHash1, boolPtr(true), boolPtr(false), []float32{0.1})
+		require.NoError(t, err)
+	}
+	err := sut.SetArticleRating(ctx, "pop-user-1", testArticleHash2, boolPtr(false), boolPtr(true), []float32{0.1})
+	require.NoError(t, err)
+
+	aggregated, err := sut.AggregateArticlePopularity(ctx, time.Now().Add(-time.Hour), 4)
+	require.NoError(t, err)
+	require.Len(t, aggregated, 1)
+	assert.Equal(t, testArticleHash1, aggregated[0].HashID)
+	assert.Equal(t, int64(4), aggregated[0].LikeCount)
+	assert.Equal(t, int64(0), aggregated[0].DislikeCount)
+	assert.Equal(t, int64(4), aggregated[0].UserCount)
+
+	aggregated[0].Score = 4.2
+	err = sut.ReplaceArticlePopularity(ctx, domain.PopularityOrderingWindow, aggregated)
+	require.NoError(t, err)
+
+	popular, err := sut.ListPopularArticles(ctx, domain.PopularityOrderingWindow, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, popular, 1)
+	assert.Equal(t, testArticleHash1, popular[0].HashID)
+	assert.InDelta(t, 4.2, popular[0].Score, 1e-9)
+
+	byID, err := sut.GetArticlePopularity(ctx, domain.PopularityOrderingWindow,
+		[]string{testArticleHash1, testArticleHash2})
+	require.NoError(t, err)
+	assert.Len(t, byID, 1)
+	assert.Contains(t, byID, testArticleHash1)
+
+	ids, err := sut.ListLatestArticleIDs(ctx, domain.ArticleFilters{}, domain.ArticleListOptions{
+		PageSize: 100,
+		Page:     1,
+		Ordering: []domain.ArticleOrdering{{Field: domain.ArticleOrderingFieldPopularity, Desc: true}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{testArticleHash1, testArticleHash2}, ids)
+}
+
+func TestRepository_ArticleNeighbours(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	// Three users like both articles; a fourth likes only article 1 and has not rated article 2
+	for _, userID := range []string{"cf-user-1", "cf-user-2", "cf-user-3"} {
+		for _, hashID := range []string{testArticleHash1, testArticleHash2} {
+			err := sut.SetArticleRating(ctx, userID, hashID, boolPtr(true), boolPtr(false), []float32{0.1})
+			require.NoError(t, err)
+		}
+	}
+	err := sut.SetArticleRating(ctx, "cf-user-4", testArticleHash1, boolPtr(true), boolPtr(false), []float32{0.1})
+	require.NoError(t, err)
+
+	coLikes, err := sut.ListArticleCoLikes(ctx, 3)
+	require.NoError(t, err)
+	assert.Len(t, coLikes, 2)
+
+	likeCounts, err := sut.CountArticleLikes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), likeCounts[testArticleHash1])
+	assert.Equal(t, int64(3), likeCounts[testArticleHash2])
+
+	neighbours := domain.ComputeArticleNeighbours(coLikes, likeCounts, domain.DefaultNeighbourConfig())
+	require.Len(t, neighbours, 2)
+	require.NoError(t, sut.ReplaceArticleNeighbours(ctx, neighbours))
+
+	candidates, err := sut.ListCollaborativeCandidates(ctx, "cf-user-4", 10)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, testArticleHash2, candidates[0].HashID)
+
+	candidates, err = sut.ListCollaborativeCandidates(ctx, "cf-user-1", 10)
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+}
+
+func TestRepository_ArticleDuplicates(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	duplicates := []domain.ArticleDuplicate{{CanonicalHashID: testArticleHash1, VariantHashID: testArticleHash2}}
+	require.NoError(t, sut.ReplaceArticleDuplicates(ctx, duplicates))
+
+	listed, err := sut.ListArticleDuplicates(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, duplicates, listed)
+
+	canonical, err := sut.GetCanonicalArticleIDs(ctx, []string{testArticleHash1, testArticleHash2})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{testArticleHash2: testArticleHash1}, canonical)
+
+	// The variant is listed only as an alternate of its canonical article
+	ids, err := sut.ListLatestArticleIDs(ctx, domain.ArticleFilters{}, domain.ArticleListOptions{
+		PageSize: 100,
+		Page:     1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{testArticleHash1}, ids)
+
+	total, err := sut.TotalMatchingArticles(ctx, domain.ArticleFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	articles, err := sut.FetchArticlesByID(ctx, []string{testArticleHash1})
+	require.NoError(t, err)
+	require.Len(t, articles, 1)
+	require.Len(t, articles[0].Alternates, 1)
+	assert.Equal(t, testArticleHash2, articles[0].Alternates[0].HashID)
+
+	require.NoError(t, sut.ReplaceArticleDuplicates(ctx, nil))
+	listed, err = sut.ListArticleDuplicates(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
+
+func TestRepository_Authors(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	err := sut.ReplaceAuthors(ctx,
+		[]domain.Author{
+			{ID: "andy-arditi", Name: "Andy Arditi"},
+			{ID: "neel-nanda", Name: "Neel Nanda", Aliases: []string{"N. Nanda"}},
+		},
+		[]domain.ArticleAuthor{
+			{ArticleHashID: testArticleHash1, AuthorID: "andy-arditi", Position: 0},
+			{ArticleHashID: testArticleHash1, AuthorID: "neel-nanda", Position: 1},
+			{ArticleHashID: testArticleHash2, AuthorID: "neel-nanda", Position: 0},
+		}, nil)
+	require.NoError(t, err)
+
+	author, ok, err := sut.GetAuthor(ctx, "neel-nanda")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, domain.Author{
+		ID:           "neel-nanda",
+		Name:         "Neel Nanda",
+		Aliases:      []string{"N. Nanda"},
+		ArticleCount: 2,
+	}, author)
+
+	_, ok, err = sut.GetAuthor(ctx, "nobody")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ids, err := sut.ListAuthorArticleIDs(ctx, "neel-nanda", 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{testArticleHash2, testArticleHash1}, ids)
+
+	coAuthors, err := sut.ListCoAuthors(ctx, "neel-nanda", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.CoAuthor{{ID: "andy-arditi", Name: "Andy Arditi", SharedArticles: 1}}, coAuthors)
+
+	articles, err := sut.FetchArticlesByID(ctx, []string{testArticleHash1})
+	require.NoError(t, err)
+	require.Len(t, articles, 1)
+	assert.Equal(t, []domain.AuthorRef{
+		{ID: "andy-arditi", Name: "Andy Arditi"},
+		{ID: "neel-nanda", Name: "Neel Nanda"},
+	}, articles[0].StructuredAuthors)
+
+	names, err := sut.ListAuthorNames(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Andy Arditi": "andy-arditi",
+		"Neel Nanda":  "neel-nanda",
+		"N. Nanda":    "neel-nanda",
+	}, names)
+
+	// Re-extracting updates authors in place and deletes those no longer credited
+	require.NoError(t, sut.ReplaceAuthors(ctx,
+		[]domain.Author{{ID: "neel-nanda", Name: "Neel N. Nanda", Aliases: []string{"Neel Nanda"}}},
+		[]domain.ArticleAuthor{{ArticleHashID: testArticleHash2, AuthorID: "neel-nanda", Position: 0}}, nil))
+	author, ok, err = sut.GetAuthor(ctx, "neel-nanda")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, domain.Author{
+		ID:           "neel-nanda",
+		Name:         "Neel N. Nanda",
+		Aliases:      []string{"Neel Nanda"},
+		ArticleCount: 1,
+	}, author)
+	_, ok, err = sut.GetAuthor(ctx, "andy-arditi")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, sut.ReplaceAuthors(ctx, nil, nil, nil))
+	_, ok, err = sut.GetAuthor(ctx, "neel-nanda")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRepository_DigestEmailConfirmation(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	require.NoError(t, sut.UpsertDigestPreferences(ctx, domain.DigestPreferences{
+		UserID:                 "digest-user",
+		PendingEmail:           "new@example.com",
+		EmailConfirmationToken: "confirm-token",
+		Frequency:              domain.DigestFrequencyDaily,
+		Enabled:                true,
+		UnsubscribeToken:       "unsubscribe-token",
+	}))
+
+	// Unconfirmed addresses aren't sent digests
+	prefs, ok, err := sut.GetDigestPreferences(ctx, "digest-user")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Empty(t, prefs.Email)
+	assert.Equal(t, "new@example.com", prefs.PendingEmail)
+	assert.False(t, prefs.IsDue(time.Now()))
+
+	confirmed, err := sut.ConfirmDigestEmail(ctx, "unknown-token")
+	require.NoError(t, err)
+	assert.False(t, confirmed)
+
+	confirmed, err = sut.ConfirmDigestEmail(ctx, "confirm-token")
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+
+	prefs, ok, err = sut.GetDigestPreferences(ctx, "digest-user")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "new@example.com", prefs.Email)
+	assert.Empty(t, prefs.PendingEmail)
+	assert.Empty(t, prefs.EmailConfirmationToken)
+
+	// Tokens can only be used once
+	confirmed, err = sut.ConfirmDigestEmail(ctx, "confirm-token")
+	require.NoError(t, err)
+	assert.False(t, confirmed)
+
+	require.NoError(t, sut.DeleteUserData(ctx, "digest-user"))
+}
+
+func TestRepository_Follows(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	require.NoError(t, sut.ReplaceAuthors(ctx,
+		[]domain.Author{{ID: "andy-arditi", Name: "Andy Arditi"}},
+		[]domain.ArticleAuthor{{ArticleHashID: testArticleHash1, AuthorID: "andy-arditi", Position: 0}}, nil))
+
+	require.NoError(t, sut.FollowItem(ctx, "follow-user", domain.FollowTypeAuthor, "andy-arditi"))
+	require.NoError(t, sut.FollowItem(ctx, "follow-user", domain.FollowTypeAuthor, "andy-arditi"))
+
+	follows, err := sut.ListFollows(ctx, "follow-user")
+	require.NoError(t, err)
+	require.Len(t, follows, 1)
+	assert.Equal(t, domain.FollowTypeAuthor, follows[0].Type)
+	assert.Equal(t, "andy-arditi", follows[0].Target)
+
+	options := domain.ArticleListOptions{Page: 1, PageSize: 10}
+	ids, err := sut.ListLatestArticleIDs(ctx,
+		domain.ArticleFilters{Followed: domain.NewFollowedItems(follows)}, options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{testArticleHash1}, ids)
+
+	require.NoError(t, sut.FollowItem(ctx, "follow-user", domain.FollowTypeSource, "lesswrong"))
+	follows, err = sut.ListFollows(ctx, "follow-user")
+	require.NoError(t, err)
+	ids, err = sut.ListLatestArticleIDs(ctx,
+		domain.ArticleFilters{Followed: domain.NewFollowedItems(follows)}, options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{testArticleHash2, testArticleHash1}, ids)
+
+	require.NoError(t, sut.UnfollowItem(ctx, "follow-user", domain.FollowTypeAuthor, "andy-arditi"))
+	follows, err = sut.ListFollows(ctx, "follow-user")
+	require.NoError(t, err)
+	require.Len(t, follows, 1)
+	assert.Equal(t, "lesswrong", follows[0].Target)
+
+	_, ok, err := sut.GetFollowPreferences(ctx, "follow-user")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, sut.CreateFollowPreferences(ctx, "follow-user", "token-1"))
+	require.NoError(t, sut.CreateFollowPreferences(ctx, "follow-user", "token-2"))
+	require.NoError(t, sut.UpsertFollowPreferences(ctx, domain.FollowPreferences{
+		UserID:                   "follow-user",
+		IncludeInRecommendations: true,
+		FeedToken:                "token-3",
+	}))
+
+	prefs, ok, err := sut.GetFollowPreferencesByFeedToken(ctx, "token-1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, domain.FollowPreferences{
+		UserID:                   "follow-user",
+		IncludeInRecommendations: true,
+		FeedToken:                "token-1",
+	}, prefs)
+
+	export, err := sut.ExportUserData(ctx, "follow-user")
+	require.NoError(t, err)
+	assert.Len(t, export.Follows, 1)
+	require.NotNil(t, export.FollowPreferences)
+
+	require.NoError(t, sut.DeleteUserData(ctx, "follow-user"))
+	follows, err = sut.ListFollows(ctx, "follow-user")
+	require.NoError(t, err)
+	assert.Empty(t, follows)
+	_, ok, err = sut.GetFollowPreferences(ctx, "follow-user")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRepository_TagCooccurrenceSharing(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	require.NoError(t, sut.TagArticle(ctx, "tag-user", testArticleHash1, "debate"))
+	require.NoError(t, sut.TagArticle(ctx, "tag-other", testArticleHash2, "debate"))
+
+	// Other users' tags are private until they opt in to sharing them
+	cooccurring, err := sut.ListTagCooccurringArticles(ctx, "tag-user", 10)
+	require.NoError(t, err)
+	assert.Empty(t, cooccurring)
+
+	prefs, ok, err := sut.GetTagPreferences(ctx, "tag-other")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, prefs.ShareForRecommendations)
+
+	require.NoError(t, sut.UpsertTagPreferences(ctx, domain.TagPreferences{
+		UserID:                  "tag-other",
+		ShareForRecommendations: true,
+	}))
+	cooccurring, err = sut.ListTagCooccurringArticles(ctx, "tag-user", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.TagCooccurrence{{HashID: testArticleHash2, Count: 1}}, cooccurring)
+
+	export, err := sut.ExportUserData(ctx, "tag-other")
+	require.NoError(t, err)
+	require.NotNil(t, export.TagPreferences)
+	assert.True(t, export.TagPreferences.ShareForRecommendations)
+
+	require.NoError(t, sut.DeleteUserData(ctx, "tag-other"))
+	_, ok, err = sut.GetTagPreferences(ctx, "tag-other")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRepository_ReplaceAuthorsMovesFollows(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	require.NoError(t, sut.FollowItem(ctx, "follow-user-1", domain.FollowTypeAuthor, "n-nanda"))
+	require.NoError(t, sut.FollowItem(ctx, "follow-user-1", domain.FollowTypeSource, "n-nanda"))
+	require.NoError(t, sut.FollowItem(ctx, "follow-user-2", domain.FollowTypeAuthor, "n-nanda"))
+	require.NoError(t, sut.FollowItem(ctx, "follow-user-2", domain.FollowTypeAuthor, "neel-nanda"))
+
+	require.NoError(t, sut.ReplaceAuthors(ctx,
+		[]domain.Author{{ID: "neel-nanda", Name: "Neel Nanda", Aliases: []string{"N. Nanda"}}},
+		[]domain.ArticleAuthor{{ArticleHashID: testArticleHash1, AuthorID: "neel-nanda", Position: 0}},
+		map[string]string{"n-nanda": "neel-nanda"}))
+
+	follows, err := sut.ListFollows(ctx, "follow-user-1")
+	require.NoError(t, err)
+	require.Len(t, follows, 2)
+	assert.Equal(t, domain.FollowTypeAuthor, follows[0].Type)
+	assert.Equal(t, "neel-nanda", follows[0].Target)
+	// Only author follows move
+	assert.Equal(t, domain.FollowTypeSource, follows[1].Type)
+	assert.Equal(t, "n-nanda", follows[1].Target)
+
+	// Already following the new ID, so the old follow is dropped
+	follows, err = sut.ListFollows(ctx, "follow-user-2")
+	require.NoError(t, err)
+	require.Len(t, follows, 1)
+	assert.Equal(t, "neel-nanda", follows[0].Target)
+}
+
+func TestRepository_ExperimentReport(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+	shownAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	// exp-user-1 is shown article 1 twice and article 2 once, then reads article 1
+	err := sut.RecordRecommendationImpressions(ctx, []domain.RecommendationImpression{
+		{UserID: "exp-user-1", ArticleHashID: testArticleHash1, ExperimentArm: "a", Source: "temporal", ServedAt: shownAt},
+		{UserID: "exp-user-1", ArticleHashID: testArticleHash2, ExperimentArm: "a", Source: "temporal", Position: 1,
+			ServedAt: shownAt},
+		{UserID: "exp-user-1", ArticleHashID: testArticleHash1, ExperimentArm: "a", Source: "temporal",
+			ServedAt: shownAt.Add(time.Minute)},
+		{UserID: "exp-user-2", ArticleHashID: testArticleHash1, ExperimentArm: "b", Source: "cluster_0",
+			ServedAt: shownAt},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, sut.SetArticleRead(ctx, testArticleHash1, "exp-user-1", true))
+	require.NoError(t, sut.SetArticleRating(ctx, "exp-user-2", testArticleHash1, boolPtr(true), boolPtr(false),
+		[]float32{0.1}))
+
+	reports, err := sut.ReportExperimentArms(ctx, shownAt.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ExperimentArmReport{
+		domain.NewExperimentArmReport("a", 1, 2, 1, 0),
+		domain.NewExperimentArmReport("b", 1, 1, 0, 1),
+	}, reports)
+
+	export, err := sut.ExportUserData(ctx, "exp-user-1")
+	require.NoError(t, err)
+	assert.Len(t, export.Impressions, 3)
+
+	require.NoError(t, sut.DeleteUserData(ctx, "exp-user-1"))
+	export, err = sut.ExportUserData(ctx, "exp-user-1")
+	require.NoError(t, err)
+	assert.Empty(t, export.Impressions)
+}
+
+func TestRepository_CountIgnoredRecommendations(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+	today := time.Now().Truncate(24 * time.Hour)
+
+	// Both articles are shown near the top on three days, and article 1 far down on a fourth
+	var impressions []domain.RecommendationImpression
+	for day := 1; day <= 3; day++ {
+		for position, hashID := range []string{testArticleHash1, testArticleHash2} {
+			impressions = append(impressions, domain.RecommendationImpression{
+				UserID: "ignore-user", ArticleHashID: hashID, Source: "temporal", Position: position,
+				ServedAt: today.AddDate(0, 0, -day),
+			})
+		}
+	}
+	impressions = append(impressions, domain.RecommendationImpression{
+		UserID: "ignore-user", ArticleHashID: testArticleHash1, Source: "temporal", Position: 50,
+		ServedAt: today.AddDate(0, 0, -4),
+	})
+	require.NoError(t, sut.RecordRecommendationImpressions(ctx, impressions))
+
+	// Reading article 2 means it was not ignored
+	require.NoError(t, sut.SetArticleRead(ctx, testArticleHash2, "ignore-user", true))
+
+	ignored, err := sut.CountIgnoredRecommendations(ctx, "ignore-user", today.AddDate(0, 0, -30), 20, 2)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{testArticleHash1: 3}, ignored)
+
+	ignored, err = sut.CountIgnoredRecommendations(ctx, "ignore-user", today.AddDate(0, 0, -30), 20, 4)
+	require.NoError(t, err)
+	assert.Empty(t, ignored)
+}
+
+func TestRepository_HiddenArticles(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+
+	require.NoError(t, sut.SetArticleDismissed(ctx, testArticleHash1, "hide-user", true))
+
+	// A snooze that has already ended no longer hides the article
+	require.NoError(t, sut.SnoozeArticle(ctx, testArticleHash2, "hide-user", time.Now().Add(-time.Hour)))
+	hidden, err := sut.ListHiddenArticleIDs(ctx, "hide-user")
+	require.NoError(t, err)
+	assert.Equal(t, []string{testArticleHash1}, hidden)
+
+	require.NoError(t, sut.SnoozeArticle(ctx, testArticleHash2, "hide-user", time.Now().Add(time.Hour)))
+	hidden, err = sut.ListHiddenArticleIDs(ctx, "hide-user")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{testArticleHash1, testArticleHash2}, hidden)
+
+	// Dismissing does not touch read state or ratings
+	readIDs, err := sut.ListReadArticleIDs(ctx, "hide-user")
+	require.NoError(t, err)
+	assert.Empty(t, readIDs)
+
+	require.NoError(t, sut.SetArticleDismissed(ctx, testArticleHash1, "hide-user", false))
+	require.NoError(t, sut.SnoozeArticle(ctx, testArticleHash2, "hide-user", time.Time{}))
+
+	hidden, err = sut.ListHiddenArticleIDs(ctx, "hide-user")
+	require.NoError(t, err)
+	assert.Empty(t, hidden)
+}
+
+func TestRepository_GradedRatingsAndSignals(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+	vector := []float32{1.0, 0.0}
+
+	// A graded rating sets its matching thumbs
+	require.NoError(t, sut.SetArticleGradedRating(ctx, "signal-user", testArticleHash1, 5, vector))
+	likedIDs, err := sut.ListThumbsUpArticleIDs(ctx, "signal-user")
+	require.NoError(t, err)
+	assert.Equal(t, []string{testArticleHash1}, likedIDs)
+
+	positive, err := sut.GetUserArticleVectorsByType(ctx, "signal-user", domain.RatingTypeThumbsUp)
+	require.NoError(t, err)
+	require.Len(t, positive, 1)
+	assert.Equal(t, domain.ArticleSignals{ThumbsUp: true, Rating: 5}, positive[0].Signals)
+
+	// Removing the thumbs up clears the rating it no longer matches
+	thumbsUp := false
+	require.NoError(t, sut.SetArticleRating(ctx, "signal-user", testArticleHash1, &thumbsUp, nil, vector))
+	positive, err = sut.GetUserArticleVectorsByType(ctx, "signal-user", domain.RatingTypeThumbsUp)
+	require.NoError(t, err)
+	assert.Empty(t, positive)
+
+	// Implicit signals alone make an article count as positive, and time on article accumulates
+	timeSignal := domain.ArticleSignal{Type: domain.ArticleSignalTimeOnArticle, Duration: 90 * time.Second}
+	require.NoError(t, sut.RecordArticleSignal(ctx, "signal-user", testArticleHash2, timeSignal, vector))
+	require.NoError(t, sut.RecordArticleSignal(ctx, "signal-user", testArticleHash2, timeSignal, nil))
+	require.NoError(t, sut.RecordArticleSignal(ctx, "signal-user", testArticleHash2,
+		domain.ArticleSignal{Type: domain.ArticleSignalOpenedLink}, nil))
+
+	positive, err = sut.GetUserArticleVectorsByType(ctx, "signal-user", domain.RatingTypeThumbsUp)
+	require.NoError(t, err)
+	require.Len(t, positive, 1)
+	assert.Equal(t, testArticleHash2, positive[0].ArticleHashID)
+	assert.Equal(t, vector, positive[0].Vector)
+	assert.Equal(t, domain.ArticleSignals{OpenedLink: true, TimeOnArticle: 3 * time.Minute}, positive[0].Signals)
+
+	// A low rating is a thumbs down, and excludes the article from positive vectors
+	require.NoError(t, sut.SetArticleGradedRating(ctx, "signal-user", testArticleHash2, 1, vector))
+	positive, err = sut.GetUserArticleVectorsByType(ctx, "signal-user", domain.RatingTypeThumbsUp)
+	require.NoError(t, err)
+	assert.Empty(t, positive)
+
+	negative, err := sut.GetUserArticleVectorsByType(ctx, "signal-user", domain.RatingTypeThumbsDown)
+	require.NoError(t, err)
+	require.Len(t, negative, 1)
+	assert.Equal(t, 1, negative[0].Signals.Rating)
+	assert.True(t, negative[0].Signals.ThumbsDown)
+}
+
+func TestRepository_ListCategoryExploration(t *testing.T) {
+	db := setupTestDB(t)
+	defer teardownTestDB(t, db)
+
+	sut := New(db)
+	ctx := t.Context()
+	shownAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	_, err := db.ExecContext(ctx, "UPDATE articles SET category = ? WHERE hash_id = ?", "interpretability", testArticleHash1)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "UPDATE articles SET category = ? WHERE hash_id = ?", "governance", testArticleHash2)
+	require.NoError(t, err)
+
+	// Article 2 is shown once as exploration and once from a cluster, then read
+	require.NoError(t, sut.RecordRecommendationImpressions(ctx, []domain.RecommendationImpression{
+		{UserID: "explore-user", ArticleHashID: testArticleHash2, Source: "explore", Position: 3, ServedAt: shownAt},
+		{UserID: "explore-user", ArticleHashID: testArticleHash2, Source: "cluster_0", ServedAt: shownAt},
+	}))
+	require.NoError(t, sut.SetArticleRead(ctx, testArticleHash2, "explore-user", true))
+
+	categories, err := sut.ListCategoryExploration(ctx, "explore-user", shownAt.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []domain.CategoryExploration{
+		{Category: "governance", UserEngaged: 1, ExploreShown: 1, ExploreEngaged: 1},
+		{Category: "interpretability"},
+	}, categories)
+
+	// Exploration shown before the lookback is left out
+	categories, err = sut.ListCategoryExploration(ctx, "explore-user", shownAt.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []domain.CategoryExploration{
+		{Category: "governance", UserEngaged: 1},
+		{Category: "interpretability"},
+	}, categories)
+}
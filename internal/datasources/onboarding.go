@@ -0,0 +1,29 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// OnboardingInterestsGetter returns the interests a user picked when onboarding.
+// The boolean is false if the user hasn't onboarded.
+type OnboardingInterestsGetter interface {
+	GetOnboardingInterests(ctx context.Context, userID string) (domain.OnboardingInterests, bool, error)
+}
+
+// OnboardingInterestsSetter stores the interests a user picked when onboarding, replacing any earlier ones.
+type OnboardingInterestsSetter interface {
+	SetOnboardingInterests(ctx context.Context, interests domain.OnboardingInterests) error
+}
+
+// OnboardingInterestsStore combines the onboarding interest operations.
+type OnboardingInterestsStore interface {
+	OnboardingInterestsGetter
+	OnboardingInterestsSetter
+}
+
+// ArticleCategoryLister lists the categories articles are in, largest first.
+type ArticleCategoryLister interface {
+	ListArticleCategories(ctx context.Context) ([]domain.ArticleCategory, error)
+}
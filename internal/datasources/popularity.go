@@ -0,0 +1,52 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticlePopularityAggregator counts interactions per article since a time, across all users.
+// Articles with interactions from fewer than minUsers distinct users are left out.
+// Scores are not computed.
+type ArticlePopularityAggregator interface {
+	AggregateArticlePopularity(
+		ctx context.Context, since time.Time, minUsers int64,
+	) ([]domain.ArticlePopularity, error)
+}
+
+// ArticlePopularityReplacer replaces the stored popularity of all articles in a window.
+type ArticlePopularityReplacer interface {
+	ReplaceArticlePopularity(
+		ctx context.Context, window domain.PopularityWindow, popularity []domain.ArticlePopularity,
+	) error
+}
+
+// PopularArticleLister lists the articles with the highest popularity score in a window, most popular first.
+type PopularArticleLister interface {
+	ListPopularArticles(
+		ctx context.Context, window domain.PopularityWindow, page, pageSize int,
+	) ([]domain.ArticlePopularity, error)
+}
+
+// ArticlePopularityGetter returns the stored popularity of the given articles in a window, by hash ID.
+// Articles without stored popularity are left out.
+type ArticlePopularityGetter interface {
+	GetArticlePopularity(
+		ctx context.Context, window domain.PopularityWindow, hashIDs []string,
+	) (map[string]domain.ArticlePopularity, error)
+}
+
+// ArticlePopularityReader combines the popularity read operations.
+type ArticlePopularityReader interface {
+	PopularArticleLister
+	ArticlePopularityGetter
+}
+
+// ArticlePopularityStore combines all popularity operations.
+type ArticlePopularityStore interface {
+	ArticlePopularityAggregator
+	ArticlePopularityReplacer
+	ArticlePopularityReader
+}
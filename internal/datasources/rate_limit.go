@@ -0,0 +1,20 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// RateLimitStore holds token buckets keyed by client, taking a token atomically.
+type RateLimitStore interface {
+	TakeRateLimitToken(
+		ctx context.Context, key string, limit domain.RateLimit, now time.Time,
+	) (domain.RateLimitDecision, error)
+}
+
+// RateLimitBucketDeleter deletes idle token buckets, returning how many were deleted.
+type RateLimitBucketDeleter interface {
+	DeleteRateLimitBucketsUpdatedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,67 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// SavedSearchCreator stores a new saved search.
+type SavedSearchCreator interface {
+	CreateSavedSearch(ctx context.Context, search domain.SavedSearch) error
+}
+
+// SavedSearchGetter retrieves one of a user's saved searches.
+// Returns ok=false if the search does not exist or belongs to another user.
+type SavedSearchGetter interface {
+	GetSavedSearch(ctx context.Context, userID, searchID string) (domain.SavedSearch, bool, error)
+}
+
+// SavedSearchByFeedTokenGetter retrieves a saved search by its RSS feed token.
+// Returns ok=false if no search matches the token.
+type SavedSearchByFeedTokenGetter interface {
+	GetSavedSearchByFeedToken(ctx context.Context, feedToken string) (domain.SavedSearch, bool, error)
+}
+
+// UserSavedSearchLister lists all saved searches for a user.
+type UserSavedSearchLister interface {
+	ListUserSavedSearches(ctx context.Context, userID string) ([]domain.SavedSearch, error)
+}
+
+// UserSavedSearchCounter counts saved searches for a user.
+type UserSavedSearchCounter interface {
+	CountUserSavedSearches(ctx context.Context, userID string) (int64, error)
+}
+
+// SavedSearchUpdater replaces the name and query of an existing saved search.
+type SavedSearchUpdater interface {
+	UpdateSavedSearch(ctx context.Context, search domain.SavedSearch) error
+}
+
+// SavedSearchDeleter deletes one of a user's saved searches.
+type SavedSearchDeleter interface {
+	DeleteSavedSearch(ctx context.Context, userID, searchID string) error
+}
+
+// SavedSearchViewedMarker records when a user last viewed new results for a saved search.
+type SavedSearchViewedMarker interface {
+	MarkSavedSearchViewed(ctx context.Context, userID, searchID string, viewedAt time.Time) error
+}
+
+// UserSavedSearchRepository reads and updates a single saved search.
+type UserSavedSearchRepository interface {
+	SavedSearchGetter
+	SavedSearchUpdater
+}
+
+// SavedSearchStore combines all saved search operations.
+type SavedSearchStore interface {
+	SavedSearchCreator
+	UserSavedSearchRepository
+	SavedSearchByFeedTokenGetter
+	UserSavedSearchLister
+	UserSavedSearchCounter
+	SavedSearchDeleter
+	SavedSearchViewedMarker
+}
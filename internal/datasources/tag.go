@@ -0,0 +1,67 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleTagger adds a tag to one of a user's articles. Adding a tag twice is a no-op.
+type ArticleTagger interface {
+	TagArticle(ctx context.Context, userID, articleHashID, tag string) error
+}
+
+// ArticleUntagger removes a tag from one of a user's articles.
+type ArticleUntagger interface {
+	UntagArticle(ctx context.Context, userID, articleHashID, tag string) error
+}
+
+// ArticleTagLister lists the tags a user has given an article, alphabetically.
+type ArticleTagLister interface {
+	ListArticleTags(ctx context.Context, userID, articleHashID string) ([]string, error)
+}
+
+// UserTagLister lists all of a user's tags with their article counts, most used first.
+type UserTagLister interface {
+	ListUserTags(ctx context.Context, userID string) ([]domain.TagCount, error)
+}
+
+// TaggedArticleLister lists the articles a user has given a tag, most recently tagged first.
+type TaggedArticleLister interface {
+	ListTaggedArticleIDs(ctx context.Context, userID, tag string, page, pageSize int) ([]string, error)
+}
+
+// TagCooccurrenceLister finds articles that other users have tagged with the same tags
+// the user has used, excluding articles the user has already tagged, most shared first.
+// Only the tags of users who have opted in to sharing them are used.
+type TagCooccurrenceLister interface {
+	ListTagCooccurringArticles(ctx context.Context, userID string, limit int) ([]domain.TagCooccurrence, error)
+}
+
+// TagPreferencesGetter retrieves a user's tag preferences.
+// Returns false if the user has none stored, along with the defaults.
+type TagPreferencesGetter interface {
+	GetTagPreferences(ctx context.Context, userID string) (domain.TagPreferences, bool, error)
+}
+
+// TagPreferencesUpserter stores a user's tag preferences.
+type TagPreferencesUpserter interface {
+	UpsertTagPreferences(ctx context.Context, prefs domain.TagPreferences) error
+}
+
+// ArticleTagStore combines the operations on a single article's tags.
+type ArticleTagStore interface {
+	ArticleTagger
+	ArticleUntagger
+	ArticleTagLister
+}
+
+// UserTagStore combines all user tag operations.
+type UserTagStore interface {
+	ArticleTagStore
+	UserTagLister
+	TaggedArticleLister
+	TagCooccurrenceLister
+	TagPreferencesGetter
+	TagPreferencesUpserter
+}
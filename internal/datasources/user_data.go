@@ -0,0 +1,23 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// UserDataExporter gathers everything stored about a user from a consistent snapshot.
+type UserDataExporter interface {
+	ExportUserData(ctx context.Context, userID string) (domain.UserDataExport, error)
+}
+
+// UserDataDeleter removes every row belonging to a user in a single transaction.
+type UserDataDeleter interface {
+	DeleteUserData(ctx context.Context, userID string) error
+}
+
+// UserDataStore combines account-wide data operations.
+type UserDataStore interface {
+	UserDataExporter
+	UserDataDeleter
+}
@@ -1,21 +1,89 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 // APITokenPrefix is the prefix for API tokens in the Authorization header.
 const APITokenPrefix = "user_api|"
 
+// APITokenScope limits what an API token may be used for.
+type APITokenScope string
+
+const (
+	// APITokenScopeArticlesRead allows reading articles, search, and the user's own lists,
+	// notes, tags, collections, saved searches and settings.
+	APITokenScopeArticlesRead APITokenScope = "articles:read"
+	// APITokenScopeInteractionsWrite allows changing the user's state: read status, ratings,
+	// notes, tags, collections, saved searches and settings.
+	APITokenScopeInteractionsWrite APITokenScope = "interactions:write"
+	// APITokenScopeRecommendationsRead allows fetching personalized recommendations.
+	APITokenScopeRecommendationsRead APITokenScope = "recommendations:read"
+	// APITokenScopeFeedsRead allows fetching RSS feeds.
+	APITokenScopeFeedsRead APITokenScope = "feeds:read"
+)
+
+// AllAPITokenScopes lists every scope. Tokens created without explicit scopes get all of them.
+var AllAPITokenScopes = []APITokenScope{
+	APITokenScopeArticlesRead,
+	APITokenScopeInteractionsWrite,
+	APITokenScopeRecommendationsRead,
+	APITokenScopeFeedsRead,
+}
+
+// ParseAPITokenScopes validates a list of scope names, dropping duplicates.
+func ParseAPITokenScopes(names []string) ([]APITokenScope, error) {
+	scopes := make([]APITokenScope, 0, len(names))
+	seen := make(map[APITokenScope]bool)
+	for _, name := range names {
+		scope := APITokenScope(strings.TrimSpace(name))
+		if !scope.IsValid() {
+			return nil, fmt.Errorf("unknown API token scope [%s]", name)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// IsValid returns true if the scope is one of AllAPITokenScopes.
+func (s APITokenScope) IsValid() bool {
+	for _, scope := range AllAPITokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APITokenStatus describes whether a token can currently be used.
+type APITokenStatus string
+
+const (
+	APITokenStatusActive  APITokenStatus = "active"
+	APITokenStatusExpired APITokenStatus = "expired"
+	APITokenStatusRevoked APITokenStatus = "revoked"
+)
+
 // APIToken represents a user's API token for programmatic access.
+// ReplacedByID is set once the token has been rotated.
 type APIToken struct {
-	ID         string     `json:"id"`
-	UserID     string     `json:"-"`
-	TokenHash  string     `json:"-"`
-	Prefix     string     `json:"prefix"`
-	Name       *string    `json:"name,omitempty"`
-	CreatedAt  time.Time  `json:"created_at"`
-	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
-	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
-	RevokedAt  *time.Time `json:"-"`
+	ID           string          `json:"id"`
+	UserID       string          `json:"-"`
+	TokenHash    string          `json:"-"`
+	Prefix       string          `json:"prefix"`
+	Name         *string         `json:"name,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+	LastUsedAt   *time.Time      `json:"last_used_at,omitempty"`
+	ExpiresAt    *time.Time      `json:"expires_at,omitempty"`
+	RevokedAt    *time.Time      `json:"-"`
+	Scopes       []APITokenScope `json:"scopes"`
+	ReplacedByID *string         `json:"replaced_by_id,omitempty"`
 }
 
 // IsActive returns true if the token is not revoked and not expired.
@@ -28,3 +96,25 @@ func (t APIToken) IsActive() bool {
 	}
 	return true
 }
+
+// Status returns the token's status at the given time. Tokens revoked by the expiry
+// sweep keep their revoked_at equal to their expiry, so they report as expired.
+func (t APIToken) Status(now time.Time) APITokenStatus {
+	if t.RevokedAt != nil && (t.ExpiresAt == nil || t.RevokedAt.Before(*t.ExpiresAt)) {
+		return APITokenStatusRevoked
+	}
+	if t.ExpiresAt != nil && !now.Before(*t.ExpiresAt) {
+		return APITokenStatusExpired
+	}
+	return APITokenStatusActive
+}
+
+// HasScope returns true if the token was granted the scope.
+func (t APIToken) HasScope(scope APITokenScope) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPITokenScopes(t *testing.T) {
+	scopes, err := ParseAPITokenScopes([]string{"articles:read", " feeds:read", "articles:read"})
+	require.NoError(t, err)
+	assert.Equal(t, []APITokenScope{APITokenScopeArticlesRead, APITokenScopeFeedsRead}, scopes)
+
+	_, err = ParseAPITokenScopes([]string{"articles:write"})
+	assert.Error(t, err)
+}
+
+func TestHasAPITokenScope(t *testing.T) {
+	readOnly := ContextWithAPITokenScopes(
+		ContextWithAuthMethod(context.Background(), AuthMethodAPIToken),
+		[]APITokenScope{APITokenScopeArticlesRead},
+	)
+
+	cases := []struct {
+		name  string
+		ctx   context.Context
+		scope APITokenScope
+		want  bool
+	}{
+		{name: "token_with_scope", ctx: readOnly, scope: APITokenScopeArticlesRead, want: true},
+		{name: "token_without_scope", ctx: readOnly, scope: APITokenScopeInteractionsWrite, want: false},
+		{
+			name:  "token_without_scopes",
+			ctx:   ContextWithAuthMethod(context.Background(), AuthMethodAPIToken),
+			scope: APITokenScopeArticlesRead,
+			want:  false,
+		},
+		{
+			name:  "auth0_unscoped",
+			ctx:   ContextWithAuthMethod(context.Background(), AuthMethodAuth0),
+			scope: APITokenScopeInteractionsWrite,
+			want:  true,
+		},
+		{name: "anonymous_unscoped", ctx: context.Background(), scope: APITokenScopeFeedsRead, want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, HasAPITokenScope(tc.ctx, tc.scope))
+		})
+	}
+}
+
+func TestAPIToken_Status(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+	muchEarlier := now.Add(-2 * time.Hour)
+
+	cases := []struct {
+		name  string
+		token APIToken
+		want  APITokenStatus
+	}{
+		{name: "active", token: APIToken{}, want: APITokenStatusActive},
+		{name: "not_yet_expired", token: APIToken{ExpiresAt: &later}, want: APITokenStatusActive},
+		{name: "expired", token: APIToken{ExpiresAt: &earlier}, want: APITokenStatusExpired},
+		{name: "swept", token: APIToken{ExpiresAt: &earlier, RevokedAt: &earlier}, want: APITokenStatusExpired},
+		{name: "revoked", token: APIToken{RevokedAt: &earlier}, want: APITokenStatusRevoked},
+		{
+			name:  "revoked_before_expiry",
+			token: APIToken{ExpiresAt: &earlier, RevokedAt: &muchEarlier},
+			want:  APITokenStatusRevoked,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.token.Status(now))
+		})
+	}
+}
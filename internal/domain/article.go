@@ -13,6 +13,10 @@ type Article struct {
 	Source      string     `json:"source"`
 	PublishedAt *time.Time `json:"published_at"`
 
+	// StructuredAuthors are the authors extracted from Authors, in order, once the extraction
+	// job has processed the article.
+	StructuredAuthors []AuthorRef `json:"structured_authors,omitempty"`
+
 	Summary      string   `json:"summary,omitempty"`
 	KeyPoints    []string `json:"key_points,omitempty"`
 	Implication  string   `json:"implication,omitempty"`
@@ -22,6 +26,13 @@ type Article struct {
 	HaveRead   *bool `json:"have_read,omitempty"`
 	ThumbsUp   *bool `json:"thumbs_up,omitempty"`
 	ThumbsDown *bool `json:"thumbs_down,omitempty"`
+
+	// Alternates are the other places the article can be read, such as crossposts, if it is
+	// the canonical article of a group of duplicates.
+	Alternates []ArticleAlternate `json:"alternates,omitempty"`
+
+	// Notes is only populated for a single article fetched by an authenticated user.
+	Notes []ArticleNote `json:"notes,omitempty"`
 }
 
 type ArticleListMetadata struct {
@@ -29,13 +40,17 @@ type ArticleListMetadata struct {
 }
 
 type ArticleFilters struct {
-	SourcesAllowlist []string
-	SourcesBlocklist []string
-	PublishedAfter   time.Time
-	PublishedBefore  time.Time
-	TitleFulltext    string
-	AuthorsFulltext  string
-	Category         string
+	SourcesAllowlist []string  `json:"sources_allowlist,omitempty"`
+	SourcesBlocklist []string  `json:"sources_blocklist,omitempty"`
+	PublishedAfter   time.Time `json:"published_after,omitzero"`
+	PublishedBefore  time.Time `json:"published_before,omitzero"`
+	TitleFulltext    string    `json:"title_fulltext,omitempty"`
+	AuthorsFulltext  string    `json:"authors_fulltext,omitempty"`
+	Category         string    `json:"category,omitempty"`
+
+	// Followed, if not empty, limits to articles by any followed author or in any followed
+	// source or category. It is resolved from the user's follows on each request.
+	Followed FollowedItems `json:"-"`
 }
 
 type ArticleListOptions struct {
@@ -61,9 +76,17 @@ const ArticleOrderingFieldAuthors ArticleOrderingField = "authors"
 const ArticleOrderingFieldSource ArticleOrderingField = "source"
 const ArticleOrderingFieldTitle ArticleOrderingField = "title"
 
+// ArticleOrderingFieldPopularity orders by popularity score over PopularityOrderingWindow.
+// Articles without enough interactions to have a score count as zero.
+const ArticleOrderingFieldPopularity ArticleOrderingField = "popularity"
+
+// PopularityOrderingWindow is the window used when ordering articles by popularity.
+const PopularityOrderingWindow = PopularityWindowMonth
+
 var ValidOrderingFields = []ArticleOrderingField{
 	ArticleOrderingFieldPublishedAt,
 	ArticleOrderingFieldAuthors,
 	ArticleOrderingFieldSource,
 	ArticleOrderingFieldTitle,
+	ArticleOrderingFieldPopularity,
 }
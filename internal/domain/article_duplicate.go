@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// ArticleDuplicate is a variant of an article, such as a crosspost or another version of the
+// same paper, grouped under the canonical article that lists and recommendations show instead.
+type ArticleDuplicate struct {
+	CanonicalHashID string
+	VariantHashID   string
+}
+
+// ArticleAlternate is another place an article can be read: one of its variants.
+type ArticleAlternate struct {
+	HashID string `json:"hash_id"`
+	Link   string `json:"link"`
+	Source string `json:"source"`
+}
+
+// DuplicateConfig controls how duplicate articles are detected.
+type DuplicateConfig struct {
+	// SimilarityThreshold is the vector similarity above which two articles may be duplicates.
+	// They must also have the same normalized title or share an author.
+	SimilarityThreshold float64
+
+	// CandidatesPerArticle is how many of each article's most similar articles are checked.
+	CandidatesPerArticle int
+
+	// LookbackDays limits the articles checked to those published in recent days, against all
+	// articles, so each run only needs to check new articles. 0 checks every article.
+	LookbackDays int
+}
+
+// DefaultDuplicateConfig returns the default duplicate detection configuration.
+func DefaultDuplicateConfig() DuplicateConfig {
+	return DuplicateConfig{
+		SimilarityThreshold:  0.95,
+		CandidatesPerArticle: 5,
+		LookbackDays:         30,
+	}
+}
+
+// IsDuplicateArticle returns whether two articles with the given vector similarity are the same
+// work: similar enough, and with the same normalized title or at least one author in common.
+func IsDuplicateArticle(a, b Article, similarity float64, config DuplicateConfig) bool {
+	if a.HashID == b.HashID || similarity < config.SimilarityThreshold {
+		return false
+	}
+
+	titleA := NormalizeTitle(a.Title)
+	if titleA != "" && titleA == NormalizeTitle(b.Title) {
+		return true
+	}
+	return SharesAuthor(a.Authors, b.Authors)
+}
+
+// SharesAuthor returns whether two comma-separated author lists have an author in common,
+// ignoring case and surrounding whitespace.
+func SharesAuthor(authorsA, authorsB string) bool {
+	names := make(map[string]struct{})
+	for _, name := range strings.Split(authorsA, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			names[name] = struct{}{}
+		}
+	}
+	for _, name := range strings.Split(authorsB, ",") {
+		if _, ok := names[strings.ToLower(strings.TrimSpace(name))]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupDuplicateArticles joins articles into groups, following pairs of duplicates transitively,
+// and returns each group's variants under its canonical article: the earliest published, since
+// later copies are usually crossposts. Articles without a publication date come last, and ties are
+// broken by hash ID. Articles missing from the articles map count as published last.
+func GroupDuplicateArticles(pairs []ArticleDuplicate, articles map[string]Article) []ArticleDuplicate {
+	parent := make(map[string]string)
+	var find func(id string) string
+	find = func(id string) string {
+		p, ok := parent[id]
+		if !ok || p == id {
+			parent[id] = id
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	for _, pair := range pairs {
+		a, b := find(pair.CanonicalHashID), find(pair.VariantHashID)
+		if a != b {
+			parent[a] = b
+		}
+	}
+
+	groups := make(map[string][]string)
+	for id := range parent {
+		root := find(id)
+		groups[root] = append(groups[root], id)
+	}
+
+	var duplicates []ArticleDuplicate
+	for _, members := range groups {
+		if len(members) < 2 {
+			continue
+		}
+		sort.Slice(members, func(i, j int) bool {
+			return publishedBefore(articles[members[i]], articles[members[j]], members[i], members[j])
+		})
+		for _, variant := range members[1:] {
+			duplicates = append(duplicates, ArticleDuplicate{CanonicalHashID: members[0], VariantHashID: variant})
+		}
+	}
+
+	sort.Slice(duplicates, func(i, j int) bool {
+		if duplicates[i].CanonicalHashID != duplicates[j].CanonicalHashID {
+			return duplicates[i].CanonicalHashID < duplicates[j].CanonicalHashID
+		}
+		return duplicates[i].VariantHashID < duplicates[j].VariantHashID
+	})
+	return duplicates
+}
+
+// publishedBefore orders articles by publication date, undated last, then by hash ID.
+func publishedBefore(a, b Article, hashIDA, hashIDB string) bool {
+	dateA, dateB := publishedAtOrZero(a), publishedAtOrZero(b)
+	if !dateA.Equal(dateB) {
+		if dateA.IsZero() || dateB.IsZero() {
+			return dateB.IsZero()
+		}
+		return dateA.Before(dateB)
+	}
+	return hashIDA < hashIDB
+}
+
+func publishedAtOrZero(a Article) time.Time {
+	if a.PublishedAt == nil {
+		return time.Time{}
+	}
+	return *a.PublishedAt
+}
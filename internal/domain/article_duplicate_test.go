@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharesAuthor(t *testing.T) {
+	assert.True(t, SharesAuthor("Andy Arditi, Neel Nanda", "neel nanda,wesg"))
+	assert.False(t, SharesAuthor("Andy Arditi", "Neel Nanda"))
+	assert.False(t, SharesAuthor("", ""), "empty author lists share no authors")
+}
+
+func TestIsDuplicateArticle(t *testing.T) {
+	config := DuplicateConfig{SimilarityThreshold: 0.9}
+	article := Article{HashID: "a", Title: "Refusal in LLMs", Authors: "Andy Arditi,Neel Nanda"}
+
+	cases := []struct {
+		name       string
+		other      Article
+		similarity float64
+		want       bool
+	}{
+		{
+			name:       "same_title_different_formatting",
+			other:      Article{HashID: "b", Title: "Refusal in LLMs.", Authors: "Someone Else"},
+			similarity: 0.95,
+			want:       true,
+		},
+		{
+			name:       "shared_author",
+			other:      Article{HashID: "b", Title: "Refusal is one direction", Authors: "Neel Nanda"},
+			similarity: 0.95,
+			want:       true,
+		},
+		{
+			name:       "below_threshold",
+			other:      Article{HashID: "b", Title: "Refusal in LLMs", Authors: "Neel Nanda"},
+			similarity: 0.85,
+		},
+		{
+			name:       "similar_but_unrelated",
+			other:      Article{HashID: "b", Title: "A response to refusal", Authors: "Someone Else"},
+			similarity: 0.97,
+		},
+		{
+			name:       "same_article",
+			other:      article,
+			similarity: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsDuplicateArticle(article, tc.other, tc.similarity, config))
+		})
+	}
+}
+
+func TestGroupDuplicateArticles(t *testing.T) {
+	date := func(day int) *time.Time {
+		d := time.Date(2024, 4, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	articles := map[string]Article{
+		"arxiv":   {PublishedAt: date(1)},
+		"af":      {PublishedAt: date(2)},
+		"lw":      {PublishedAt: date(2)},
+		"undated": {},
+		"blog":    {PublishedAt: date(10)},
+		"video":   {PublishedAt: date(5)},
+	}
+
+	pairs := []ArticleDuplicate{
+		// The arXiv paper's AF post and its LW crosspost, found from different sides
+		{CanonicalHashID: "lw", VariantHashID: "af"},
+		{CanonicalHashID: "af", VariantHashID: "arxiv"},
+		{CanonicalHashID: "undated", VariantHashID: "lw"},
+		// A separate work
+		{CanonicalHashID: "blog", VariantHashID: "video"},
+		{CanonicalHashID: "video", VariantHashID: "blog"},
+	}
+
+	assert.Equal(t, []ArticleDuplicate{
+		{CanonicalHashID: "arxiv", VariantHashID: "af"},
+		{CanonicalHashID: "arxiv", VariantHashID: "lw"},
+		{CanonicalHashID: "arxiv", VariantHashID: "undated"},
+		{CanonicalHashID: "video", VariantHashID: "blog"},
+	}, GroupDuplicateArticles(pairs, articles))
+
+	assert.Empty(t, GroupDuplicateArticles(nil, articles))
+}
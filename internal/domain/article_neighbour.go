@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+// ArticleCoLike is a pair of articles along with the number of users who gave both a thumbs up.
+type ArticleCoLike struct {
+	HashID          string
+	NeighbourHashID string
+	Count           int64
+}
+
+// ArticleNeighbour is an article that users who liked another article also liked,
+// scored by the cosine similarity of the two articles' likes.
+type ArticleNeighbour struct {
+	HashID          string
+	NeighbourHashID string
+	CoLikeCount     int64
+	Score           float64
+}
+
+// NeighbourConfig controls how item-item collaborative filtering neighbours are computed.
+type NeighbourConfig struct {
+	// NeighboursPerArticle is how many of the most similar neighbours are kept per article.
+	NeighboursPerArticle int
+
+	// MinCoLikes is the number of users who must have liked both articles for them to be
+	// neighbours. Besides filtering noise, this stops a recommendation from revealing
+	// what a single other user liked.
+	MinCoLikes int64
+}
+
+// DefaultNeighbourConfig returns the default neighbour configuration.
+func DefaultNeighbourConfig() NeighbourConfig {
+	return NeighbourConfig{
+		NeighboursPerArticle: 20,
+		MinCoLikes:           3,
+	}
+}
+
+// ComputeArticleNeighbours scores co-liked article pairs by cosine similarity, treating each
+// article as the vector of users who liked it: co-likes / sqrt(likes(a) * likes(b)).
+// Pairs below the minimum co-likes are dropped, and only the top neighbours per article are kept.
+// Each pair is expected in both directions.
+func ComputeArticleNeighbours(
+	coLikes []ArticleCoLike, likeCounts map[string]int64, config NeighbourConfig,
+) []ArticleNeighbour {
+	byArticle := make(map[string][]ArticleNeighbour)
+	for _, co := range coLikes {
+		if co.Count < config.MinCoLikes {
+			continue
+		}
+		likesA, likesB := likeCounts[co.HashID], likeCounts[co.NeighbourHashID]
+		if likesA <= 0 || likesB <= 0 {
+			continue
+		}
+		byArticle[co.HashID] = append(byArticle[co.HashID], ArticleNeighbour{
+			HashID:          co.HashID,
+			NeighbourHashID: co.NeighbourHashID,
+			CoLikeCount:     co.Count,
+			Score:           float64(co.Count) / math.Sqrt(float64(likesA)*float64(likesB)),
+		})
+	}
+
+	hashIDs := make([]string, 0, len(byArticle))
+	for hashID := range byArticle {
+		hashIDs = append(hashIDs, hashID)
+	}
+	sort.Strings(hashIDs)
+
+	var neighbours []ArticleNeighbour
+	for _, hashID := range hashIDs {
+		candidates := byArticle[hashID]
+		sort.Slice(candidates, func(i, j int) bool {
+			if candidates[i].Score != candidates[j].Score {
+				return candidates[i].Score > candidates[j].Score
+			}
+			return candidates[i].NeighbourHashID < candidates[j].NeighbourHashID
+		})
+		if len(candidates) > config.NeighboursPerArticle {
+			candidates = candidates[:config.NeighboursPerArticle]
+		}
+		neighbours = append(neighbours, candidates...)
+	}
+
+	return neighbours
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeArticleNeighbours(t *testing.T) {
+	likeCounts := map[string]int64{"a": 4, "b": 9, "c": 4, "d": 1}
+	coLikes := []ArticleCoLike{
+		{HashID: "a", NeighbourHashID: "b", Count: 3},
+		{HashID: "b", NeighbourHashID: "a", Count: 3},
+		{HashID: "a", NeighbourHashID: "c", Count: 4},
+		{HashID: "c", NeighbourHashID: "a", Count: 4},
+		{HashID: "b", NeighbourHashID: "c", Count: 2},
+		{HashID: "c", NeighbourHashID: "b", Count: 2},
+		{HashID: "a", NeighbourHashID: "d", Count: 1},
+		{HashID: "d", NeighbourHashID: "a", Count: 1},
+	}
+
+	cases := []struct {
+		name   string
+		config NeighbourConfig
+		want   []ArticleNeighbour
+	}{
+		{
+			name:   "min_co_likes",
+			config: NeighbourConfig{NeighboursPerArticle: 10, MinCoLikes: 3},
+			want: []ArticleNeighbour{
+				{HashID: "a", NeighbourHashID: "c", CoLikeCount: 4, Score: 1},
+				{HashID: "a", NeighbourHashID: "b", CoLikeCount: 3, Score: 0.5},
+				{HashID: "b", NeighbourHashID: "a", CoLikeCount: 3, Score: 0.5},
+				{HashID: "c", NeighbourHashID: "a", CoLikeCount: 4, Score: 1},
+			},
+		},
+		{
+			name:   "top_n",
+			config: NeighbourConfig{NeighboursPerArticle: 1, MinCoLikes: 2},
+			want: []ArticleNeighbour{
+				{HashID: "a", NeighbourHashID: "c", CoLikeCount: 4, Score: 1},
+				{HashID: "b", NeighbourHashID: "a", CoLikeCount: 3, Score: 0.5},
+				{HashID: "c", NeighbourHashID: "a", CoLikeCount: 4, Score: 1},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ComputeArticleNeighbours(coLikes, likeCounts, tc.config)
+			require.Len(t, got, len(tc.want))
+			for i := range tc.want {
+				assert.Equal(t, tc.want[i].HashID, got[i].HashID, "index %d", i)
+				assert.Equal(t, tc.want[i].NeighbourHashID, got[i].NeighbourHashID, "index %d", i)
+				assert.Equal(t, tc.want[i].CoLikeCount, got[i].CoLikeCount, "index %d", i)
+				assert.InDelta(t, tc.want[i].Score, got[i].Score, 1e-9, "index %d", i)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// ArticleNote is a user's private markdown note on an article.
+// A note with a Quote is a highlight of that passage, optionally with commentary in Body.
+type ArticleNote struct {
+	ID            string    `json:"id"`
+	UserID        string    `json:"-"`
+	ArticleHashID string    `json:"article_hash_id"`
+	Body          string    `json:"body"`
+	Quote         string    `json:"quote,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
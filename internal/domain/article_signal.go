@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// MinArticleRating and MaxArticleRating bound a graded article rating.
+const (
+	MinArticleRating = 1
+	MaxArticleRating = 5
+)
+
+// MaxReportedTimeOnArticle is the most time on an article a client may report at once.
+const MaxReportedTimeOnArticle = 4 * time.Hour
+
+// ErrInvalidArticleRating is returned for a graded rating outside 1-5.
+var ErrInvalidArticleRating = errors.New("article rating must be between 1 and 5")
+
+// ErrInvalidArticleSignal is returned for an unknown implicit signal type or an out of range duration.
+var ErrInvalidArticleSignal = errors.New("invalid article signal")
+
+// ValidateArticleRating checks that a graded rating is between 1 and 5.
+func ValidateArticleRating(rating int) error {
+	if rating < MinArticleRating || rating > MaxArticleRating {
+		return ErrInvalidArticleRating
+	}
+	return nil
+}
+
+// ThumbsForRating returns the thumbs up and thumbs down matching a graded rating, so clients that
+// only understand thumbs keep seeing graded ratings: 4 and 5 are a thumbs up, 1 and 2 a thumbs down,
+// and 3 neither.
+func ThumbsForRating(rating int) (thumbsUp, thumbsDown bool) {
+	return rating >= 4, rating > 0 && rating <= 2
+}
+
+// ArticleSignalType is a kind of implicit signal of a user's interest in an article.
+type ArticleSignalType string
+
+const (
+	// ArticleSignalOpenedLink is reported by clients when the user opens the article's link.
+	ArticleSignalOpenedLink ArticleSignalType = "opened_link"
+	// ArticleSignalTimeOnArticle is reported by clients with time the user spent reading the article.
+	ArticleSignalTimeOnArticle ArticleSignalType = "time_on_article"
+	// ArticleSignalSavedToCollection is recorded when the user adds the article to a collection.
+	ArticleSignalSavedToCollection ArticleSignalType = "saved_to_collection"
+)
+
+// ArticleSignal is one implicit signal of a user's interest in an article.
+// Duration is only used by time on article signals, and accumulates across reports.
+type ArticleSignal struct {
+	Type     ArticleSignalType
+	Duration time.Duration
+}
+
+// Validate checks the signal type is known and a time on article duration is within range.
+func (s ArticleSignal) Validate() error {
+	switch s.Type {
+	case ArticleSignalOpenedLink, ArticleSignalSavedToCollection:
+		return nil
+	case ArticleSignalTimeOnArticle:
+		if s.Duration <= 0 || s.Duration > MaxReportedTimeOnArticle {
+			return fmt.Errorf("%w: time on article must be positive and at most %s",
+				ErrInvalidArticleSignal, MaxReportedTimeOnArticle)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidArticleSignal, s.Type)
+	}
+}
+
+// ArticleSignals are everything known about how a user feels about an article,
+// from explicit ratings and implicit engagement.
+type ArticleSignals struct {
+	ThumbsUp          bool
+	ThumbsDown        bool
+	Rating            int // 1-5, or 0 if not given a graded rating
+	OpenedLink        bool
+	TimeOnArticle     time.Duration
+	SavedToCollection bool
+}
+
+// IsZero reports whether nothing is known about the signals, as for ratings loaded without them.
+func (s ArticleSignals) IsZero() bool {
+	return s == ArticleSignals{}
+}
+
+// SignalWeights controls how strongly each signal counts when weighting a user's article vectors.
+// Weights are between 0 and 1.
+type SignalWeights struct {
+	// ThumbsUp and ThumbsDown weigh a thumbs rating given without a graded rating.
+	ThumbsUp   float64
+	ThumbsDown float64
+
+	// Ratings maps each graded rating to its weight: positive for interest and negative for
+	// disinterest. Ratings take precedence over the thumbs they imply.
+	Ratings map[int]float64
+
+	// OpenedLink, TimeOnArticle and SavedToCollection are implicit signals, added to any positive
+	// explicit weight. TimeOnArticle scales linearly up to TimeOnArticleSaturation.
+	OpenedLink              float64
+	TimeOnArticle           float64
+	TimeOnArticleSaturation time.Duration
+	SavedToCollection       float64
+}
+
+// DefaultSignalWeights returns the default signal weights. Implicit signals alone never
+// outweigh a 5 rating, and a plain thumbs up counts the same as a 4.
+func DefaultSignalWeights() SignalWeights {
+	return SignalWeights{
+		ThumbsUp:   0.7,
+		ThumbsDown: 0.7,
+		Ratings: map[int]float64{
+			1: -1.0,
+			2: -0.7,
+			3: 0,
+			4: 0.7,
+			5: 1.0,
+		},
+		OpenedLink:              0.1,
+		TimeOnArticle:           0.3,
+		TimeOnArticleSaturation: 5 * time.Minute,
+		SavedToCollection:       0.3,
+	}
+}
+
+// Positive returns the strength of a user's interest in an article, from 0 to 1.
+// Articles the user rated negatively have no positive strength, whatever their engagement.
+func (w SignalWeights) Positive(s ArticleSignals) float64 {
+	var explicit float64
+	switch {
+	case s.Rating != 0:
+		explicit = w.Ratings[s.Rating]
+	case s.ThumbsUp:
+		explicit = w.ThumbsUp
+	case s.ThumbsDown:
+		explicit = -w.ThumbsDown
+	}
+	if explicit < 0 {
+		return 0
+	}
+
+	implicit := 0.0
+	if s.OpenedLink {
+		implicit += w.OpenedLink
+	}
+	if s.TimeOnArticle > 0 && w.TimeOnArticleSaturation > 0 {
+		implicit += w.TimeOnArticle * math.Min(1, float64(s.TimeOnArticle)/float64(w.TimeOnArticleSaturation))
+	}
+	if s.SavedToCollection {
+		implicit += w.SavedToCollection
+	}
+
+	return math.Min(1, explicit+implicit)
+}
+
+// Negative returns the strength of a user's disinterest in an article, from 0 to 1.
+func (w SignalWeights) Negative(s ArticleSignals) float64 {
+	switch {
+	case s.Rating != 0:
+		return math.Max(0, -w.Ratings[s.Rating])
+	case s.ThumbsDown:
+		return w.ThumbsDown
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThumbsForRating(t *testing.T) {
+	cases := []struct {
+		rating   int
+		wantUp   bool
+		wantDown bool
+	}{
+		{rating: 0},
+		{rating: 1, wantDown: true},
+		{rating: 2, wantDown: true},
+		{rating: 3},
+		{rating: 4, wantUp: true},
+		{rating: 5, wantUp: true},
+	}
+
+	for _, tc := range cases {
+		up, down := ThumbsForRating(tc.rating)
+		assert.Equal(t, tc.wantUp, up, "rating %d thumbs up", tc.rating)
+		assert.Equal(t, tc.wantDown, down, "rating %d thumbs down", tc.rating)
+	}
+}
+
+func TestArticleSignal_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		signal  ArticleSignal
+		wantErr bool
+	}{
+		{name: "opened_link", signal: ArticleSignal{Type: ArticleSignalOpenedLink}},
+		{name: "saved_to_collection", signal: ArticleSignal{Type: ArticleSignalSavedToCollection}},
+		{name: "time_on_article", signal: ArticleSignal{Type: ArticleSignalTimeOnArticle, Duration: time.Minute}},
+		{name: "time_on_article_zero", signal: ArticleSignal{Type: ArticleSignalTimeOnArticle}, wantErr: true},
+		{
+			name:    "time_on_article_too_long",
+			signal:  ArticleSignal{Type: ArticleSignalTimeOnArticle, Duration: MaxReportedTimeOnArticle + time.Second},
+			wantErr: true,
+		},
+		{name: "unknown_type", signal: ArticleSignal{Type: "shared"}, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.signal.Validate()
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidArticleSignal)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSignalWeights_Positive(t *testing.T) {
+	weights := DefaultSignalWeights()
+
+	cases := []struct {
+		name     string
+		signals  ArticleSignals
+		expected float64
+	}{
+		{name: "no_signals", signals: ArticleSignals{}, expected: 0},
+		{name: "thumbs_up", signals: ArticleSignals{ThumbsUp: true}, expected: 0.7},
+		{name: "rating_5", signals: ArticleSignals{ThumbsUp: true, Rating: 5}, expected: 1.0},
+		{name: "rating_3_opened", signals: ArticleSignals{Rating: 3, OpenedLink: true}, expected: 0.1},
+		{name: "opened_link", signals: ArticleSignals{OpenedLink: true}, expected: 0.1},
+		{name: "half_saturated_read", signals: ArticleSignals{TimeOnArticle: 150 * time.Second}, expected: 0.15},
+		{
+			name:     "all_implicit_signals",
+			signals:  ArticleSignals{OpenedLink: true, TimeOnArticle: time.Hour, SavedToCollection: true},
+			expected: 0.7,
+		},
+		{
+			name:     "capped_at_one",
+			signals:  ArticleSignals{ThumbsUp: true, Rating: 5, OpenedLink: true, SavedToCollection: true},
+			expected: 1.0,
+		},
+		{
+			name:     "thumbs_down_ignores_engagement",
+			signals:  ArticleSignals{ThumbsDown: true, OpenedLink: true, SavedToCollection: true},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, weights.Positive(tc.signals), 0.0001)
+		})
+	}
+}
+
+func TestSignalWeights_Negative(t *testing.T) {
+	weights := DefaultSignalWeights()
+
+	cases := []struct {
+		name     string
+		signals  ArticleSignals
+		expected float64
+	}{
+		{name: "no_signals", signals: ArticleSignals{}, expected: 0},
+		{name: "thumbs_down", signals: ArticleSignals{ThumbsDown: true}, expected: 0.7},
+		{name: "rating_1", signals: ArticleSignals{ThumbsDown: true, Rating: 1}, expected: 1.0},
+		{name: "rating_2", signals: ArticleSignals{ThumbsDown: true, Rating: 2}, expected: 0.7},
+		{name: "rating_4", signals: ArticleSignals{ThumbsUp: true, Rating: 4}, expected: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, weights.Negative(tc.signals), 0.0001)
+		})
+	}
+}
+
+func TestUserArticleRating_Weight(t *testing.T) {
+	weights := DefaultSignalWeights()
+
+	assert.InDelta(t, 1.0, UserArticleRating{RatingType: RatingTypeThumbsUp}.Weight(weights), 0.0001,
+		"ratings loaded without signals count fully")
+	assert.InDelta(t, 0.7, UserArticleRating{
+		RatingType: RatingTypeThumbsUp,
+		Signals:    ArticleSignals{ThumbsUp: true},
+	}.Weight(weights), 0.0001)
+	assert.InDelta(t, 1.0, UserArticleRating{
+		RatingType: RatingTypeThumbsDown,
+		Signals:    ArticleSignals{ThumbsDown: true, Rating: 1},
+	}.Weight(weights), 0.0001)
+}
+
+func TestUserArticleRating_IsExplicit(t *testing.T) {
+	assert.True(t, UserArticleRating{}.IsExplicit(), "ratings loaded without signals are explicit")
+	assert.True(t, UserArticleRating{Signals: ArticleSignals{ThumbsUp: true, OpenedLink: true}}.IsExplicit())
+	assert.True(t, UserArticleRating{Signals: ArticleSignals{Rating: 4}}.IsExplicit())
+	assert.False(t, UserArticleRating{Signals: ArticleSignals{OpenedLink: true, SavedToCollection: true}}.IsExplicit())
+	assert.False(t, UserArticleRating{Signals: ArticleSignals{TimeOnArticle: time.Minute}}.IsExplicit())
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// AuditEventType identifies what happened in an audit event.
+type AuditEventType string
+
+const (
+	AuditEventAPITokenCreated      AuditEventType = "api_token.created"
+	AuditEventAPITokenRevoked      AuditEventType = "api_token.revoked"
+	AuditEventAPITokenRotated      AuditEventType = "api_token.rotated"
+	AuditEventAPITokenScopeDenied  AuditEventType = "api_token.scope_denied"
+	AuditEventAuthenticationFailed AuditEventType = "auth.failed"
+)
+
+// AuditOutcome records whether the audited action succeeded.
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+	AuditOutcomeDenied  AuditOutcome = "denied"
+)
+
+// MaxAuditUserAgentLength and MaxAuditDetailLength match the audit_events column sizes.
+const (
+	MaxAuditUserAgentLength = 512
+	MaxAuditDetailLength    = 255
+)
+
+// AuditEvent is an append-only record of a security-relevant event on an account.
+// UserID is empty for failed authentication that couldn't be attributed to an account.
+type AuditEvent struct {
+	ID        int64          `json:"id"`
+	UserID    string         `json:"-"`
+	Type      AuditEventType `json:"type"`
+	Outcome   AuditOutcome   `json:"outcome"`
+	TokenID   *string        `json:"token_id,omitempty"`
+	IPAddress string         `json:"ip_address"`
+	UserAgent string         `json:"user_agent"`
+	Detail    string         `json:"detail,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// NewAuditEvent creates an event for the request in ctx, taking the user, API token
+// and client details from the context. Long user agents and details are truncated.
+func NewAuditEvent(ctx context.Context, eventType AuditEventType, outcome AuditOutcome, detail string) AuditEvent {
+	client := ClientInfoFromContext(ctx)
+	event := AuditEvent{
+		UserID:    UserIDFromContext(ctx),
+		Type:      eventType,
+		Outcome:   outcome,
+		IPAddress: client.IPAddress,
+		UserAgent: truncate(client.UserAgent, MaxAuditUserAgentLength),
+		Detail:    truncate(detail, MaxAuditDetailLength),
+	}
+	if tokenID := APITokenIDFromContext(ctx); tokenID != "" {
+		event.TokenID = &tokenID
+	}
+	return event
+}
+
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	// Drop any multi-byte character cut in half
+	return strings.ToValidUTF8(s[:maxLength], "")
+}
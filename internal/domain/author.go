@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MaxAuthorNameLength is the maximum length of an author name, matching the author columns' size.
+// Longer names are almost always several names run together, and are skipped.
+const MaxAuthorNameLength = 255
+
+// Author is a person credited on articles, with the other spellings of their name
+// found in article author lists.
+type Author struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Aliases      []string `json:"aliases,omitempty"`
+	ArticleCount int64    `json:"article_count"`
+}
+
+// AuthorRef is an author as credited on an article.
+type AuthorRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ArticleAuthor links an author to an article they're credited on, at their position in
+// the article's author list.
+type ArticleAuthor struct {
+	ArticleHashID string
+	AuthorID      string
+	Position      int
+}
+
+// CoAuthor is an author who has written articles with another, and how many.
+type CoAuthor struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	SharedArticles int64  `json:"shared_articles"`
+}
+
+// SimilarAuthor is an author whose articles are similar to another's, scored by the summed
+// similarity of their articles to the other author's centroid.
+type SimilarAuthor struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// ArticleAuthorList is an article's free-text comma-separated author list.
+type ArticleAuthorList struct {
+	HashID  string
+	Authors string
+}
+
+// ParseAuthorNames splits a free-text author list into names, separated by commas, semicolons,
+// "&" or "and", collapsing whitespace and dropping empties, "et al." and duplicates.
+func ParseAuthorNames(authors string) []string {
+	replacer := strings.NewReplacer(";", ",", " & ", ",", " and ", ",")
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(replacer.Replace(authors), ",") {
+		name := strings.Join(strings.Fields(part), " ")
+		key := NormalizeAuthorName(name)
+		if key == "" || key == "et al" || len(name) > MaxAuthorNameLength || seen[key] {
+			continue
+		}
+		seen[key] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// NormalizeAuthorName lower-cases a name and reduces it to words separated by single spaces,
+// so "N. Nanda" and "n nanda" compare equal.
+func NormalizeAuthorName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '-'
+	}), " ")
+}
+
+// AuthorIDFromName returns the ID of the author with the given canonical name: its normalized
+// words joined by hyphens, such as "neel-nanda".
+func AuthorIDFromName(name string) string {
+	return strings.ReplaceAll(NormalizeAuthorName(name), " ", "-")
+}
+
+// authorNameGroup is the spellings of one author's name, and how often each is credited.
+type authorNameGroup struct {
+	first, last string
+	counts      map[string]int
+}
+
+// ExtractAuthors parses articles' author lists into authors and the articles each is credited on.
+//
+// Spellings of a name are merged into one author when they normalize to the same first and last
+// words, such as "Neel Nanda" and "Neel N. Nanda". A name given with only an initial, such as
+// "N. Nanda", is merged into the one author with a matching full first name, if there is exactly
+// one. Each author's name is their most often credited full spelling, and the rest are aliases.
+//
+// existingIDs maps the names and aliases of previously extracted authors to their IDs. An author
+// keeps the existing ID most of their credits map to, so IDs stay the same when the most often
+// credited spelling changes; only authors with no existing ID are given one from their name.
+func ExtractAuthors(lists []ArticleAuthorList, existingIDs map[string]string) ([]Author, []ArticleAuthor) {
+	groups := make(map[string]*authorNameGroup)
+	for _, list := range lists {
+		for _, name := range ParseAuthorNames(list.Authors) {
+			first, last := nameSignature(name)
+			key := first + " " + last
+			group, ok := groups[key]
+			if !ok {
+				group = &authorNameGroup{first: first, last: last, counts: make(map[string]int)}
+				groups[key] = group
+			}
+			group.counts[name]++
+		}
+	}
+
+	mergeInitialedNames(groups)
+
+	ids := make(map[*authorNameGroup]string)
+	authorsByID := make(map[string]*Author)
+	for _, group := range sortedGroups(groups) {
+		names := sortedNames(group.counts)
+		id := existingAuthorID(group.counts, existingIDs, authorsByID)
+		if id == "" {
+			id = AuthorIDFromName(names[0])
+		}
+		ids[group] = id
+		if existing, ok := authorsByID[id]; ok {
+			existing.Aliases = append(existing.Aliases, names...)
+			continue
+		}
+		author := &Author{ID: id, Name: names[0]}
+		if len(names) > 1 {
+			author.Aliases = names[1:]
+		}
+		authorsByID[id] = author
+	}
+
+	authors := make([]Author, 0, len(authorsByID))
+	for _, author := range authorsByID {
+		sort.Strings(author.Aliases)
+		authors = append(authors, *author)
+	}
+	sort.Slice(authors, func(i, j int) bool { return authors[i].ID < authors[j].ID })
+
+	var links []ArticleAuthor
+	for _, list := range lists {
+		seen := make(map[string]bool)
+		for _, name := range ParseAuthorNames(list.Authors) {
+			first, last := nameSignature(name)
+			id := ids[groups[first+" "+last]]
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			links = append(links, ArticleAuthor{ArticleHashID: list.HashID, AuthorID: id, Position: len(seen) - 1})
+		}
+	}
+
+	return authors, links
+}
+
+// AuthorIDRenames maps each existing author ID no author keeps to the ID of the author who now
+// has its names, so references to it, such as follows, can be moved. When its names are split
+// between several authors, the one with the lowest ID is chosen.
+func AuthorIDRenames(existingIDs map[string]string, authors []Author) map[string]string {
+	kept := make(map[string]bool, len(authors))
+	for _, author := range authors {
+		kept[author.ID] = true
+	}
+
+	renames := make(map[string]string)
+	for _, author := range authors {
+		for _, name := range append([]string{author.Name}, author.Aliases...) {
+			oldID, ok := existingIDs[name]
+			if !ok || kept[oldID] {
+				continue
+			}
+			if newID, ok := renames[oldID]; !ok || author.ID < newID {
+				renames[oldID] = author.ID
+			}
+		}
+	}
+	return renames
+}
+
+// nameSignature returns the normalized first and last words of a name, ignoring middle names.
+func nameSignature(name string) (string, string) {
+	words := strings.Fields(NormalizeAuthorName(name))
+	return words[0], words[len(words)-1]
+}
+
+// mergeInitialedNames points the groups of names given with only a first initial at the group
+// with a matching full first name and last name, where there is exactly one.
+func mergeInitialedNames(groups map[string]*authorNameGroup) {
+	full := make(map[string][]*authorNameGroup)
+	for _, group := range groups {
+		if len([]rune(group.first)) > 1 && group.first != group.last {
+			initial := string([]rune(group.first)[0])
+			full[initial+" "+group.last] = append(full[initial+" "+group.last], group)
+		}
+	}
+
+	for key, group := range groups {
+		if len([]rune(group.first)) != 1 || group.first == group.last {
+			continue
+		}
+		matches := full[key]
+		if len(matches) != 1 {
+			continue
+		}
+		for name, count := range group.counts {
+			matches[0].counts[name] += count
+		}
+		groups[key] = matches[0]
+	}
+}
+
+// sortedGroups returns each distinct name group once, the most often credited first, so that
+// if two authors' credits map to the same existing ID, the one credited more keeps it.
+func sortedGroups(groups map[string]*authorNameGroup) []*authorNameGroup {
+	seen := make(map[*authorNameGroup]bool)
+	var sorted []*authorNameGroup
+	for _, group := range groups {
+		if !seen[group] {
+			seen[group] = true
+			sorted = append(sorted, group)
+		}
+	}
+
+	total := func(group *authorNameGroup) int {
+		sum := 0
+		for _, count := range group.counts {
+			sum += count
+		}
+		return sum
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if ti, tj := total(sorted[i]), total(sorted[j]); ti != tj {
+			return ti > tj
+		}
+		return sorted[i].first+" "+sorted[i].last < sorted[j].first+" "+sorted[j].last
+	})
+	return sorted
+}
+
+// existingAuthorID returns the existing ID most of a name group's credits map to, leaving out IDs
+// already claimed by another author, or an empty string if there is none.
+func existingAuthorID(counts map[string]int, existingIDs map[string]string, claimed map[string]*Author) string {
+	credits := make(map[string]int)
+	for name, count := range counts {
+		if id, ok := existingIDs[name]; ok && claimed[id] == nil {
+			credits[id] += count
+		}
+	}
+
+	best := ""
+	for id, count := range credits {
+		if best == "" || count > credits[best] || (count == credits[best] && id < best) {
+			best = id
+		}
+	}
+	return best
+}
+
+// sortedNames returns the spellings of a name with the most often credited first, preferring
+// full first names over initials, then longer spellings.
+func sortedNames(counts map[string]int) []string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		a, b := names[i], names[j]
+		if initialA, initialB := hasInitialFirstName(a), hasInitialFirstName(b); initialA != initialB {
+			return initialB
+		}
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a < b
+	})
+	return names
+}
+
+func hasInitialFirstName(name string) bool {
+	first, last := nameSignature(name)
+	return len([]rune(first)) == 1 && first != last
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAuthorNames(t *testing.T) {
+	cases := []struct {
+		name    string
+		authors string
+		want    []string
+	}{
+		{
+			name:    "comma_separated",
+			authors: "Andy Arditi,  Oscar Obeso ,Neel Nanda",
+			want:    []string{"Andy Arditi", "Oscar Obeso", "Neel Nanda"},
+		},
+		{
+			name:    "other_separators",
+			authors: "Evan Hubinger; Paul Christiano & Jan Leike and Chris Olah",
+			want:    []string{"Evan Hubinger", "Paul Christiano", "Jan Leike", "Chris Olah"},
+		},
+		{
+			name:    "duplicates_and_et_al_dropped",
+			authors: "Neel Nanda, neel nanda, , et al.",
+			want:    []string{"Neel Nanda"},
+		},
+		{
+			name:    "empty",
+			authors: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ParseAuthorNames(tc.authors))
+		})
+	}
+}
+
+func TestAuthorIDFromName(t *testing.T) {
+	assert.Equal(t, "neel-nanda", AuthorIDFromName("Neel Nanda"))
+	assert.Equal(t, "jean-luc-picard", AuthorIDFromName(" Jean-Luc  Picard."))
+	assert.Equal(t, "andrés-garcía", AuthorIDFromName("Andrés García"))
+}
+
+func TestExtractAuthors(t *testing.T) {
+	authors, links := ExtractAuthors([]ArticleAuthorList{
+		{HashID: "a1", Authors: "Neel Nanda, Andy Arditi"},
+		{HashID: "a2", Authors: "N. Nanda, Neel N. Nanda"},
+		{HashID: "a3", Authors: "Neel Nanda, J. Smith"},
+		{HashID: "a4", Authors: "John Smith, Jane Smith"},
+		{HashID: "a5", Authors: ""},
+	}, nil)
+
+	assert.Equal(t, []Author{
+		{ID: "andy-arditi", Name: "Andy Arditi"},
+		// Ambiguous between John and Jane, so left as its own author
+		{ID: "j-smith", Name: "J. Smith"},
+		{ID: "jane-smith", Name: "Jane Smith"},
+		{ID: "john-smith", Name: "John Smith"},
+		{ID: "neel-nanda", Name: "Neel Nanda", Aliases: []string{"N. Nanda", "Neel N. Nanda"}},
+	}, authors)
+
+	assert.Equal(t, []ArticleAuthor{
+		{ArticleHashID: "a1", AuthorID: "neel-nanda", Position: 0},
+		{ArticleHashID: "a1", AuthorID: "andy-arditi", Position: 1},
+		// Both spellings are the same author, credited once
+		{ArticleHashID: "a2", AuthorID: "neel-nanda", Position: 0},
+		{ArticleHashID: "a3", AuthorID: "neel-nanda", Position: 0},
+		{ArticleHashID: "a3", AuthorID: "j-smith", Position: 1},
+		{ArticleHashID: "a4", AuthorID: "john-smith", Position: 0},
+		{ArticleHashID: "a4", AuthorID: "jane-smith", Position: 1},
+	}, links)
+}
+
+func TestExtractAuthors_KeepsExistingIDs(t *testing.T) {
+	authors, links := ExtractAuthors([]ArticleAuthorList{
+		{HashID: "a1", Authors: "Neel Nanda, John Smith"},
+		{HashID: "a2", Authors: "Neel Nanda, John Smith, Jane Smith"},
+		{HashID: "a3", Authors: "Neel N. Nanda, Andy Arditi"},
+	}, map[string]string{
+		"Neel N. Nanda": "neel-n-nanda",
+		"Neel Nanda":    "neel-n-nanda",
+		// Previously merged, so both claim the same ID
+		"John Smith": "smith",
+		"Jane Smith": "smith",
+	})
+
+	assert.Equal(t, []Author{
+		{ID: "andy-arditi", Name: "Andy Arditi"},
+		// Split from John, who is credited more and keeps the ID
+		{ID: "jane-smith", Name: "Jane Smith"},
+		// Renamed to the now most credited spelling, keeping the ID
+		{ID: "neel-n-nanda", Name: "Neel Nanda", Aliases: []string{"Neel N. Nanda"}},
+		{ID: "smith", Name: "John Smith"},
+	}, authors)
+
+	assert.Equal(t, []ArticleAuthor{
+		{ArticleHashID: "a1", AuthorID: "neel-n-nanda", Position: 0},
+		{ArticleHashID: "a1", AuthorID: "smith", Position: 1},
+		{ArticleHashID: "a2", AuthorID: "neel-n-nanda", Position: 0},
+		{ArticleHashID: "a2", AuthorID: "smith", Position: 1},
+		{ArticleHashID: "a2", AuthorID: "jane-smith", Position: 2},
+		{ArticleHashID: "a3", AuthorID: "neel-n-nanda", Position: 0},
+		{ArticleHashID: "a3", AuthorID: "andy-arditi", Position: 1},
+	}, links)
+}
+
+func TestAuthorIDRenames(t *testing.T) {
+	renames := AuthorIDRenames(map[string]string{
+		"Neel Nanda":    "neel-nanda",
+		"N. Nanda":      "n-nanda",
+		"John Smith":    "smith",
+		"Jane Smith":    "smith",
+		"Andy Arditi":   "andy-arditi",
+		"Former Author": "former-author",
+	}, []Author{
+		{ID: "andy-arditi", Name: "Andy Arditi"},
+		{ID: "jane-smith", Name: "Jane Smith"},
+		{ID: "john-smith", Name: "John Smith"},
+		{ID: "neel-nanda", Name: "Neel Nanda", Aliases: []string{"N. Nanda"}},
+	})
+
+	assert.Equal(t, map[string]string{
+		// Merged into another author
+		"n-nanda": "neel-nanda",
+		// Split between authors, so the lowest ID is chosen
+		"smith": "jane-smith",
+	}, renames)
+}
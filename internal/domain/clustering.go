@@ -19,6 +19,10 @@ type ClusterConfig struct {
 
 	// ConvergenceThreshold is the minimum centroid movement to continue iterating.
 	ConvergenceThreshold float64
+
+	// SignalWeights weights each liked article by the strength of the user's signals,
+	// so strongly liked articles pull cluster centroids further.
+	SignalWeights SignalWeights
 }
 
 // DefaultClusterConfig returns the default clustering configuration.
@@ -28,6 +32,7 @@ func DefaultClusterConfig() ClusterConfig {
 		MinArticlesForClustering: 6,
 		MaxIterations:            50,
 		ConvergenceThreshold:     0.0001,
+		SignalWeights:            DefaultSignalWeights(),
 	}
 }
 
@@ -41,12 +46,24 @@ type ClusterResult struct {
 // Returns cluster centroids and assignments (which cluster each point belongs to).
 // If data is empty or k is 0, returns nil results.
 func KMeans(data [][]float32, k int, config ClusterConfig, rng *rand.Rand) ClusterResult {
+	return WeightedKMeans(data, nil, k, config, rng)
+}
+
+// WeightedKMeans performs k-means clustering where each point counts in proportion to its weight,
+// both when seeding centroids and when recomputing them. Nil weights count every point equally.
+func WeightedKMeans(data [][]float32, weights []float64, k int, config ClusterConfig, rng *rand.Rand) ClusterResult {
 	if len(data) == 0 || k == 0 {
 		return ClusterResult{}
 	}
+	if weights == nil {
+		weights = make([]float64, len(data))
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
 
 	// Initialize centroids using k-means++ algorithm
-	centroids := initializeCentroidsKMeansPlusPlus(data, k, rng)
+	centroids := initializeCentroidsKMeansPlusPlus(data, weights, k, rng)
 	assignments := make([]int, len(data))
 
 	for range config.MaxIterations {
@@ -54,7 +71,7 @@ func KMeans(data [][]float32, k int, config ClusterConfig, rng *rand.Rand) Clust
 		assignPointsToCentroids(data, centroids, assignments)
 
 		// Update step: recompute centroids
-		newCentroids := recomputeCentroids(data, assignments, k, centroids)
+		newCentroids := recomputeCentroids(data, weights, assignments, k, centroids)
 
 		// Check convergence
 		if maxCentroidMovement(centroids, newCentroids) < config.ConvergenceThreshold {
@@ -78,35 +95,37 @@ func assignPointsToCentroids(data [][]float32, centroids [][]float32, assignment
 	}
 }
 
-// recomputeCentroids calculates new centroid positions based on current assignments.
+// recomputeCentroids calculates new centroid positions based on current assignments,
+// as the weighted mean of each cluster's points.
 func recomputeCentroids(
 	data [][]float32,
+	weights []float64,
 	assignments []int,
 	k int,
 	oldCentroids [][]float32,
 ) [][]float32 {
 	dim := len(data[0])
 	newCentroids := make([][]float32, k)
-	counts := make([]int, k)
+	totals := make([]float64, k)
 
 	for i := range newCentroids {
 		newCentroids[i] = make([]float32, dim)
 	}
 
-	// Sum up points for each cluster
+	// Sum up weighted points for each cluster
 	for i, point := range data {
 		cluster := assignments[i]
-		counts[cluster]++
+		totals[cluster] += weights[i]
 		for j, val := range point {
-			newCentroids[cluster][j] += val
+			newCentroids[cluster][j] += float32(weights[i]) * val
 		}
 	}
 
-	// Normalize centroids (or keep old centroid for empty clusters)
+	// Normalize centroids (or keep old centroid for empty or weightless clusters)
 	for i := range newCentroids {
-		if counts[i] > 0 {
+		if totals[i] > 0 {
 			for j := range newCentroids[i] {
-				newCentroids[i][j] /= float32(counts[i])
+				newCentroids[i][j] /= float32(totals[i])
 			}
 		} else {
 			newCentroids[i] = oldCentroids[i]
@@ -128,8 +147,9 @@ func maxCentroidMovement(oldCentroids, newCentroids [][]float32) float64 {
 	return maxMovement
 }
 
-// initializeCentroidsKMeansPlusPlus initializes centroids using k-means++ algorithm.
-func initializeCentroidsKMeansPlusPlus(data [][]float32, k int, rng *rand.Rand) [][]float32 {
+// initializeCentroidsKMeansPlusPlus initializes centroids using k-means++ algorithm,
+// with each point's chance of being chosen after the first scaled by its weight.
+func initializeCentroidsKMeansPlusPlus(data [][]float32, weights []float64, k int, rng *rand.Rand) [][]float32 {
 	n := len(data)
 	dim := len(data[0])
 	centroids := make([][]float32, k)
@@ -152,11 +172,11 @@ func initializeCentroidsKMeansPlusPlus(data [][]float32, k int, rng *rand.Rand)
 					minDist = dist
 				}
 			}
-			distances[j] = minDist
-			totalDist += minDist
+			distances[j] = minDist * weights[j]
+			totalDist += distances[j]
 		}
 
-		// Choose next centroid with probability proportional to distance squared
+		// Choose next centroid with probability proportional to weighted distance squared
 		target := rng.Float64() * totalDist
 		cumulative := float64(0)
 		chosenIdx := 0
@@ -191,6 +211,25 @@ func findNearestCentroid(point []float32, centroids [][]float32) int {
 	return minIdx
 }
 
+// NearestCentroid returns the index of the centroid nearest to point, or -1 if there are no centroids.
+func NearestCentroid(point []float32, centroids [][]float32) int {
+	if len(centroids) == 0 {
+		return -1
+	}
+	return findNearestCentroid(point, centroids)
+}
+
+// AddPointToCentroid returns the centroid of a cluster of count points after adding one more,
+// moving it towards the point by 1/(count+1): the running mean update of online k-means.
+func AddPointToCentroid(centroid []float32, count int, point []float32) []float32 {
+	step := 1 / float32(max(count, 0)+1)
+	moved := make([]float32, len(centroid))
+	for i := range centroid {
+		moved[i] = centroid[i] + step*(point[i]-centroid[i])
+	}
+	return moved
+}
+
 // EuclideanDistance computes the Euclidean distance between two vectors.
 func EuclideanDistance(a, b []float32) float64 {
 	return math.Sqrt(EuclideanDistanceSquared(a, b))
@@ -470,6 +470,36 @@ func TestFindNearestCentroid(t *testing.T) {
 	}
 }
 
+func TestNearestCentroid(t *testing.T) {
+	assert.Equal(t, -1, NearestCentroid([]float32{1.0, 1.0}, nil))
+	assert.Equal(t, 1, NearestCentroid([]float32{9.0, 9.0}, [][]float32{{0.0, 0.0}, {10.0, 10.0}}))
+}
+
+func TestAddPointToCentroid(t *testing.T) {
+	cases := []struct {
+		name     string
+		centroid []float32
+		count    int
+		point    []float32
+		want     []float32
+	}{
+		{name: "empty_cluster", centroid: []float32{0.0, 0.0}, count: 0, point: []float32{2.0, 4.0},
+			want: []float32{2.0, 4.0}},
+		{name: "one_point", centroid: []float32{0.0, 0.0}, count: 1, point: []float32{2.0, 4.0},
+			want: []float32{1.0, 2.0}},
+		{name: "three_points", centroid: []float32{1.0, 1.0}, count: 3, point: []float32{5.0, 1.0},
+			want: []float32{2.0, 1.0}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := AddPointToCentroid(tc.centroid, tc.count, tc.point)
+			assert.InDeltaSlice(t, tc.want, got, 0.0001)
+			assert.NotSame(t, &tc.centroid[0], &got[0], "centroid is not modified in place")
+		})
+	}
+}
+
 func TestKMeans_MaxIterationsRespected(t *testing.T) {
 	// Create a scenario where convergence is slow
 	config := ClusterConfig{
@@ -543,7 +573,8 @@ func TestKMeansPlusPlusInitialization(t *testing.T) {
 	}
 
 	rng := newTestRand(42)
-	centroids := initializeCentroidsKMeansPlusPlus(data, 3, rng)
+	weights := []float64{1, 1, 1, 1, 1, 1}
+	centroids := initializeCentroidsKMeansPlusPlus(data, weights, 3, rng)
 
 	require.Len(t, centroids, 3)
 
@@ -586,3 +617,30 @@ func TestKMeans_NaNHandling(t *testing.T) {
 		}
 	}
 }
+
+func TestWeightedKMeans(t *testing.T) {
+	data := [][]float32{
+		{0.0, 0.0},
+		{10.0, 0.0},
+	}
+	config := DefaultClusterConfig()
+
+	cases := []struct {
+		name     string
+		weights  []float64
+		expected []float32
+	}{
+		{name: "nil_weights_equal", weights: nil, expected: []float32{5.0, 0.0}},
+		{name: "heavier_point_pulls_centroid", weights: []float64{1, 3}, expected: []float32{7.5, 0.0}},
+		{name: "weightless_point_ignored", weights: []float64{0, 1}, expected: []float32{10.0, 0.0}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := WeightedKMeans(data, tc.weights, 1, config, newTestRand(42))
+
+			require.Len(t, result.Centroids, 1)
+			assert.InDeltaSlice(t, tc.expected, result.Centroids[0], 0.001)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Collection is a user's named, ordered reading list of articles.
+type Collection struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"-"`
+	Name         string    `json:"name"`
+	IsPublic     bool      `json:"is_public"`
+	ShareToken   string    `json:"-"`
+	ArticleCount int       `json:"article_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
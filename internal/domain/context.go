@@ -42,6 +42,7 @@ type AuthMethod string
 const (
 	AuthMethodNone     AuthMethod = ""
 	AuthMethodAuth0    AuthMethod = "auth0"
+	AuthMethodOIDC     AuthMethod = "oidc"
 	AuthMethodAPIToken AuthMethod = "api_token"
 )
 
@@ -58,3 +59,74 @@ func AuthMethodFromContext(ctx context.Context) AuthMethod {
 	}
 	return method.(AuthMethod)
 }
+
+const apiTokenScopesContextKey contextKey = "api_token_scopes"
+
+// ContextWithAPITokenScopes records the scopes of the API token that authenticated the request.
+func ContextWithAPITokenScopes(ctx context.Context, scopes []APITokenScope) context.Context {
+	return context.WithValue(ctx, apiTokenScopesContextKey, scopes)
+}
+
+// APITokenScopesFromContext returns the scopes of the API token that authenticated the request,
+// or nil if the request was not authenticated with an API token.
+func APITokenScopesFromContext(ctx context.Context) []APITokenScope {
+	scopes := ctx.Value(apiTokenScopesContextKey)
+	if scopes == nil {
+		return nil
+	}
+	return scopes.([]APITokenScope)
+}
+
+// HasAPITokenScope returns true unless the request was authenticated with an API token
+// that lacks the scope. Requests with other authentication methods are not scoped.
+func HasAPITokenScope(ctx context.Context, scope APITokenScope) bool {
+	if AuthMethodFromContext(ctx) != AuthMethodAPIToken {
+		return true
+	}
+	for _, s := range APITokenScopesFromContext(ctx) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+const apiTokenIDContextKey contextKey = "api_token_id"
+
+// ContextWithAPITokenID records the ID of the API token that authenticated the request.
+func ContextWithAPITokenID(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, apiTokenIDContextKey, tokenID)
+}
+
+// APITokenIDFromContext returns the ID of the API token that authenticated the request,
+// or an empty string if the request was not authenticated with an API token.
+func APITokenIDFromContext(ctx context.Context) string {
+	tokenID := ctx.Value(apiTokenIDContextKey)
+	if tokenID == nil {
+		return ""
+	}
+	return tokenID.(string)
+}
+
+// ClientInfo describes the client that made a request.
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+const clientInfoContextKey contextKey = "client_info"
+
+// ContextWithClientInfo records the client that made the request.
+func ContextWithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoContextKey, info)
+}
+
+// ClientInfoFromContext returns the client that made the request, or a zero ClientInfo
+// outside of a request.
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info := ctx.Value(clientInfoContextKey)
+	if info == nil {
+		return ClientInfo{}
+	}
+	return info.(ClientInfo)
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// DigestFrequency is how often a user receives an email digest.
+type DigestFrequency string
+
+const (
+	// DigestFrequencyDaily sends a digest once a day.
+	DigestFrequencyDaily DigestFrequency = "daily"
+	// DigestFrequencyWeekly sends a digest once a week.
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+// digestSendSlack is subtracted from the digest interval when checking whether
+// a digest is due, so a job scheduled at the same time each day doesn't skip
+// a day because the previous run finished a few minutes later.
+const digestSendSlack = time.Hour
+
+// ParseDigestFrequency validates a digest frequency string.
+func ParseDigestFrequency(s string) (DigestFrequency, error) {
+	switch f := DigestFrequency(s); f {
+	case DigestFrequencyDaily, DigestFrequencyWeekly:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown digest frequency: %s", s)
+	}
+}
+
+// Interval returns the time between digests for this frequency.
+func (f DigestFrequency) Interval() time.Duration {
+	switch f {
+	case DigestFrequencyDaily:
+		return 24 * time.Hour
+	case DigestFrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// DigestPreferences holds a user's email digest settings.
+// Digests are only sent to Email, which has been confirmed by its owner; a newly entered
+// address is held as PendingEmail until the link sent to it is followed.
+type DigestPreferences struct {
+	UserID                 string          `json:"-"`
+	Email                  string          `json:"email"`
+	PendingEmail           string          `json:"pending_email,omitempty"`
+	EmailConfirmationToken string          `json:"-"`
+	Frequency              DigestFrequency `json:"frequency"`
+	Categories             []string        `json:"categories"`
+	Enabled                bool            `json:"enabled"`
+	UnsubscribeToken       string          `json:"-"`
+	LastSentAt             *time.Time      `json:"last_sent_at,omitempty"`
+}
+
+// IsDue returns true if a digest should be sent to the user at the given time.
+func (p DigestPreferences) IsDue(now time.Time) bool {
+	if !p.Enabled || p.Email == "" {
+		return false
+	}
+	interval := p.Frequency.Interval()
+	if interval == 0 {
+		return false
+	}
+	if p.LastSentAt == nil {
+		return true
+	}
+	return !now.Before(p.LastSentAt.Add(interval - digestSendSlack))
+}
+
+// Since returns the start of the period a digest sent at the given time covers.
+func (p DigestPreferences) Since(now time.Time) time.Time {
+	if p.LastSentAt != nil {
+		return *p.LastSentAt
+	}
+	return now.Add(-p.Frequency.Interval())
+}
+
+// Digest is the content of a single email digest.
+type Digest struct {
+	Frequency       DigestFrequency
+	Recommended     []Article
+	NewInCategories []Article
+	UnsubscribeURL  string
+	GeneratedAt     time.Time
+}
+
+// IsEmpty returns true if the digest has no articles to send.
+func (d Digest) IsEmpty() bool {
+	return len(d.Recommended) == 0 && len(d.NewInCategories) == 0
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestPreferences_IsDue(t *testing.T) {
+	now := time.Date(2024, 6, 10, 8, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time {
+		ts := now.Add(-d)
+		return &ts
+	}
+
+	cases := []struct {
+		name  string
+		prefs DigestPreferences
+		want  bool
+	}{
+		{
+			name:  "never_sent",
+			prefs: DigestPreferences{Email: "a@example.com", Frequency: DigestFrequencyDaily, Enabled: true},
+			want:  true,
+		},
+		{
+			name: "disabled",
+			prefs: DigestPreferences{
+				Email: "a@example.com", Frequency: DigestFrequencyDaily, Enabled: false,
+			},
+			want: false,
+		},
+		{
+			name: "no_email",
+			prefs: DigestPreferences{
+				Frequency: DigestFrequencyDaily, Enabled: true,
+			},
+			want: false,
+		},
+		{
+			name: "unknown_frequency",
+			prefs: DigestPreferences{
+				Email: "a@example.com", Frequency: "hourly", Enabled: true,
+			},
+			want: false,
+		},
+		{
+			name: "daily_sent_yesterday_slightly_late",
+			prefs: DigestPreferences{
+				Email: "a@example.com", Frequency: DigestFrequencyDaily, Enabled: true,
+				LastSentAt: ago(24*time.Hour - 10*time.Minute),
+			},
+			want: true,
+		},
+		{
+			name: "daily_sent_this_morning",
+			prefs: DigestPreferences{
+				Email: "a@example.com", Frequency: DigestFrequencyDaily, Enabled: true,
+				LastSentAt: ago(2 * time.Hour),
+			},
+			want: false,
+		},
+		{
+			name: "weekly_sent_three_days_ago",
+			prefs: DigestPreferences{
+				Email: "a@example.com", Frequency: DigestFrequencyWeekly, Enabled: true,
+				LastSentAt: ago(3 * 24 * time.Hour),
+			},
+			want: false,
+		},
+		{
+			name: "weekly_sent_a_week_ago",
+			prefs: DigestPreferences{
+				Email: "a@example.com", Frequency: DigestFrequencyWeekly, Enabled: true,
+				LastSentAt: ago(7 * 24 * time.Hour),
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.prefs.IsDue(now))
+		})
+	}
+}
+
+func TestDigestPreferences_Since(t *testing.T) {
+	now := time.Date(2024, 6, 10, 8, 0, 0, 0, time.UTC)
+	lastSent := now.Add(-30 * time.Hour)
+
+	weekly := DigestPreferences{Frequency: DigestFrequencyWeekly}
+	assert.Equal(t, now.Add(-7*24*time.Hour), weekly.Since(now))
+
+	weekly.LastSentAt = &lastSent
+	assert.Equal(t, lastSent, weekly.Since(now))
+}
+
+func TestParseDigestFrequency(t *testing.T) {
+	f, err := ParseDigestFrequency("weekly")
+	assert.NoError(t, err)
+	assert.Equal(t, DigestFrequencyWeekly, f)
+
+	_, err = ParseDigestFrequency("monthly")
+	assert.Error(t, err)
+}
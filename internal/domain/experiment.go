@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"hash/fnv"
+	"time"
+)
+
+// RecommendationImpression records a recommended article being shown to a user.
+type RecommendationImpression struct {
+	UserID        string
+	ArticleHashID string
+	ExperimentArm string
+	Source        string
+	Position      int
+	ServedAt      time.Time
+}
+
+// ExperimentArmReport summarises how users in an experiment arm responded to their recommendations.
+// Each article counts once per user however often it was shown, and counts as read or liked
+// if the user read or liked it after it was first shown.
+type ExperimentArmReport struct {
+	Arm      string  `json:"arm"`
+	Users    int64   `json:"users"`
+	Shown    int64   `json:"shown"`
+	Read     int64   `json:"read"`
+	Liked    int64   `json:"liked"`
+	ReadRate float64 `json:"read_rate"`
+	LikeRate float64 `json:"like_rate"`
+}
+
+// NewExperimentArmReport builds a report for an arm from its counts, computing the rates.
+func NewExperimentArmReport(arm string, users, shown, read, liked int64) ExperimentArmReport {
+	report := ExperimentArmReport{Arm: arm, Users: users, Shown: shown, Read: read, Liked: liked}
+	if shown > 0 {
+		report.ReadRate = float64(read) / float64(shown)
+		report.LikeRate = float64(liked) / float64(shown)
+	}
+	return report
+}
+
+// ChooseExperimentArm deterministically buckets a user into one of an experiment's arms,
+// with each arm taking a share of users proportional to its weight. It returns the arm's
+// index, or -1 if no arm has a positive weight. The experiment name is hashed along with
+// the user ID, so starting a new experiment reshuffles users rather than reusing old buckets.
+func ChooseExperimentArm(experiment, userID string, weights []int) int {
+	var total uint32
+	for _, w := range weights {
+		if w > 0 {
+			total += uint32(w) //nolint:gosec // weights are small and positive
+		}
+	}
+	if total == 0 {
+		return -1
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(experiment))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(userID))
+	bucket := h.Sum32() % total
+
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if bucket < uint32(w) { //nolint:gosec // weights are small and positive
+			return i
+		}
+		bucket -= uint32(w) //nolint:gosec // weights are small and positive
+	}
+	return -1
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChooseExperimentArm(t *testing.T) {
+	cases := []struct {
+		name    string
+		weights []int
+		want    int
+	}{
+		{name: "no_arms", weights: nil, want: -1},
+		{name: "no_positive_weights", weights: []int{0, -1}, want: -1},
+		{name: "single_arm", weights: []int{1}, want: 0},
+		{name: "only_weighted_arm", weights: []int{0, 3, 0}, want: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ChooseExperimentArm("exp", "user1", tc.weights))
+		})
+	}
+}
+
+func TestChooseExperimentArm_Distribution(t *testing.T) {
+	counts := make([]int, 2)
+	for i := range 10000 {
+		userID := fmt.Sprintf("user-%d", i)
+		arm := ChooseExperimentArm("exp", userID, []int{1, 3})
+		counts[arm]++
+
+		// Bucketing is stable for the same user and experiment
+		assert.Equal(t, arm, ChooseExperimentArm("exp", userID, []int{1, 3}))
+	}
+
+	assert.InDelta(t, 2500, counts[0], 250)
+	assert.InDelta(t, 7500, counts[1], 250)
+}
+
+func TestNewExperimentArmReport(t *testing.T) {
+	report := NewExperimentArmReport("control", 3, 40, 10, 4)
+	assert.InDelta(t, 0.25, report.ReadRate, 1e-9)
+	assert.InDelta(t, 0.1, report.LikeRate, 1e-9)
+
+	empty := NewExperimentArmReport("control", 0, 0, 0, 0)
+	assert.Zero(t, empty.ReadRate)
+}
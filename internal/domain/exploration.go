@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"sort"
+)
+
+// CategoryExploration is how much a user has explored an article category: how many articles in it
+// they liked or engaged with, and how many exploration recommendations from it they were shown and
+// went on to read, like or engage with.
+type CategoryExploration struct {
+	Category       string
+	UserEngaged    int64
+	ExploreShown   int64
+	ExploreEngaged int64
+}
+
+// SampledCategory is a category chosen for exploration, with its sampled chance of engagement.
+type SampledCategory struct {
+	Category string
+	Score    float64
+}
+
+// ChooseExplorationCategories picks up to n categories to explore, best first, by Thompson sampling
+// on how the user received earlier exploration recommendations from each. Only categories in which the
+// user has liked or engaged with at most maxUserEngaged articles are eligible.
+//
+// Each category's chance of engagement is drawn from Beta(1+engaged, 1+ignored), so categories never
+// explored are sampled widely while ones that worked before are favoured and ones that didn't fade out.
+func ChooseExplorationCategories(
+	categories []CategoryExploration, maxUserEngaged int64, n int, rng *rand.Rand,
+) []SampledCategory {
+	if n <= 0 {
+		return nil
+	}
+
+	sampled := make([]SampledCategory, 0, len(categories))
+	for _, c := range categories {
+		if c.UserEngaged > maxUserEngaged {
+			continue
+		}
+		ignored := max(0, c.ExploreShown-c.ExploreEngaged)
+		sampled = append(sampled, SampledCategory{
+			Category: c.Category,
+			Score:    sampleBeta(1+c.ExploreEngaged, 1+ignored, rng),
+		})
+	}
+
+	sort.SliceStable(sampled, func(i, j int) bool {
+		return sampled[i].Score > sampled[j].Score
+	})
+
+	if len(sampled) > n {
+		sampled = sampled[:n]
+	}
+	return sampled
+}
+
+// sampleBeta draws from a Beta distribution with whole-number shape parameters,
+// as the ratio of two Gamma draws.
+func sampleBeta(alpha, beta int64, rng *rand.Rand) float64 {
+	x := sampleGamma(alpha, rng)
+	y := sampleGamma(beta, rng)
+	return x / (x + y)
+}
+
+// sampleGamma draws from a Gamma distribution with a whole-number shape and unit scale,
+// as the sum of that many exponential draws.
+func sampleGamma(shape int64, rng *rand.Rand) float64 {
+	var sum float64
+	for range shape {
+		sum += rng.ExpFloat64()
+	}
+	return sum
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChooseExplorationCategories(t *testing.T) {
+	cases := []struct {
+		name       string
+		categories []CategoryExploration
+		n          int
+		expected   []string
+		wantLen    int
+	}{
+		{
+			name:       "no_slots",
+			categories: []CategoryExploration{{Category: "governance"}},
+			n:          0,
+			expected:   nil,
+		},
+		{
+			name: "engaged_categories_not_explored",
+			categories: []CategoryExploration{
+				{Category: "governance", UserEngaged: 5},
+				{Category: "interpretability", UserEngaged: 1},
+			},
+			n:        2,
+			expected: []string{"interpretability"},
+		},
+		{
+			name: "successful_exploration_favoured",
+			categories: []CategoryExploration{
+				{Category: "ignored", ExploreShown: 40, ExploreEngaged: 0},
+				{Category: "engaging", ExploreShown: 40, ExploreEngaged: 30},
+			},
+			n:        1,
+			expected: []string{"engaging"},
+		},
+		{
+			name: "limited_to_n",
+			categories: []CategoryExploration{
+				{Category: "a"}, {Category: "b"}, {Category: "c"},
+			},
+			n:       2,
+			wantLen: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			chosen := ChooseExplorationCategories(tc.categories, 2, tc.n, newTestRand(42))
+
+			if tc.wantLen > 0 {
+				assert.Len(t, chosen, tc.wantLen)
+				return
+			}
+			var names []string
+			for _, c := range chosen {
+				names = append(names, c.Category)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestSampleBeta(t *testing.T) {
+	rng := newTestRand(42)
+
+	const samples = 2000
+	var sum float64
+	for range samples {
+		sample := sampleBeta(3, 1, rng)
+		require.GreaterOrEqual(t, sample, 0.0)
+		require.LessOrEqual(t, sample, 1.0)
+		sum += sample
+	}
+
+	// Beta(3, 1) has mean 3 / (3 + 1)
+	assert.InDelta(t, 0.75, sum/samples, 0.03)
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FollowType is the kind of thing a user follows.
+type FollowType string
+
+const (
+	// FollowTypeAuthor follows an author, by author ID.
+	FollowTypeAuthor FollowType = "author"
+	// FollowTypeSource follows a source, such as "arxiv" or "lesswrong".
+	FollowTypeSource FollowType = "source"
+	// FollowTypeCategory follows an article category.
+	FollowTypeCategory FollowType = "category"
+)
+
+// MaxFollowTargetLength is the maximum length of a followed author ID, source or category,
+// matching the target column's size.
+const MaxFollowTargetLength = 255
+
+// ParseFollowType validates a follow type string.
+func ParseFollowType(s string) (FollowType, error) {
+	switch t := FollowType(s); t {
+	case FollowTypeAuthor, FollowTypeSource, FollowTypeCategory:
+		return t, nil
+	default:
+		return "", fmt.Errorf("unknown follow type: %s", s)
+	}
+}
+
+// NormalizeFollowTarget trims a followed author ID, source or category, returning an error
+// if it is empty or too long.
+func NormalizeFollowTarget(target string) (string, error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return "", fmt.Errorf("follow target must not be empty")
+	}
+	if len(target) > MaxFollowTargetLength {
+		return "", fmt.Errorf("follow target must be at most %d bytes", MaxFollowTargetLength)
+	}
+	return target, nil
+}
+
+// Follow is an author, source or category a user follows.
+type Follow struct {
+	Type      FollowType `json:"type"`
+	Target    string     `json:"target"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// FollowedItems is everything a user follows, matching articles by any of their authors,
+// their source or their category.
+type FollowedItems struct {
+	AuthorIDs  []string
+	Sources    []string
+	Categories []string
+}
+
+// NewFollowedItems groups a user's follows by type.
+func NewFollowedItems(follows []Follow) FollowedItems {
+	var items FollowedItems
+	for _, f := range follows {
+		switch f.Type {
+		case FollowTypeAuthor:
+			items.AuthorIDs = append(items.AuthorIDs, f.Target)
+		case FollowTypeSource:
+			items.Sources = append(items.Sources, f.Target)
+		case FollowTypeCategory:
+			items.Categories = append(items.Categories, f.Target)
+		}
+	}
+	return items
+}
+
+// IsEmpty returns true if nothing is followed.
+func (f FollowedItems) IsEmpty() bool {
+	return len(f.AuthorIDs) == 0 && len(f.Sources) == 0 && len(f.Categories) == 0
+}
+
+// FollowPreferences holds a user's settings for the articles they follow.
+// The feed token gives access to their personalized RSS feed of followed articles.
+type FollowPreferences struct {
+	UserID                   string `json:"-"`
+	IncludeInRecommendations bool   `json:"include_in_recommendations"`
+	FeedToken                string `json:"-"`
+}
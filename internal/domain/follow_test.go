@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFollowType(t *testing.T) {
+	for _, s := range []string{"author", "source", "category"} {
+		followType, err := ParseFollowType(s)
+		require.NoError(t, err)
+		assert.Equal(t, FollowType(s), followType)
+	}
+
+	_, err := ParseFollowType("tag")
+	assert.Error(t, err)
+}
+
+func TestNormalizeFollowTarget(t *testing.T) {
+	cases := []struct {
+		name    string
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{name: "already_normal", target: "arxiv", want: "arxiv"},
+		{name: "whitespace", target: "  Interpretability ", want: "Interpretability"},
+		{name: "blank", target: "   ", wantErr: true},
+		{name: "too_long", target: strings.Repeat("a", MaxFollowTargetLength+1), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NormalizeFollowTarget(tc.target)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewFollowedItems(t *testing.T) {
+	items := NewFollowedItems([]Follow{
+		{Type: FollowTypeAuthor, Target: "neel-nanda"},
+		{Type: FollowTypeSource, Target: "arxiv"},
+		{Type: FollowTypeCategory, Target: "Interpretability"},
+		{Type: FollowTypeSource, Target: "lesswrong"},
+	})
+
+	assert.Equal(t, FollowedItems{
+		AuthorIDs:  []string{"neel-nanda"},
+		Sources:    []string{"arxiv", "lesswrong"},
+		Categories: []string{"Interpretability"},
+	}, items)
+	assert.False(t, items.IsEmpty())
+	assert.True(t, NewFollowedItems(nil).IsEmpty())
+}
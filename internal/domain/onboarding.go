@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxOnboardingCategories is the maximum number of categories a user can pick when onboarding.
+	MaxOnboardingCategories = 20
+	// MaxOnboardingInterests is the maximum number of free-text interests a user can enter when onboarding.
+	MaxOnboardingInterests = 10
+	// MaxOnboardingInterestLength is the maximum length in characters of a free-text interest.
+	MaxOnboardingInterestLength = 500
+)
+
+// ErrInvalidOnboardingInterests is returned when onboarding interests are empty or exceed the limits.
+var ErrInvalidOnboardingInterests = errors.New("onboarding interests must include 1-20 categories or " +
+	"1-10 interests of at most 500 characters")
+
+// OnboardingInterests are the categories and free-text interests a new user picks
+// so they can be recommended articles before they have rated any.
+type OnboardingInterests struct {
+	UserID     string    `json:"-"`
+	Categories []string  `json:"categories"`
+	Interests  []string  `json:"interests"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Normalize trims whitespace and drops empty and duplicate entries,
+// returning ErrInvalidOnboardingInterests if nothing is left or a limit is exceeded.
+func (o OnboardingInterests) Normalize() (OnboardingInterests, error) {
+	o.Categories = uniqueTrimmed(o.Categories)
+	o.Interests = uniqueTrimmed(o.Interests)
+
+	if len(o.Categories) == 0 && len(o.Interests) == 0 {
+		return OnboardingInterests{}, ErrInvalidOnboardingInterests
+	}
+	if len(o.Categories) > MaxOnboardingCategories || len(o.Interests) > MaxOnboardingInterests {
+		return OnboardingInterests{}, ErrInvalidOnboardingInterests
+	}
+	for _, interest := range o.Interests {
+		if len([]rune(interest)) > MaxOnboardingInterestLength {
+			return OnboardingInterests{}, ErrInvalidOnboardingInterests
+		}
+	}
+	return o, nil
+}
+
+// SeedTexts returns the texts to embed as seed vectors, one per category and interest.
+func (o OnboardingInterests) SeedTexts() []string {
+	texts := make([]string, 0, len(o.Categories)+len(o.Interests))
+	texts = append(texts, o.Categories...)
+	texts = append(texts, o.Interests...)
+	return texts
+}
+
+// ArticleCategory is a category along with the number of articles in it.
+type ArticleCategory struct {
+	Name         string `json:"name"`
+	ArticleCount int64  `json:"article_count"`
+}
+
+func uniqueTrimmed(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
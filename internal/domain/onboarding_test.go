@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnboardingInterests_Normalize(t *testing.T) {
+	cases := []struct {
+		name           string
+		categories     []string
+		interests      []string
+		wantCategories []string
+		wantInterests  []string
+		wantErr        bool
+	}{
+		{
+			name:           "trims_and_dedupes",
+			categories:     []string{" Interpretability ", "Interpretability", ""},
+			interests:      []string{"scalable oversight", "  "},
+			wantCategories: []string{"Interpretability"},
+			wantInterests:  []string{"scalable oversight"},
+		},
+		{
+			name:           "categories_only",
+			categories:     []string{"Governance"},
+			wantCategories: []string{"Governance"},
+			wantInterests:  []string{},
+		},
+		{name: "empty", categories: []string{" "}, wantErr: true},
+		{name: "too_many_categories", categories: numbered("c", MaxOnboardingCategories+1), wantErr: true},
+		{name: "too_many_interests", interests: numbered("i", MaxOnboardingInterests+1), wantErr: true},
+		{
+			name:      "interest_too_long",
+			interests: []string{strings.Repeat("x", MaxOnboardingInterestLength+1)},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := OnboardingInterests{Categories: tc.categories, Interests: tc.interests}.Normalize()
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidOnboardingInterests)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCategories, got.Categories)
+			assert.Equal(t, tc.wantInterests, got.Interests)
+		})
+	}
+}
+
+func TestOnboardingInterests_SeedTexts(t *testing.T) {
+	interests := OnboardingInterests{Categories: []string{"Governance"}, Interests: []string{"debate"}}
+	assert.Equal(t, []string{"Governance", "debate"}, interests.SeedTexts())
+}
+
+func numbered(prefix string, n int) []string {
+	values := make([]string, n)
+	for i := range values {
+		values[i] = prefix + strings.Repeat("x", i+1)
+	}
+	return values
+}
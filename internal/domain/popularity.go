@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidPopularityWindow is returned when a popularity window name isn't recognised.
+var ErrInvalidPopularityWindow = errors.New("invalid popularity window")
+
+// PopularityWindow is a sliding window, in days, over which article popularity is aggregated.
+type PopularityWindow int
+
+const (
+	PopularityWindowDay   PopularityWindow = 1
+	PopularityWindowWeek  PopularityWindow = 7
+	PopularityWindowMonth PopularityWindow = 30
+)
+
+// ValidPopularityWindows are the windows article popularity is aggregated over.
+var ValidPopularityWindows = []PopularityWindow{
+	PopularityWindowDay,
+	PopularityWindowWeek,
+	PopularityWindowMonth,
+}
+
+// String returns the window's name, as used in query parameters.
+func (w PopularityWindow) String() string {
+	switch w {
+	case PopularityWindowDay:
+		return "day"
+	case PopularityWindowWeek:
+		return "week"
+	case PopularityWindowMonth:
+		return "month"
+	default:
+		return fmt.Sprintf("%dd", int(w))
+	}
+}
+
+// ParsePopularityWindow parses a window name: "day", "week" or "month".
+func ParsePopularityWindow(name string) (PopularityWindow, error) {
+	for _, w := range ValidPopularityWindows {
+		if w.String() == name {
+			return w, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrInvalidPopularityWindow, name)
+}
+
+// ArticlePopularity is how many users interacted with an article within a window.
+// Counts only exist for articles with interactions from at least PopularityConfig.MinUsers users.
+type ArticlePopularity struct {
+	HashID       string
+	Window       PopularityWindow
+	LikeCount    int64
+	DislikeCount int64
+	ReadCount    int64
+	UserCount    int64
+	Score        float64
+}
+
+// PopularityConfig controls how article popularity is aggregated.
+type PopularityConfig struct {
+	// MinUsers is the number of distinct users who must have interacted with an article
+	// within a window for its counts to be stored. This stops aggregate counts from
+	// revealing what an individual user has read or rated.
+	MinUsers int64
+
+	// LikeWeight, DislikeWeight and ReadWeight are how much each interaction adds to an
+	// article's popularity score. Dislikes subtract from it.
+	LikeWeight    float64
+	DislikeWeight float64
+	ReadWeight    float64
+}
+
+// DefaultPopularityConfig returns the default popularity configuration.
+func DefaultPopularityConfig() PopularityConfig {
+	return PopularityConfig{
+		MinUsers:      5,
+		LikeWeight:    1.0,
+		DislikeWeight: 1.0,
+		ReadWeight:    0.2,
+	}
+}
+
+// Score computes a popularity score from interaction counts.
+func (c PopularityConfig) Score(likes, dislikes, reads int64) float64 {
+	return c.LikeWeight*float64(likes) - c.DislikeWeight*float64(dislikes) + c.ReadWeight*float64(reads)
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePopularityWindow(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   string
+		want    PopularityWindow
+		wantErr bool
+	}{
+		{name: "day", input: "day", want: PopularityWindowDay},
+		{name: "week", input: "week", want: PopularityWindowWeek},
+		{name: "month", input: "month", want: PopularityWindowMonth},
+		{name: "unknown", input: "year", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParsePopularityWindow(tc.input)
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrInvalidPopularityWindow)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.input, got.String())
+		})
+	}
+}
+
+func TestPopularityConfig_Score(t *testing.T) {
+	config := DefaultPopularityConfig()
+
+	assert.InDelta(t, 10.0, config.Score(10, 0, 0), 1e-9)
+	assert.InDelta(t, 2.0, config.Score(0, 0, 10), 1e-9)
+	assert.InDelta(t, -3.0, config.Score(1, 4, 0), 1e-9)
+}
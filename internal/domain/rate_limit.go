@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// RateLimit is a token bucket allowing bursts of up to Requests, refilled
+// at Requests per Window.
+type RateLimit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Validate returns an error unless the limit allows some requests over a positive window,
+// since the refill rate would otherwise be zero or undefined.
+func (l RateLimit) Validate() error {
+	if l.Requests <= 0 {
+		return fmt.Errorf("requests must be positive, got %d", l.Requests)
+	}
+	if l.Window <= 0 {
+		return fmt.Errorf("window must be positive, got %s", l.Window)
+	}
+	return nil
+}
+
+// RateLimitBucket is the state of one client's token bucket.
+// A zero bucket is treated as full.
+type RateLimitBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// RateLimitDecision is the result of trying to take a token from a bucket.
+// RetryAfter is only set when the request is not allowed; ResetAfter is how long
+// until the bucket is full again.
+type RateLimitDecision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+// Take refills the bucket for the time elapsed since it was last updated and
+// tries to take a token, returning the new bucket state and the decision.
+// The limit must be valid.
+func (b RateLimitBucket) Take(limit RateLimit, now time.Time) (RateLimitBucket, RateLimitDecision) {
+	capacity := float64(limit.Requests)
+	perSecond := capacity / limit.Window.Seconds()
+
+	tokens := capacity
+	if !b.UpdatedAt.IsZero() {
+		elapsed := max(now.Sub(b.UpdatedAt).Seconds(), 0)
+		tokens = min(capacity, b.Tokens+elapsed*perSecond)
+	}
+
+	decision := RateLimitDecision{Limit: limit.Requests}
+	if tokens >= 1 {
+		tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = secondsToDuration((1 - tokens) / perSecond)
+	}
+	decision.Remaining = int(math.Floor(tokens))
+	decision.ResetAfter = secondsToDuration((capacity - tokens) / perSecond)
+
+	return RateLimitBucket{Tokens: tokens, UpdatedAt: now}, decision
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitBucket_Take(t *testing.T) {
+	limit := RateLimit{Requests: 2, Window: 10 * time.Second}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// A new bucket starts full
+	bucket, decision := RateLimitBucket{}.Take(limit, now)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 1, decision.Remaining)
+	assert.Equal(t, 5*time.Second, decision.ResetAfter)
+
+	bucket, decision = bucket.Take(limit, now)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 0, decision.Remaining)
+
+	// Empty, so the next request must wait for a token to refill
+	bucket, decision = bucket.Take(limit, now.Add(time.Second))
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, 0, decision.Remaining)
+	assert.InDelta(t, 4*time.Second, decision.RetryAfter, float64(time.Millisecond))
+
+	// After the wait a token is available again
+	_, decision = bucket.Take(limit, now.Add(5*time.Second))
+	assert.True(t, decision.Allowed)
+
+	// Idle buckets never refill beyond capacity
+	_, decision = bucket.Take(limit, now.Add(time.Hour))
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 1, decision.Remaining)
+}
+
+func TestRateLimit_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		limit   RateLimit
+		wantErr bool
+	}{
+		{name: "valid", limit: RateLimit{Requests: 60, Window: time.Minute}},
+		{name: "zero_requests", limit: RateLimit{Window: time.Minute}, wantErr: true},
+		{name: "negative_requests", limit: RateLimit{Requests: -1, Window: time.Minute}, wantErr: true},
+		{name: "zero_window", limit: RateLimit{Requests: 60}, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
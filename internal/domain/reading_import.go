@@ -0,0 +1,411 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// ImportFormat identifies the kind of file a reading history import is read from.
+type ImportFormat string
+
+const (
+	// ImportFormatBibTeX is a BibTeX file, such as a Zotero library export.
+	ImportFormatBibTeX ImportFormat = "bibtex"
+	// ImportFormatURLList is a plain text list of URLs, DOIs or arXiv IDs, one per line.
+	ImportFormatURLList ImportFormat = "urls"
+	// ImportFormatOPML is an OPML outline whose entries link to articles.
+	ImportFormatOPML ImportFormat = "opml"
+	// ImportFormatPocket is a Pocket HTML export.
+	ImportFormatPocket ImportFormat = "pocket"
+	// ImportFormatArxivCSV is a CSV file with an arXiv ID or URL in each row.
+	ImportFormatArxivCSV ImportFormat = "arxiv_csv"
+)
+
+var (
+	ErrUnknownImportFormat = errors.New("unknown import format")
+	ErrInvalidImport       = errors.New("invalid import file")
+)
+
+// ImportMatchMethod records how an imported item was matched to an article.
+type ImportMatchMethod string
+
+const (
+	ImportMatchURL     ImportMatchMethod = "url"
+	ImportMatchArxivID ImportMatchMethod = "arxiv_id"
+	ImportMatchTitle   ImportMatchMethod = "title"
+)
+
+// ImportItem is one reference read from an import file.
+// Reference is the text that identified it in the file, used when reporting it back.
+type ImportItem struct {
+	Reference string `json:"reference"`
+	URL       string `json:"url,omitempty"`
+	DOI       string `json:"doi,omitempty"`
+	ArxivID   string `json:"arxiv_id,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+// ImportMatch is an imported item along with the article it was matched to.
+type ImportMatch struct {
+	Item          ImportItem        `json:"item"`
+	ArticleHashID string            `json:"article_hash_id"`
+	MatchedBy     ImportMatchMethod `json:"matched_by"`
+}
+
+// ParseImport reads the items from an import file in the given format.
+func ParseImport(format ImportFormat, data []byte) ([]ImportItem, error) {
+	switch format {
+	case ImportFormatBibTeX:
+		return parseBibTeX(string(data))
+	case ImportFormatURLList:
+		return parseURLList(string(data)), nil
+	case ImportFormatOPML:
+		return parseOPML(data)
+	case ImportFormatPocket:
+		return parsePocket(string(data)), nil
+	case ImportFormatArxivCSV:
+		return parseArxivCSV(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownImportFormat, format)
+	}
+}
+
+// ArxivURLs returns the URLs an article for the item's arXiv ID may be stored under.
+func (i ImportItem) ArxivURLs() []string {
+	if i.ArxivID == "" {
+		return nil
+	}
+	return urlVariants("https://arxiv.org/abs/" + i.ArxivID)
+}
+
+// LinkURLs returns the URLs an article for the item's URL or DOI may be stored under.
+func (i ImportItem) LinkURLs() []string {
+	var urls []string
+	if i.URL != "" {
+		urls = append(urls, urlVariants(i.URL)...)
+	}
+	if i.DOI != "" {
+		urls = append(urls, urlVariants("https://doi.org/"+i.DOI)...)
+		urls = append(urls, urlVariants("https://dx.doi.org/"+i.DOI)...)
+	}
+	return urls
+}
+
+// urlVariants returns a URL along with its http/https, www/non-www and trailing slash variants,
+// since the same page is often linked in several of these forms.
+func urlVariants(rawURL string) []string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return []string{rawURL}
+	}
+	u.Fragment = ""
+
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+	path := strings.TrimSuffix(u.Path, "/")
+
+	variants := []string{rawURL}
+	seen := map[string]bool{rawURL: true}
+	for _, scheme := range []string{"https", "http"} {
+		for _, h := range []string{host, "www." + host} {
+			for _, p := range []string{path, path + "/"} {
+				v := *u
+				v.Scheme, v.Host, v.Path, v.RawPath = scheme, h, p, ""
+				s := v.String()
+				if !seen[s] {
+					seen[s] = true
+					variants = append(variants, s)
+				}
+			}
+		}
+	}
+	return variants
+}
+
+// NormalizeTitle lower-cases a title and reduces it to words separated by single spaces,
+// so titles differing only in case, punctuation or LaTeX braces compare equal.
+func NormalizeTitle(title string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+var (
+	arxivIDPattern  = regexp.MustCompile(`^(\d{4}\.\d{4,5}|[a-z][a-z\-]*(?:\.[A-Z]{2})?/\d{7})(?:v\d+)?$`)
+	arxivDOIPattern = regexp.MustCompile(`(?i)^10\.48550/arxiv\.(.+)$`)
+	doiPattern      = regexp.MustCompile(`^10\.\d{4,9}/\S+$`)
+)
+
+// ParseArxivID extracts an arXiv ID, without its version, from a bare ID, an "arXiv:" reference,
+// an arxiv.org abstract or PDF URL, or an arXiv DOI.
+func ParseArxivID(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) > len("arxiv:") && strings.EqualFold(s[:len("arxiv:")], "arxiv:") {
+		s = s[len("arxiv:"):]
+	}
+
+	if m := arxivDOIPattern.FindStringSubmatch(parseDOI(s)); m != nil {
+		s = m[1]
+	} else if u, err := url.Parse(s); err == nil && strings.HasSuffix(strings.ToLower(u.Host), "arxiv.org") {
+		path := strings.TrimSuffix(u.Path, ".pdf")
+		for _, prefix := range []string{"/abs/", "/pdf/"} {
+			if strings.HasPrefix(path, prefix) {
+				s = path[len(prefix):]
+			}
+		}
+	}
+
+	m := arxivIDPattern.FindStringSubmatch(s)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+// parseDOI extracts a DOI from a bare DOI, a "doi:" reference or a doi.org URL.
+func parseDOI(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) > len("doi:") && strings.EqualFold(s[:len("doi:")], "doi:") {
+		s = strings.TrimSpace(s[len("doi:"):])
+	}
+	if u, err := url.Parse(s); err == nil && strings.HasSuffix(strings.ToLower(u.Host), "doi.org") {
+		s = strings.TrimPrefix(u.Path, "/")
+	}
+	if !doiPattern.MatchString(s) {
+		return ""
+	}
+	return s
+}
+
+// newImportItem classifies a reference as an arXiv ID, DOI or URL.
+// References that are none of these are kept so they can be reported as unmatched.
+func newImportItem(reference, title string) ImportItem {
+	item := ImportItem{Reference: reference, Title: title}
+	item.ArxivID, _ = ParseArxivID(reference)
+	item.DOI = parseDOI(reference)
+	if u, err := url.Parse(reference); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		item.URL = reference
+	}
+	return item
+}
+
+func parseURLList(data string) []ImportItem {
+	var items []ImportItem
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		items = append(items, newImportItem(line, ""))
+	}
+	return items
+}
+
+func parseArxivCSV(data []byte) ([]ImportItem, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	var items []ImportItem
+	for row, record := range records {
+		item, ok := ImportItem{Reference: strings.Join(record, ",")}, false
+		for _, field := range record {
+			if id, found := ParseArxivID(field); found {
+				item.ArxivID, ok = id, true
+				break
+			}
+		}
+		if !ok && row == 0 {
+			continue // Header row
+		}
+		if strings.TrimSpace(item.Reference) != "" {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+type opmlOutline struct {
+	Text     string        `xml:"text,attr"`
+	Title    string        `xml:"title,attr"`
+	URL      string        `xml:"url,attr"`
+	HTMLURL  string        `xml:"htmlUrl,attr"`
+	XMLURL   string        `xml:"xmlUrl,attr"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+func parseOPML(data []byte) ([]ImportItem, error) {
+	var doc struct {
+		Outlines []opmlOutline `xml:"body>outline"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	var items []ImportItem
+	var walk func(outlines []opmlOutline)
+	walk = func(outlines []opmlOutline) {
+		for _, o := range outlines {
+			title := o.Title
+			if title == "" {
+				title = o.Text
+			}
+			for _, link := range []string{o.HTMLURL, o.URL, o.XMLURL} {
+				if link != "" {
+					items = append(items, newImportItem(link, title))
+					break
+				}
+			}
+			walk(o.Outlines)
+		}
+	}
+	walk(doc.Outlines)
+	return items, nil
+}
+
+var pocketLinkPattern = regexp.MustCompile(`(?is)<a\s[^>]*href="([^"]+)"[^>]*>(.*?)</a>`)
+
+func parsePocket(data string) []ImportItem {
+	var items []ImportItem
+	for _, m := range pocketLinkPattern.FindAllStringSubmatch(data, -1) {
+		link := html.UnescapeString(m[1])
+		title := strings.TrimSpace(html.UnescapeString(m[2]))
+		if title == link {
+			title = ""
+		}
+		items = append(items, newImportItem(link, title))
+	}
+	return items
+}
+
+func parseBibTeX(data string) ([]ImportItem, error) {
+	var items []ImportItem
+	for {
+		at := strings.IndexByte(data, '@')
+		if at < 0 {
+			return items, nil
+		}
+		data = data[at+1:]
+
+		open := strings.IndexAny(data, "{(")
+		if open < 0 {
+			return nil, fmt.Errorf("%w: entry without body", ErrInvalidImport)
+		}
+		entryType := strings.ToLower(strings.TrimSpace(data[:open]))
+
+		body, rest, ok := bibTeXGroup(data[open:])
+		if !ok {
+			return nil, fmt.Errorf("%w: unterminated @%s entry", ErrInvalidImport, entryType)
+		}
+		data = rest
+
+		if entryType == "comment" || entryType == "string" || entryType == "preamble" {
+			continue
+		}
+		items = append(items, bibTeXItem(body))
+	}
+}
+
+// bibTeXGroup splits a braced or parenthesized group from the start of s,
+// returning its contents and the remainder after the closing delimiter.
+func bibTeXGroup(s string) (body, rest string, ok bool) {
+	closing := byte('}')
+	if s[0] == '(' {
+		closing = ')'
+	}
+	depth := 0
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 && closing == '}' {
+				return s[1:i], s[i+1:], true
+			}
+			depth--
+		case ')':
+			if depth == 0 && closing == ')' {
+				return s[1:i], s[i+1:], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func bibTeXItem(body string) ImportItem {
+	key, fieldsText, _ := strings.Cut(body, ",")
+	fields := bibTeXFields(fieldsText)
+
+	item := ImportItem{
+		Reference: strings.TrimSpace(key),
+		Title:     fields["title"],
+		URL:       fields["url"],
+		DOI:       parseDOI(fields["doi"]),
+	}
+	for _, candidate := range []string{fields["eprint"], fields["url"], fields["doi"], fields["journal"]} {
+		for _, word := range strings.Fields(candidate) {
+			if id, ok := ParseArxivID(word); ok {
+				item.ArxivID = id
+				return item
+			}
+		}
+	}
+	return item
+}
+
+// bibTeXFields parses the "name = value" fields of an entry. Values may be braced, quoted
+// or bare; braces used to protect capitalization are removed and whitespace is collapsed.
+func bibTeXFields(s string) map[string]string {
+	fields := make(map[string]string)
+	for {
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			return fields
+		}
+		name := strings.ToLower(strings.Trim(strings.TrimSpace(s[:eq]), ","))
+		s = strings.TrimLeft(s[eq+1:], " \t\r\n")
+
+		var value string
+		switch {
+		case strings.HasPrefix(s, "{"):
+			body, rest, ok := bibTeXGroup(s)
+			if !ok {
+				return fields
+			}
+			value, s = body, rest
+		case strings.HasPrefix(s, `"`):
+			end := strings.IndexByte(s[1:], '"')
+			if end < 0 {
+				return fields
+			}
+			value, s = s[1:end+1], s[end+2:]
+		default:
+			end := strings.IndexByte(s, ',')
+			if end < 0 {
+				end = len(s)
+			}
+			value, s = s[:end], s[end:]
+		}
+
+		value = strings.NewReplacer("{", "", "}", "").Replace(value)
+		fields[name] = strings.Join(strings.Fields(value), " ")
+
+		comma := strings.IndexByte(s, ',')
+		if comma < 0 {
+			return fields
+		}
+		s = s[comma+1:]
+	}
+}
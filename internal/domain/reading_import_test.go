@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImport(t *testing.T) {
+	cases := []struct {
+		name    string
+		format  ImportFormat
+		data    string
+		want    []ImportItem
+		wantErr error
+	}{
+		{
+			name:   "bibtex",
+			format: ImportFormatBibTeX,
+			data: `@comment{jabref-meta: databaseType:bibtex;}
+@article{hubinger2019risks,
+  title = {Risks from {Learned} Optimization in
+           Advanced Machine Learning Systems},
+  author = {Hubinger, Evan and others},
+  eprint = {1906.01820v3},
+  archivePrefix = {arXiv},
+  year = 2019
+}
+@inproceedings{christiano2017,
+  title = "Deep reinforcement learning from human preferences",
+  url = {https://papers.nips.cc/paper/7017?x=1},
+  doi = {10.5555/3294996.3295184},
+}`,
+			want: []ImportItem{
+				{
+					Reference: "hubinger2019risks",
+					Title:     "Risks from Learned Optimization in Advanced Machine Learning Systems",
+					ArxivID:   "1906.01820",
+				},
+				{
+					Reference: "christiano2017",
+					Title:     "Deep reinforcement learning from human preferences",
+					URL:       "https://papers.nips.cc/paper/7017?x=1",
+					DOI:       "10.5555/3294996.3295184",
+				},
+			},
+		},
+		{
+			name:    "bibtex_unterminated",
+			format:  ImportFormatBibTeX,
+			data:    `@article{key, title = {Oops}`,
+			wantErr: ErrInvalidImport,
+		},
+		{
+			name:   "url_list",
+			format: ImportFormatURLList,
+			data: "# reading list\nhttps://www.lesswrong.com/posts/abc\n\n" +
+				"doi:10.48550/arXiv.2212.03827\narXiv:2301.00001v2\nnot a reference\n",
+			want: []ImportItem{
+				{Reference: "https://www.lesswrong.com/posts/abc", URL: "https://www.lesswrong.com/posts/abc"},
+				{
+					Reference: "doi:10.48550/arXiv.2212.03827",
+					DOI:       "10.48550/arXiv.2212.03827",
+					ArxivID:   "2212.03827",
+				},
+				{Reference: "arXiv:2301.00001v2", ArxivID: "2301.00001"},
+				{Reference: "not a reference"},
+			},
+		},
+		{
+			name:   "opml",
+			format: ImportFormatOPML,
+			data: `<?xml version="1.0"?><opml version="2.0"><body>
+<outline text="Folder"><outline text="A post" htmlUrl="https://example.com/a"/></outline>
+<outline title="Paper" url="https://arxiv.org/pdf/2301.00001.pdf"/>
+</body></opml>`,
+			want: []ImportItem{
+				{Reference: "https://example.com/a", URL: "https://example.com/a", Title: "A post"},
+				{
+					Reference: "https://arxiv.org/pdf/2301.00001.pdf",
+					URL:       "https://arxiv.org/pdf/2301.00001.pdf",
+					ArxivID:   "2301.00001",
+					Title:     "Paper",
+				},
+			},
+		},
+		{
+			name:    "opml_invalid",
+			format:  ImportFormatOPML,
+			data:    `<opml><body>`,
+			wantErr: ErrInvalidImport,
+		},
+		{
+			name:   "pocket",
+			format: ImportFormatPocket,
+			data: `<ul><li><a href="https://example.com/a?x=1&amp;y=2" time_added="1">Tom &amp; Jerry</a></li>
+<li><a href="https://example.com/b">https://example.com/b</a></li></ul>`,
+			want: []ImportItem{
+				{Reference: "https://example.com/a?x=1&y=2", URL: "https://example.com/a?x=1&y=2", Title: "Tom & Jerry"},
+				{Reference: "https://example.com/b", URL: "https://example.com/b"},
+			},
+		},
+		{
+			name:   "arxiv_csv",
+			format: ImportFormatArxivCSV,
+			data:   "id,note\n2301.00001,good\nhttps://arxiv.org/abs/hep-th/9901001v1,old\nunknown,row\n",
+			want: []ImportItem{
+				{Reference: "2301.00001,good", ArxivID: "2301.00001"},
+				{Reference: "https://arxiv.org/abs/hep-th/9901001v1,old", ArxivID: "hep-th/9901001"},
+				{Reference: "unknown,row"},
+			},
+		},
+		{
+			name:    "unknown_format",
+			format:  "endnote",
+			wantErr: ErrUnknownImportFormat,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseImport(tc.format, []byte(tc.data))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestImportItem_URLs(t *testing.T) {
+	item := ImportItem{ArxivID: "2301.00001", URL: "http://www.example.com/post/#comments", DOI: "10.1000/xyz"}
+
+	assert.Contains(t, item.ArxivURLs(), "https://arxiv.org/abs/2301.00001")
+	assert.Contains(t, item.ArxivURLs(), "http://www.arxiv.org/abs/2301.00001")
+
+	links := item.LinkURLs()
+	assert.Contains(t, links, "https://example.com/post")
+	assert.Contains(t, links, "https://www.example.com/post/")
+	assert.Contains(t, links, "https://doi.org/10.1000/xyz")
+	assert.NotContains(t, links, "https://example.com/post/#comments")
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t, "risks from learned optimization", NormalizeTitle("  Risks from {Learned} Optimization!"))
+	assert.Equal(t, NormalizeTitle("AI Safety via Debate"), NormalizeTitle("AI safety via debate."))
+}
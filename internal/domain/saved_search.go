@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// SavedSearchKind identifies how a saved search finds articles.
+type SavedSearchKind string
+
+const (
+	// SavedSearchKindFilters runs stored article filters against the latest articles.
+	SavedSearchKindFilters SavedSearchKind = "filters"
+	// SavedSearchKindSemantic runs a stored query embedding against the similarity index.
+	SavedSearchKindSemantic SavedSearchKind = "semantic"
+)
+
+// SavedSearch is a named search a user can re-run, either as article filters
+// or as a semantic query.
+type SavedSearch struct {
+	ID           string          `json:"id"`
+	UserID       string          `json:"-"`
+	Name         string          `json:"name"`
+	Kind         SavedSearchKind `json:"kind"`
+	Filters      *ArticleFilters `json:"filters,omitempty"`
+	QueryText    string          `json:"query_text,omitempty"`
+	QueryVector  []float32       `json:"-"`
+	FeedToken    string          `json:"-"`
+	LastViewedAt *time.Time      `json:"last_viewed_at,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// NewSince returns the cutoff for "new since last viewed" results.
+// A search that has never been viewed counts from when it was created.
+func (s SavedSearch) NewSince() time.Time {
+	if s.LastViewedAt != nil {
+		return *s.LastViewedAt
+	}
+	return s.CreatedAt
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearch_NewSince(t *testing.T) {
+	created := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	viewed := time.Date(2024, 6, 5, 12, 0, 0, 0, time.UTC)
+
+	search := SavedSearch{CreatedAt: created}
+	assert.Equal(t, created, search.NewSince())
+
+	search.LastViewedAt = &viewed
+	assert.Equal(t, viewed, search.NewSince())
+}
+
+func TestArticleFilters_JSONRoundTrip(t *testing.T) {
+	filters := ArticleFilters{
+		SourcesAllowlist: []string{"arxiv"},
+		PublishedAfter:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Category:         "Interpretability",
+	}
+
+	data, err := json.Marshal(filters)
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"sources_allowlist":["arxiv"],"published_after":"2024-01-01T00:00:00Z","category":"Interpretability"}`,
+		string(data))
+
+	var decoded ArticleFilters
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, filters, decoded)
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "strings"
+
+// MaxTagLength is the maximum length of a normalized tag, matching the tag column size.
+const MaxTagLength = 64
+
+// TagCount is one of a user's tags along with how many articles they've given it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
+
+// TagCooccurrence is an article that other users have given the same tags as
+// articles the user has tagged, with the number of such shared tag applications.
+type TagCooccurrence struct {
+	HashID string
+	Count  int64
+}
+
+// TagPreferences holds a user's settings for their tags.
+// Tags are private by default; sharing them lets them feed other users' tag co-occurrence recommendations.
+type TagPreferences struct {
+	UserID                  string `json:"-"`
+	ShareForRecommendations bool   `json:"share_for_recommendations"`
+}
+
+// NormalizeTag trims, lower-cases and collapses internal whitespace in a tag,
+// so that "Mech Interp" and " mech  interp" are the same tag.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), " "))
+}
+
+// ParseTagList parses a comma-separated list of tags, normalizing each and
+// dropping empties and duplicates.
+func ParseTagList(s string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ",") {
+		tag := NormalizeTag(part)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	cases := []struct {
+		name string
+		tag  string
+		want string
+	}{
+		{name: "already_normal", tag: "interpretability", want: "interpretability"},
+		{name: "case", tag: "RLHF", want: "rlhf"},
+		{name: "whitespace", tag: "  Mech \t Interp ", want: "mech interp"},
+		{name: "blank", tag: "   ", want: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, NormalizeTag(tc.tag))
+		})
+	}
+}
+
+func TestParseTagList(t *testing.T) {
+	assert.Equal(t, []string{"to read", "rlhf"}, ParseTagList("To Read, rlhf,,to  read "))
+	assert.Nil(t, ParseTagList(""))
+}
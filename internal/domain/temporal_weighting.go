@@ -6,14 +6,17 @@ import (
 )
 
 // TimestampedVector represents a vector with an associated timestamp.
+// Weight scales the vector's contribution on top of its decay; zero counts as 1,
+// so unweighted vectors count equally.
 type TimestampedVector struct {
 	Vector    []float32
 	Timestamp time.Time
+	Weight    float64
 }
 
 // ComputeTemporallyWeightedVector computes a weighted average of vectors using exponential decay.
 // More recent vectors have higher weights. The decay follows: weight = exp(-lambda * days_ago)
-// where lambda = ln(2) / halfLifeDays.
+// where lambda = ln(2) / halfLifeDays, multiplied by each vector's own weight.
 //
 // Returns nil if vectors is empty or all weights sum to zero.
 func ComputeTemporallyWeightedVector(
@@ -33,6 +36,9 @@ func ComputeTemporallyWeightedVector(
 	for _, v := range vectors {
 		daysSinceRating := now.Sub(v.Timestamp).Hours() / 24
 		weight := math.Exp(-lambda * daysSinceRating)
+		if v.Weight != 0 {
+			weight *= v.Weight
+		}
 
 		if weightedSum == nil {
 			weightedSum = make([]float32, len(v.Vector))
@@ -56,3 +62,18 @@ func ComputeTemporallyWeightedVector(
 
 	return result
 }
+
+// RecencyFactor returns the multiplier applied to an article's recommendation score for its age.
+// It is 1 for an article published at now, decaying exponentially towards 1 - weight with the
+// given half-life in days, so weight controls how much age can count against an article.
+// Articles dated in the future count as published at now. Returns 1 if weight or
+// halfLifeDays is not positive.
+func RecencyFactor(publishedAt, now time.Time, halfLifeDays, weight float64) float64 {
+	if weight <= 0 || halfLifeDays <= 0 {
+		return 1
+	}
+
+	daysSincePublished := max(0, now.Sub(publishedAt).Hours()/24)
+	decay := math.Exp(-math.Ln2 / halfLifeDays * daysSincePublished)
+	return 1 - weight + weight*decay
+}
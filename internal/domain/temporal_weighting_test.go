@@ -42,6 +42,15 @@ func TestComputeTemporallyWeightedVector(t *testing.T) {
 			},
 			expected: []float32{0.5, 0.5},
 		},
+		{
+			name: "signal_weight_scales_vector",
+			vectors: []TimestampedVector{
+				{Vector: []float32{1.0, 0.0}, Timestamp: now, Weight: 1.0},
+				{Vector: []float32{0.0, 1.0}, Timestamp: now, Weight: 0.25},
+			},
+			// weights: 1.0 and 0.25, total = 1.25
+			expected: []float32{0.8, 0.2},
+		},
 		{
 			name: "recent_vector_weighted_higher",
 			vectors: []TimestampedVector{
@@ -120,3 +129,30 @@ func TestComputeTemporallyWeightedVector_FutureTimestamp(t *testing.T) {
 	// result: [1.0*2.0 + 0.0*1.0] / 3.0 = 0.667
 	assert.InDelta(t, 0.667, result[0], 0.01)
 }
+
+func TestRecencyFactor(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name         string
+		publishedAt  time.Time
+		halfLifeDays float64
+		weight       float64
+		expected     float64
+	}{
+		{name: "published_now", publishedAt: now, halfLifeDays: 30, weight: 0.5, expected: 1.0},
+		{name: "one_half_life", publishedAt: now.AddDate(0, 0, -30), halfLifeDays: 30, weight: 0.5, expected: 0.75},
+		{name: "two_half_lives", publishedAt: now.AddDate(0, 0, -60), halfLifeDays: 30, weight: 1, expected: 0.25},
+		{name: "very_old_floors", publishedAt: now.AddDate(-20, 0, 0), halfLifeDays: 30, weight: 0.4, expected: 0.6},
+		{name: "future_counts_as_now", publishedAt: now.AddDate(0, 0, 10), halfLifeDays: 30, weight: 0.5,
+			expected: 1.0},
+		{name: "zero_weight_disabled", publishedAt: now.AddDate(-5, 0, 0), halfLifeDays: 30, expected: 1.0},
+		{name: "zero_half_life_disabled", publishedAt: now.AddDate(-5, 0, 0), weight: 0.5, expected: 1.0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, RecencyFactor(tc.publishedAt, now, tc.halfLifeDays, tc.weight), 0.001)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package domain
+
+import "time"
+
+// UserDataExport is everything stored about a user, gathered for them to download.
+// Secrets are left out: API token hashes, saved search and following feed tokens,
+// collection share tokens and the digest unsubscribe token.
+type UserDataExport struct {
+	UserID              string                       `json:"user_id"`
+	ExportedAt          time.Time                    `json:"exported_at"`
+	Interactions        []ExportedInteraction        `json:"interactions"`
+	InterestClusters    []ExportedInterestCluster    `json:"interest_clusters"`
+	Recommendations     []ExportedRecommendation     `json:"recommendations"`
+	Impressions         []ExportedImpression         `json:"recommendation_impressions"`
+	RecommendationState *ExportedRecommendationState `json:"recommendation_state,omitempty"`
+	APITokens           []ExportedAPIToken           `json:"api_tokens"`
+	DigestPreferences   *DigestPreferences           `json:"digest_preferences,omitempty"`
+	OnboardingInterests *OnboardingInterests         `json:"onboarding_interests,omitempty"`
+	SavedSearches       []SavedSearch                `json:"saved_searches"`
+	Collections         []ExportedCollection         `json:"collections"`
+	Notes               []ArticleNote                `json:"notes"`
+	Tags                []ExportedTag                `json:"tags"`
+	TagPreferences      *TagPreferences              `json:"tag_preferences,omitempty"`
+	Follows             []Follow                     `json:"follows"`
+	FollowPreferences   *FollowPreferences           `json:"follow_preferences,omitempty"`
+	AuditEvents         []AuditEvent                 `json:"audit_events"`
+}
+
+// ExportedInteraction is a user's read state, ratings and implicit signals on an article.
+type ExportedInteraction struct {
+	ArticleHashID        string     `json:"article_hash_id"`
+	HaveRead             bool       `json:"have_read"`
+	ThumbsUp             bool       `json:"thumbs_up"`
+	ThumbsDown           bool       `json:"thumbs_down"`
+	Rating               int        `json:"rating,omitempty"`
+	OpenedLink           bool       `json:"opened_link"`
+	TimeOnArticleSeconds int        `json:"time_on_article_seconds"`
+	SavedToCollection    bool       `json:"saved_to_collection"`
+	Dismissed            bool       `json:"dismissed"`
+	DateRead             *time.Time `json:"date_read,omitempty"`
+	DateRated            *time.Time `json:"date_rated,omitempty"`
+	DateEngaged          *time.Time `json:"date_engaged,omitempty"`
+	DateDismissed        *time.Time `json:"date_dismissed,omitempty"`
+	SnoozedUntil         *time.Time `json:"snoozed_until,omitempty"`
+}
+
+// ExportedInterestCluster describes one of a user's interest clusters, without its centroid vector.
+type ExportedInterestCluster struct {
+	ClusterID    int       `json:"cluster_id"`
+	ArticleCount int       `json:"article_count"`
+	Provisional  bool      `json:"provisional"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ExportedRecommendation is a precomputed recommendation for a user.
+type ExportedRecommendation struct {
+	ArticleHashID string    `json:"article_hash_id"`
+	Score         float64   `json:"score"`
+	Source        string    `json:"source"`
+	ExperimentArm string    `json:"experiment_arm,omitempty"`
+	Position      int       `json:"position"`
+	GeneratedAt   time.Time `json:"generated_at"`
+}
+
+// ExportedImpression is a recommended article that was shown to a user.
+type ExportedImpression struct {
+	ArticleHashID string    `json:"article_hash_id"`
+	ExperimentArm string    `json:"experiment_arm,omitempty"`
+	Source        string    `json:"source"`
+	Position      int       `json:"position"`
+	ServedAt      time.Time `json:"served_at"`
+}
+
+// ExportedRecommendationState records when a user's recommendations were last regenerated.
+type ExportedRecommendationState struct {
+	LastGeneratedAt   *time.Time `json:"last_generated_at,omitempty"`
+	LastRatingAt      *time.Time `json:"last_rating_at,omitempty"`
+	NeedsRegeneration bool       `json:"needs_regeneration"`
+}
+
+// ExportedAPIToken is an API token's metadata, including revoked and expired tokens.
+type ExportedAPIToken struct {
+	ID           string          `json:"id"`
+	Prefix       string          `json:"prefix"`
+	Name         *string         `json:"name,omitempty"`
+	Scopes       []APITokenScope `json:"scopes"`
+	Status       APITokenStatus  `json:"status"`
+	CreatedAt    time.Time       `json:"created_at"`
+	LastUsedAt   *time.Time      `json:"last_used_at,omitempty"`
+	ExpiresAt    *time.Time      `json:"expires_at,omitempty"`
+	RevokedAt    *time.Time      `json:"revoked_at,omitempty"`
+	ReplacedByID *string         `json:"replaced_by_id,omitempty"`
+}
+
+// NewExportedAPIToken creates the exported form of a token, with its status as of now.
+func NewExportedAPIToken(token APIToken, now time.Time) ExportedAPIToken {
+	return ExportedAPIToken{
+		ID:           token.ID,
+		Prefix:       token.Prefix,
+		Name:         token.Name,
+		Scopes:       token.Scopes,
+		Status:       token.Status(now),
+		CreatedAt:    token.CreatedAt,
+		LastUsedAt:   token.LastUsedAt,
+		ExpiresAt:    token.ExpiresAt,
+		RevokedAt:    token.RevokedAt,
+		ReplacedByID: token.ReplacedByID,
+	}
+}
+
+// ExportedCollection is a collection with its articles in order.
+type ExportedCollection struct {
+	Collection
+	ArticleHashIDs []string `json:"article_hash_ids"`
+}
+
+// ExportedTag is a tag a user has given an article.
+type ExportedTag struct {
+	ArticleHashID string    `json:"article_hash_id"`
+	Tag           string    `json:"tag"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -6,16 +6,37 @@ import "time"
 type UserRatingType string
 
 const (
-	// RatingTypeThumbsUp indicates a positive rating.
+	// RatingTypeThumbsUp indicates a positive rating. When listing a user's article vectors, it also
+	// covers ratings of 4 and 5 and articles the user engaged with without rating negatively.
 	RatingTypeThumbsUp UserRatingType = "thumbs_up"
 	// RatingTypeThumbsDown indicates a negative rating.
 	RatingTypeThumbsDown UserRatingType = "thumbs_down"
 )
 
 // UserArticleRating represents a stored vector for a user's rated article.
+// RatedAt is when the article was last rated, or if never rated, last engaged with.
 type UserArticleRating struct {
 	ArticleHashID string
 	Vector        []float32
 	RatingType    UserRatingType
 	RatedAt       time.Time
+	Signals       ArticleSignals
+}
+
+// IsExplicit reports whether the user rated the article, with thumbs or a graded rating,
+// rather than only engaging with it. Ratings without signals are explicit.
+func (r UserArticleRating) IsExplicit() bool {
+	return r.Signals.IsZero() || r.Signals.ThumbsUp || r.Signals.ThumbsDown || r.Signals.Rating != 0
+}
+
+// Weight returns how strongly the rating's vector should count, by the strength of the user's
+// signals in the direction of its rating type. Ratings without signals count fully.
+func (r UserArticleRating) Weight(weights SignalWeights) float64 {
+	if r.Signals.IsZero() {
+		return 1
+	}
+	if r.RatingType == RatingTypeThumbsDown {
+		return weights.Negative(r.Signals)
+	}
+	return weights.Positive(r.Signals)
 }
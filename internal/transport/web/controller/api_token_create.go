@@ -4,21 +4,30 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/jbeshir/alignment-research-feed/internal/command"
 	"github.com/jbeshir/alignment-research-feed/internal/domain"
 )
 
 // APITokenCreateRequest is the JSON request body for creating a token.
+// If scopes are omitted, the token is granted all scopes. Expiry may be given
+// as a number of days or an absolute time, but not both; without either the
+// token never expires.
 type APITokenCreateRequest struct {
-	Name string `json:"name,omitempty"`
+	Name          string     `json:"name,omitempty"`
+	Scopes        []string   `json:"scopes,omitempty"`
+	ExpiresInDays int        `json:"expires_in_days,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
 }
 
 // APITokenCreateResponse is the JSON response for a created token.
 type APITokenCreateResponse struct {
-	ID     string `json:"id"`
-	Token  string `json:"token"`
-	Prefix string `json:"prefix"`
+	ID        string                 `json:"id"`
+	Token     string                 `json:"token"`
+	Prefix    string                 `json:"prefix"`
+	Scopes    []domain.APITokenScope `json:"scopes"`
+	ExpiresAt *time.Time             `json:"expires_at,omitempty"`
 }
 
 // APITokenCreate handles POST /v1/tokens to create a new API token.
@@ -45,8 +54,18 @@ func (c APITokenCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	scopes, err := domain.ParseAPITokenScopes(reqBody.Scopes)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid API token scopes", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	req := command.CreateAPITokenRequest{
-		UserID: userID,
+		UserID:    userID,
+		Scopes:    scopes,
+		TTL:       time.Duration(reqBody.ExpiresInDays) * 24 * time.Hour,
+		ExpiresAt: reqBody.ExpiresAt,
 	}
 	if reqBody.Name != "" {
 		req.Name = &reqBody.Name
@@ -55,28 +74,46 @@ func (c APITokenCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	result, err := c.CreateCmd.Execute(ctx, req)
 	if err != nil {
 		logger.ErrorContext(ctx, "unable to create API token", "error", err)
-		if errors.Is(err, command.ErrTokenLimitExceeded) {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusConflict)
-			if encErr := json.NewEncoder(w).Encode(map[string]string{
-				"error": err.Error(),
-			}); encErr != nil {
-				logger.ErrorContext(ctx, "unable to write error response", "error", encErr)
-			}
-			return
-		}
-		w.WriteHeader(http.StatusInternalServerError)
+		writeAPITokenError(w, r, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 
-	if err := json.NewEncoder(w).Encode(APITokenCreateResponse{
-		ID:     result.TokenID,
-		Token:  result.FullToken,
-		Prefix: result.Prefix,
-	}); err != nil {
+	if err := json.NewEncoder(w).Encode(newAPITokenCreateResponse(result)); err != nil {
 		logger.ErrorContext(ctx, "unable to write response", "error", err)
 	}
 }
+
+func newAPITokenCreateResponse(result command.CreateAPITokenResponse) APITokenCreateResponse {
+	return APITokenCreateResponse{
+		ID:        result.TokenID,
+		Token:     result.FullToken,
+		Prefix:    result.Prefix,
+		Scopes:    result.Scopes,
+		ExpiresAt: result.ExpiresAt,
+	}
+}
+
+func writeAPITokenError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, command.ErrInvalidTokenExpiry):
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Is(err, command.ErrTokenNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, command.ErrTokenLimitExceeded), errors.Is(err, command.ErrTokenNotRotatable):
+		ctx := r.Context()
+		logger := domain.LoggerFromContext(ctx)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		if encErr := json.NewEncoder(w).Encode(map[string]string{
+			"error": err.Error(),
+		}); encErr != nil {
+			logger.ErrorContext(ctx, "unable to write error response", "error", encErr)
+		}
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -10,14 +10,18 @@ import (
 )
 
 // APITokenListItem represents a token in the list response.
+// Revoked is only true for tokens revoked by the user, not ones that expired.
 type APITokenListItem struct {
-	ID         string     `json:"id"`
-	Prefix     string     `json:"prefix"`
-	Name       *string    `json:"name,omitempty"`
-	CreatedAt  time.Time  `json:"created_at"`
-	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
-	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
-	Revoked    bool       `json:"revoked"`
+	ID           string                 `json:"id"`
+	Prefix       string                 `json:"prefix"`
+	Name         *string                `json:"name,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	LastUsedAt   *time.Time             `json:"last_used_at,omitempty"`
+	ExpiresAt    *time.Time             `json:"expires_at,omitempty"`
+	Revoked      bool                   `json:"revoked"`
+	Status       domain.APITokenStatus  `json:"status"`
+	Scopes       []domain.APITokenScope `json:"scopes"`
+	ReplacedByID *string                `json:"replaced_by_id,omitempty"`
 }
 
 // APITokenListResponse is the JSON response for listing tokens.
@@ -47,17 +51,10 @@ func (c APITokenList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	now := time.Now()
 	items := make([]APITokenListItem, 0, len(tokens))
 	for _, token := range tokens {
-		items = append(items, APITokenListItem{
-			ID:         token.ID,
-			Prefix:     token.Prefix,
-			Name:       token.Name,
-			CreatedAt:  token.CreatedAt,
-			LastUsedAt: token.LastUsedAt,
-			ExpiresAt:  token.ExpiresAt,
-			Revoked:    token.RevokedAt != nil,
-		})
+		items = append(items, newAPITokenListItem(token, now))
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -68,3 +65,19 @@ func (c APITokenList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		logger.ErrorContext(ctx, "unable to write response", "error", err)
 	}
 }
+
+func newAPITokenListItem(token domain.APIToken, now time.Time) APITokenListItem {
+	status := token.Status(now)
+	return APITokenListItem{
+		ID:           token.ID,
+		Prefix:       token.Prefix,
+		Name:         token.Name,
+		CreatedAt:    token.CreatedAt,
+		LastUsedAt:   token.LastUsedAt,
+		ExpiresAt:    token.ExpiresAt,
+		Revoked:      status == domain.APITokenStatusRevoked,
+		Status:       status,
+		Scopes:       token.Scopes,
+		ReplacedByID: token.ReplacedByID,
+	}
+}
@@ -11,6 +11,7 @@ import (
 // APITokenRevoke handles DELETE /v1/tokens/{token_id} to revoke a token.
 type APITokenRevoke struct {
 	TokenRevoker datasources.APITokenRevoker
+	AuditWriter  datasources.AuditEventWriter
 }
 
 func (c APITokenRevoke) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -30,11 +31,23 @@ func (c APITokenRevoke) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := c.TokenRevoker.RevokeAPIToken(ctx, tokenID, userID); err != nil {
+	revoked, err := c.TokenRevoker.RevokeAPIToken(ctx, tokenID, userID)
+	if err != nil {
 		logger.ErrorContext(ctx, "unable to revoke API token", "error", err, "token_id", tokenID)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	if !revoked {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Best-effort; the token is already revoked
+	event := domain.NewAuditEvent(ctx, domain.AuditEventAPITokenRevoked, domain.AuditOutcomeSuccess, "")
+	event.TokenID = &tokenID
+	if err := c.AuditWriter.WriteAuditEvent(ctx, event); err != nil {
+		logger.WarnContext(ctx, "unable to write audit event", "error", err, "token_id", tokenID)
+	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
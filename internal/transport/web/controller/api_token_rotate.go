@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// APITokenRotateResponse is the JSON response for a rotated token.
+type APITokenRotateResponse struct {
+	APITokenCreateResponse
+	ReplacedTokenExpiresAt time.Time `json:"replaced_token_expires_at"`
+}
+
+// APITokenRotate handles POST /v1/tokens/{token_id}/rotate to issue a new secret
+// for a token, revoking the old one after a grace period.
+type APITokenRotate struct {
+	RotateCmd command.Command[command.RotateAPITokenRequest, command.RotateAPITokenResponse]
+}
+
+func (c APITokenRotate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	result, err := c.RotateCmd.Execute(ctx, command.RotateAPITokenRequest{
+		UserID:  userID,
+		TokenID: mux.Vars(r)["token_id"],
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to rotate API token", "error", err)
+		writeAPITokenError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(APITokenRotateResponse{
+		APITokenCreateResponse: newAPITokenCreateResponse(result.Token),
+		ReplacedTokenExpiresAt: result.ReplacedTokenExpiresAt,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
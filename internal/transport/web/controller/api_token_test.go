@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAPITokenUpdate_ServeHTTP(t *testing.T) {
+	oldName := "Old"
+	newName := "Laptop"
+
+	cases := []struct {
+		name       string
+		body       string
+		wantFetch  bool
+		found      bool
+		wantRename bool
+		wantName   *string
+		wantStatus int
+	}{
+		{
+			name:       "renames",
+			body:       `{"name": "Laptop"}`,
+			wantFetch:  true,
+			found:      true,
+			wantRename: true,
+			wantName:   &newName,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "clears_name",
+			body:       `{"name": ""}`,
+			wantFetch:  true,
+			found:      true,
+			wantRename: true,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unknown_token",
+			body:       `{"name": "Laptop"}`,
+			wantFetch:  true,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "name_too_long",
+			body:       `{"name": "` + strings.Repeat("a", maxAPITokenNameLength+1) + `"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewAPITokenGetter(t)
+			renamer := mocks.NewAPITokenRenamer(t)
+
+			if tc.wantFetch {
+				getter.EXPECT().GetUserAPIToken(mock.Anything, "tok1", "user1").Return(domain.APIToken{
+					ID:        "tok1",
+					Name:      &oldName,
+					CreatedAt: time.Now(),
+				}, tc.found, nil)
+			}
+			if tc.wantRename {
+				renamer.EXPECT().RenameAPIToken(mock.Anything, "tok1", "user1", tc.wantName).Return(nil)
+			}
+
+			controller := APITokenUpdate{TokenGetter: getter, TokenRenamer: renamer}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPatch, "/v1/tokens/tok1",
+				strings.NewReader(tc.body))
+			req = testContextWithUserID("user1")(req)
+			req = mux.SetURLVars(req, map[string]string{"token_id": "tok1"})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestAPITokenRevoke_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		revoked    bool
+		wantStatus int
+	}{
+		{name: "revokes", revoked: true, wantStatus: http.StatusNoContent},
+		{name: "unknown_or_already_revoked", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			revoker := mocks.NewAPITokenRevoker(t)
+			auditWriter := mocks.NewAuditEventWriter(t)
+
+			revoker.EXPECT().RevokeAPIToken(mock.Anything, "tok1", "user1").Return(tc.revoked, nil)
+			if tc.revoked {
+				auditWriter.EXPECT().
+					WriteAuditEvent(mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+						return e.Type == domain.AuditEventAPITokenRevoked && e.TokenID != nil && *e.TokenID == "tok1"
+					})).
+					Return(nil)
+			}
+
+			controller := APITokenRevoke{TokenRevoker: revoker, AuditWriter: auditWriter}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/v1/tokens/tok1", nil)
+			req = testContextWithUserID("user1")(req)
+			req = mux.SetURLVars(req, map[string]string{"token_id": "tok1"})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
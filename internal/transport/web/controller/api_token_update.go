@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// maxAPITokenNameLength matches the size of the api_tokens.name column.
+const maxAPITokenNameLength = 128
+
+// APITokenUpdateRequest is the JSON request body for renaming a token.
+// An empty name clears it.
+type APITokenUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// APITokenUpdate handles PATCH /v1/tokens/{token_id} to rename a token.
+type APITokenUpdate struct {
+	TokenGetter  datasources.APITokenGetter
+	TokenRenamer datasources.APITokenRenamer
+}
+
+func (c APITokenUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var reqBody APITokenUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(reqBody.Name) > maxAPITokenNameLength {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	tokenID := mux.Vars(r)["token_id"]
+	token, ok, err := c.TokenGetter.GetUserAPIToken(ctx, tokenID, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch API token", "error", err, "token_id", tokenID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	token.Name = nil
+	if reqBody.Name != "" {
+		token.Name = &reqBody.Name
+	}
+
+	if err := c.TokenRenamer.RenameAPIToken(ctx, tokenID, userID, token.Name); err != nil {
+		logger.ErrorContext(ctx, "unable to rename API token", "error", err, "token_id", tokenID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(newAPITokenListItem(token, time.Now())); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
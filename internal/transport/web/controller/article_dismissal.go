@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleDismissedSet handles POST /v1/articles/{article_id}/dismissed/{dismissed} to dismiss an
+// article, leaving it out of the user's recommendations, or to undo a dismissal.
+type ArticleDismissedSet struct {
+	Fetcher         datasources.ArticleFetcher
+	DismissedSetter datasources.ArticleDismissedSetter
+}
+
+func (c ArticleDismissedSet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	setArticleBoolFromRequest(w, r, c.Fetcher, c.DismissedSetter.SetArticleDismissed, "dismissed")
+}
+
+// ArticleSnoozeRequest is the JSON request body for snoozing an article.
+type ArticleSnoozeRequest struct {
+	Until time.Time `json:"until"`
+}
+
+// ArticleSnoozeSet handles POST /v1/articles/{article_id}/snooze to leave an article out of the
+// user's recommendations until a time in the future.
+type ArticleSnoozeSet struct {
+	Fetcher datasources.ArticleFetcher
+	Snoozer datasources.ArticleSnoozer
+}
+
+func (c ArticleSnoozeSet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var reqBody ArticleSnoozeRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if !reqBody.Until.After(time.Now()) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, []string{articleID})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch article", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if len(articles) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err := c.Snoozer.SnoozeArticle(ctx, articleID, userID, reqBody.Until); err != nil {
+		logger.ErrorContext(ctx, "unable to snooze article", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArticleSnoozeClear handles DELETE /v1/articles/{article_id}/snooze to end an article's snooze early.
+type ArticleSnoozeClear struct {
+	Snoozer datasources.ArticleSnoozer
+}
+
+func (c ArticleSnoozeClear) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	if err := c.Snoozer.SnoozeArticle(ctx, articleID, userID, time.Time{}); err != nil {
+		logger.ErrorContext(ctx, "unable to clear article snooze", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestArticleDismissedSet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		value      string
+		wantSet    bool
+		wantStatus int
+	}{
+		{name: "dismiss", value: "true", wantSet: true, wantStatus: http.StatusNoContent},
+		{name: "undismiss", value: "false", wantSet: true, wantStatus: http.StatusNoContent},
+		{name: "invalid_value", value: "maybe", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocks.NewArticleFetcher(t)
+			setter := mocks.NewArticleDismissedSetter(t)
+
+			if tc.wantSet {
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"hash123"}).
+					Return([]domain.Article{{HashID: "hash123"}}, nil)
+				setter.EXPECT().
+					SetArticleDismissed(mock.Anything, "hash123", "user1", tc.value == boolTrue).
+					Return(nil)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/articles/hash123/dismissed/"+tc.value, nil)
+			req = testContextWithUserID("user1")(req)
+			req = mux.SetURLVars(req, map[string]string{"article_id": "hash123", "dismissed": tc.value})
+			rec := httptest.NewRecorder()
+
+			ArticleDismissedSet{Fetcher: fetcher, DismissedSetter: setter}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestArticleSnoozeSet_ServeHTTP(t *testing.T) {
+	until := time.Now().Add(7 * 24 * time.Hour).UTC().Truncate(time.Second)
+
+	cases := []struct {
+		name         string
+		userID       string
+		body         string
+		wantFetch    bool
+		articleFound bool
+		snoozeErr    error
+		wantSnooze   bool
+		wantStatus   int
+	}{
+		{
+			name:         "snoozes",
+			userID:       "user1",
+			body:         `{"until":"` + until.Format(time.RFC3339) + `"}`,
+			wantFetch:    true,
+			articleFound: true,
+			wantSnooze:   true,
+			wantStatus:   http.StatusNoContent,
+		},
+		{
+			name:       "unknown_article",
+			userID:     "user1",
+			body:       `{"until":"` + until.Format(time.RFC3339) + `"}`,
+			wantFetch:  true,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "until_in_past",
+			userID:     "user1",
+			body:       `{"until":"2020-01-01T00:00:00Z"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing_until",
+			userID:     "user1",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_json",
+			userID:     "user1",
+			body:       `not json`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "snooze_error",
+			userID:       "user1",
+			body:         `{"until":"` + until.Format(time.RFC3339) + `"}`,
+			wantFetch:    true,
+			articleFound: true,
+			wantSnooze:   true,
+			snoozeErr:    errors.New("db down"),
+			wantStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:       "no_user_id_unauthorized",
+			body:       `{"until":"` + until.Format(time.RFC3339) + `"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocks.NewArticleFetcher(t)
+			snoozer := mocks.NewArticleSnoozer(t)
+
+			if tc.wantFetch {
+				var articles []domain.Article
+				if tc.articleFound {
+					articles = []domain.Article{{HashID: "hash123"}}
+				}
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"hash123"}).
+					Return(articles, nil)
+			}
+			if tc.wantSnooze {
+				snoozer.EXPECT().
+					SnoozeArticle(mock.Anything, "hash123", tc.userID, mock.MatchedBy(until.Equal)).
+					Return(tc.snoozeErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/articles/hash123/snooze", strings.NewReader(tc.body))
+			req = testContextWithUserID(tc.userID)(req)
+			req = mux.SetURLVars(req, map[string]string{"article_id": "hash123"})
+			rec := httptest.NewRecorder()
+
+			ArticleSnoozeSet{Fetcher: fetcher, Snoozer: snoozer}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestArticleSnoozeClear_ServeHTTP(t *testing.T) {
+	snoozer := mocks.NewArticleSnoozer(t)
+	snoozer.EXPECT().
+		SnoozeArticle(mock.Anything, "hash123", "user1", time.Time{}).
+		Return(nil)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/v1/articles/hash123/snooze", nil)
+	req = testContextWithUserID("user1")(req)
+	req = mux.SetURLVars(req, map[string]string{"article_id": "hash123"})
+	rec := httptest.NewRecorder()
+
+	ArticleSnoozeClear{Snoozer: snoozer}.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
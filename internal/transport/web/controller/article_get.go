@@ -13,6 +13,7 @@ import (
 
 type ArticleGet struct {
 	Fetcher     datasources.ArticleFetcher
+	NoteLister  datasources.ArticleNoteLister
 	CacheMaxAge time.Duration
 }
 
@@ -35,12 +36,23 @@ func (c ArticleGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	article := articles[0]
+	userID := domain.UserIDFromContext(ctx)
+	if userID != "" {
+		article.Notes, err = c.NoteLister.ListArticleNotes(ctx, userID, id)
+		if err != nil {
+			logger.ErrorContext(ctx, "unable to list article notes", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	if domain.UserIDFromContext(ctx) == "" {
+	if userID == "" {
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(c.CacheMaxAge.Seconds())))
 	}
 
-	if err := json.NewEncoder(w).Encode(articles[0]); err != nil {
+	if err := json.NewEncoder(w).Encode(article); err != nil {
 		logger.ErrorContext(ctx, "unable to write articles to response", "error", err)
 	}
 }
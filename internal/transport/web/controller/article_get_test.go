@@ -25,6 +25,7 @@ func TestArticleGet_ServeHTTP(t *testing.T) {
 		setupContext  func(r *http.Request) *http.Request
 		articles      []domain.Article
 		fetchErr      error
+		notes         []domain.ArticleNote
 		wantStatus    int
 		wantCacheCtrl string
 		wantArticle   *domain.Article
@@ -73,6 +74,25 @@ func TestArticleGet_ServeHTTP(t *testing.T) {
 				PublishedAt: &testTime,
 			},
 		},
+		{
+			name:         "includes_notes_for_authenticated_user",
+			articleID:    "hash123",
+			setupContext: testContextWithUserID("user456"),
+			articles: []domain.Article{
+				{HashID: "hash123", Title: "Test Article"},
+			},
+			notes: []domain.ArticleNote{
+				{ID: "note1", ArticleHashID: "hash123", Quote: "a key passage", CreatedAt: testTime, UpdatedAt: testTime},
+			},
+			wantStatus: http.StatusOK,
+			wantArticle: &domain.Article{
+				HashID: "hash123",
+				Title:  "Test Article",
+				Notes: []domain.ArticleNote{
+					{ID: "note1", ArticleHashID: "hash123", Quote: "a key passage", CreatedAt: testTime, UpdatedAt: testTime},
+				},
+			},
+		},
 		{
 			name:         "fetch_error",
 			articleID:    "hash123",
@@ -85,13 +105,20 @@ func TestArticleGet_ServeHTTP(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			fetcher := mocks.NewArticleFetcher(t)
+			noteLister := mocks.NewArticleNoteLister(t)
 
 			fetcher.EXPECT().
 				FetchArticlesByID(mock.Anything, []string{tc.articleID}).
 				Return(tc.articles, tc.fetchErr)
+			if tc.wantStatus == http.StatusOK && tc.wantCacheCtrl == "" {
+				noteLister.EXPECT().
+					ListArticleNotes(mock.Anything, "user456", tc.articleID).
+					Return(tc.notes, nil)
+			}
 
 			controller := ArticleGet{
 				Fetcher:     fetcher,
+				NoteLister:  noteLister,
 				CacheMaxAge: time.Hour,
 			}
 
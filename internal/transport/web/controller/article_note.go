@@ -0,0 +1,299 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// maxArticleNoteLength is the maximum size of a note body or quote, matching the TEXT column limit.
+const maxArticleNoteLength = 65535
+
+// ArticleNoteRequest is the JSON request body for creating or updating a note.
+// On update, omitted fields are left unchanged.
+type ArticleNoteRequest struct {
+	Body  *string `json:"body,omitempty"`
+	Quote *string `json:"quote,omitempty"`
+}
+
+// ArticleNoteListResponse is the JSON response for listing notes.
+type ArticleNoteListResponse struct {
+	Data []domain.ArticleNote `json:"data"`
+}
+
+// ArticleNotesList handles GET /v1/articles/{article_id}/notes to list the user's notes on an article.
+type ArticleNotesList struct {
+	Lister datasources.ArticleNoteLister
+}
+
+func (c ArticleNotesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	notes, err := c.Lister.ListArticleNotes(ctx, userID, articleID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list article notes", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeArticleNoteList(w, r, notes)
+}
+
+// ArticleNoteCreate handles POST /v1/articles/{article_id}/notes to add a note or highlight to an article.
+type ArticleNoteCreate struct {
+	Fetcher   datasources.ArticleFetcher
+	CreateCmd command.Command[command.CreateArticleNoteRequest, domain.ArticleNote]
+}
+
+func (c ArticleNoteCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reqBody, ok := parseArticleNoteRequest(w, r)
+	if !ok {
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, []string{articleID})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch article", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if len(articles) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	req := command.CreateArticleNoteRequest{
+		UserID:        userID,
+		ArticleHashID: articleID,
+	}
+	if reqBody.Body != nil {
+		req.Body = *reqBody.Body
+	}
+	if reqBody.Quote != nil {
+		req.Quote = *reqBody.Quote
+	}
+
+	note, err := c.CreateCmd.Execute(ctx, req)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to create article note", "error", err, "article_id", articleID)
+		writeArticleNoteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(note); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// UserArticleNotesList handles GET /v1/notes to list the user's notes across all articles.
+// With a q parameter, it instead runs a full-text search over the notes and their quotes.
+type UserArticleNotesList struct {
+	Lister   datasources.UserArticleNoteLister
+	Searcher datasources.ArticleNoteSearcher
+}
+
+func (c UserArticleNotesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	page, pageSize, err := parsePagination(q)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse pagination", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var notes []domain.ArticleNote
+	if query := q.Get("q"); query != "" {
+		notes, err = c.Searcher.SearchUserArticleNotes(ctx, userID, query, page, pageSize)
+	} else {
+		notes, err = c.Lister.ListUserArticleNotes(ctx, userID, page, pageSize)
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list user article notes", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeArticleNoteList(w, r, notes)
+}
+
+// ArticleNoteGet handles GET /v1/notes/{note_id} to fetch a note.
+type ArticleNoteGet struct {
+	Getter datasources.ArticleNoteGetter
+}
+
+func (c ArticleNoteGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteID := mux.Vars(r)["note_id"]
+	note, ok, err := c.Getter.GetArticleNote(ctx, userID, noteID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get article note", "error", err, "note_id", noteID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(note); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// ArticleNoteUpdate handles PATCH /v1/notes/{note_id} to edit a note's body or quote.
+type ArticleNoteUpdate struct {
+	UpdateCmd command.Command[command.UpdateArticleNoteRequest, domain.ArticleNote]
+}
+
+func (c ArticleNoteUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reqBody, ok := parseArticleNoteRequest(w, r)
+	if !ok {
+		return
+	}
+
+	noteID := mux.Vars(r)["note_id"]
+	note, err := c.UpdateCmd.Execute(ctx, command.UpdateArticleNoteRequest{
+		UserID: userID,
+		NoteID: noteID,
+		Body:   reqBody.Body,
+		Quote:  reqBody.Quote,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to update article note", "error", err, "note_id", noteID)
+		writeArticleNoteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(note); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// ArticleNoteDelete handles DELETE /v1/notes/{note_id} to delete a note.
+type ArticleNoteDelete struct {
+	Deleter datasources.ArticleNoteDeleter
+}
+
+func (c ArticleNoteDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteID := mux.Vars(r)["note_id"]
+	if err := c.Deleter.DeleteArticleNote(ctx, userID, noteID); err != nil {
+		logger.ErrorContext(ctx, "unable to delete article note", "error", err, "note_id", noteID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseArticleNoteRequest(w http.ResponseWriter, r *http.Request) (ArticleNoteRequest, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	var reqBody ArticleNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return ArticleNoteRequest{}, false
+	}
+
+	if (reqBody.Body != nil && len(*reqBody.Body) > maxArticleNoteLength) ||
+		(reqBody.Quote != nil && len(*reqBody.Quote) > maxArticleNoteLength) {
+		w.WriteHeader(http.StatusBadRequest)
+		return ArticleNoteRequest{}, false
+	}
+
+	return reqBody, true
+}
+
+func writeArticleNoteList(w http.ResponseWriter, r *http.Request, notes []domain.ArticleNote) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	if notes == nil {
+		notes = []domain.ArticleNote{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(ArticleNoteListResponse{
+		Data: notes,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+func writeArticleNoteError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, command.ErrInvalidArticleNote):
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Is(err, command.ErrArticleNoteNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestArticleNoteCreate_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name         string
+		body         string
+		wantFetch    bool
+		articleFound bool
+		wantReq      *command.CreateArticleNoteRequest
+		commandErr   error
+		wantStatus   int
+	}{
+		{
+			name:         "highlight_with_comment",
+			body:         `{"body":"Key result","quote":"we find that"}`,
+			wantFetch:    true,
+			articleFound: true,
+			wantReq: &command.CreateArticleNoteRequest{
+				UserID: "user1", ArticleHashID: "a1", Body: "Key result", Quote: "we find that",
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:         "empty_note",
+			body:         `{}`,
+			wantFetch:    true,
+			articleFound: true,
+			wantReq:      &command.CreateArticleNoteRequest{UserID: "user1", ArticleHashID: "a1"},
+			commandErr:   command.ErrInvalidArticleNote,
+			wantStatus:   http.StatusBadRequest,
+		},
+		{
+			name:       "unknown_article",
+			body:       `{"body":"x"}`,
+			wantFetch:  true,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "body_too_long",
+			body:       `{"body":"` + strings.Repeat("a", maxArticleNoteLength+1) + `"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocks.NewArticleFetcher(t)
+			createCmd := cmdmocks.NewCommand[command.CreateArticleNoteRequest, domain.ArticleNote](t)
+
+			if tc.wantFetch {
+				var articles []domain.Article
+				if tc.articleFound {
+					articles = []domain.Article{{HashID: "a1"}}
+				}
+				fetcher.EXPECT().FetchArticlesByID(mock.Anything, []string{"a1"}).Return(articles, nil)
+			}
+			if tc.wantReq != nil {
+				createCmd.EXPECT().
+					Execute(mock.Anything, *tc.wantReq).
+					Return(domain.ArticleNote{ID: "note1", ArticleHashID: "a1"}, tc.commandErr)
+			}
+
+			controller := ArticleNoteCreate{Fetcher: fetcher, CreateCmd: createCmd}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v1/articles/a1/notes",
+				strings.NewReader(tc.body))
+			req = testContextWithUserID("user1")(req)
+			req = mux.SetURLVars(req, map[string]string{"article_id": "a1"})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusCreated {
+				assert.Contains(t, rec.Body.String(), `"id":"note1"`)
+			}
+		})
+	}
+}
+
+func TestUserArticleNotesList_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		query      string
+		wantSearch string
+		wantStatus int
+	}{
+		{name: "lists_all", wantStatus: http.StatusOK},
+		{name: "searches", query: "?q=sparse+autoencoders", wantSearch: "sparse autoencoders", wantStatus: http.StatusOK},
+		{name: "bad_pagination", query: "?page_size=0", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lister := mocks.NewUserArticleNoteLister(t)
+			searcher := mocks.NewArticleNoteSearcher(t)
+
+			notes := []domain.ArticleNote{{ID: "note1"}}
+			switch {
+			case tc.wantStatus != http.StatusOK:
+			case tc.wantSearch != "":
+				searcher.EXPECT().
+					SearchUserArticleNotes(mock.Anything, "user1", tc.wantSearch, 1, defaultPageSize).
+					Return(notes, nil)
+			default:
+				lister.EXPECT().ListUserArticleNotes(mock.Anything, "user1", 1, defaultPageSize).Return(notes, nil)
+			}
+
+			controller := UserArticleNotesList{Lister: lister, Searcher: searcher}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/notes"+tc.query, nil)
+			req = testContextWithUserID("user1")(req)
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusOK {
+				assert.Contains(t, rec.Body.String(), `"id":"note1"`)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleGradedRatingSet handles POST /v1/articles/{article_id}/rating/{rating} to give an article
+// a 1-5 rating. Ratings of 4 or 5 also count as a thumbs up, and 1 or 2 as a thumbs down.
+type ArticleGradedRatingSet struct {
+	Fetcher      datasources.ArticleFetcher
+	SetRatingCmd command.Command[command.SetArticleRatingRequest, command.Empty]
+}
+
+func (c ArticleGradedRatingSet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	articleID := vars["article_id"]
+	rating, err := strconv.Atoi(vars["rating"])
+	if err != nil || domain.ValidateArticleRating(rating) != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !articleExists(w, r, c.Fetcher, articleID) {
+		return
+	}
+
+	if _, err := c.SetRatingCmd.Execute(ctx, command.SetArticleRatingRequest{
+		UserID:        userID,
+		ArticleHashID: articleID,
+		Rating:        &rating,
+	}); err != nil {
+		logger.ErrorContext(ctx, "failed to set article rating", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArticleGradedRatingClear handles DELETE /v1/articles/{article_id}/rating to clear an article's
+// rating along with its thumbs.
+type ArticleGradedRatingClear struct {
+	SetRatingCmd command.Command[command.SetArticleRatingRequest, command.Empty]
+}
+
+func (c ArticleGradedRatingClear) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	noRating := 0
+	if _, err := c.SetRatingCmd.Execute(ctx, command.SetArticleRatingRequest{
+		UserID:        userID,
+		ArticleHashID: articleID,
+		Rating:        &noRating,
+	}); err != nil {
+		logger.ErrorContext(ctx, "failed to clear article rating", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArticleSignalRequest is the JSON request body for reporting an implicit signal.
+// Seconds is the time spent on the article, for time_on_article signals.
+type ArticleSignalRequest struct {
+	Type    domain.ArticleSignalType `json:"type"`
+	Seconds int                      `json:"seconds,omitempty"`
+}
+
+// ArticleSignalRecord handles POST /v1/articles/{article_id}/signals for clients to report implicit
+// signals of interest in an article: opening its link, or time spent reading it.
+type ArticleSignalRecord struct {
+	Fetcher   datasources.ArticleFetcher
+	RecordCmd command.Command[command.RecordArticleSignalRequest, command.Empty]
+}
+
+func (c ArticleSignalRecord) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var reqBody ArticleSignalRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// Saves to collections are recorded by the collection endpoints, not reported by clients
+	if reqBody.Type != domain.ArticleSignalOpenedLink && reqBody.Type != domain.ArticleSignalTimeOnArticle {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	signal := domain.ArticleSignal{
+		Type:     reqBody.Type,
+		Duration: time.Duration(reqBody.Seconds) * time.Second,
+	}
+	if err := signal.Validate(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	if !articleExists(w, r, c.Fetcher, articleID) {
+		return
+	}
+
+	if _, err := c.RecordCmd.Execute(ctx, command.RecordArticleSignalRequest{
+		UserID:        userID,
+		ArticleHashID: articleID,
+		Signal:        signal,
+	}); err != nil {
+		if errors.Is(err, domain.ErrInvalidArticleSignal) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		logger.ErrorContext(ctx, "failed to record article signal", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// articleExists writes a not found or internal error response and returns false if the article
+// can't be fetched.
+func articleExists(w http.ResponseWriter, r *http.Request, fetcher datasources.ArticleFetcher, articleID string) bool {
+	ctx := r.Context()
+
+	articles, err := fetcher.FetchArticlesByID(ctx, []string{articleID})
+	if err != nil {
+		logger := domain.LoggerFromContext(ctx)
+		logger.ErrorContext(ctx, "unable to fetch article", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return false
+	}
+	if len(articles) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return false
+	}
+	return true
+}
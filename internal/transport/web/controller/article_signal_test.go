@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestArticleGradedRatingSet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		rating     string
+		found      bool
+		wantFetch  bool
+		wantSet    int
+		setErr     error
+		wantStatus int
+	}{
+		{name: "sets_rating", userID: "user1", rating: "4", found: true, wantFetch: true, wantSet: 4,
+			wantStatus: http.StatusNoContent},
+		{name: "unknown_article", userID: "user1", rating: "4", wantFetch: true, wantStatus: http.StatusNotFound},
+		{name: "out_of_range", userID: "user1", rating: "6", wantStatus: http.StatusBadRequest},
+		{name: "not_a_number", userID: "user1", rating: "great", wantStatus: http.StatusBadRequest},
+		{name: "set_error", userID: "user1", rating: "1", found: true, wantFetch: true, wantSet: 1,
+			setErr: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+		{name: "no_user_id_unauthorized", rating: "4", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocks.NewArticleFetcher(t)
+			setRatingCmd := cmdmocks.NewCommand[command.SetArticleRatingRequest, command.Empty](t)
+
+			if tc.wantFetch {
+				var articles []domain.Article
+				if tc.found {
+					articles = []domain.Article{{HashID: "hash123"}}
+				}
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"hash123"}).
+					Return(articles, nil)
+			}
+			if tc.wantSet != 0 {
+				setRatingCmd.EXPECT().
+					Execute(mock.Anything, mock.MatchedBy(func(req command.SetArticleRatingRequest) bool {
+						return req.UserID == tc.userID && req.ArticleHashID == "hash123" &&
+							req.Rating != nil && *req.Rating == tc.wantSet &&
+							req.ThumbsUp == nil && req.ThumbsDown == nil
+					})).
+					Return(command.Empty{}, tc.setErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/articles/hash123/rating/"+tc.rating, nil)
+			req = testContextWithUserID(tc.userID)(req)
+			req = mux.SetURLVars(req, map[string]string{"article_id": "hash123", "rating": tc.rating})
+			rec := httptest.NewRecorder()
+
+			ArticleGradedRatingSet{Fetcher: fetcher, SetRatingCmd: setRatingCmd}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestArticleGradedRatingClear_ServeHTTP(t *testing.T) {
+	setRatingCmd := cmdmocks.NewCommand[command.SetArticleRatingRequest, command.Empty](t)
+	setRatingCmd.EXPECT().
+		Execute(mock.Anything, mock.MatchedBy(func(req command.SetArticleRatingRequest) bool {
+			return req.UserID == "user1" && req.Rating != nil && *req.Rating == 0
+		})).
+		Return(command.Empty{}, nil)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/v1/articles/hash123/rating", nil)
+	req = testContextWithUserID("user1")(req)
+	req = mux.SetURLVars(req, map[string]string{"article_id": "hash123"})
+	rec := httptest.NewRecorder()
+
+	ArticleGradedRatingClear{SetRatingCmd: setRatingCmd}.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestArticleSignalRecord_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		body       string
+		found      bool
+		wantFetch  bool
+		wantSignal *domain.ArticleSignal
+		recordErr  error
+		wantStatus int
+	}{
+		{
+			name:       "opened_link",
+			userID:     "user1",
+			body:       `{"type":"opened_link"}`,
+			found:      true,
+			wantFetch:  true,
+			wantSignal: &domain.ArticleSignal{Type: domain.ArticleSignalOpenedLink},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "time_on_article",
+			userID:     "user1",
+			body:       `{"type":"time_on_article","seconds":90}`,
+			found:      true,
+			wantFetch:  true,
+			wantSignal: &domain.ArticleSignal{Type: domain.ArticleSignalTimeOnArticle, Duration: 90 * time.Second},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "time_on_article_without_seconds",
+			userID:     "user1",
+			body:       `{"type":"time_on_article"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "saved_to_collection_not_reportable",
+			userID:     "user1",
+			body:       `{"type":"saved_to_collection"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown_type",
+			userID:     "user1",
+			body:       `{"type":"shared"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_json",
+			userID:     "user1",
+			body:       `not json`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown_article",
+			userID:     "user1",
+			body:       `{"type":"opened_link"}`,
+			wantFetch:  true,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "record_error",
+			userID:     "user1",
+			body:       `{"type":"opened_link"}`,
+			found:      true,
+			wantFetch:  true,
+			wantSignal: &domain.ArticleSignal{Type: domain.ArticleSignalOpenedLink},
+			recordErr:  errors.New("db down"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "no_user_id_unauthorized",
+			body:       `{"type":"opened_link"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocks.NewArticleFetcher(t)
+			recordCmd := cmdmocks.NewCommand[command.RecordArticleSignalRequest, command.Empty](t)
+
+			if tc.wantFetch {
+				var articles []domain.Article
+				if tc.found {
+					articles = []domain.Article{{HashID: "hash123"}}
+				}
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"hash123"}).
+					Return(articles, nil)
+			}
+			if tc.wantSignal != nil {
+				recordCmd.EXPECT().
+					Execute(mock.Anything, command.RecordArticleSignalRequest{
+						UserID:        tc.userID,
+						ArticleHashID: "hash123",
+						Signal:        *tc.wantSignal,
+					}).
+					Return(command.Empty{}, tc.recordErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/articles/hash123/signals", strings.NewReader(tc.body))
+			req = testContextWithUserID(tc.userID)(req)
+			req = mux.SetURLVars(req, map[string]string{"article_id": "hash123"})
+			rec := httptest.NewRecorder()
+
+			ArticleSignalRecord{Fetcher: fetcher, RecordCmd: recordCmd}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestCollectionArticleAdd_ServeHTTP_RecordsSignal(t *testing.T) {
+	cases := []struct {
+		name       string
+		signalErr  error
+		wantStatus int
+	}{
+		{name: "records_saved_signal", wantStatus: http.StatusNoContent},
+		{name: "signal_error_ignored", signalErr: errors.New("db down"), wantStatus: http.StatusNoContent},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewCollectionGetter(t)
+			fetcher := mocks.NewArticleFetcher(t)
+			adder := mocks.NewCollectionArticleAdder(t)
+			signalCmd := cmdmocks.NewCommand[command.RecordArticleSignalRequest, command.Empty](t)
+
+			getter.EXPECT().GetCollection(mock.Anything, "user1", "col1").
+				Return(domain.Collection{ID: "col1", UserID: "user1"}, true, nil)
+			fetcher.EXPECT().
+				FetchArticlesByID(mock.Anything, []string{"hash123"}).
+				Return([]domain.Article{{HashID: "hash123"}}, nil)
+			adder.EXPECT().AddCollectionArticle(mock.Anything, "col1", "hash123").Return(nil)
+			signalCmd.EXPECT().
+				Execute(mock.Anything, command.RecordArticleSignalRequest{
+					UserID:        "user1",
+					ArticleHashID: "hash123",
+					Signal:        domain.ArticleSignal{Type: domain.ArticleSignalSavedToCollection},
+				}).
+				Return(command.Empty{}, tc.signalErr)
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut,
+				"/v1/collections/col1/articles/hash123", nil)
+			req = testContextWithUserID("user1")(req)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": "col1", "article_id": "hash123"})
+			rec := httptest.NewRecorder()
+
+			CollectionArticleAdd{Getter: getter, Fetcher: fetcher, Adder: adder, SignalCmd: signalCmd}.
+				ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
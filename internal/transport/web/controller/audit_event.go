@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// AuditEventListResponse is the JSON response for listing audit events.
+type AuditEventListResponse struct {
+	Data []domain.AuditEvent `json:"data"`
+}
+
+// AuditEventsList handles GET /v1/me/audit-events to list security-relevant
+// activity on the user's account, most recent first.
+type AuditEventsList struct {
+	Lister datasources.UserAuditEventLister
+}
+
+func (c AuditEventsList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	page, pageSize, err := parsePagination(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse pagination", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	events, err := c.Lister.ListUserAuditEvents(ctx, userID, page, pageSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list audit events", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if events == nil {
+		events = []domain.AuditEvent{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(AuditEventListResponse{
+		Data: events,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+const (
+	defaultAuthorsLimit = 10
+	maxAuthorsLimit     = 100
+)
+
+// CoAuthorsResponse is the JSON response for listing an author's co-authors.
+type CoAuthorsResponse struct {
+	Data []domain.CoAuthor `json:"data"`
+}
+
+// SimilarAuthorsResponse is the JSON response for listing authors similar to an author.
+type SimilarAuthorsResponse struct {
+	Data []domain.SimilarAuthor `json:"data"`
+}
+
+// AuthorGet handles GET /v1/authors/{author_id} to get an author and the other spellings
+// of their name.
+type AuthorGet struct {
+	Getter datasources.AuthorGetter
+}
+
+func (c AuthorGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	author, ok := getAuthor(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(author); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// AuthorArticlesList handles GET /v1/authors/{author_id}/articles to list an author's
+// articles, newest first.
+type AuthorArticlesList struct {
+	Getter  datasources.AuthorGetter
+	Lister  datasources.AuthorArticleIDsLister
+	Fetcher datasources.ArticleFetcher
+}
+
+func (c AuthorArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	page, pageSize, err := parsePagination(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse pagination", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	author, ok := getAuthor(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	ids, err := c.Lister.ListAuthorArticleIDs(ctx, author.ID, page, pageSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list author articles", "error", err, "author_id", author.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, ids)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch articles", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ArticlesListResponse{
+		Data:     articles,
+		Metadata: ArticlesListMetadata{},
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write articles to response", "error", err)
+	}
+}
+
+// AuthorCoAuthorsList handles GET /v1/authors/{author_id}/coauthors to list the authors
+// who have written the most articles with an author.
+type AuthorCoAuthorsList struct {
+	Getter datasources.AuthorGetter
+	Lister datasources.CoAuthorLister
+}
+
+func (c AuthorCoAuthorsList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	limit, err := parseLimit(r.URL.Query(), defaultAuthorsLimit, maxAuthorsLimit)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse limit", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	author, ok := getAuthor(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	coAuthors, err := c.Lister.ListCoAuthors(ctx, author.ID, limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list co-authors", "error", err, "author_id", author.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if coAuthors == nil {
+		coAuthors = []domain.CoAuthor{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(CoAuthorsResponse{Data: coAuthors}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// AuthorSimilarList handles GET /v1/authors/{author_id}/similar to list the authors whose
+// articles are most similar to an author's.
+type AuthorSimilarList struct {
+	Getter  datasources.AuthorGetter
+	Command command.Command[command.SimilarAuthorsRequest, []domain.SimilarAuthor]
+}
+
+func (c AuthorSimilarList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	limit, err := parseLimit(r.URL.Query(), defaultAuthorsLimit, maxAuthorsLimit)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse limit", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	author, ok := getAuthor(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	similar, err := c.Command.Execute(ctx, command.SimilarAuthorsRequest{AuthorID: author.ID, Limit: limit})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to find similar authors", "error", err, "author_id", author.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if similar == nil {
+		similar = []domain.SimilarAuthor{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(SimilarAuthorsResponse{Data: similar}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// getAuthor loads the author identified in the route, writing a not found response
+// if there is no such author.
+func getAuthor(w http.ResponseWriter, r *http.Request, getter datasources.AuthorGetter) (domain.Author, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	authorID := mux.Vars(r)["author_id"]
+	author, ok, err := getter.GetAuthor(ctx, authorID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get author", "error", err, "author_id", authorID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return domain.Author{}, false
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return domain.Author{}, false
+	}
+
+	return author, true
+}
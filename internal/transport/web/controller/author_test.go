@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newAuthorRequest(t *testing.T, path string) *http.Request {
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, path, nil)
+	return mux.SetURLVars(req, map[string]string{"author_id": "neel-nanda"})
+}
+
+func TestAuthorGet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		found      bool
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "found",
+			found:      true,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"neel-nanda","name":"Neel Nanda","aliases":["N. Nanda"],"article_count":3}`,
+		},
+		{
+			name:       "not_found",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewAuthorGetter(t)
+			var author domain.Author
+			if tc.found {
+				author = domain.Author{ID: "neel-nanda", Name: "Neel Nanda", Aliases: []string{"N. Nanda"}, ArticleCount: 3}
+			}
+			getter.EXPECT().GetAuthor(mock.Anything, "neel-nanda").Return(author, tc.found, nil)
+
+			controller := AuthorGet{Getter: getter}
+
+			rec := httptest.NewRecorder()
+			controller.ServeHTTP(rec, newAuthorRequest(t, "/v1/authors/neel-nanda"))
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAuthorArticlesList_ServeHTTP(t *testing.T) {
+	getter := mocks.NewAuthorGetter(t)
+	lister := mocks.NewAuthorArticleIDsLister(t)
+	fetcher := mocks.NewArticleFetcher(t)
+
+	getter.EXPECT().GetAuthor(mock.Anything, "neel-nanda").Return(domain.Author{ID: "neel-nanda"}, true, nil)
+	lister.EXPECT().ListAuthorArticleIDs(mock.Anything, "neel-nanda", 2, 10).Return([]string{"a1"}, nil)
+	fetcher.EXPECT().FetchArticlesByID(mock.Anything, []string{"a1"}).Return([]domain.Article{{HashID: "a1"}}, nil)
+
+	controller := AuthorArticlesList{Getter: getter, Lister: lister, Fetcher: fetcher}
+
+	rec := httptest.NewRecorder()
+	controller.ServeHTTP(rec, newAuthorRequest(t, "/v1/authors/neel-nanda/articles?page=2&page_size=10"))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"hash_id":"a1"`)
+}
+
+func TestAuthorCoAuthorsList_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		query      string
+		wantLimit  int
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "default_limit",
+			wantLimit:  10,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"data":[{"id":"andy-arditi","name":"Andy Arditi","shared_articles":2}]}`,
+		},
+		{
+			name:       "custom_limit",
+			query:      "?limit=5",
+			wantLimit:  5,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"data":[{"id":"andy-arditi","name":"Andy Arditi","shared_articles":2}]}`,
+		},
+		{
+			name:       "invalid_limit",
+			query:      "?limit=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewAuthorGetter(t)
+			lister := mocks.NewCoAuthorLister(t)
+
+			if tc.wantLimit > 0 {
+				getter.EXPECT().
+					GetAuthor(mock.Anything, "neel-nanda").
+					Return(domain.Author{ID: "neel-nanda"}, true, nil)
+				lister.EXPECT().
+					ListCoAuthors(mock.Anything, "neel-nanda", tc.wantLimit).
+					Return([]domain.CoAuthor{{ID: "andy-arditi", Name: "Andy Arditi", SharedArticles: 2}}, nil)
+			}
+
+			controller := AuthorCoAuthorsList{Getter: getter, Lister: lister}
+
+			rec := httptest.NewRecorder()
+			controller.ServeHTTP(rec, newAuthorRequest(t, "/v1/authors/neel-nanda/coauthors"+tc.query))
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAuthorSimilarList_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name     string
+		similar  []domain.SimilarAuthor
+		wantBody string
+	}{
+		{
+			name:     "found",
+			similar:  []domain.SimilarAuthor{{ID: "jane-doe", Name: "Jane Doe", Score: 1.5}},
+			wantBody: `{"data":[{"id":"jane-doe","name":"Jane Doe","score":1.5}]}`,
+		},
+		{
+			name:     "none",
+			wantBody: `{"data":[]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewAuthorGetter(t)
+			similarCmd := cmdmocks.NewCommand[command.SimilarAuthorsRequest, []domain.SimilarAuthor](t)
+
+			getter.EXPECT().GetAuthor(mock.Anything, "neel-nanda").Return(domain.Author{ID: "neel-nanda"}, true, nil)
+			similarCmd.EXPECT().
+				Execute(mock.Anything, command.SimilarAuthorsRequest{AuthorID: "neel-nanda", Limit: 10}).
+				Return(tc.similar, nil)
+
+			controller := AuthorSimilarList{Getter: getter, Command: similarCmd}
+
+			rec := httptest.NewRecorder()
+			controller.ServeHTTP(rec, newAuthorRequest(t, "/v1/authors/neel-nanda/similar"))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+		})
+	}
+}
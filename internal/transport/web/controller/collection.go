@@ -0,0 +1,273 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+const maxCollectionNameLength = 128
+
+// CollectionRequest is the JSON request body for creating or updating a collection.
+// On update, omitted fields are left unchanged.
+type CollectionRequest struct {
+	Name     string `json:"name"`
+	IsPublic *bool  `json:"is_public,omitempty"`
+}
+
+// CollectionResponse is the JSON representation of a collection.
+// Share and feed URLs are only included while the collection is public.
+type CollectionResponse struct {
+	domain.Collection
+	ShareURL string `json:"share_url,omitempty"`
+	FeedURL  string `json:"feed_url,omitempty"`
+}
+
+// CollectionListResponse is the JSON response for listing collections.
+type CollectionListResponse struct {
+	Data []CollectionResponse `json:"data"`
+}
+
+// CollectionCreate handles POST /v1/collections to create a collection.
+type CollectionCreate struct {
+	CreateCmd    command.Command[command.CreateCollectionRequest, domain.Collection]
+	ShareBaseURL string
+}
+
+func (c CollectionCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reqBody, ok := parseCollectionRequest(w, r)
+	if !ok {
+		return
+	}
+	if reqBody.Name == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	collection, err := c.CreateCmd.Execute(ctx, command.CreateCollectionRequest{
+		UserID:   userID,
+		Name:     reqBody.Name,
+		IsPublic: reqBody.IsPublic != nil && *reqBody.IsPublic,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to create collection", "error", err)
+		if errors.Is(err, command.ErrCollectionLimitExceeded) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			if encErr := json.NewEncoder(w).Encode(map[string]string{
+				"error": err.Error(),
+			}); encErr != nil {
+				logger.ErrorContext(ctx, "unable to write error response", "error", encErr)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(newCollectionResponse(collection, c.ShareBaseURL)); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// CollectionList handles GET /v1/collections to list the user's collections.
+type CollectionList struct {
+	Lister       datasources.UserCollectionLister
+	ShareBaseURL string
+}
+
+func (c CollectionList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	collections, err := c.Lister.ListUserCollections(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list collections", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]CollectionResponse, 0, len(collections))
+	for _, collection := range collections {
+		items = append(items, newCollectionResponse(collection, c.ShareBaseURL))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(CollectionListResponse{
+		Data: items,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// CollectionGet handles GET /v1/collections/{collection_id} to fetch a collection.
+type CollectionGet struct {
+	Getter       datasources.CollectionGetter
+	ShareBaseURL string
+}
+
+func (c CollectionGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getUserCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(newCollectionResponse(collection, c.ShareBaseURL)); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// CollectionUpdate handles PATCH /v1/collections/{collection_id} to rename a collection
+// or change whether it is public.
+type CollectionUpdate struct {
+	Store interface {
+		datasources.CollectionGetter
+		datasources.CollectionUpdater
+	}
+	ShareBaseURL string
+}
+
+func (c CollectionUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getUserCollection(w, r, c.Store)
+	if !ok {
+		return
+	}
+
+	reqBody, ok := parseCollectionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if reqBody.Name != "" {
+		collection.Name = reqBody.Name
+	}
+	if reqBody.IsPublic != nil {
+		collection.IsPublic = *reqBody.IsPublic
+	}
+
+	if err := c.Store.UpdateCollection(ctx, collection); err != nil {
+		logger.ErrorContext(ctx, "unable to update collection", "error", err, "collection_id", collection.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(newCollectionResponse(collection, c.ShareBaseURL)); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// CollectionDelete handles DELETE /v1/collections/{collection_id} to delete a collection.
+type CollectionDelete struct {
+	Deleter datasources.CollectionDeleter
+}
+
+func (c CollectionDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	collectionID := mux.Vars(r)["collection_id"]
+	if err := c.Deleter.DeleteCollection(ctx, userID, collectionID); err != nil {
+		logger.ErrorContext(ctx, "unable to delete collection", "error", err, "collection_id", collectionID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newCollectionResponse(collection domain.Collection, shareBaseURL string) CollectionResponse {
+	resp := CollectionResponse{Collection: collection}
+	if collection.IsPublic {
+		resp.ShareURL = shareBaseURL + "/v1/shared/collections/" + collection.ShareToken
+		resp.FeedURL = shareBaseURL + "/rss/collections/" + collection.ShareToken
+	}
+	return resp
+}
+
+func parseCollectionRequest(w http.ResponseWriter, r *http.Request) (CollectionRequest, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	var reqBody CollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return CollectionRequest{}, false
+	}
+
+	if len(reqBody.Name) > maxCollectionNameLength {
+		w.WriteHeader(http.StatusBadRequest)
+		return CollectionRequest{}, false
+	}
+
+	return reqBody, true
+}
+
+// getUserCollection loads the collection named in the route for the authenticated user,
+// writing an error response and returning false if it can't be loaded.
+func getUserCollection(
+	w http.ResponseWriter, r *http.Request, getter datasources.CollectionGetter,
+) (domain.Collection, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return domain.Collection{}, false
+	}
+
+	collectionID := mux.Vars(r)["collection_id"]
+	collection, ok, err := getter.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get collection", "error", err, "collection_id", collectionID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return domain.Collection{}, false
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return domain.Collection{}, false
+	}
+
+	return collection, true
+}
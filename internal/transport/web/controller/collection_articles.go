@@ -0,0 +1,349 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/feeds"
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// CollectionReorderRequest is the JSON request body for reordering a collection.
+type CollectionReorderRequest struct {
+	ArticleIDs []string `json:"article_ids"`
+}
+
+// SharedCollectionResponse is the JSON response for a publicly shared collection.
+type SharedCollectionResponse struct {
+	Collection CollectionResponse `json:"collection"`
+	Data       []domain.Article   `json:"data"`
+}
+
+// CollectionArticlesList handles GET /v1/collections/{collection_id}/articles
+// to list a collection's articles in order.
+type CollectionArticlesList struct {
+	Getter  datasources.CollectionGetter
+	Lister  datasources.CollectionArticleIDsLister
+	Fetcher datasources.ArticleFetcher
+}
+
+func (c CollectionArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getUserCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	articles, err := fetchCollectionArticles(r, c.Lister, c.Fetcher, collection.ID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch collection articles", "error", err, "collection_id", collection.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ArticlesListResponse{
+		Data:     articles,
+		Metadata: ArticlesListMetadata{},
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write articles to response", "error", err)
+	}
+}
+
+// CollectionArticleAdd handles PUT /v1/collections/{collection_id}/articles/{article_id}
+// to append an article to a collection. Saving an article is recorded as a signal of interest in it.
+type CollectionArticleAdd struct {
+	Getter    datasources.CollectionGetter
+	Fetcher   datasources.ArticleFetcher
+	Adder     datasources.CollectionArticleAdder
+	SignalCmd command.Command[command.RecordArticleSignalRequest, command.Empty]
+}
+
+func (c CollectionArticleAdd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getUserCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, []string{articleID})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch article", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if len(articles) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err := c.Adder.AddCollectionArticle(ctx, collection.ID, articleID); err != nil {
+		logger.ErrorContext(ctx, "unable to add article to collection", "error", err,
+			"collection_id", collection.ID, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := c.SignalCmd.Execute(ctx, command.RecordArticleSignalRequest{
+		UserID:        domain.UserIDFromContext(ctx),
+		ArticleHashID: articleID,
+		Signal:        domain.ArticleSignal{Type: domain.ArticleSignalSavedToCollection},
+	}); err != nil {
+		logger.WarnContext(ctx, "unable to record saved to collection signal", "error", err,
+			"article_id", articleID)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CollectionArticleRemove handles DELETE /v1/collections/{collection_id}/articles/{article_id}
+// to remove an article from a collection.
+type CollectionArticleRemove struct {
+	Getter  datasources.CollectionGetter
+	Remover datasources.CollectionArticleRemover
+}
+
+func (c CollectionArticleRemove) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getUserCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	if err := c.Remover.RemoveCollectionArticle(ctx, collection.ID, articleID); err != nil {
+		logger.ErrorContext(ctx, "unable to remove article from collection", "error", err,
+			"collection_id", collection.ID, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CollectionReorder handles PUT /v1/collections/{collection_id}/articles to set the order
+// of a collection's articles.
+type CollectionReorder struct {
+	Getter     datasources.CollectionGetter
+	ReorderCmd command.Command[command.ReorderCollectionRequest, command.Empty]
+}
+
+func (c CollectionReorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getUserCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	var reqBody CollectionReorderRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if _, err := c.ReorderCmd.Execute(ctx, command.ReorderCollectionRequest{
+		CollectionID: collection.ID,
+		ArticleIDs:   reqBody.ArticleIDs,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to reorder collection", "error", err, "collection_id", collection.ID)
+		if errors.Is(err, command.ErrInvalidCollectionOrder) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CollectionSimilarArticles handles GET /v1/collections/{collection_id}/similar to find
+// articles similar to a collection as a whole, using its articles as the seed set.
+type CollectionSimilarArticles struct {
+	Getter     datasources.CollectionGetter
+	Lister     datasources.CollectionArticleIDsLister
+	Similarity datasources.SimilarArticleLister
+	Fetcher    datasources.ArticleFetcher
+}
+
+func (c CollectionSimilarArticles) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	limit := 10
+	if v := r.URL.Query().Get("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		limit = min(parsed, 100)
+	}
+
+	collection, ok := getUserCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	seedIDs, err := c.Lister.ListCollectionArticleIDs(ctx, collection.ID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list collection articles", "error", err, "collection_id", collection.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	articles := []domain.Article{}
+	if len(seedIDs) > 0 {
+		similarArticles, err := c.Similarity.ListSimilarArticles(ctx, seedIDs, limit)
+		if err != nil {
+			logger.ErrorContext(ctx, "unable to fetch similar articles", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		ids := make([]string, 0, len(similarArticles))
+		for _, similar := range similarArticles {
+			ids = append(ids, similar.HashID)
+		}
+
+		articles, err = c.Fetcher.FetchArticlesByID(ctx, ids)
+		if err != nil {
+			logger.ErrorContext(ctx, "unable to fetch articles", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ArticlesListResponse{
+		Data:     articles,
+		Metadata: ArticlesListMetadata{},
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write articles to response", "error", err)
+	}
+}
+
+// SharedCollectionGet handles GET /v1/shared/collections/{share_token} to view a public
+// collection and its articles without authentication.
+type SharedCollectionGet struct {
+	Getter       datasources.CollectionByShareTokenGetter
+	Lister       datasources.CollectionArticleIDsLister
+	Fetcher      datasources.ArticleFetcher
+	ShareBaseURL string
+}
+
+func (c SharedCollectionGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getPublicCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	articles, err := fetchCollectionArticles(r, c.Lister, c.Fetcher, collection.ID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch collection articles", "error", err, "collection_id", collection.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(SharedCollectionResponse{
+		Collection: newCollectionResponse(collection, c.ShareBaseURL),
+		Data:       articles,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// CollectionRSS handles GET /rss/collections/{share_token}, exporting a public collection as RSS.
+type CollectionRSS struct {
+	FeedHostname    string
+	FeedAuthorName  string
+	FeedAuthorEmail string
+	Getter          datasources.CollectionByShareTokenGetter
+	Lister          datasources.CollectionArticleIDsLister
+	Fetcher         datasources.ArticleFetcher
+	CacheMaxAge     time.Duration
+}
+
+func (c CollectionRSS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok := getPublicCollection(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	articles, err := fetchCollectionArticles(r, c.Lister, c.Fetcher, collection.ID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch collection articles for feed", "error", err,
+			"collection_id", collection.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	feed := &feeds.Feed{
+		Title:       "Alignment Research Feed: " + collection.Name,
+		Link:        &feeds.Link{Href: c.FeedHostname + r.URL.Path},
+		Description: "A reading list of alignment research articles",
+		Author:      &feeds.Author{Name: c.FeedAuthorName, Email: c.FeedAuthorEmail},
+		Created:     time.Now(),
+	}
+
+	writeArticlesRSS(w, r, feed, articles, c.CacheMaxAge)
+}
+
+func fetchCollectionArticles(
+	r *http.Request,
+	lister datasources.CollectionArticleIDsLister,
+	fetcher datasources.ArticleFetcher,
+	collectionID string,
+) ([]domain.Article, error) {
+	ids, err := lister.ListCollectionArticleIDs(r.Context(), collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetcher.FetchArticlesByID(r.Context(), ids)
+}
+
+// getPublicCollection loads the collection identified by the share token in the route,
+// writing a not found response unless it exists and is public.
+func getPublicCollection(
+	w http.ResponseWriter, r *http.Request, getter datasources.CollectionByShareTokenGetter,
+) (domain.Collection, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	collection, ok, err := getter.GetCollectionByShareToken(ctx, mux.Vars(r)["share_token"])
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get collection by share token", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return domain.Collection{}, false
+	}
+	if !ok || !collection.IsPublic {
+		w.WriteHeader(http.StatusNotFound)
+		return domain.Collection{}, false
+	}
+
+	return collection, true
+}
@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionCreate_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		body       string
+		wantReq    *command.CreateCollectionRequest
+		commandErr error
+		wantStatus int
+		wantShared bool
+	}{
+		{
+			name:       "private",
+			userID:     "user1",
+			body:       `{"name":"Reading list"}`,
+			wantReq:    &command.CreateCollectionRequest{UserID: "user1", Name: "Reading list"},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "public",
+			userID:     "user1",
+			body:       `{"name":"Reading list","is_public":true}`,
+			wantReq:    &command.CreateCollectionRequest{UserID: "user1", Name: "Reading list", IsPublic: true},
+			wantStatus: http.StatusCreated,
+			wantShared: true,
+		},
+		{
+			name:       "limit_exceeded",
+			userID:     "user1",
+			body:       `{"name":"One too many"}`,
+			wantReq:    &command.CreateCollectionRequest{UserID: "user1", Name: "One too many"},
+			commandErr: command.ErrCollectionLimitExceeded,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "missing_name",
+			userID:     "user1",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "name_too_long",
+			userID:     "user1",
+			body:       `{"name":"` + strings.Repeat("a", maxCollectionNameLength+1) + `"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "no_user_id_unauthorized",
+			body:       `{"name":"x"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			createCmd := cmdmocks.NewCommand[command.CreateCollectionRequest, domain.Collection](t)
+			if tc.wantReq != nil {
+				createCmd.EXPECT().
+					Execute(mock.Anything, *tc.wantReq).
+					Return(domain.Collection{
+						ID:         "col1",
+						UserID:     tc.wantReq.UserID,
+						Name:       tc.wantReq.Name,
+						IsPublic:   tc.wantReq.IsPublic,
+						ShareToken: "sharetoken",
+					}, tc.commandErr)
+			}
+
+			controller := CollectionCreate{CreateCmd: createCmd, ShareBaseURL: "https://api.example.com"}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v1/collections",
+				strings.NewReader(tc.body))
+			if tc.userID != "" {
+				req = testContextWithUserID(tc.userID)(req)
+			}
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var resp map[string]any
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, "col1", resp["id"])
+			assert.NotContains(t, resp, "share_token")
+			if tc.wantShared {
+				assert.Equal(t, "https://api.example.com/v1/shared/collections/sharetoken", resp["share_url"])
+				assert.Equal(t, "https://api.example.com/rss/collections/sharetoken", resp["feed_url"])
+			} else {
+				assert.NotContains(t, resp, "share_url")
+			}
+		})
+	}
+}
+
+func TestCollectionReorder_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		found      bool
+		body       string
+		wantRun    bool
+		commandErr error
+		wantStatus int
+	}{
+		{name: "reorders", found: true, body: `{"article_ids":["b","a"]}`, wantRun: true,
+			wantStatus: http.StatusNoContent},
+		{name: "invalid_order", found: true, body: `{"article_ids":["b","a"]}`, wantRun: true,
+			commandErr: command.ErrInvalidCollectionOrder, wantStatus: http.StatusBadRequest},
+		{name: "invalid_json", found: true, body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "not_found", found: false, body: `{"article_ids":[]}`, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewCollectionGetter(t)
+			reorderCmd := cmdmocks.NewCommand[command.ReorderCollectionRequest, command.Empty](t)
+
+			getter.EXPECT().GetCollection(mock.Anything, "user1", "col1").
+				Return(domain.Collection{ID: "col1", UserID: "user1"}, tc.found, nil)
+			if tc.wantRun {
+				reorderCmd.EXPECT().
+					Execute(mock.Anything, command.ReorderCollectionRequest{
+						CollectionID: "col1", ArticleIDs: []string{"b", "a"},
+					}).
+					Return(command.Empty{}, tc.commandErr)
+			}
+
+			controller := CollectionReorder{Getter: getter, ReorderCmd: reorderCmd}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/v1/collections/col1/articles",
+				strings.NewReader(tc.body))
+			req = testContextWithUserID("user1")(req)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": "col1"})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestSharedCollectionGet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		found      bool
+		public     bool
+		wantStatus int
+	}{
+		{name: "public", found: true, public: true, wantStatus: http.StatusOK},
+		{name: "private", found: true, public: false, wantStatus: http.StatusNotFound},
+		{name: "unknown_token", found: false, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewCollectionByShareTokenGetter(t)
+			lister := mocks.NewCollectionArticleIDsLister(t)
+			fetcher := mocks.NewArticleFetcher(t)
+
+			getter.EXPECT().GetCollectionByShareToken(mock.Anything, "sharetoken").
+				Return(domain.Collection{ID: "col1", Name: "Reading list", IsPublic: tc.public}, tc.found, nil)
+			if tc.wantStatus == http.StatusOK {
+				lister.EXPECT().ListCollectionArticleIDs(mock.Anything, "col1").Return([]string{"a1"}, nil)
+				fetcher.EXPECT().FetchArticlesByID(mock.Anything, []string{"a1"}).
+					Return([]domain.Article{{HashID: "a1"}}, nil)
+			}
+
+			controller := SharedCollectionGet{Getter: getter, Lister: lister, Fetcher: fetcher}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/shared/collections/sharetoken", nil)
+			req = mux.SetURLVars(req, map[string]string{"share_token": "sharetoken"})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusOK {
+				assert.Contains(t, rec.Body.String(), `"hash_id":"a1"`)
+				assert.Contains(t, rec.Body.String(), `"name":"Reading list"`)
+			}
+		})
+	}
+}
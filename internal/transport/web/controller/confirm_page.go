@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// confirmPageTemplate asks the user to confirm an action linked from an email by submitting
+// a POST, so mail scanners and prefetchers following the link with GET don't perform it.
+var confirmPageTemplate = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Button}}</title></head>
+<body>
+<p>{{.Prompt}}</p>
+<form method="post" action="?token={{.Token}}">
+<button type="submit">{{.Button}}</button>
+</form>
+</body>
+</html>
+`))
+
+// writeConfirmPage serves a page asking the user to confirm an action by POSTing its token.
+func writeConfirmPage(w http.ResponseWriter, r *http.Request, prompt, button, token string) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := confirmPageTemplate.Execute(w, struct{ Prompt, Button, Token string }{prompt, button, token}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
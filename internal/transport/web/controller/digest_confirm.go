@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// DigestEmailConfirm handles GET and POST /v1/digest/confirm?token=... to confirm a digest
+// email address. No authentication is required; the token in the confirmation email identifies
+// the address. GET serves a page confirming the address, and only POST confirms it.
+type DigestEmailConfirm struct {
+	Confirmer datasources.DigestEmailConfirmer
+}
+
+func (c DigestEmailConfirm) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		writeConfirmPage(w, r, "Send Alignment Research Feed digests to this address?", "Confirm", token)
+		return
+	}
+
+	found, err := c.Confirmer.ConfirmDigestEmail(ctx, token)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to confirm digest email", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := w.Write([]byte("Your address will now receive Alignment Research Feed digests.\n")); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
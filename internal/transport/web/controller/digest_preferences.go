@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/mail"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// DigestPreferencesRequest is the JSON request body for setting digest preferences.
+type DigestPreferencesRequest struct {
+	Email      string   `json:"email"`
+	Frequency  string   `json:"frequency"`
+	Categories []string `json:"categories,omitempty"`
+	Enabled    *bool    `json:"enabled,omitempty"`
+}
+
+// DigestPreferencesGet handles GET /v1/me/digest to fetch the user's digest preferences.
+type DigestPreferencesGet struct {
+	PreferencesGetter datasources.DigestPreferencesGetter
+}
+
+func (c DigestPreferencesGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefs, ok, err := c.PreferencesGetter.GetDigestPreferences(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get digest preferences", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	writeDigestPreferences(w, r, prefs)
+}
+
+// DigestPreferencesSet handles PUT /v1/me/digest to create or update the user's digest preferences.
+type DigestPreferencesSet struct {
+	SetCmd command.Command[command.SetDigestPreferencesRequest, domain.DigestPreferences]
+}
+
+func (c DigestPreferencesSet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var reqBody DigestPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if _, err := mail.ParseAddress(reqBody.Email); err != nil {
+		logger.ErrorContext(ctx, "invalid digest email", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	frequency, err := domain.ParseDigestFrequency(reqBody.Frequency)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid digest frequency", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	enabled := true
+	if reqBody.Enabled != nil {
+		enabled = *reqBody.Enabled
+	}
+
+	prefs, err := c.SetCmd.Execute(ctx, command.SetDigestPreferencesRequest{
+		UserID:     userID,
+		Email:      reqBody.Email,
+		Frequency:  frequency,
+		Categories: reqBody.Categories,
+		Enabled:    enabled,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to set digest preferences", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeDigestPreferences(w, r, prefs)
+}
+
+func writeDigestPreferences(w http.ResponseWriter, r *http.Request, prefs domain.DigestPreferences) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	if prefs.Categories == nil {
+		prefs.Categories = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(prefs); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestPreferencesSet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		body       string
+		wantReq    *command.SetDigestPreferencesRequest
+		commandErr error
+		wantStatus int
+	}{
+		{
+			name:   "valid_weekly",
+			userID: "user1",
+			body:   `{"email":"user@example.com","frequency":"weekly","categories":["Interpretability"]}`,
+			wantReq: &command.SetDigestPreferencesRequest{
+				UserID:     "user1",
+				Email:      "user@example.com",
+				Frequency:  domain.DigestFrequencyWeekly,
+				Categories: []string{"Interpretability"},
+				Enabled:    true,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "explicitly_disabled",
+			userID: "user1",
+			body:   `{"email":"user@example.com","frequency":"daily","enabled":false}`,
+			wantReq: &command.SetDigestPreferencesRequest{
+				UserID:    "user1",
+				Email:     "user@example.com",
+				Frequency: domain.DigestFrequencyDaily,
+				Enabled:   false,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid_frequency",
+			userID:     "user1",
+			body:       `{"email":"user@example.com","frequency":"hourly"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_email",
+			userID:     "user1",
+			body:       `{"email":"not-an-email","frequency":"daily"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_json",
+			userID:     "user1",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "command_error",
+			userID: "user1",
+			body:   `{"email":"user@example.com","frequency":"daily"}`,
+			wantReq: &command.SetDigestPreferencesRequest{
+				UserID:    "user1",
+				Email:     "user@example.com",
+				Frequency: domain.DigestFrequencyDaily,
+				Enabled:   true,
+			},
+			commandErr: errors.New("database error"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "no_user_id_unauthorized",
+			body:       `{"email":"user@example.com","frequency":"daily"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setCmd := cmdmocks.NewCommand[command.SetDigestPreferencesRequest, domain.DigestPreferences](t)
+			if tc.wantReq != nil {
+				setCmd.EXPECT().
+					Execute(mock.Anything, *tc.wantReq).
+					Return(domain.DigestPreferences{
+						UserID:           tc.wantReq.UserID,
+						Email:            tc.wantReq.Email,
+						Frequency:        tc.wantReq.Frequency,
+						Categories:       tc.wantReq.Categories,
+						Enabled:          tc.wantReq.Enabled,
+						UnsubscribeToken: "secret-token",
+					}, tc.commandErr)
+			}
+
+			controller := DigestPreferencesSet{SetCmd: setCmd}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/v1/me/digest",
+				strings.NewReader(tc.body))
+			if tc.userID != "" {
+				req = testContextWithUserID(tc.userID)(req)
+			}
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			assert.NotContains(t, rec.Body.String(), "secret-token")
+
+			var resp map[string]any
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.wantReq.Email, resp["email"])
+			assert.Equal(t, string(tc.wantReq.Frequency), resp["frequency"])
+			assert.Equal(t, tc.wantReq.Enabled, resp["enabled"])
+			assert.NotNil(t, resp["categories"])
+		})
+	}
+}
+
+func TestDigestUnsubscribe_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		token      string
+		found      bool
+		unsubErr   error
+		wantStatus int
+	}{
+		{name: "valid_token", token: "abc", found: true, wantStatus: http.StatusOK},
+		{name: "unknown_token", token: "abc", found: false, wantStatus: http.StatusNotFound},
+		{name: "missing_token", wantStatus: http.StatusBadRequest},
+		{name: "store_error", token: "abc", unsubErr: errors.New("db error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			unsubscriber := mocks.NewDigestUnsubscriber(t)
+			if tc.token != "" {
+				unsubscriber.EXPECT().UnsubscribeDigest(mock.Anything, tc.token).Return(tc.found, tc.unsubErr)
+			}
+
+			controller := DigestUnsubscribe{Unsubscriber: unsubscriber}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/digest/unsubscribe?token="+tc.token, nil)
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestDigestUnsubscribe_ServeHTTP_GetConfirms(t *testing.T) {
+	// Link scanners follow links with GET, so it must not unsubscribe
+	unsubscriber := mocks.NewDigestUnsubscriber(t)
+	controller := DigestUnsubscribe{Unsubscriber: unsubscriber}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/digest/unsubscribe?token=abc", nil)
+	rec := httptest.NewRecorder()
+
+	controller.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `<form method="post" action="?token=abc">`)
+}
+
+func TestDigestEmailConfirm_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		method     string
+		token      string
+		found      bool
+		confirmErr error
+		wantStatus int
+	}{
+		{name: "valid_token", method: http.MethodPost, token: "abc", found: true, wantStatus: http.StatusOK},
+		{name: "unknown_token", method: http.MethodPost, token: "abc", wantStatus: http.StatusNotFound},
+		{name: "missing_token", method: http.MethodPost, wantStatus: http.StatusBadRequest},
+		{
+			name:       "store_error",
+			method:     http.MethodPost,
+			token:      "abc",
+			confirmErr: errors.New("db error"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		// Link scanners follow links with GET, so it must not confirm
+		{name: "get_confirms_first", method: http.MethodGet, token: "abc", wantStatus: http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			confirmer := mocks.NewDigestEmailConfirmer(t)
+			if tc.method == http.MethodPost && tc.token != "" {
+				confirmer.EXPECT().ConfirmDigestEmail(mock.Anything, tc.token).Return(tc.found, tc.confirmErr)
+			}
+
+			controller := DigestEmailConfirm{Confirmer: confirmer}
+
+			req := httptest.NewRequestWithContext(t.Context(), tc.method, "/v1/digest/confirm?token="+tc.token, nil)
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
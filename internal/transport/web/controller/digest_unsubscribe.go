@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// DigestUnsubscribe handles GET and POST /v1/digest/unsubscribe?token=... to disable
+// a user's email digest. No authentication is required; the token in the digest
+// email identifies the user. GET serves a page confirming the unsubscribe, and only
+// POST unsubscribes, which also supports RFC 8058 one-click unsubscribe.
+type DigestUnsubscribe struct {
+	Unsubscriber datasources.DigestUnsubscriber
+}
+
+func (c DigestUnsubscribe) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		writeConfirmPage(w, r, "Unsubscribe from Alignment Research Feed digests?", "Unsubscribe", token)
+		return
+	}
+
+	found, err := c.Unsubscriber.UnsubscribeDigest(ctx, token)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to unsubscribe from digest", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := w.Write([]byte("You have been unsubscribed from Alignment Research Feed digests.\n")); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
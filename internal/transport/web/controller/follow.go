@@ -0,0 +1,373 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/feeds"
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// FollowListResponse is the JSON response for listing the user's follows.
+type FollowListResponse struct {
+	Data []domain.Follow `json:"data"`
+}
+
+// FollowPreferencesRequest is the JSON request body for setting follow preferences.
+type FollowPreferencesRequest struct {
+	IncludeInRecommendations *bool `json:"include_in_recommendations"`
+}
+
+// FollowPreferencesResponse is the JSON response for follow preferences, with the URL of the
+// user's personalized RSS feed of followed articles once they have one.
+type FollowPreferencesResponse struct {
+	domain.FollowPreferences
+	FeedURL string `json:"feed_url,omitempty"`
+}
+
+// FollowsList handles GET /v1/follows to list the authors, sources and categories the user follows.
+type FollowsList struct {
+	Lister datasources.FollowLister
+}
+
+func (c FollowsList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	follows, err := c.Lister.ListFollows(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list follows", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if follows == nil {
+		follows = []domain.Follow{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(FollowListResponse{Data: follows}); err != nil {
+		logger.ErrorContext(ctx, "unable to write follows to response", "error", err)
+	}
+}
+
+// FollowAdd handles PUT /v1/follows/{follow_type}/{target} to follow an author, source or category.
+type FollowAdd struct {
+	FollowCmd command.Command[command.FollowItemRequest, command.Empty]
+}
+
+func (c FollowAdd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	followType, target, ok := followFromRoute(w, r)
+	if !ok {
+		return
+	}
+
+	_, err := c.FollowCmd.Execute(ctx, command.FollowItemRequest{
+		UserID: userID,
+		Type:   followType,
+		Target: target,
+	})
+	if err != nil {
+		if errors.Is(err, command.ErrFollowedAuthorNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		logger.ErrorContext(ctx, "unable to follow item", "error", err, "follow_type", followType, "target", target)
+		if errors.Is(err, command.ErrFollowLimitExceeded) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			if encErr := json.NewEncoder(w).Encode(map[string]string{
+				"error": err.Error(),
+			}); encErr != nil {
+				logger.ErrorContext(ctx, "unable to write error response", "error", encErr)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FollowRemove handles DELETE /v1/follows/{follow_type}/{target} to unfollow an author, source or category.
+type FollowRemove struct {
+	Unfollower datasources.ItemUnfollower
+}
+
+func (c FollowRemove) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	followType, target, ok := followFromRoute(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.Unfollower.UnfollowItem(ctx, userID, followType, target); err != nil {
+		logger.ErrorContext(ctx, "unable to unfollow item", "error", err, "follow_type", followType, "target", target)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FollowPreferencesGet handles GET /v1/me/following to fetch the user's follow preferences.
+// Users without stored preferences get the defaults, without a feed URL.
+type FollowPreferencesGet struct {
+	PreferencesGetter datasources.FollowPreferencesGetter
+	FeedBaseURL       string
+}
+
+func (c FollowPreferencesGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefs, _, err := c.PreferencesGetter.GetFollowPreferences(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get follow preferences", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeFollowPreferences(w, r, prefs, c.FeedBaseURL)
+}
+
+// FollowPreferencesSet handles PUT /v1/me/following to create or update the user's follow preferences.
+type FollowPreferencesSet struct {
+	SetCmd      command.Command[command.SetFollowPreferencesRequest, domain.FollowPreferences]
+	FeedBaseURL string
+}
+
+func (c FollowPreferencesSet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var reqBody FollowPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if reqBody.IncludeInRecommendations == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	prefs, err := c.SetCmd.Execute(ctx, command.SetFollowPreferencesRequest{
+		UserID:                   userID,
+		IncludeInRecommendations: *reqBody.IncludeInRecommendations,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to set follow preferences", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeFollowPreferences(w, r, prefs, c.FeedBaseURL)
+}
+
+// FollowingArticlesList handles GET /v1/articles/following to list the latest articles by any
+// author, or in any source or category, the user follows. The usual article filters narrow it further.
+type FollowingArticlesList struct {
+	ListCmd command.Command[command.ListFollowedArticleIDsRequest, []string]
+	Fetcher datasources.ArticleFetcher
+}
+
+func (c FollowingArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	articles, ok := listFollowedArticles(w, r, c.ListCmd, c.Fetcher, userID)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ArticlesListResponse{
+		Data:     articles,
+		Metadata: ArticlesListMetadata{},
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write articles to response", "error", err)
+	}
+}
+
+// FollowingRSS handles GET /rss/following/{feed_token}, rendering a user's following feed as RSS.
+// The feed token stands in for authentication since feed readers can't send an Authorization header.
+type FollowingRSS struct {
+	FeedHostname    string
+	FeedAuthorName  string
+	FeedAuthorEmail string
+	Getter          datasources.FollowPreferencesByFeedTokenGetter
+	ListCmd         command.Command[command.ListFollowedArticleIDsRequest, []string]
+	Fetcher         datasources.ArticleFetcher
+	CacheMaxAge     time.Duration
+}
+
+func (c FollowingRSS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	prefs, ok, err := c.Getter.GetFollowPreferencesByFeedToken(ctx, mux.Vars(r)["feed_token"])
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get follow preferences by feed token", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	articles, ok := listFollowedArticles(w, r, c.ListCmd, c.Fetcher, prefs.UserID)
+	if !ok {
+		return
+	}
+
+	feed := &feeds.Feed{
+		Title:       "Alignment Research Feed: Following",
+		Link:        &feeds.Link{Href: c.FeedHostname + r.URL.Path},
+		Description: "Articles by followed authors, sources and categories on the alignment research feed",
+		Author:      &feeds.Author{Name: c.FeedAuthorName, Email: c.FeedAuthorEmail},
+		Created:     time.Now(),
+	}
+
+	writeArticlesRSS(w, r, feed, articles, c.CacheMaxAge)
+}
+
+// listFollowedArticles lists the user's followed articles matching the filters and options in the
+// query string, writing an error response and returning false if that fails.
+func listFollowedArticles(
+	w http.ResponseWriter,
+	r *http.Request,
+	listCmd command.Command[command.ListFollowedArticleIDsRequest, []string],
+	fetcher datasources.ArticleFetcher,
+	userID string,
+) ([]domain.Article, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	filters, err := articleFiltersFromQuery(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse article filters in query string", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+
+	options, err := listOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse article list options in query string", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+
+	articleIDs, err := listCmd.Execute(ctx, command.ListFollowedArticleIDsRequest{
+		UserID:  userID,
+		Filters: filters,
+		Options: options,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list followed article IDs", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	articles, err := fetcher.FetchArticlesByID(ctx, articleIDs)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch article metadata", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+	if articles == nil {
+		articles = []domain.Article{}
+	}
+
+	return articles, true
+}
+
+// followFromRoute parses the follow type and target named in the route, writing a bad request
+// response and returning false if either is invalid.
+func followFromRoute(w http.ResponseWriter, r *http.Request) (domain.FollowType, string, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	vars := mux.Vars(r)
+	followType, err := domain.ParseFollowType(vars["follow_type"])
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid follow type", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return "", "", false
+	}
+
+	target, err := domain.NormalizeFollowTarget(vars["target"])
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid follow target", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return "", "", false
+	}
+
+	return followType, target, true
+}
+
+func writeFollowPreferences(
+	w http.ResponseWriter, r *http.Request, prefs domain.FollowPreferences, feedBaseURL string,
+) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	resp := FollowPreferencesResponse{FollowPreferences: prefs}
+	if prefs.FeedToken != "" {
+		resp.FeedURL = feedBaseURL + "/rss/following/" + prefs.FeedToken
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,302 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFollowAdd_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		followType string
+		target     string
+		wantReq    *command.FollowItemRequest
+		commandErr error
+		wantStatus int
+	}{
+		{
+			name:       "author",
+			userID:     "user1",
+			followType: "author",
+			target:     "neel-nanda",
+			wantReq:    &command.FollowItemRequest{UserID: "user1", Type: domain.FollowTypeAuthor, Target: "neel-nanda"},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "trimmed_category",
+			userID:     "user1",
+			followType: "category",
+			target:     " Interpretability ",
+			wantReq: &command.FollowItemRequest{
+				UserID: "user1", Type: domain.FollowTypeCategory, Target: "Interpretability",
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "unauthenticated",
+			followType: "source",
+			target:     "arxiv",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid_type",
+			userID:     "user1",
+			followType: "tag",
+			target:     "rlhf",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "empty_target",
+			userID:     "user1",
+			followType: "source",
+			target:     " ",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown_author",
+			userID:     "user1",
+			followType: "author",
+			target:     "nobody",
+			wantReq:    &command.FollowItemRequest{UserID: "user1", Type: domain.FollowTypeAuthor, Target: "nobody"},
+			commandErr: command.ErrFollowedAuthorNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "limit_exceeded",
+			userID:     "user1",
+			followType: "source",
+			target:     "arxiv",
+			wantReq:    &command.FollowItemRequest{UserID: "user1", Type: domain.FollowTypeSource, Target: "arxiv"},
+			commandErr: command.ErrFollowLimitExceeded,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "command_error",
+			userID:     "user1",
+			followType: "source",
+			target:     "arxiv",
+			wantReq:    &command.FollowItemRequest{UserID: "user1", Type: domain.FollowTypeSource, Target: "arxiv"},
+			commandErr: errors.New("db down"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			followCmd := cmdmocks.NewCommand[command.FollowItemRequest, command.Empty](t)
+			if tc.wantReq != nil {
+				followCmd.EXPECT().Execute(mock.Anything, *tc.wantReq).Return(command.Empty{}, tc.commandErr)
+			}
+
+			controller := FollowAdd{FollowCmd: followCmd}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/v1/follows/x/y", nil)
+			if tc.userID != "" {
+				req = testContextWithUserID(tc.userID)(req)
+			}
+			req = mux.SetURLVars(req, map[string]string{"follow_type": tc.followType, "target": tc.target})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestFollowsList_ServeHTTP(t *testing.T) {
+	lister := mocks.NewFollowLister(t)
+	lister.EXPECT().ListFollows(mock.Anything, "user1").Return([]domain.Follow{
+		{Type: domain.FollowTypeSource, Target: "arxiv"},
+	}, nil)
+
+	controller := FollowsList{Lister: lister}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/follows", nil)
+	req = testContextWithUserID("user1")(req)
+	rec := httptest.NewRecorder()
+
+	controller.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t,
+		`{"data":[{"type":"source","target":"arxiv","created_at":"0001-01-01T00:00:00Z"}]}`,
+		rec.Body.String())
+}
+
+func TestFollowPreferencesGet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name     string
+		prefs    domain.FollowPreferences
+		found    bool
+		wantBody string
+	}{
+		{
+			name:     "found",
+			prefs:    domain.FollowPreferences{UserID: "user1", IncludeInRecommendations: true, FeedToken: "tok"},
+			found:    true,
+			wantBody: `{"include_in_recommendations":true,"feed_url":"https://example.com/rss/following/tok"}`,
+		},
+		{
+			name:     "not_found",
+			wantBody: `{"include_in_recommendations":false}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewFollowPreferencesGetter(t)
+			getter.EXPECT().GetFollowPreferences(mock.Anything, "user1").Return(tc.prefs, tc.found, nil)
+
+			controller := FollowPreferencesGet{PreferencesGetter: getter, FeedBaseURL: "https://example.com"}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/me/following", nil)
+			req = testContextWithUserID("user1")(req)
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+		})
+	}
+}
+
+func TestFollowPreferencesSet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		body       string
+		wantReq    *command.SetFollowPreferencesRequest
+		wantStatus int
+	}{
+		{
+			name:       "include",
+			body:       `{"include_in_recommendations":true}`,
+			wantReq:    &command.SetFollowPreferencesRequest{UserID: "user1", IncludeInRecommendations: true},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing_field",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_json",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setCmd := cmdmocks.NewCommand[command.SetFollowPreferencesRequest, domain.FollowPreferences](t)
+			if tc.wantReq != nil {
+				setCmd.EXPECT().Execute(mock.Anything, *tc.wantReq).Return(domain.FollowPreferences{
+					UserID:                   "user1",
+					IncludeInRecommendations: tc.wantReq.IncludeInRecommendations,
+					FeedToken:                "tok",
+				}, nil)
+			}
+
+			controller := FollowPreferencesSet{SetCmd: setCmd, FeedBaseURL: "https://example.com"}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/v1/me/following",
+				strings.NewReader(tc.body))
+			req = testContextWithUserID("user1")(req)
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusOK {
+				assert.Contains(t, rec.Body.String(), `"feed_url":"https://example.com/rss/following/tok"`)
+			}
+		})
+	}
+}
+
+func TestFollowingArticlesList_ServeHTTP(t *testing.T) {
+	listCmd := cmdmocks.NewCommand[command.ListFollowedArticleIDsRequest, []string](t)
+	fetcher := mocks.NewArticleFetcher(t)
+
+	listCmd.EXPECT().Execute(mock.Anything, command.ListFollowedArticleIDsRequest{
+		UserID:  "user1",
+		Filters: domain.ArticleFilters{Category: "Interpretability"},
+		Options: domain.ArticleListOptions{Page: 2, PageSize: 10},
+	}).Return([]string{"a1"}, nil)
+	fetcher.EXPECT().FetchArticlesByID(mock.Anything, []string{"a1"}).Return([]domain.Article{{HashID: "a1"}}, nil)
+
+	controller := FollowingArticlesList{ListCmd: listCmd, Fetcher: fetcher}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+		"/v1/articles/following?filter_category=Interpretability&page=2&page_size=10", nil)
+	req = testContextWithUserID("user1")(req)
+	rec := httptest.NewRecorder()
+
+	controller.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"hash_id":"a1"`)
+}
+
+func TestFollowingRSS_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		found      bool
+		wantStatus int
+	}{
+		{name: "found", found: true, wantStatus: http.StatusOK},
+		{name: "unknown_token", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewFollowPreferencesByFeedTokenGetter(t)
+			listCmd := cmdmocks.NewCommand[command.ListFollowedArticleIDsRequest, []string](t)
+			fetcher := mocks.NewArticleFetcher(t)
+
+			getter.EXPECT().
+				GetFollowPreferencesByFeedToken(mock.Anything, "tok").
+				Return(domain.FollowPreferences{UserID: "user1", FeedToken: "tok"}, tc.found, nil)
+			if tc.found {
+				listCmd.EXPECT().Execute(mock.Anything, command.ListFollowedArticleIDsRequest{
+					UserID:  "user1",
+					Options: domain.ArticleListOptions{Page: 1, PageSize: 50},
+				}).Return([]string{"a1"}, nil)
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"a1"}).
+					Return([]domain.Article{{HashID: "a1", Title: "Followed article"}}, nil)
+			}
+
+			controller := FollowingRSS{
+				FeedHostname: "https://example.com",
+				Getter:       getter,
+				ListCmd:      listCmd,
+				Fetcher:      fetcher,
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/rss/following/tok", nil)
+			req = mux.SetURLVars(req, map[string]string{"feed_token": "tok"})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.found {
+				assert.Contains(t, rec.Body.String(), "Followed article")
+			}
+		})
+	}
+}
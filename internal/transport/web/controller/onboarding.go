@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleCategoryListResponse is the JSON response for listing article categories.
+type ArticleCategoryListResponse struct {
+	Data []domain.ArticleCategory `json:"data"`
+}
+
+// OnboardingInterestsRequest is the JSON request body for setting onboarding interests.
+type OnboardingInterestsRequest struct {
+	Categories []string `json:"categories"`
+	Interests  []string `json:"interests"`
+}
+
+// ArticleCategoriesList handles GET /v1/categories to list the categories articles are in,
+// for users to pick from when onboarding.
+type ArticleCategoriesList struct {
+	Lister datasources.ArticleCategoryLister
+}
+
+func (c ArticleCategoriesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	categories, err := c.Lister.ListArticleCategories(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list article categories", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(ArticleCategoryListResponse{Data: categories}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// OnboardingInterestsGet handles GET /v1/me/onboarding to fetch the interests the user picked when onboarding.
+type OnboardingInterestsGet struct {
+	InterestsGetter datasources.OnboardingInterestsGetter
+}
+
+func (c OnboardingInterestsGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	interests, ok, err := c.InterestsGetter.GetOnboardingInterests(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get onboarding interests", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	writeOnboardingInterests(w, r, interests)
+}
+
+// OnboardingInterestsSet handles PUT /v1/me/onboarding to pick categories and free-text interests
+// that seed recommendations before the user has rated anything.
+type OnboardingInterestsSet struct {
+	SetCmd command.Command[command.SetOnboardingInterestsRequest, domain.OnboardingInterests]
+}
+
+func (c OnboardingInterestsSet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var reqBody OnboardingInterestsRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTextBytes+1024)).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	interests, err := c.SetCmd.Execute(ctx, command.SetOnboardingInterestsRequest{
+		UserID:     userID,
+		Categories: reqBody.Categories,
+		Interests:  reqBody.Interests,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to set onboarding interests", "error", err)
+		switch {
+		case errors.Is(err, domain.ErrInvalidOnboardingInterests):
+			w.WriteHeader(http.StatusBadRequest)
+		case errors.Is(err, command.ErrEmbeddingUnavailable):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	writeOnboardingInterests(w, r, interests)
+}
+
+func writeOnboardingInterests(w http.ResponseWriter, r *http.Request, interests domain.OnboardingInterests) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	if interests.Categories == nil {
+		interests.Categories = []string{}
+	}
+	if interests.Interests == nil {
+		interests.Interests = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(interests); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnboardingInterestsSet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		body       string
+		wantReq    *command.SetOnboardingInterestsRequest
+		commandErr error
+		wantStatus int
+	}{
+		{
+			name:   "valid",
+			userID: "user1",
+			body:   `{"categories":["Interpretability"],"interests":["reward hacking"]}`,
+			wantReq: &command.SetOnboardingInterestsRequest{
+				UserID:     "user1",
+				Categories: []string{"Interpretability"},
+				Interests:  []string{"reward hacking"},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid_interests",
+			userID:     "user1",
+			body:       `{"categories":[]}`,
+			wantReq:    &command.SetOnboardingInterestsRequest{UserID: "user1", Categories: []string{}},
+			commandErr: fmt.Errorf("normalizing: %w", domain.ErrInvalidOnboardingInterests),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "embedding_unavailable",
+			userID:     "user1",
+			body:       `{"interests":["debate"]}`,
+			wantReq:    &command.SetOnboardingInterestsRequest{UserID: "user1", Interests: []string{"debate"}},
+			commandErr: command.ErrEmbeddingUnavailable,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "command_error",
+			userID:     "user1",
+			body:       `{"interests":["debate"]}`,
+			wantReq:    &command.SetOnboardingInterestsRequest{UserID: "user1", Interests: []string{"debate"}},
+			commandErr: errors.New("db down"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{name: "invalid_json", userID: "user1", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "unauthenticated", body: `{"interests":["debate"]}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setCmd := cmdmocks.NewCommand[command.SetOnboardingInterestsRequest, domain.OnboardingInterests](t)
+			if tc.wantReq != nil {
+				setCmd.EXPECT().Execute(mock.Anything, *tc.wantReq).Return(domain.OnboardingInterests{
+					UserID:     "user1",
+					Categories: tc.wantReq.Categories,
+					Interests:  tc.wantReq.Interests,
+				}, tc.commandErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/v1/me/onboarding",
+				strings.NewReader(tc.body))
+			req = testContextWithUserID(tc.userID)(req)
+			rec := httptest.NewRecorder()
+
+			OnboardingInterestsSet{SetCmd: setCmd}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			var got domain.OnboardingInterests
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(t, tc.wantReq.Categories, got.Categories)
+			assert.Equal(t, tc.wantReq.Interests, got.Interests)
+		})
+	}
+}
+
+func TestOnboardingInterestsGet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		found      bool
+		getErr     error
+		wantStatus int
+	}{
+		{name: "found", userID: "user1", found: true, wantStatus: http.StatusOK},
+		{name: "not_onboarded", userID: "user1", wantStatus: http.StatusNotFound},
+		{name: "get_error", userID: "user1", getErr: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+		{name: "unauthenticated", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewOnboardingInterestsGetter(t)
+			if tc.userID != "" {
+				getter.EXPECT().GetOnboardingInterests(mock.Anything, "user1").Return(domain.OnboardingInterests{
+					UserID:     "user1",
+					Categories: []string{"Governance"},
+					UpdatedAt:  time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+				}, tc.found, tc.getErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/me/onboarding", nil)
+			req = testContextWithUserID(tc.userID)(req)
+			rec := httptest.NewRecorder()
+
+			OnboardingInterestsGet{InterestsGetter: getter}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusOK {
+				assert.JSONEq(t,
+					`{"categories":["Governance"],"interests":[],"updated_at":"2026-03-04T00:00:00Z"}`,
+					rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestArticleCategoriesList_ServeHTTP(t *testing.T) {
+	lister := mocks.NewArticleCategoryLister(t)
+	lister.EXPECT().ListArticleCategories(mock.Anything).Return([]domain.ArticleCategory{
+		{Name: "Interpretability", ArticleCount: 12},
+		{Name: "Governance", ArticleCount: 3},
+	}, nil)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/categories", nil)
+	rec := httptest.NewRecorder()
+
+	ArticleCategoriesList{Lister: lister}.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data":[{"name":"Interpretability","article_count":12},`+
+		`{"name":"Governance","article_count":3}]}`, rec.Body.String())
+}
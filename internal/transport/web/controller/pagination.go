@@ -43,3 +43,19 @@ func parsePagination(q url.Values) (page, pageSize int, err error) {
 
 	return page, pageSize, nil
 }
+
+// parseLimit parses an optional limit from the query, capping it at maxLimit.
+func parseLimit(q url.Values, defaultLimit, maxLimit int) (int, error) {
+	if !q.Has("limit") {
+		return defaultLimit, nil
+	}
+
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse limit from query: %w", err)
+	}
+	if limit < 1 {
+		return 0, fmt.Errorf("invalid limit value [%d]", limit)
+	}
+	return min(limit, maxLimit), nil
+}
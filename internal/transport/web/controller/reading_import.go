@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// maxImportBytes limits the size of an uploaded reading history file.
+const maxImportBytes = 5 << 20
+
+// ReadingImportResponse reports the outcome of a reading history import.
+type ReadingImportResponse struct {
+	MatchedCount   int                  `json:"matched_count"`
+	UnmatchedCount int                  `json:"unmatched_count"`
+	Matched        []domain.ImportMatch `json:"matched"`
+	Unmatched      []domain.ImportItem  `json:"unmatched"`
+}
+
+// ReadingImport handles POST /v1/me/import to import reading history from another tool.
+// The request body is the exported file, in the format given by the format query parameter.
+// Matched articles are marked read, and also liked if the like query parameter is true.
+type ReadingImport struct {
+	ImportCmd command.Command[command.ImportReadingHistoryRequest, command.ImportReadingHistoryResult]
+}
+
+func (c ReadingImport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	var like bool
+	switch query.Get("like") {
+	case "", boolFalse:
+	case boolTrue:
+		like = true
+	default:
+		logger.ErrorContext(ctx, "invalid like value", "value", query.Get("like"))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportBytes))
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to read import body", "error", err)
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	result, err := c.ImportCmd.Execute(ctx, command.ImportReadingHistoryRequest{
+		UserID: userID,
+		Format: domain.ImportFormat(query.Get("format")),
+		Data:   data,
+		Like:   like,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to import reading history", "error", err)
+		writeReadingImportError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ReadingImportResponse{
+		MatchedCount:   len(result.Matched),
+		UnmatchedCount: len(result.Unmatched),
+		Matched:        result.Matched,
+		Unmatched:      result.Unmatched,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+func writeReadingImportError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUnknownImportFormat),
+		errors.Is(err, domain.ErrInvalidImport),
+		errors.Is(err, command.ErrTooManyImportItems):
+		ctx := r.Context()
+		logger := domain.LoggerFromContext(ctx)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		if encErr := json.NewEncoder(w).Encode(map[string]string{
+			"error": err.Error(),
+		}); encErr != nil {
+			logger.ErrorContext(ctx, "unable to write error response", "error", encErr)
+		}
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadingImport_ServeHTTP(t *testing.T) {
+	result := command.ImportReadingHistoryResult{
+		Matched: []domain.ImportMatch{{
+			Item:          domain.ImportItem{Reference: "https://example.com/a", URL: "https://example.com/a"},
+			ArticleHashID: "art1",
+			MatchedBy:     domain.ImportMatchURL,
+		}},
+		Unmatched: []domain.ImportItem{{Reference: "nothing"}},
+	}
+
+	cases := []struct {
+		name       string
+		userID     string
+		query      string
+		importErr  error
+		wantImport bool
+		wantLike   bool
+		wantStatus int
+		wantError  bool
+	}{
+		{name: "imported", userID: "user1", query: "?format=urls", wantImport: true, wantStatus: http.StatusOK},
+		{name: "imported_liked", userID: "user1", query: "?format=urls&like=true", wantImport: true, wantLike: true,
+			wantStatus: http.StatusOK},
+		{name: "invalid_like", userID: "user1", query: "?format=urls&like=yes", wantStatus: http.StatusBadRequest},
+		{name: "unauthenticated", query: "?format=urls", wantStatus: http.StatusUnauthorized},
+		{name: "unknown_format", userID: "user1", query: "?format=endnote", wantImport: true,
+			importErr:  fmt.Errorf("parsing import: %w", domain.ErrUnknownImportFormat),
+			wantStatus: http.StatusBadRequest, wantError: true},
+		{name: "too_many_items", userID: "user1", query: "?format=urls", wantImport: true,
+			importErr: command.ErrTooManyImportItems, wantStatus: http.StatusBadRequest, wantError: true},
+		{name: "import_error", userID: "user1", query: "?format=urls", wantImport: true,
+			importErr: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			importCmd := cmdmocks.NewCommand[command.ImportReadingHistoryRequest, command.ImportReadingHistoryResult](t)
+			if tc.wantImport {
+				importCmd.EXPECT().
+					Execute(mock.Anything, mock.MatchedBy(func(req command.ImportReadingHistoryRequest) bool {
+						return req.UserID == "user1" && req.Like == tc.wantLike &&
+							string(req.Data) == "https://example.com/a\nnothing\n"
+					})).
+					Return(result, tc.importErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v1/me/import"+tc.query,
+				strings.NewReader("https://example.com/a\nnothing\n"))
+			req = testContextWithUserID(tc.userID)(req)
+			rec := httptest.NewRecorder()
+
+			ReadingImport{ImportCmd: importCmd}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantError {
+				var body map[string]string
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.NotEmpty(t, body["error"])
+			}
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			var got ReadingImportResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(t, 1, got.MatchedCount)
+			assert.Equal(t, 1, got.UnmatchedCount)
+			assert.Equal(t, result.Matched, got.Matched)
+			assert.Equal(t, result.Unmatched, got.Unmatched)
+		})
+	}
+}
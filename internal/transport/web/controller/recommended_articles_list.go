@@ -3,6 +3,8 @@ package controller
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/jbeshir/alignment-research-feed/internal/command"
 	"github.com/jbeshir/alignment-research-feed/internal/domain"
@@ -11,10 +13,19 @@ import (
 const (
 	// recommendationsLimit is the number of recommendations to return.
 	recommendationsLimit = 100
+
+	// newRecommendationsDefaultDays is how far back new recommendations look by default.
+	newRecommendationsDefaultDays = 7
+
+	// newRecommendationsMaxDays is the furthest back new recommendations can look.
+	newRecommendationsMaxDays = 90
 )
 
+// RecommendedArticlesList handles GET /v1/articles/recommended. The recommendations served
+// are sent to Impressions to be recorded asynchronously, so recording never delays the response.
 type RecommendedArticlesList struct {
-	Command command.Command[command.RecommendArticlesRequest, []domain.Article]
+	Command     command.Command[command.RecommendArticlesRequest, command.RecommendArticlesResponse]
+	Impressions chan<- []domain.RecommendationImpression
 }
 
 func (c RecommendedArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -27,13 +38,71 @@ func (c RecommendedArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	articles, err := c.Command.Execute(ctx, command.RecommendArticlesRequest{UserID: userID, Limit: recommendationsLimit})
+	result, err := c.Command.Execute(ctx, command.RecommendArticlesRequest{UserID: userID, Limit: recommendationsLimit})
 	if err != nil {
 		logger.ErrorContext(ctx, "unable to get recommended articles", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
+	writeRecommendations(w, r, result, c.Impressions)
+}
+
+// NewRecommendedArticlesList handles GET /v1/articles/recommended/new to recommend only articles
+// published in the last few days, 7 by default or as given by the days query parameter.
+// Like RecommendedArticlesList, the recommendations served are sent to Impressions to be recorded.
+type NewRecommendedArticlesList struct {
+	Command     command.Command[command.RecommendArticlesRequest, command.RecommendArticlesResponse]
+	Impressions chan<- []domain.RecommendationImpression
+}
+
+func (c NewRecommendedArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	days := newRecommendationsDefaultDays
+	if v := r.URL.Query().Get("days"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 || parsed > newRecommendationsMaxDays {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		days = parsed
+	}
+
+	result, err := c.Command.Execute(ctx, command.RecommendArticlesRequest{
+		UserID:         userID,
+		Limit:          recommendationsLimit,
+		PublishedAfter: time.Now().AddDate(0, 0, -days),
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get new recommended articles", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeRecommendations(w, r, result, c.Impressions)
+}
+
+// writeRecommendations writes recommended articles to the response, then sends the impressions
+// served to be recorded. If the queue is full the impressions are dropped rather than
+// holding up the request.
+func writeRecommendations(
+	w http.ResponseWriter,
+	r *http.Request,
+	result command.RecommendArticlesResponse,
+	impressions chan<- []domain.RecommendationImpression,
+) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	articles := result.Articles
 	if articles == nil {
 		articles = []domain.Article{}
 	}
@@ -45,5 +114,14 @@ func (c RecommendedArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		Metadata: ArticlesListMetadata{},
 	}); err != nil {
 		logger.ErrorContext(ctx, "unable to write recommended articles to response", "error", err)
+		return
+	}
+
+	if len(result.Impressions) > 0 {
+		select {
+		case impressions <- result.Impressions:
+		default:
+			logger.WarnContext(ctx, "impression queue full, dropping recommendation impressions")
+		}
 	}
 }
@@ -23,6 +23,7 @@ func TestRecommendedArticlesList_ServeHTTP(t *testing.T) {
 		name         string
 		userID       string
 		articles     []domain.Article
+		queueFull    bool
 		commandErr   error
 		wantStatus   int
 		wantArticles []domain.Article
@@ -40,6 +41,18 @@ func TestRecommendedArticlesList_ServeHTTP(t *testing.T) {
 				{HashID: "rec2", Title: "Recommended 2", PublishedAt: &testTime},
 			},
 		},
+		{
+			name:   "impression_queue_full",
+			userID: "user456",
+			articles: []domain.Article{
+				{HashID: "rec1", Title: "Recommended 1", PublishedAt: &testTime},
+			},
+			queueFull:  true,
+			wantStatus: http.StatusOK,
+			wantArticles: []domain.Article{
+				{HashID: "rec1", Title: "Recommended 1", PublishedAt: &testTime},
+			},
+		},
 		{
 			name:       "no_user_id_unauthorized",
 			userID:     "",
@@ -62,17 +75,30 @@ func TestRecommendedArticlesList_ServeHTTP(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			recommendCmd := cmdmocks.NewCommand[command.RecommendArticlesRequest, []domain.Article](t)
+			recommendCmd := cmdmocks.NewCommand[command.RecommendArticlesRequest, command.RecommendArticlesResponse](t)
+
+			var impressions []domain.RecommendationImpression
+			for i, article := range tc.articles {
+				impressions = append(impressions, domain.RecommendationImpression{
+					UserID: tc.userID, ArticleHashID: article.HashID, Position: i, ServedAt: testTime,
+				})
+			}
 
 			if tc.userID != "" {
 				expectedReq := command.RecommendArticlesRequest{UserID: tc.userID, Limit: recommendationsLimit}
 				recommendCmd.EXPECT().
 					Execute(mock.Anything, expectedReq).
-					Return(tc.articles, tc.commandErr)
+					Return(command.RecommendArticlesResponse{Articles: tc.articles, Impressions: impressions}, tc.commandErr)
 			}
 
+			impressionChan := make(chan []domain.RecommendationImpression, 1)
+			queued := []domain.RecommendationImpression{{UserID: "other", ArticleHashID: "queued"}}
+			if tc.queueFull {
+				impressionChan <- queued
+			}
 			controller := RecommendedArticlesList{
-				Command: recommendCmd,
+				Command:     recommendCmd,
+				Impressions: impressionChan,
 			}
 
 			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/recommended", nil)
@@ -93,6 +119,84 @@ func TestRecommendedArticlesList_ServeHTTP(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, tc.wantArticles, response.Data)
 			}
+
+			// Only recommendations actually served are recorded, and only if there's room in the queue
+			switch {
+			case tc.queueFull:
+				require.Len(t, impressionChan, 1)
+				assert.Equal(t, queued, <-impressionChan)
+			case len(impressions) > 0 && tc.commandErr == nil:
+				require.Len(t, impressionChan, 1)
+				assert.Equal(t, impressions, <-impressionChan)
+			default:
+				assert.Empty(t, impressionChan)
+			}
+		})
+	}
+}
+
+func TestNewRecommendedArticlesList_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		query      string
+		wantDays   int
+		commandErr error
+		wantStatus int
+	}{
+		{name: "default_days", userID: "user456", wantDays: 7, wantStatus: http.StatusOK},
+		{name: "custom_days", userID: "user456", query: "?days=30", wantDays: 30, wantStatus: http.StatusOK},
+		{name: "too_many_days", userID: "user456", query: "?days=91", wantStatus: http.StatusBadRequest},
+		{name: "zero_days", userID: "user456", query: "?days=0", wantStatus: http.StatusBadRequest},
+		{name: "invalid_days", userID: "user456", query: "?days=week", wantStatus: http.StatusBadRequest},
+		{
+			name:       "command_error",
+			userID:     "user456",
+			wantDays:   7,
+			commandErr: errors.New("database error"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{name: "no_user_id_unauthorized", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			recommendCmd := cmdmocks.NewCommand[command.RecommendArticlesRequest, command.RecommendArticlesResponse](t)
+			articles := []domain.Article{{HashID: "new1"}}
+			impressions := []domain.RecommendationImpression{{UserID: tc.userID, ArticleHashID: "new1"}}
+
+			if tc.wantDays != 0 {
+				recommendCmd.EXPECT().
+					Execute(mock.Anything, mock.MatchedBy(func(req command.RecommendArticlesRequest) bool {
+						wantAfter := time.Now().AddDate(0, 0, -tc.wantDays)
+						return req.UserID == tc.userID && req.Limit == recommendationsLimit &&
+							req.PublishedAfter.Sub(wantAfter).Abs() < time.Minute
+					})).
+					Return(command.RecommendArticlesResponse{Articles: articles, Impressions: impressions}, tc.commandErr)
+			}
+
+			impressionChan := make(chan []domain.RecommendationImpression, 1)
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/recommended/new"+tc.query, nil)
+			if tc.userID != "" {
+				req = testContextWithUserID(tc.userID)(req)
+			} else {
+				req = testContext()(req)
+			}
+			rec := httptest.NewRecorder()
+
+			NewRecommendedArticlesList{Command: recommendCmd, Impressions: impressionChan}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus != http.StatusOK {
+				assert.Empty(t, impressionChan)
+				return
+			}
+
+			var response ArticlesListResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+			assert.Equal(t, articles, response.Data)
+			require.Len(t, impressionChan, 1)
+			assert.Equal(t, impressions, <-impressionChan)
 		})
 	}
 }
@@ -75,6 +75,17 @@ func (c RSS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	writeArticlesRSS(w, r, feed, articles, c.CacheMaxAge)
+}
+
+// writeArticlesRSS renders articles as items of the given feed and writes it as RSS.
+func writeArticlesRSS(
+	w http.ResponseWriter,
+	r *http.Request,
+	feed *feeds.Feed,
+	articles []domain.Article,
+	cacheMaxAge time.Duration,
+) {
 	for _, a := range articles {
 		item := &feeds.Item{
 			Id:          a.HashID,
@@ -111,7 +122,7 @@ func (c RSS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	rss := xml.Header + string(data)
 
 	w.Header().Set("Content-Type", "text/xml")
-	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(c.CacheMaxAge.Seconds())))
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(cacheMaxAge.Seconds())))
 
 	if _, err := w.Write([]byte(rss)); err != nil {
 		ctx := r.Context()
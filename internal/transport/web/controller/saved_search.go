@@ -0,0 +1,287 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+const maxSavedSearchNameLength = 128
+
+// SavedSearchRequest is the JSON request body for creating or updating a saved search.
+// Exactly one of Filters or QueryText may be set; on update, omitting both keeps the existing query.
+type SavedSearchRequest struct {
+	Name      string                 `json:"name"`
+	Filters   *domain.ArticleFilters `json:"filters,omitempty"`
+	QueryText string                 `json:"query_text,omitempty"`
+}
+
+// SavedSearchResponse is the JSON representation of a saved search.
+type SavedSearchResponse struct {
+	domain.SavedSearch
+	FeedURL string `json:"feed_url"`
+}
+
+// SavedSearchListResponse is the JSON response for listing saved searches.
+type SavedSearchListResponse struct {
+	Data []SavedSearchResponse `json:"data"`
+}
+
+// SavedSearchCreate handles POST /v1/saved-searches to create a saved search.
+type SavedSearchCreate struct {
+	CreateCmd   command.Command[command.CreateSavedSearchRequest, domain.SavedSearch]
+	FeedBaseURL string
+}
+
+func (c SavedSearchCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reqBody, ok := parseSavedSearchRequest(w, r)
+	if !ok {
+		return
+	}
+	if reqBody.Name == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	search, err := c.CreateCmd.Execute(ctx, command.CreateSavedSearchRequest{
+		UserID:    userID,
+		Name:      reqBody.Name,
+		Filters:   reqBody.Filters,
+		QueryText: reqBody.QueryText,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to create saved search", "error", err)
+		writeSavedSearchError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(newSavedSearchResponse(search, c.FeedBaseURL)); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// SavedSearchList handles GET /v1/saved-searches to list the user's saved searches.
+type SavedSearchList struct {
+	Lister      datasources.UserSavedSearchLister
+	FeedBaseURL string
+}
+
+func (c SavedSearchList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	searches, err := c.Lister.ListUserSavedSearches(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list saved searches", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]SavedSearchResponse, 0, len(searches))
+	for _, search := range searches {
+		items = append(items, newSavedSearchResponse(search, c.FeedBaseURL))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(SavedSearchListResponse{
+		Data: items,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// SavedSearchGet handles GET /v1/saved-searches/{saved_search_id} to fetch a saved search.
+type SavedSearchGet struct {
+	Getter      datasources.SavedSearchGetter
+	FeedBaseURL string
+}
+
+func (c SavedSearchGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	search, ok := getUserSavedSearch(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(newSavedSearchResponse(search, c.FeedBaseURL)); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// SavedSearchUpdate handles PATCH /v1/saved-searches/{saved_search_id} to rename
+// a saved search or replace its query.
+type SavedSearchUpdate struct {
+	UpdateCmd   command.Command[command.UpdateSavedSearchRequest, domain.SavedSearch]
+	FeedBaseURL string
+}
+
+func (c SavedSearchUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reqBody, ok := parseSavedSearchRequest(w, r)
+	if !ok {
+		return
+	}
+
+	req := command.UpdateSavedSearchRequest{
+		UserID:    userID,
+		SearchID:  mux.Vars(r)["saved_search_id"],
+		Filters:   reqBody.Filters,
+		QueryText: reqBody.QueryText,
+	}
+	if reqBody.Name != "" {
+		req.Name = &reqBody.Name
+	}
+
+	search, err := c.UpdateCmd.Execute(ctx, req)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to update saved search", "error", err)
+		writeSavedSearchError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(newSavedSearchResponse(search, c.FeedBaseURL)); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// SavedSearchDelete handles DELETE /v1/saved-searches/{saved_search_id} to delete a saved search.
+type SavedSearchDelete struct {
+	Deleter datasources.SavedSearchDeleter
+}
+
+func (c SavedSearchDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	searchID := mux.Vars(r)["saved_search_id"]
+	if err := c.Deleter.DeleteSavedSearch(ctx, userID, searchID); err != nil {
+		logger.ErrorContext(ctx, "unable to delete saved search", "error", err, "saved_search_id", searchID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newSavedSearchResponse(search domain.SavedSearch, feedBaseURL string) SavedSearchResponse {
+	return SavedSearchResponse{
+		SavedSearch: search,
+		FeedURL:     feedBaseURL + "/rss/saved-searches/" + search.FeedToken,
+	}
+}
+
+func parseSavedSearchRequest(w http.ResponseWriter, r *http.Request) (SavedSearchRequest, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	var reqBody SavedSearchRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTextBytes+1024)).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return SavedSearchRequest{}, false
+	}
+
+	if len(reqBody.Name) > maxSavedSearchNameLength || len(reqBody.QueryText) > maxTextBytes {
+		w.WriteHeader(http.StatusBadRequest)
+		return SavedSearchRequest{}, false
+	}
+
+	return reqBody, true
+}
+
+// getUserSavedSearch loads the saved search named in the route for the authenticated user,
+// writing an error response and returning false if it can't be loaded.
+func getUserSavedSearch(
+	w http.ResponseWriter, r *http.Request, getter datasources.SavedSearchGetter,
+) (domain.SavedSearch, bool) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return domain.SavedSearch{}, false
+	}
+
+	searchID := mux.Vars(r)["saved_search_id"]
+	search, ok, err := getter.GetSavedSearch(ctx, userID, searchID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get saved search", "error", err, "saved_search_id", searchID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return domain.SavedSearch{}, false
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return domain.SavedSearch{}, false
+	}
+
+	return search, true
+}
+
+func writeSavedSearchError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, command.ErrInvalidSavedSearch):
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Is(err, command.ErrSavedSearchNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, command.ErrEmbeddingUnavailable):
+		w.WriteHeader(http.StatusServiceUnavailable)
+	case errors.Is(err, command.ErrSavedSearchLimitExceeded):
+		ctx := r.Context()
+		logger := domain.LoggerFromContext(ctx)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		if encErr := json.NewEncoder(w).Encode(map[string]string{
+			"error": err.Error(),
+		}); encErr != nil {
+			logger.ErrorContext(ctx, "unable to write error response", "error", encErr)
+		}
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/feeds"
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// SavedSearchArticles handles GET /v1/saved-searches/{saved_search_id}/articles to run a saved search,
+// and GET /v1/saved-searches/{saved_search_id}/new to fetch only results published since it was
+// last viewed, marking it viewed.
+type SavedSearchArticles struct {
+	Getter  datasources.SavedSearchGetter
+	RunCmd  command.Command[command.RunSavedSearchRequest, []domain.Article]
+	NewOnly bool
+}
+
+func (c SavedSearchArticles) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	page, pageSize, err := parsePagination(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse pagination", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	search, ok := getUserSavedSearch(w, r, c.Getter)
+	if !ok {
+		return
+	}
+
+	articles, err := c.RunCmd.Execute(ctx, command.RunSavedSearchRequest{
+		Search:   search,
+		Page:     page,
+		PageSize: pageSize,
+		NewOnly:  c.NewOnly,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to run saved search", "error", err, "saved_search_id", search.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ArticlesListResponse{
+		Data:     articles,
+		Metadata: ArticlesListMetadata{},
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write articles to response", "error", err)
+	}
+}
+
+// SavedSearchRSS handles GET /rss/saved-searches/{feed_token}, rendering a saved search's
+// results as RSS. The feed token stands in for authentication since feed readers
+// can't send an Authorization header.
+type SavedSearchRSS struct {
+	FeedHostname    string
+	FeedAuthorName  string
+	FeedAuthorEmail string
+	Getter          datasources.SavedSearchByFeedTokenGetter
+	RunCmd          command.Command[command.RunSavedSearchRequest, []domain.Article]
+	CacheMaxAge     time.Duration
+}
+
+func (c SavedSearchRSS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	page, pageSize, err := parsePagination(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse pagination", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	search, ok, err := c.Getter.GetSavedSearchByFeedToken(ctx, mux.Vars(r)["feed_token"])
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get saved search by feed token", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	articles, err := c.RunCmd.Execute(ctx, command.RunSavedSearchRequest{
+		Search:   search,
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to run saved search for feed", "error", err, "saved_search_id", search.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	feed := &feeds.Feed{
+		Title:       "Alignment Research Feed: " + search.Name,
+		Link:        &feeds.Link{Href: c.FeedHostname + r.URL.Path},
+		Description: "Articles matching a saved search on the alignment research feed",
+		Author:      &feeds.Author{Name: c.FeedAuthorName, Email: c.FeedAuthorEmail},
+		Created:     time.Now(),
+	}
+
+	writeArticlesRSS(w, r, feed, articles, c.CacheMaxAge)
+}
@@ -0,0 +1,207 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearchCreate_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		body       string
+		wantReq    *command.CreateSavedSearchRequest
+		commandErr error
+		wantStatus int
+	}{
+		{
+			name:   "filters",
+			userID: "user1",
+			body:   `{"name":"Interp","filters":{"category":"Interpretability","sources_allowlist":["arxiv"]}}`,
+			wantReq: &command.CreateSavedSearchRequest{
+				UserID: "user1",
+				Name:   "Interp",
+				Filters: &domain.ArticleFilters{
+					Category:         "Interpretability",
+					SourcesAllowlist: []string{"arxiv"},
+				},
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "semantic",
+			userID: "user1",
+			body:   `{"name":"SAEs","query_text":"sparse autoencoders"}`,
+			wantReq: &command.CreateSavedSearchRequest{
+				UserID:    "user1",
+				Name:      "SAEs",
+				QueryText: "sparse autoencoders",
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "invalid_query",
+			userID: "user1",
+			body:   `{"name":"Empty"}`,
+			wantReq: &command.CreateSavedSearchRequest{
+				UserID: "user1",
+				Name:   "Empty",
+			},
+			commandErr: command.ErrInvalidSavedSearch,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "limit_exceeded",
+			userID: "user1",
+			body:   `{"name":"One too many","query_text":"x"}`,
+			wantReq: &command.CreateSavedSearchRequest{
+				UserID:    "user1",
+				Name:      "One too many",
+				QueryText: "x",
+			},
+			commandErr: command.ErrSavedSearchLimitExceeded,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:   "embedding_unavailable",
+			userID: "user1",
+			body:   `{"name":"SAEs","query_text":"sparse autoencoders"}`,
+			wantReq: &command.CreateSavedSearchRequest{
+				UserID:    "user1",
+				Name:      "SAEs",
+				QueryText: "sparse autoencoders",
+			},
+			commandErr: fmt.Errorf("wrapped: %w", command.ErrEmbeddingUnavailable),
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "missing_name",
+			userID:     "user1",
+			body:       `{"query_text":"x"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "name_too_long",
+			userID:     "user1",
+			body:       `{"name":"` + strings.Repeat("a", maxSavedSearchNameLength+1) + `","query_text":"x"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_json",
+			userID:     "user1",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "no_user_id_unauthorized",
+			body:       `{"name":"x","query_text":"x"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			createCmd := cmdmocks.NewCommand[command.CreateSavedSearchRequest, domain.SavedSearch](t)
+			if tc.wantReq != nil {
+				createCmd.EXPECT().
+					Execute(mock.Anything, *tc.wantReq).
+					Return(domain.SavedSearch{
+						ID:          "search1",
+						UserID:      tc.wantReq.UserID,
+						Name:        tc.wantReq.Name,
+						Filters:     tc.wantReq.Filters,
+						QueryText:   tc.wantReq.QueryText,
+						QueryVector: []float32{0.5},
+						FeedToken:   "feedtoken",
+					}, tc.commandErr)
+			}
+
+			controller := SavedSearchCreate{CreateCmd: createCmd, FeedBaseURL: "https://api.example.com"}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v1/saved-searches",
+				strings.NewReader(tc.body))
+			if tc.userID != "" {
+				req = testContextWithUserID(tc.userID)(req)
+			}
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var resp map[string]any
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, "search1", resp["id"])
+			assert.Equal(t, tc.wantReq.Name, resp["name"])
+			assert.Equal(t, "https://api.example.com/rss/saved-searches/feedtoken", resp["feed_url"])
+			assert.NotContains(t, resp, "query_vector")
+		})
+	}
+}
+
+func TestSavedSearchArticles_ServeHTTP(t *testing.T) {
+	search := domain.SavedSearch{ID: "search1", UserID: "user1", Kind: domain.SavedSearchKindFilters}
+
+	cases := []struct {
+		name       string
+		found      bool
+		newOnly    bool
+		query      string
+		wantRun    bool
+		wantStatus int
+	}{
+		{name: "runs_search", found: true, wantRun: true, wantStatus: http.StatusOK},
+		{name: "new_only", found: true, newOnly: true, wantRun: true, wantStatus: http.StatusOK},
+		{name: "not_found", found: false, wantStatus: http.StatusNotFound},
+		{name: "bad_pagination", query: "?page=0", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := mocks.NewSavedSearchGetter(t)
+			runCmd := cmdmocks.NewCommand[command.RunSavedSearchRequest, []domain.Article](t)
+
+			if tc.query == "" {
+				getter.EXPECT().GetSavedSearch(mock.Anything, "user1", "search1").Return(search, tc.found, nil)
+			}
+			if tc.wantRun {
+				runCmd.EXPECT().
+					Execute(mock.Anything, command.RunSavedSearchRequest{
+						Search: search, Page: 1, PageSize: defaultPageSize, NewOnly: tc.newOnly,
+					}).
+					Return([]domain.Article{{HashID: "a1"}}, nil)
+			}
+
+			controller := SavedSearchArticles{Getter: getter, RunCmd: runCmd, NewOnly: tc.newOnly}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/articles"+tc.query, nil)
+			req = testContextWithUserID("user1")(req)
+			req = mux.SetURLVars(req, map[string]string{"saved_search_id": "search1"})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusOK {
+				assert.Contains(t, rec.Body.String(), `"hash_id":"a1"`)
+			}
+		})
+	}
+}
@@ -11,9 +11,13 @@ import (
 	"github.com/jbeshir/alignment-research-feed/internal/domain"
 )
 
+// similarArticlesLimit is how many similar articles are listed.
+const similarArticlesLimit = 10
+
 type SimilarArticlesList struct {
 	Fetcher     datasources.ArticleFetcher
 	Similarity  datasources.SimilarArticleLister
+	Duplicates  datasources.CanonicalArticleGetter
 	CacheMaxAge time.Duration
 }
 
@@ -31,7 +35,8 @@ func (c SimilarArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	similarArticles, err := c.Similarity.ListSimilarArticles(ctx, []string{articleID}, 10)
+	// Extra articles are listed to make up for duplicates collapsed into one
+	similarArticles, err := c.Similarity.ListSimilarArticles(ctx, []string{articleID}, 2*similarArticlesLimit)
 	if err != nil {
 		logger.ErrorContext(ctx, "unable to fetch similar articles", "error", err)
 
@@ -39,10 +44,7 @@ func (c SimilarArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ids := make([]string, 0, len(similarArticles))
-	for _, similar := range similarArticles {
-		ids = append(ids, similar.HashID)
-	}
+	ids := c.collapseDuplicates(r, articleID, similarArticles)
 
 	articles, err := c.Fetcher.FetchArticlesByID(ctx, ids)
 	if err != nil {
@@ -68,3 +70,46 @@ func (c SimilarArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		logger.ErrorContext(ctx, "unable to write articles to response", "error", err)
 	}
 }
+
+// collapseDuplicates returns the IDs of the similar articles with duplicates replaced by their
+// canonical article and listed once, leaving out the listed article's own duplicates.
+// If duplicates can't be looked up, they are listed separately.
+func (c SimilarArticlesList) collapseDuplicates(
+	r *http.Request, articleID string, similarArticles []domain.SimilarArticle,
+) []string {
+	ctx := r.Context()
+
+	hashIDs := make([]string, 0, len(similarArticles)+1)
+	hashIDs = append(hashIDs, articleID)
+	for _, similar := range similarArticles {
+		hashIDs = append(hashIDs, similar.HashID)
+	}
+
+	canonical, err := c.Duplicates.GetCanonicalArticleIDs(ctx, hashIDs)
+	if err != nil {
+		logger := domain.LoggerFromContext(ctx)
+		logger.WarnContext(ctx, "unable to fetch canonical articles", "error", err)
+	}
+
+	canonicalID := func(id string) string {
+		if canonicalID, ok := canonical[id]; ok {
+			return canonicalID
+		}
+		return id
+	}
+
+	seen := map[string]struct{}{canonicalID(articleID): {}}
+	ids := make([]string, 0, similarArticlesLimit)
+	for _, similar := range similarArticles {
+		id := canonicalID(similar.HashID)
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+		if len(ids) == similarArticlesLimit {
+			break
+		}
+	}
+	return ids
+}
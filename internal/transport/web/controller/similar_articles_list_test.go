@@ -25,6 +25,8 @@ func TestSimilarArticlesList_ServeHTTP(t *testing.T) {
 		setupContext  func(r *http.Request) *http.Request
 		similarResult []domain.SimilarArticle
 		similarErr    error
+		canonical     map[string]string
+		fetchIDs      []string
 		articles      []domain.Article
 		fetchErr      error
 		wantStatus    int
@@ -68,6 +70,33 @@ func TestSimilarArticlesList_ServeHTTP(t *testing.T) {
 				{HashID: "similar1", Title: "Similar Article 1", PublishedAt: &testTime},
 			},
 		},
+		{
+			name:         "duplicates_collapsed",
+			articleID:    "lw_post",
+			setupContext: testContext(),
+			similarResult: []domain.SimilarArticle{
+				{HashID: "arxiv_paper", Score: 0.99},
+				{HashID: "crosspost1", Score: 0.9},
+				{HashID: "crosspost2", Score: 0.85},
+				{HashID: "similar1", Score: 0.8},
+			},
+			canonical: map[string]string{
+				"lw_post":    "arxiv_paper",
+				"crosspost1": "canonical1",
+				"crosspost2": "canonical1",
+			},
+			fetchIDs: []string{"canonical1", "similar1"},
+			articles: []domain.Article{
+				{HashID: "canonical1", Title: "Canonical Article 1", PublishedAt: &testTime},
+				{HashID: "similar1", Title: "Similar Article 1", PublishedAt: &testTime},
+			},
+			wantStatus:    http.StatusOK,
+			wantCacheCtrl: "max-age=3600",
+			wantArticles: []domain.Article{
+				{HashID: "canonical1", Title: "Canonical Article 1", PublishedAt: &testTime},
+				{HashID: "similar1", Title: "Similar Article 1", PublishedAt: &testTime},
+			},
+		},
 		{
 			name:          "empty_similar_articles",
 			articleID:     "hash123",
@@ -110,26 +139,36 @@ func TestSimilarArticlesList_ServeHTTP(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			fetcher := mocks.NewArticleFetcher(t)
 			similarity := mocks.NewSimilarArticleLister(t)
+			duplicates := mocks.NewCanonicalArticleGetter(t)
 
 			if !tc.skipSimilar {
 				similarity.EXPECT().
-					ListSimilarArticles(mock.Anything, []string{tc.articleID}, 10).
+					ListSimilarArticles(mock.Anything, []string{tc.articleID}, 20).
 					Return(tc.similarResult, tc.similarErr)
 			}
 
 			if !tc.skipFetch && tc.similarErr == nil {
-				hashIDs := make([]string, 0, len(tc.similarResult))
+				hashIDs := []string{tc.articleID}
 				for _, s := range tc.similarResult {
 					hashIDs = append(hashIDs, s.HashID)
 				}
+				duplicates.EXPECT().
+					GetCanonicalArticleIDs(mock.Anything, hashIDs).
+					Return(tc.canonical, nil)
+
+				fetchIDs := tc.fetchIDs
+				if fetchIDs == nil {
+					fetchIDs = hashIDs[1:]
+				}
 				fetcher.EXPECT().
-					FetchArticlesByID(mock.Anything, hashIDs).
+					FetchArticlesByID(mock.Anything, fetchIDs).
 					Return(tc.articles, tc.fetchErr)
 			}
 
 			controller := SimilarArticlesList{
 				Fetcher:     fetcher,
 				Similarity:  similarity,
+				Duplicates:  duplicates,
 				CacheMaxAge: time.Hour,
 			}
 
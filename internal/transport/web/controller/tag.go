@@ -0,0 +1,306 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// ArticleTagsResponse is the JSON response for listing an article's tags.
+type ArticleTagsResponse struct {
+	Data []string `json:"data"`
+}
+
+// TagListResponse is the JSON response for listing a user's tags.
+type TagListResponse struct {
+	Data []domain.TagCount `json:"data"`
+}
+
+// ArticleTagsList handles GET /v1/articles/{article_id}/tags to list the user's tags on an article.
+type ArticleTagsList struct {
+	Lister datasources.ArticleTagLister
+}
+
+func (c ArticleTagsList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	tags, err := c.Lister.ListArticleTags(ctx, userID, articleID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list article tags", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(ArticleTagsResponse{
+		Data: tags,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// ArticleTagAdd handles PUT /v1/articles/{article_id}/tags/{tag} to tag an article.
+type ArticleTagAdd struct {
+	Fetcher datasources.ArticleFetcher
+	Tagger  datasources.ArticleTagger
+}
+
+func (c ArticleTagAdd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	tag, ok := tagFromRoute(w, r)
+	if !ok {
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, []string{articleID})
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch article", "error", err, "article_id", articleID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if len(articles) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err := c.Tagger.TagArticle(ctx, userID, articleID, tag); err != nil {
+		logger.ErrorContext(ctx, "unable to tag article", "error", err, "article_id", articleID, "tag", tag)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArticleTagRemove handles DELETE /v1/articles/{article_id}/tags/{tag} to untag an article.
+type ArticleTagRemove struct {
+	Untagger datasources.ArticleUntagger
+}
+
+func (c ArticleTagRemove) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	tag, ok := tagFromRoute(w, r)
+	if !ok {
+		return
+	}
+
+	articleID := mux.Vars(r)["article_id"]
+	if err := c.Untagger.UntagArticle(ctx, userID, articleID, tag); err != nil {
+		logger.ErrorContext(ctx, "unable to untag article", "error", err, "article_id", articleID, "tag", tag)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UserTagsList handles GET /v1/tags to list the user's tags with how many articles have each.
+type UserTagsList struct {
+	Lister datasources.UserTagLister
+}
+
+func (c UserTagsList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	tags, err := c.Lister.ListUserTags(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list user tags", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if tags == nil {
+		tags = []domain.TagCount{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(TagListResponse{
+		Data: tags,
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// TaggedArticlesList handles GET /v1/tags/{tag}/articles to list the articles the user has given a tag.
+type TaggedArticlesList struct {
+	Lister  datasources.TaggedArticleLister
+	Fetcher datasources.ArticleFetcher
+}
+
+func (c TaggedArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	tag, ok := tagFromRoute(w, r)
+	if !ok {
+		return
+	}
+
+	page, pageSize, err := parsePagination(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse pagination", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	articleIDs, err := c.Lister.ListTaggedArticleIDs(ctx, userID, tag, page, pageSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list tagged article IDs", "error", err, "tag", tag)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, articleIDs)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch article metadata", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(ArticlesListResponse{
+		Data:     articles,
+		Metadata: ArticlesListMetadata{},
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write tagged articles to response", "error", err)
+	}
+}
+
+// TagPreferencesRequest is the JSON request body for setting tag preferences.
+type TagPreferencesRequest struct {
+	ShareForRecommendations *bool `json:"share_for_recommendations"`
+}
+
+// TagPreferencesGet handles GET /v1/me/tags to fetch the user's tag preferences.
+// Users without stored preferences get the defaults, with their tags kept private.
+type TagPreferencesGet struct {
+	PreferencesGetter datasources.TagPreferencesGetter
+}
+
+func (c TagPreferencesGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefs, _, err := c.PreferencesGetter.GetTagPreferences(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to get tag preferences", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeTagPreferences(w, r, prefs)
+}
+
+// TagPreferencesSet handles PUT /v1/me/tags to update the user's tag preferences,
+// including whether their tags may feed other users' recommendations.
+type TagPreferencesSet struct {
+	PreferencesUpserter datasources.TagPreferencesUpserter
+}
+
+func (c TagPreferencesSet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var reqBody TagPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logger.ErrorContext(ctx, "unable to parse request body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if reqBody.ShareForRecommendations == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	prefs := domain.TagPreferences{
+		UserID:                  userID,
+		ShareForRecommendations: *reqBody.ShareForRecommendations,
+	}
+	if err := c.PreferencesUpserter.UpsertTagPreferences(ctx, prefs); err != nil {
+		logger.ErrorContext(ctx, "unable to set tag preferences", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeTagPreferences(w, r, prefs)
+}
+
+func writeTagPreferences(w http.ResponseWriter, r *http.Request, prefs domain.TagPreferences) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(prefs); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+// tagFromRoute normalizes the tag named in the route, writing a bad request response
+// and returning false if it is empty or too long.
+func tagFromRoute(w http.ResponseWriter, r *http.Request) (string, bool) {
+	tag := domain.NormalizeTag(mux.Vars(r)["tag"])
+	if tag == "" || len(tag) > domain.MaxTagLength {
+		w.WriteHeader(http.StatusBadRequest)
+		return "", false
+	}
+	return tag, true
+}
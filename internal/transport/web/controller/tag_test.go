@@ -0,0 +1,255 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestArticleTagAdd_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name         string
+		userID       string
+		tag          string
+		wantFetch    bool
+		articleFound bool
+		wantTag      string
+		wantStatus   int
+	}{
+		{
+			name:         "normalizes_tag",
+			userID:       "user1",
+			tag:          "  To Read ",
+			wantFetch:    true,
+			articleFound: true,
+			wantTag:      "to read",
+			wantStatus:   http.StatusNoContent,
+		},
+		{
+			name:       "unknown_article",
+			userID:     "user1",
+			tag:        "rlhf",
+			wantFetch:  true,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "blank_tag",
+			userID:     "user1",
+			tag:        "   ",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "tag_too_long",
+			userID:     "user1",
+			tag:        strings.Repeat("a", domain.MaxTagLength+1),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "no_user_id_unauthorized",
+			tag:        "rlhf",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocks.NewArticleFetcher(t)
+			tagger := mocks.NewArticleTagger(t)
+
+			if tc.wantFetch {
+				var articles []domain.Article
+				if tc.articleFound {
+					articles = []domain.Article{{HashID: "a1"}}
+				}
+				fetcher.EXPECT().FetchArticlesByID(mock.Anything, []string{"a1"}).Return(articles, nil)
+			}
+			if tc.wantTag != "" {
+				tagger.EXPECT().TagArticle(mock.Anything, "user1", "a1", tc.wantTag).Return(nil)
+			}
+
+			controller := ArticleTagAdd{Fetcher: fetcher, Tagger: tagger}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/v1/articles/a1/tags/x", nil)
+			if tc.userID != "" {
+				req = testContextWithUserID(tc.userID)(req)
+			}
+			req = mux.SetURLVars(req, map[string]string{"article_id": "a1", "tag": tc.tag})
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestUserTagsList_ServeHTTP(t *testing.T) {
+	lister := mocks.NewUserTagLister(t)
+	lister.EXPECT().ListUserTags(mock.Anything, "user1").Return([]domain.TagCount{
+		{Tag: "rlhf", Count: 3},
+		{Tag: "to read", Count: 1},
+	}, nil)
+
+	controller := UserTagsList{Lister: lister}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/tags", nil)
+	req = testContextWithUserID("user1")(req)
+	rec := httptest.NewRecorder()
+
+	controller.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t,
+		`{"data":[{"tag":"rlhf","count":3},{"tag":"to read","count":1}]}`,
+		rec.Body.String())
+}
+
+func TestTaggedArticlesList_ServeHTTP(t *testing.T) {
+	lister := mocks.NewTaggedArticleLister(t)
+	fetcher := mocks.NewArticleFetcher(t)
+
+	lister.EXPECT().ListTaggedArticleIDs(mock.Anything, "user1", "rlhf", 2, 10).Return([]string{"a1"}, nil)
+	fetcher.EXPECT().FetchArticlesByID(mock.Anything, []string{"a1"}).Return([]domain.Article{{HashID: "a1"}}, nil)
+
+	controller := TaggedArticlesList{Lister: lister, Fetcher: fetcher}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+		"/v1/tags/RLHF/articles?page=2&page_size=10", nil)
+	req = testContextWithUserID("user1")(req)
+	req = mux.SetURLVars(req, map[string]string{"tag": "RLHF"})
+	rec := httptest.NewRecorder()
+
+	controller.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"hash_id":"a1"`)
+}
+
+func TestTagPreferencesGet_ServeHTTP(t *testing.T) {
+	getter := mocks.NewTagPreferencesGetter(t)
+	getter.EXPECT().GetTagPreferences(mock.Anything, "user1").
+		Return(domain.TagPreferences{UserID: "user1"}, false, nil)
+
+	controller := TagPreferencesGet{PreferencesGetter: getter}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/me/tags", nil)
+	req = testContextWithUserID("user1")(req)
+	rec := httptest.NewRecorder()
+
+	controller.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"share_for_recommendations":false}`, rec.Body.String())
+}
+
+func TestTagPreferencesSet_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		body       string
+		wantPrefs  *domain.TagPreferences
+		wantStatus int
+	}{
+		{
+			name:       "share",
+			body:       `{"share_for_recommendations":true}`,
+			wantPrefs:  &domain.TagPreferences{UserID: "user1", ShareForRecommendations: true},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "stop_sharing",
+			body:       `{"share_for_recommendations":false}`,
+			wantPrefs:  &domain.TagPreferences{UserID: "user1"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing_field",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_json",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			upserter := mocks.NewTagPreferencesUpserter(t)
+			if tc.wantPrefs != nil {
+				upserter.EXPECT().UpsertTagPreferences(mock.Anything, *tc.wantPrefs).Return(nil)
+			}
+
+			controller := TagPreferencesSet{PreferencesUpserter: upserter}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/v1/me/tags",
+				strings.NewReader(tc.body))
+			req = testContextWithUserID("user1")(req)
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantPrefs != nil {
+				assert.Contains(t, rec.Body.String(), tc.body)
+			}
+		})
+	}
+}
+
+func TestUserArticlesList_ServeHTTP_FilterTags(t *testing.T) {
+	cases := []struct {
+		name       string
+		query      string
+		wantTags   []string
+		wantStatus int
+	}{
+		{name: "no_filter", query: "", wantTags: nil, wantStatus: http.StatusOK},
+		{name: "filter_tags", query: "?filter_tags=RLHF,to%20read,rlhf", wantTags: []string{"rlhf", "to read"},
+			wantStatus: http.StatusOK},
+		{name: "tag_too_long", query: "?filter_tags=" + strings.Repeat("a", domain.MaxTagLength+1),
+			wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocks.NewArticleFetcher(t)
+
+			var gotTags []string
+			listCalled := false
+			listFunc := func(_ context.Context, userID string, tags []string, page, pageSize int) ([]string, error) {
+				listCalled = true
+				gotTags = tags
+				assert.Equal(t, "user1", userID)
+				assert.Equal(t, 1, page)
+				assert.Equal(t, defaultPageSize, pageSize)
+				return []string{"a1"}, nil
+			}
+			if tc.wantStatus == http.StatusOK {
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"a1"}).
+					Return([]domain.Article{{HashID: "a1"}}, nil)
+			}
+
+			controller := UserArticlesList{Fetcher: fetcher, ListFunc: listFunc, ListEntity: "liked"}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/articles/liked"+tc.query, nil)
+			req = testContextWithUserID("user1")(req)
+			rec := httptest.NewRecorder()
+
+			controller.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantStatus == http.StatusOK, listCalled)
+			assert.Equal(t, tc.wantTags, gotTags)
+		})
+	}
+}
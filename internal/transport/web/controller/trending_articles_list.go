@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// TrendingArticlesList handles GET /v1/articles/trending to list the articles most popular
+// across all users over a window: day, week (the default) or month.
+type TrendingArticlesList struct {
+	Lister      datasources.PopularArticleLister
+	Fetcher     datasources.ArticleFetcher
+	CacheMaxAge time.Duration
+}
+
+func (c TrendingArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	window := domain.PopularityWindowWeek
+	if name := r.URL.Query().Get("window"); name != "" {
+		var err error
+		window, err = domain.ParsePopularityWindow(name)
+		if err != nil {
+			logger.ErrorContext(ctx, "unable to parse popularity window", "error", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	page, pageSize, err := parsePagination(r.URL.Query())
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to parse pagination", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	popular, err := c.Lister.ListPopularArticles(ctx, window, page, pageSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to list popular articles", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	articleIDs := make([]string, 0, len(popular))
+	for _, p := range popular {
+		articleIDs = append(articleIDs, p.HashID)
+	}
+
+	articles, err := c.Fetcher.FetchArticlesByID(ctx, articleIDs)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to fetch article metadata", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if domain.UserIDFromContext(ctx) == "" {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(c.CacheMaxAge.Seconds())))
+	}
+
+	if err := json.NewEncoder(w).Encode(ArticlesListResponse{
+		Data:     articles,
+		Metadata: ArticlesListMetadata{},
+	}); err != nil {
+		logger.ErrorContext(ctx, "unable to write trending articles to response", "error", err)
+	}
+}
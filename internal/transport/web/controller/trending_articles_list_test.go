@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendingArticlesList_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name          string
+		query         string
+		userID        string
+		wantWindow    domain.PopularityWindow
+		listErr       error
+		wantStatus    int
+		wantCacheCtrl string
+	}{
+		{name: "default_window", wantWindow: domain.PopularityWindowWeek, wantStatus: http.StatusOK,
+			wantCacheCtrl: "max-age=60"},
+		{name: "day_window", query: "?window=day", wantWindow: domain.PopularityWindowDay,
+			wantStatus: http.StatusOK, wantCacheCtrl: "max-age=60"},
+		{name: "authenticated_not_cached", query: "?window=month", userID: "user1",
+			wantWindow: domain.PopularityWindowMonth, wantStatus: http.StatusOK},
+		{name: "invalid_window", query: "?window=year", wantStatus: http.StatusBadRequest},
+		{name: "invalid_page", query: "?page=0", wantStatus: http.StatusBadRequest},
+		{name: "list_error", wantWindow: domain.PopularityWindowWeek, listErr: errors.New("db down"),
+			wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lister := mocks.NewPopularArticleLister(t)
+			fetcher := mocks.NewArticleFetcher(t)
+
+			if tc.wantWindow != 0 {
+				lister.EXPECT().
+					ListPopularArticles(mock.Anything, tc.wantWindow, 1, 50).
+					Return([]domain.ArticlePopularity{{HashID: "hash2"}, {HashID: "hash1"}}, tc.listErr)
+			}
+			if tc.wantStatus == http.StatusOK {
+				fetcher.EXPECT().
+					FetchArticlesByID(mock.Anything, []string{"hash2", "hash1"}).
+					Return([]domain.Article{{HashID: "hash2"}, {HashID: "hash1"}}, nil)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/articles/trending"+tc.query, nil)
+			req = testContextWithUserID(tc.userID)(req)
+			rec := httptest.NewRecorder()
+
+			TrendingArticlesList{
+				Lister:      lister,
+				Fetcher:     fetcher,
+				CacheMaxAge: time.Minute,
+			}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantCacheCtrl, rec.Header().Get("Cache-Control"))
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			var got ArticlesListResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Len(t, got.Data, 2)
+			assert.Equal(t, "hash2", got.Data[0].HashID)
+		})
+	}
+}
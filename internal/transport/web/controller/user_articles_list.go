@@ -9,10 +9,12 @@ import (
 	"github.com/jbeshir/alignment-research-feed/internal/domain"
 )
 
-// UserArticlesLister is a function type that lists article IDs for a user with pagination.
-type UserArticlesLister func(ctx context.Context, userID string, page, pageSize int) ([]string, error)
+// UserArticlesLister is a function type that lists article IDs for a user with pagination,
+// optionally restricted to articles the user has given every one of the tags.
+type UserArticlesLister func(ctx context.Context, userID string, tags []string, page, pageSize int) ([]string, error)
 
 // UserArticlesList is a generic controller for user-specific article lists.
+// A filter_tags parameter, comma-separated, limits the list to articles with all of those tags.
 type UserArticlesList struct {
 	Fetcher    datasources.ArticleFetcher
 	ListFunc   UserArticlesLister
@@ -36,7 +38,15 @@ func (c UserArticlesList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	articleIDs, err := c.ListFunc(ctx, userID, page, pageSize)
+	tags := domain.ParseTagList(r.URL.Query().Get("filter_tags"))
+	for _, tag := range tags {
+		if len(tag) > domain.MaxTagLength {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	articleIDs, err := c.ListFunc(ctx, userID, tags, page, pageSize)
 	if err != nil {
 		logger.ErrorContext(ctx, "unable to list "+c.ListEntity+" article IDs", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// UserDataExport handles GET /v1/me/export to download everything stored about the user.
+// The format query parameter selects a single JSON document (the default) or a ZIP
+// archive with one JSON file per kind of data.
+type UserDataExport struct {
+	Exporter datasources.UserDataExporter
+}
+
+func (c UserDataExport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "zip" {
+		logger.ErrorContext(ctx, "invalid export format", "format", format)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	export, err := c.Exporter.ExportUserData(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "unable to export user data", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	filename := "alignment-feed-export-" + export.ExportedAt.UTC().Format("20060102")
+	if format == "zip" {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, filename))
+		if err := writeUserDataArchive(w, export); err != nil {
+			logger.ErrorContext(ctx, "unable to write export archive", "error", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+	if err := json.NewEncoder(w).Encode(export); err != nil {
+		logger.ErrorContext(ctx, "unable to write response", "error", err)
+	}
+}
+
+func writeUserDataArchive(w io.Writer, export domain.UserDataExport) error {
+	files := []struct {
+		name string
+		data any
+	}{
+		{"account.json", map[string]any{"user_id": export.UserID, "exported_at": export.ExportedAt}},
+		{"interactions.json", export.Interactions},
+		{"interest_clusters.json", export.InterestClusters},
+		{"recommendations.json", export.Recommendations},
+		{"impressions.json", export.Impressions},
+		{"recommendation_state.json", export.RecommendationState},
+		{"api_tokens.json", export.APITokens},
+		{"digest_preferences.json", export.DigestPreferences},
+		{"onboarding_interests.json", export.OnboardingInterests},
+		{"saved_searches.json", export.SavedSearches},
+		{"collections.json", export.Collections},
+		{"notes.json", export.Notes},
+		{"tags.json", export.Tags},
+		{"tag_preferences.json", export.TagPreferences},
+		{"follows.json", export.Follows},
+		{"follow_preferences.json", export.FollowPreferences},
+		{"audit_events.json", export.AuditEvents},
+	}
+
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		f, err := archive.Create(file.name)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", file.name, err)
+		}
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return fmt.Errorf("writing %s: %w", file.name, err)
+		}
+	}
+	return archive.Close()
+}
+
+// UserDelete handles DELETE /v1/me to permanently erase the user's account data.
+type UserDelete struct {
+	Deleter datasources.UserDataDeleter
+}
+
+func (c UserDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := domain.LoggerFromContext(ctx)
+
+	userID := domain.UserIDFromContext(ctx)
+	if userID == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if err := c.Deleter.DeleteUserData(ctx, userID); err != nil {
+		logger.ErrorContext(ctx, "unable to delete user data", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.InfoContext(ctx, "deleted user data", "user_id", userID)
+	w.WriteHeader(http.StatusNoContent)
+}
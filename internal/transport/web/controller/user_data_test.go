@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// archiveFileForExportField gives the ZIP export file holding each top-level field of the JSON export.
+var archiveFileForExportField = map[string]string{
+	"user_id":                    "account.json",
+	"exported_at":                "account.json",
+	"interactions":               "interactions.json",
+	"interest_clusters":          "interest_clusters.json",
+	"recommendations":            "recommendations.json",
+	"recommendation_impressions": "impressions.json",
+	"recommendation_state":       "recommendation_state.json",
+	"api_tokens":                 "api_tokens.json",
+	"digest_preferences":         "digest_preferences.json",
+	"onboarding_interests":       "onboarding_interests.json",
+	"saved_searches":             "saved_searches.json",
+	"collections":                "collections.json",
+	"notes":                      "notes.json",
+	"tags":                       "tags.json",
+	"tag_preferences":            "tag_preferences.json",
+	"follows":                    "follows.json",
+	"follow_preferences":         "follow_preferences.json",
+	"audit_events":               "audit_events.json",
+}
+
+func TestUserDataExport_ServeHTTP(t *testing.T) {
+	at := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	tokenID := "tok1"
+
+	// Every field is set, so each must be found in the ZIP export
+	export := domain.UserDataExport{
+		UserID:     "user1",
+		ExportedAt: at,
+		Interactions: []domain.ExportedInteraction{
+			{ArticleHashID: "a1", HaveRead: true, ThumbsUp: true},
+		},
+		InterestClusters: []domain.ExportedInterestCluster{{ClusterID: 1, ArticleCount: 3, UpdatedAt: at}},
+		Recommendations: []domain.ExportedRecommendation{
+			{ArticleHashID: "a2", Score: 0.5, Source: "cluster", GeneratedAt: at},
+		},
+		Impressions: []domain.ExportedImpression{
+			{ArticleHashID: "a2", Source: "cluster", Position: 1, ServedAt: at},
+		},
+		RecommendationState: &domain.ExportedRecommendationState{LastGeneratedAt: &at},
+		APITokens: []domain.ExportedAPIToken{
+			{ID: "tok1", Prefix: "abcd1234", Status: domain.APITokenStatusRevoked},
+		},
+		DigestPreferences:   &domain.DigestPreferences{Email: "user@example.com", Frequency: domain.DigestFrequencyWeekly},
+		OnboardingInterests: &domain.OnboardingInterests{Interests: []string{"interpretability"}, UpdatedAt: at},
+		SavedSearches:       []domain.SavedSearch{{ID: "s1", Name: "Debate"}},
+		Collections: []domain.ExportedCollection{
+			{Collection: domain.Collection{ID: "c1", Name: "Reading list"}, ArticleHashIDs: []string{"a1"}},
+		},
+		Notes:          []domain.ArticleNote{{ID: "n1", ArticleHashID: "a1", Body: "Read again", CreatedAt: at}},
+		Tags:           []domain.ExportedTag{{ArticleHashID: "a1", Tag: "debate", CreatedAt: at}},
+		TagPreferences: &domain.TagPreferences{ShareForRecommendations: true},
+		Follows: []domain.Follow{
+			{Type: domain.FollowTypeAuthor, Target: "neel-nanda", CreatedAt: at},
+		},
+		FollowPreferences: &domain.FollowPreferences{IncludeInRecommendations: true},
+		AuditEvents: []domain.AuditEvent{
+			{ID: 1, Type: domain.AuditEventAPITokenRevoked, TokenID: &tokenID, CreatedAt: at},
+		},
+	}
+	exportValue := reflect.ValueOf(export)
+	for i := range exportValue.NumField() {
+		require.False(t, exportValue.Field(i).IsZero(), "export field %s not set", exportValue.Type().Field(i).Name)
+	}
+
+	cases := []struct {
+		name        string
+		userID      string
+		query       string
+		exportErr   error
+		wantStatus  int
+		wantType    string
+		wantExport  bool
+		wantArchive bool
+	}{
+		{name: "json_default", userID: "user1", wantStatus: http.StatusOK, wantType: "application/json", wantExport: true},
+		{name: "zip", userID: "user1", query: "?format=zip", wantStatus: http.StatusOK, wantType: "application/zip",
+			wantExport: true, wantArchive: true},
+		{name: "invalid_format", userID: "user1", query: "?format=xml", wantStatus: http.StatusBadRequest},
+		{name: "unauthenticated", wantStatus: http.StatusUnauthorized},
+		{name: "export_error", userID: "user1", exportErr: errors.New("db down"), wantExport: true,
+			wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			exporter := mocks.NewUserDataExporter(t)
+			if tc.wantExport {
+				exporter.EXPECT().ExportUserData(mock.Anything, "user1").Return(export, tc.exportErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/me/export"+tc.query, nil)
+			req = testContextWithUserID(tc.userID)(req)
+			rec := httptest.NewRecorder()
+
+			UserDataExport{Exporter: exporter}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, tc.wantType, rec.Header().Get("Content-Type"))
+			assert.Contains(t, rec.Header().Get("Content-Disposition"), "alignment-feed-export-20260304")
+			assert.NotContains(t, rec.Body.String(), "token_hash")
+
+			if !tc.wantArchive {
+				var got domain.UserDataExport
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Equal(t, export.Interactions, got.Interactions)
+				assert.Equal(t, export.APITokens, got.APITokens)
+				return
+			}
+
+			archive, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+			require.NoError(t, err)
+			files := make(map[string][]byte)
+			for _, f := range archive.File {
+				r, err := f.Open()
+				require.NoError(t, err)
+				files[f.Name], err = io.ReadAll(r)
+				require.NoError(t, err)
+				_ = r.Close()
+			}
+			assertArchiveMatchesExport(t, export, files)
+		})
+	}
+}
+
+func TestUserDelete_ServeHTTP(t *testing.T) {
+	cases := []struct {
+		name       string
+		userID     string
+		deleteErr  error
+		wantDelete bool
+		wantStatus int
+	}{
+		{name: "deleted", userID: "user1", wantDelete: true, wantStatus: http.StatusNoContent},
+		{name: "unauthenticated", wantStatus: http.StatusUnauthorized},
+		{name: "delete_error", userID: "user1", deleteErr: errors.New("db down"), wantDelete: true,
+			wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleter := mocks.NewUserDataDeleter(t)
+			if tc.wantDelete {
+				deleter.EXPECT().DeleteUserData(mock.Anything, "user1").Return(tc.deleteErr)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/v1/me", nil)
+			req = testContextWithUserID(tc.userID)(req)
+			rec := httptest.NewRecorder()
+
+			UserDelete{Deleter: deleter}.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+// assertArchiveMatchesExport checks that every top-level field of the JSON export is found
+// in its ZIP export file, and that the ZIP export has no other files.
+func assertArchiveMatchesExport(t *testing.T, export domain.UserDataExport, files map[string][]byte) {
+	t.Helper()
+
+	exportJSON, err := json.Marshal(export)
+	require.NoError(t, err)
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exportJSON, &fields))
+
+	var account map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(files["account.json"], &account))
+
+	wantFiles := make(map[string]bool)
+	for field, value := range fields {
+		name, ok := archiveFileForExportField[field]
+		require.True(t, ok, "export field %s has no ZIP export file", field)
+		wantFiles[name] = true
+
+		if name == "account.json" {
+			assert.JSONEq(t, string(value), string(account[field]), field)
+			continue
+		}
+		_, ok = files[name]
+		require.True(t, ok, "ZIP export has no %s", name)
+		assert.JSONEq(t, string(value), string(files[name]), name)
+	}
+	assert.Len(t, files, len(wantFiles))
+}
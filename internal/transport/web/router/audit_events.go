@@ -0,0 +1,42 @@
+package router
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// NewAuditEventRecorder starts writing the audit events sent to the returned channel in the
+// background, so rejected requests never wait on the audit log, and an audit log outage
+// doesn't slow them down.
+func NewAuditEventRecorder(
+	ctx context.Context,
+	writer datasources.AuditEventWriter,
+) chan<- domain.AuditEvent {
+	// Asynchronous best-effort audit logging, so a flood of bad credentials or denied
+	// requests doesn't queue up database writes. Senders drop events once the buffer is full.
+	auditChan := make(chan domain.AuditEvent, 100)
+	go func() {
+		for event := range auditChan {
+			if err := writer.WriteAuditEvent(context.WithoutCancel(ctx), event); err != nil {
+				domain.LoggerFromContext(ctx).WarnContext(context.WithoutCancel(ctx),
+					"failed to write audit event",
+					"type", event.Type,
+					"error", err)
+			}
+		}
+	}()
+
+	return auditChan
+}
+
+// recordAuditEvent queues an audit event without waiting, dropping it if the queue is full.
+func recordAuditEvent(ctx context.Context, auditEvents chan<- domain.AuditEvent, event domain.AuditEvent) {
+	select {
+	case auditEvents <- event:
+	default:
+		logger := domain.LoggerFromContext(ctx)
+		logger.WarnContext(ctx, "audit event queue full, dropping event", "type", event.Type)
+	}
+}
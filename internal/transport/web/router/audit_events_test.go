@@ -0,0 +1,49 @@
+package router
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewAuditEventRecorder(t *testing.T) {
+	event := domain.AuditEvent{Type: domain.AuditEventAPITokenScopeDenied, UserID: "user1"}
+
+	written := make(chan domain.AuditEvent, 1)
+	writer := mocks.NewAuditEventWriter(t)
+	writer.EXPECT().
+		WriteAuditEvent(mock.Anything, event).
+		RunAndReturn(func(_ context.Context, e domain.AuditEvent) error {
+			written <- e
+			return nil
+		})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	auditEvents := NewAuditEventRecorder(ctx, writer)
+
+	// Events are still written once the context is cancelled
+	cancel()
+	auditEvents <- event
+
+	select {
+	case got := <-written:
+		assert.Equal(t, event, got)
+	case <-time.After(time.Second):
+		t.Fatal("audit event was not written")
+	}
+}
+
+func TestRecordAuditEvent_QueueFull(t *testing.T) {
+	auditEvents := make(chan domain.AuditEvent, 1)
+	auditEvents <- domain.AuditEvent{}
+
+	// Dropped rather than blocking the request
+	recordAuditEvent(t.Context(), auditEvents, domain.AuditEvent{Type: domain.AuditEventAPITokenScopeDenied})
+
+	assert.Len(t, auditEvents, 1)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -17,9 +18,25 @@ import (
 )
 
 // AuthResult represents the result of a successful authentication.
+// TokenID and Scopes are only set for API token authentication.
 type AuthResult struct {
-	UserID string
-	Method domain.AuthMethod
+	UserID  string
+	Method  domain.AuthMethod
+	TokenID string
+	Scopes  []domain.APITokenScope
+}
+
+// AuthError is returned by validators when a credential was rejected but could be
+// attributed to an account, such as a revoked API token, so the failure is recorded
+// in that account's audit log.
+type AuthError struct {
+	Message string
+	UserID  string
+	TokenID string
+}
+
+func (e *AuthError) Error() string {
+	return e.Message
 }
 
 // AuthValidator attempts to validate authentication from a request.
@@ -29,7 +46,11 @@ type AuthResult struct {
 type AuthValidator func(r *http.Request) (*AuthResult, error)
 
 // NewAuthMiddleware creates a middleware that validates requests using multiple authentication methods.
-func NewAuthMiddleware(validators []AuthValidator) func(http.Handler) http.Handler {
+// Failed authentication is recorded in the audit log asynchronously, through auditEvents.
+func NewAuthMiddleware(
+	validators []AuthValidator,
+	auditEvents chan<- domain.AuditEvent,
+) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			for _, validate := range validators {
@@ -41,6 +62,9 @@ func NewAuthMiddleware(validators []AuthValidator) func(http.Handler) http.Handl
 				if err != nil {
 					logger := domain.LoggerFromContext(r.Context())
 					logger.WarnContext(r.Context(), "authentication failed", "error", err)
+
+					recordAuditEvent(r.Context(), auditEvents, authFailureEvent(r.Context(), err))
+
 					w.Header().Set("Content-Type", "application/json")
 					w.WriteHeader(http.StatusUnauthorized)
 					_, _ = fmt.Fprintf(w, `{"message":"%s"}`, err.Error())
@@ -49,6 +73,10 @@ func NewAuthMiddleware(validators []AuthValidator) func(http.Handler) http.Handl
 
 				ctx := domain.ContextWithUserID(r.Context(), result.UserID)
 				ctx = domain.ContextWithAuthMethod(ctx, result.Method)
+				if result.Method == domain.AuthMethodAPIToken {
+					ctx = domain.ContextWithAPITokenID(ctx, result.TokenID)
+					ctx = domain.ContextWithAPITokenScopes(ctx, result.Scopes)
+				}
 				next.ServeHTTP(w, r.WithContext(ctx))
 				return
 			}
@@ -59,6 +87,19 @@ func NewAuthMiddleware(validators []AuthValidator) func(http.Handler) http.Handl
 	}
 }
 
+func authFailureEvent(ctx context.Context, err error) domain.AuditEvent {
+	event := domain.NewAuditEvent(ctx, domain.AuditEventAuthenticationFailed, domain.AuditOutcomeFailure, err.Error())
+
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		event.UserID = authErr.UserID
+		if authErr.TokenID != "" {
+			event.TokenID = &authErr.TokenID
+		}
+	}
+	return event
+}
+
 // NewAuth0Validator creates a validator for Auth0 JWT tokens.
 func NewAuth0Validator(auth0Domain, auth0Audience string) (AuthValidator, error) {
 	issuerURL, err := url.Parse("https://" + auth0Domain + "/")
@@ -136,7 +177,11 @@ func NewAPITokenValidator(
 		}
 
 		if !token.IsActive() {
-			return nil, fmt.Errorf("API token is revoked or expired")
+			return nil, &AuthError{
+				Message: "API token is revoked or expired",
+				UserID:  token.UserID,
+				TokenID: token.ID,
+			}
 		}
 
 		select {
@@ -145,8 +190,10 @@ func NewAPITokenValidator(
 		}
 
 		return &AuthResult{
-			UserID: token.UserID,
-			Method: domain.AuthMethodAPIToken,
+			UserID:  token.UserID,
+			Method:  domain.AuthMethodAPIToken,
+			TokenID: token.ID,
+			Scopes:  token.Scopes,
 		}, nil
 	}
 }
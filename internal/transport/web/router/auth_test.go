@@ -0,0 +1,63 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMiddleware_AuditsFailures(t *testing.T) {
+	tokenID := "tok1"
+
+	cases := []struct {
+		name        string
+		err         error
+		wantUserID  string
+		wantTokenID *string
+	}{
+		{name: "unattributed", err: errors.New("invalid API token")},
+		{
+			name:        "revoked_token",
+			err:         &AuthError{Message: "API token is revoked or expired", UserID: "user1", TokenID: "tok1"},
+			wantUserID:  "user1",
+			wantTokenID: &tokenID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			written := make(chan domain.AuditEvent, 1)
+
+			validator := func(*http.Request) (*AuthResult, error) { return nil, tc.err }
+			middleware := NewAuthMiddleware([]AuthValidator{validator}, written)
+			handler := clientInfoMiddleware(middleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				t.Fatal("handler should not be called after failed authentication")
+			})))
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/articles/liked", nil)
+			req.RemoteAddr = "203.0.113.7:51234"
+			req.Header.Set("User-Agent", "test-agent")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+			select {
+			case event := <-written:
+				assert.Equal(t, domain.AuditEventAuthenticationFailed, event.Type)
+				assert.Equal(t, domain.AuditOutcomeFailure, event.Outcome)
+				assert.Equal(t, tc.wantUserID, event.UserID)
+				assert.Equal(t, tc.wantTokenID, event.TokenID)
+				assert.Equal(t, "203.0.113.7", event.IPAddress)
+				assert.Equal(t, "test-agent", event.UserAgent)
+			default:
+				require.Fail(t, "audit event was not queued")
+			}
+		})
+	}
+}
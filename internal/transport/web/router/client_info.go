@@ -0,0 +1,26 @@
+package router
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// clientInfoMiddleware records the client's IP address and user agent in the request
+// context for the audit log. The server is not run behind a proxy, so the remote
+// address is the client's.
+func clientInfoMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+
+		ctx := domain.ContextWithClientInfo(r.Context(), domain.ClientInfo{
+			IPAddress: ip,
+			UserAgent: r.UserAgent(),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
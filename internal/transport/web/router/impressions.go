@@ -0,0 +1,33 @@
+package router
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// NewImpressionRecorder starts recording the recommendation impressions sent to the returned
+// channel in the background, so serving recommendations never waits on the write.
+func NewImpressionRecorder(
+	ctx context.Context,
+	recorder datasources.RecommendationImpressionRecorder,
+) chan<- []domain.RecommendationImpression {
+	// Asynchronous best-effort recording of the recommendations shown to users.
+	// If the service restarts up to the buffer size of impressions here might be lost, but this is tolerable.
+	// Senders drop impressions rather than wait once the channel becomes full.
+	impressionChan := make(chan []domain.RecommendationImpression, 100)
+	go func() {
+		for impressions := range impressionChan {
+			recordErr := recorder.RecordRecommendationImpressions(context.WithoutCancel(ctx), impressions)
+			if recordErr != nil {
+				logger := domain.LoggerFromContext(ctx).With("user_id", impressions[0].UserID)
+				logger.WarnContext(context.WithoutCancel(ctx),
+					"failed to record recommendation impressions",
+					"error", recordErr)
+			}
+		}
+	}()
+
+	return impressionChan
+}
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewImpressionRecorder(t *testing.T) {
+	impressions := []domain.RecommendationImpression{{UserID: "user1", ArticleHashID: "rec1"}}
+
+	recorded := make(chan []domain.RecommendationImpression, 1)
+	recorder := mocks.NewRecommendationImpressionRecorder(t)
+	recorder.EXPECT().
+		RecordRecommendationImpressions(mock.Anything, impressions).
+		RunAndReturn(func(_ context.Context, i []domain.RecommendationImpression) error {
+			recorded <- i
+			return nil
+		})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	impressionChan := NewImpressionRecorder(ctx, recorder)
+
+	// Impressions are still recorded once the context is cancelled
+	cancel()
+	impressionChan <- impressions
+
+	select {
+	case got := <-recorded:
+		assert.Equal(t, impressions, got)
+	case <-time.After(time.Second):
+		t.Fatal("impressions were not recorded")
+	}
+}
@@ -0,0 +1,138 @@
+package router
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-jwt-middleware/v2/jwks"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// oidcClaims holds all claims of a token, so the user ID can be read from a configurable claim.
+type oidcClaims map[string]any
+
+func (c *oidcClaims) Validate(context.Context) error {
+	return nil
+}
+
+// oidcIssuer is a configured issuer's validator and the prefix namespacing its user IDs.
+type oidcIssuer struct {
+	validator    *validator.Validator
+	userIDPrefix string
+}
+
+// NewOIDCValidator creates a validator for JWTs issued by any of the given OpenID Connect issuers.
+// Each issuer is given as "<prefix>=<issuer URL>", and its signing keys are found through its
+// discovery document. A token must be issued for at least one of the audiences. The user ID is
+// the issuer's prefix and the value of userIDClaim (default "sub") joined by "|", so issuers
+// reusing each other's subjects can't share accounts. Tokens from issuers that aren't
+// configured are left for other validators.
+func NewOIDCValidator(issuers, audiences []string, userIDClaim string) (AuthValidator, error) {
+	if userIDClaim == "" {
+		userIDClaim = "sub"
+	}
+	audiences = slices.DeleteFunc(slices.Clone(audiences), func(a string) bool { return a == "" })
+
+	configured := make(map[string]oidcIssuer, len(issuers))
+	prefixes := make(map[string]bool, len(issuers))
+	for _, entry := range issuers {
+		if entry == "" {
+			continue
+		}
+
+		prefix, issuer, ok := strings.Cut(entry, "=")
+		if !ok || prefix == "" || issuer == "" || strings.Contains(prefix, "|") {
+			return nil, fmt.Errorf("OIDC issuer [%s] must be given as <user ID prefix>=<issuer URL>", entry)
+		}
+		if prefixes[prefix] {
+			return nil, fmt.Errorf("OIDC user ID prefix [%s] is used by more than one issuer", prefix)
+		}
+		prefixes[prefix] = true
+
+		issuerURL, err := url.Parse(issuer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the issuer url [%s]: %w", issuer, err)
+		}
+
+		provider := jwks.NewCachingProvider(issuerURL, 5*time.Minute)
+		jwtValidator, err := validator.New(
+			provider.KeyFunc,
+			validator.RS256,
+			issuer,
+			audiences,
+			validator.WithAllowedClockSkew(time.Minute),
+			validator.WithCustomClaims(func() validator.CustomClaims { return &oidcClaims{} }),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create JWT validator for issuer [%s]: %w", issuer, err)
+		}
+		configured[issuer] = oidcIssuer{validator: jwtValidator, userIDPrefix: prefix}
+	}
+	if len(configured) == 0 {
+		return nil, errors.New("at least one OIDC issuer is required")
+	}
+
+	return func(r *http.Request) (*AuthResult, error) {
+		authHeader := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authHeader, "Bearer ") {
+			return nil, nil
+		}
+		rawToken := authHeader[len("Bearer "):]
+
+		issuer, ok := configured[unverifiedIssuer(rawToken)]
+		if !ok {
+			return nil, nil
+		}
+
+		token, err := issuer.validator.ValidateToken(r.Context(), rawToken)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT token")
+		}
+
+		claims := token.(*validator.ValidatedClaims)
+		userID, _ := (*claims.CustomClaims.(*oidcClaims))[userIDClaim].(string)
+		if userID == "" {
+			return nil, fmt.Errorf("JWT token has no %s claim", userIDClaim)
+		}
+
+		return &AuthResult{
+			UserID: issuer.userIDPrefix + "|" + userID,
+			Method: domain.AuthMethodOIDC,
+		}, nil
+	}, nil
+}
+
+// unverifiedIssuer returns the "iss" claim of a compact JWT without checking its signature,
+// or an empty string if the token isn't a well-formed JWT. It is only used to choose which
+// issuer's keys to verify the token with.
+func unverifiedIssuer(rawToken string) string {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(parts[0]); err != nil {
+		return ""
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Issuer
+}
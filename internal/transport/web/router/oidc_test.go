@@ -0,0 +1,200 @@
+package router
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-jose/go-jose.v2"
+	"gopkg.in/go-jose/go-jose.v2/jwt"
+)
+
+type testOIDCIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+}
+
+// newTestOIDCIssuer starts a local OpenID Connect issuer serving a discovery document and JWKS.
+func newTestOIDCIssuer(t *testing.T) *testOIDCIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   server.URL,
+			"jwks_uri": server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test-key", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+
+	return &testOIDCIssuer{server: server, key: key}
+}
+
+func (i *testOIDCIssuer) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: i.key, KeyID: "test-key"}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestOIDCValidator(t *testing.T) {
+	issuerA := newTestOIDCIssuer(t)
+	issuerB := newTestOIDCIssuer(t)
+	otherIssuer := newTestOIDCIssuer(t)
+
+	claims := func(issuer *testOIDCIssuer, overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":   issuer.server.URL,
+			"sub":   "subject1",
+			"aud":   "feed-api",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"email": "user@example.com",
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+
+	cases := []struct {
+		name        string
+		userIDClaim string
+		authHeader  string
+		wantUserID  string
+		wantErr     bool
+		wantSkipped bool
+	}{
+		{
+			name:       "first_issuer",
+			authHeader: "Bearer " + issuerA.sign(t, claims(issuerA, nil)),
+			wantUserID: "issuer-a|subject1",
+		},
+		{
+			// Same subject as first_issuer, but a different account
+			name:       "second_issuer_second_audience",
+			authHeader: "Bearer " + issuerB.sign(t, claims(issuerB, map[string]any{"aud": "feed-mcp"})),
+			wantUserID: "issuer-b|subject1",
+		},
+		{
+			name:        "custom_user_id_claim",
+			userIDClaim: "email",
+			authHeader:  "Bearer " + issuerA.sign(t, claims(issuerA, nil)),
+			wantUserID:  "issuer-a|user@example.com",
+		},
+		{
+			name:        "missing_user_id_claim",
+			userIDClaim: "preferred_username",
+			authHeader:  "Bearer " + issuerA.sign(t, claims(issuerA, nil)),
+			wantErr:     true,
+		},
+		{
+			name:       "wrong_audience",
+			authHeader: "Bearer " + issuerA.sign(t, claims(issuerA, map[string]any{"aud": "other-api"})),
+			wantErr:    true,
+		},
+		{
+			name: "expired",
+			authHeader: "Bearer " + issuerA.sign(t, claims(issuerA, map[string]any{
+				"exp": time.Now().Add(-time.Hour).Unix(),
+			})),
+			wantErr: true,
+		},
+		{
+			name:       "signed_by_another_issuers_key",
+			authHeader: "Bearer " + otherIssuer.sign(t, claims(issuerA, nil)),
+			wantErr:    true,
+		},
+		{
+			name:        "unconfigured_issuer",
+			authHeader:  "Bearer " + otherIssuer.sign(t, claims(otherIssuer, nil)),
+			wantSkipped: true,
+		},
+		{
+			name:        "api_token",
+			authHeader:  "Bearer " + domain.APITokenPrefix + "abc123",
+			wantSkipped: true,
+		},
+		{
+			name:        "no_header",
+			wantSkipped: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			validate, err := NewOIDCValidator(
+				[]string{"issuer-a=" + issuerA.server.URL, "issuer-b=" + issuerB.server.URL},
+				[]string{"feed-api", "feed-mcp"},
+				tc.userIDClaim,
+			)
+			require.NoError(t, err)
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/articles/liked", nil)
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+
+			result, err := validate(req)
+			switch {
+			case tc.wantSkipped:
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			case tc.wantErr:
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			default:
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tc.wantUserID, result.UserID)
+				assert.Equal(t, domain.AuthMethodOIDC, result.Method)
+			}
+		})
+	}
+}
+
+func TestNewOIDCValidator_InvalidIssuers(t *testing.T) {
+	cases := []struct {
+		name    string
+		issuers []string
+	}{
+		{name: "no_issuers", issuers: []string{""}},
+		{name: "missing_prefix", issuers: []string{"https://issuer.example.com"}},
+		{name: "empty_prefix", issuers: []string{"=https://issuer.example.com"}},
+		{name: "separator_in_prefix", issuers: []string{"a|b=https://issuer.example.com"}},
+		{
+			name:    "duplicate_prefix",
+			issuers: []string{"idp=https://one.example.com", "idp=https://two.example.com"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewOIDCValidator(tc.issuers, []string{"feed-api"}, "")
+			assert.Error(t, err)
+		})
+	}
+}
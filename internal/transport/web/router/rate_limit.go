@@ -0,0 +1,107 @@
+package router
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// RateLimitClass groups routes that share a rate limit.
+type RateLimitClass string
+
+const (
+	// RateLimitClassRead covers reads not in another class.
+	RateLimitClassRead RateLimitClass = "read"
+	// RateLimitClassWrite covers every non-GET request.
+	RateLimitClassWrite RateLimitClass = "write"
+	// RateLimitClassSearch covers semantic search, where each call costs an embedding request.
+	RateLimitClassSearch RateLimitClass = "search"
+	// RateLimitClassFeed covers RSS feeds.
+	RateLimitClassFeed RateLimitClass = "feed"
+)
+
+// NewRateLimitMiddleware creates a middleware applying a token bucket per client and route class.
+// Clients are identified by API token, then user ID, then IP address, so it must run after the
+// auth middleware. Classes missing from limits are not rate limited. If the store fails, requests
+// are let through rather than failing.
+func NewRateLimitMiddleware(
+	store datasources.RateLimitStore,
+	limits map[RateLimitClass]domain.RateLimit,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			class := rateLimitClassForRequest(r)
+			limit, ok := limits[class]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key := string(class) + ":" + rateLimitClientKey(r)
+			decision, err := store.TakeRateLimitToken(ctx, key, limit, time.Now())
+			if err != nil {
+				logger := domain.LoggerFromContext(ctx)
+				logger.WarnContext(ctx, "unable to apply rate limit", "error", err, "class", class)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+			w.Header().Set("RateLimit-Reset", ceilSeconds(decision.ResetAfter))
+
+			if !decision.Allowed {
+				w.Header().Set("Retry-After", ceilSeconds(decision.RetryAfter))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = fmt.Fprintf(w, `{"message":"rate limit exceeded for %s requests"}`, class)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func rateLimitClassForRequest(r *http.Request) RateLimitClass {
+	var path string
+	if route := mux.CurrentRoute(r); route != nil {
+		path, _ = route.GetPathTemplate()
+	}
+
+	switch {
+	case path == "/v1/articles/semantic-search":
+		return RateLimitClassSearch
+	case strings.HasPrefix(path, "/rss"):
+		return RateLimitClassFeed
+	case r.Method != http.MethodGet && r.Method != http.MethodHead:
+		return RateLimitClassWrite
+	default:
+		return RateLimitClassRead
+	}
+}
+
+func rateLimitClientKey(r *http.Request) string {
+	ctx := r.Context()
+	if tokenID := domain.APITokenIDFromContext(ctx); tokenID != "" {
+		return "token:" + tokenID
+	}
+	if userID := domain.UserIDFromContext(ctx); userID != "" {
+		return "user:" + userID
+	}
+	return "ip:" + domain.ClientInfoFromContext(ctx).IPAddress
+}
+
+// ceilSeconds formats a duration as whole seconds, rounding up so clients never retry early.
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,93 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/memory"
+	"github.com/jbeshir/alignment-research-feed/internal/datasources/mocks"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newRateLimitTestRouter(store datasources.RateLimitStore) http.Handler {
+	r := mux.NewRouter()
+	r.Use(clientInfoMiddleware)
+	r.Use(NewRateLimitMiddleware(store, map[RateLimitClass]domain.RateLimit{
+		RateLimitClassSearch: {Requests: 1, Window: time.Minute},
+		RateLimitClassWrite:  {Requests: 1, Window: time.Minute},
+	}))
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	r.Handle("/v1/articles/semantic-search", ok).Methods(http.MethodPost)
+	r.Handle("/v1/articles/{article_id}/read/{read}", ok).Methods(http.MethodPost)
+	r.Handle("/v1/articles", ok).Methods(http.MethodGet)
+	return r
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	router := newRateLimitTestRouter(memory.NewRateLimitStore())
+
+	send := func(method, path, remoteAddr, userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(t.Context(), method, path, nil)
+		req.RemoteAddr = remoteAddr
+		if userID != "" {
+			req = req.WithContext(domain.ContextWithUserID(req.Context(), userID))
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Anonymous searches are limited by IP address
+	rec := send(http.MethodPost, "/v1/articles/semantic-search", "203.0.113.7:1000", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "60", rec.Header().Get("RateLimit-Reset"))
+
+	rec = send(http.MethodPost, "/v1/articles/semantic-search", "203.0.113.7:2000", "")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+	assert.JSONEq(t, `{"message":"rate limit exceeded for search requests"}`, rec.Body.String())
+
+	rec = send(http.MethodPost, "/v1/articles/semantic-search", "203.0.113.8:1000", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Classes have separate buckets, keyed by user when authenticated
+	rec = send(http.MethodPost, "/v1/articles/a1/read/true", "203.0.113.7:1000", "user1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = send(http.MethodPost, "/v1/articles/a2/read/true", "203.0.113.9:1000", "user1")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	rec = send(http.MethodPost, "/v1/articles/a1/read/true", "203.0.113.7:1000", "user2")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Classes without a limit are not limited
+	for range 3 {
+		rec = send(http.MethodGet, "/v1/articles", "203.0.113.7:1000", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+	}
+}
+
+func TestRateLimitMiddleware_StoreErrorLetsRequestsThrough(t *testing.T) {
+	store := mocks.NewRateLimitStore(t)
+	store.EXPECT().TakeRateLimitToken(mock.Anything, "search:ip:203.0.113.7", mock.Anything, mock.Anything).
+		Return(domain.RateLimitDecision{}, errors.New("database unavailable"))
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v1/articles/semantic-search", nil)
+	req.RemoteAddr = "203.0.113.7:1000"
+	rec := httptest.NewRecorder()
+
+	newRateLimitTestRouter(store).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,33 @@
+package router
+
+import (
+	"context"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+)
+
+// NewRecommendationUpdater starts applying the rating changes sent to the returned channel to
+// users' interest clusters and recommendations in the background, so rating never waits on it.
+func NewRecommendationUpdater(
+	ctx context.Context,
+	applyCmd command.Command[command.ApplyRatingToRecommendationsRequest, command.Empty],
+) chan<- command.ApplyRatingToRecommendationsRequest {
+	// Asynchronous best-effort updates, applied one at a time so concurrent ratings by the same
+	// user don't race to move a centroid. Updates lost on restart or dropped when the channel is
+	// full are tolerable, as the batch job still regenerates the user's clusters and recommendations.
+	updateChan := make(chan command.ApplyRatingToRecommendationsRequest, 100)
+	go func() {
+		for update := range updateChan {
+			_, applyErr := applyCmd.Execute(context.WithoutCancel(ctx), update)
+			if applyErr != nil {
+				logger := domain.LoggerFromContext(ctx).With("user_id", update.UserID)
+				logger.WarnContext(context.WithoutCancel(ctx),
+					"failed to apply rating to recommendations",
+					"error", applyErr)
+			}
+		}
+	}()
+
+	return updateChan
+}
@@ -0,0 +1,47 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeshir/alignment-research-feed/internal/command"
+	cmdmocks "github.com/jbeshir/alignment-research-feed/internal/command/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewRecommendationUpdater(t *testing.T) {
+	first := command.ApplyRatingToRecommendationsRequest{UserID: "user1", LikedVector: []float32{1.0, 0.0}}
+	second := command.ApplyRatingToRecommendationsRequest{UserID: "user2"}
+
+	applied := make(chan command.ApplyRatingToRecommendationsRequest, 2)
+	applyCmd := cmdmocks.NewCommand[command.ApplyRatingToRecommendationsRequest, command.Empty](t)
+	applyCmd.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req command.ApplyRatingToRecommendationsRequest) (command.Empty, error) {
+			applied <- req
+			if req.UserID == "user1" {
+				return command.Empty{}, errors.New("db down")
+			}
+			return command.Empty{}, nil
+		})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	updateChan := NewRecommendationUpdater(ctx, applyCmd)
+
+	// Updates are still applied once the context is cancelled, and after one fails
+	cancel()
+	updateChan <- first
+	updateChan <- second
+
+	for _, want := range []command.ApplyRatingToRecommendationsRequest{first, second} {
+		select {
+		case got := <-applied:
+			assert.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatal("update was not applied")
+		}
+	}
+}
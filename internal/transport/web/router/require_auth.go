@@ -1,6 +1,7 @@
 package router
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/jbeshir/alignment-research-feed/internal/domain"
@@ -48,3 +49,32 @@ func requireNonAPITokenAuthMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// requireScopeMiddleware returns a middleware that rejects requests authenticated with an
+// API token lacking the given scope. Requests using other authentication methods, or none,
+// are passed through; combine with requireAuthMiddleware where authentication is required.
+// Rejections are recorded in the audit log asynchronously, through auditEvents.
+func requireScopeMiddleware(
+	auditEvents chan<- domain.AuditEvent, scope domain.APITokenScope,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !domain.HasAPITokenScope(r.Context(), scope) {
+				logger := domain.LoggerFromContext(r.Context())
+				logger.WarnContext(r.Context(), "attempt to use endpoint with API token lacking scope",
+					"scope", scope)
+
+				event := domain.NewAuditEvent(r.Context(), domain.AuditEventAPITokenScopeDenied,
+					domain.AuditOutcomeDenied, fmt.Sprintf("%s %s requires %s", r.Method, r.URL.Path, scope))
+				recordAuditEvent(r.Context(), auditEvents, event)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = fmt.Fprintf(w, `{"message":"This endpoint requires an API token with the %s scope."}`, scope)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
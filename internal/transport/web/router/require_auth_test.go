@@ -0,0 +1,73 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jbeshir/alignment-research-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireScopeMiddleware(t *testing.T) {
+	cases := []struct {
+		name       string
+		method     domain.AuthMethod
+		scopes     []domain.APITokenScope
+		wantStatus int
+	}{
+		{
+			name:       "token_with_scope",
+			method:     domain.AuthMethodAPIToken,
+			scopes:     []domain.APITokenScope{domain.APITokenScopeInteractionsWrite},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "read_only_token",
+			method:     domain.AuthMethodAPIToken,
+			scopes:     []domain.APITokenScope{domain.APITokenScopeArticlesRead},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "auth0_session",
+			method:     domain.AuthMethodAuth0,
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+			auditEvents := make(chan domain.AuditEvent, 1)
+			handler := requireScopeMiddleware(auditEvents, domain.APITokenScopeInteractionsWrite)(next)
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v1/articles/a1/thumbs_up/true", nil)
+			ctx := domain.ContextWithAuthMethod(req.Context(), tc.method)
+			if tc.scopes != nil {
+				ctx = domain.ContextWithAPITokenID(ctx, "tok1")
+				ctx = domain.ContextWithAPITokenScopes(ctx, tc.scopes)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req.WithContext(ctx))
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus != http.StatusForbidden {
+				assert.Empty(t, auditEvents)
+				return
+			}
+			select {
+			case event := <-auditEvents:
+				assert.Equal(t, domain.AuditEventAPITokenScopeDenied, event.Type)
+				assert.Equal(t, domain.AuditOutcomeDenied, event.Outcome)
+				require.NotNil(t, event.TokenID)
+				assert.Equal(t, "tok1", *event.TokenID)
+			default:
+				require.Fail(t, "audit event was not queued")
+			}
+		})
+	}
+}
@@ -18,76 +18,185 @@ func MakeRouter(
 	rssFeedBaseURL, rssFeedAuthorName, rssFeedAuthorEmail string,
 	latestCacheMaxAge time.Duration,
 	authMiddleware func(http.Handler) http.Handler,
+	rateLimitMiddleware func(http.Handler) http.Handler,
 	createAPITokenCmd *command.CreateAPIToken,
+	rotateAPITokenCmd *command.RotateAPIToken,
+	setDigestPreferencesCmd *command.SetDigestPreferences,
 	recommendArticlesCmd *command.RecommendArticles,
+	auditEvents chan<- domain.AuditEvent,
+	impressions chan<- []domain.RecommendationImpression,
+	recommendationUpdates chan<- command.ApplyRatingToRecommendationsRequest,
 ) (http.Handler, error) {
 	r := mux.NewRouter()
 	r.Use(corsMiddleware)
+	r.Use(clientInfoMiddleware)
 	r.Use(authMiddleware)
+	r.Use(rateLimitMiddleware)
+
+	// API tokens may only use routes covered by their scopes
+	articlesRead := requireScopeMiddleware(auditEvents, domain.APITokenScopeArticlesRead)
+	interactionsWrite := requireScopeMiddleware(auditEvents, domain.APITokenScopeInteractionsWrite)
+	recommendationsRead := requireScopeMiddleware(auditEvents, domain.APITokenScopeRecommendationsRead)
+	feedsRead := requireScopeMiddleware(auditEvents, domain.APITokenScopeFeedsRead)
 
 	// Create shared command for rating updates
-	setRatingCmd := command.NewSetArticleRating(similarity, dataset, dataset)
+	setRatingCmd := command.NewSetArticleRating(similarity, dataset, dataset, recommendationUpdates)
+	recordSignalCmd := command.NewRecordArticleSignal(similarity, dataset, dataset)
+	createSavedSearchCmd := command.NewCreateSavedSearch(dataset, dataset, embedder)
+	updateSavedSearchCmd := command.NewUpdateSavedSearch(dataset, embedder)
+	runSavedSearchCmd := command.NewRunSavedSearch(dataset, similarity, dataset, dataset)
+	createCollectionCmd := command.NewCreateCollection(dataset, dataset)
+	reorderCollectionCmd := command.NewReorderCollection(dataset)
+	importReadingHistoryCmd := command.NewImportReadingHistory(dataset, dataset, setRatingCmd)
+	setOnboardingInterestsCmd := command.NewSetOnboardingInterests(
+		embedder, dataset, dataset, dataset, dataset, dataset,
+		domain.DefaultClusterConfig().MinArticlesForClustering,
+	)
+	createArticleNoteCmd := command.NewCreateArticleNote(dataset)
+	updateArticleNoteCmd := command.NewUpdateArticleNote(dataset)
+	similarAuthorsCmd := command.NewSimilarAuthors(dataset, similarity, similarity, dataset)
+	followItemCmd := command.NewFollowItem(dataset, dataset, dataset, dataset)
+	setFollowPreferencesCmd := command.NewSetFollowPreferences(dataset)
+	listFollowedArticleIDsCmd := command.NewListFollowedArticleIDs(dataset, dataset)
 
-	r.Handle("/v1/articles", controller.ArticlesList{
+	r.Handle("/v1/articles", articlesRead(controller.ArticlesList{
 		Lister:      dataset,
 		CacheMaxAge: latestCacheMaxAge,
-	}).Methods(http.MethodGet, http.MethodOptions)
-
-	r.Handle("/v1/articles/recommended", requireAuthMiddleware(controller.RecommendedArticlesList{
-		Command: recommendArticlesCmd,
 	})).Methods(http.MethodGet, http.MethodOptions)
 
-	r.Handle("/v1/articles/unreviewed", requireAuthMiddleware(controller.UserArticlesList{
+	r.Handle("/v1/articles/recommended", recommendationsRead(requireAuthMiddleware(controller.RecommendedArticlesList{
+		Command:     recommendArticlesCmd,
+		Impressions: impressions,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/articles/recommended/new", recommendationsRead(requireAuthMiddleware(
+		controller.NewRecommendedArticlesList{
+			Command:     recommendArticlesCmd,
+			Impressions: impressions,
+		},
+	))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/articles/unreviewed", articlesRead(requireAuthMiddleware(controller.UserArticlesList{
 		Fetcher:    dataset,
 		ListFunc:   dataset.ListUnreviewedArticleIDs,
 		ListEntity: "unreviewed",
-	})).Methods(http.MethodGet, http.MethodOptions)
+	}))).Methods(http.MethodGet, http.MethodOptions)
 
-	r.Handle("/v1/articles/liked", requireAuthMiddleware(controller.UserArticlesList{
+	r.Handle("/v1/articles/liked", articlesRead(requireAuthMiddleware(controller.UserArticlesList{
 		Fetcher:    dataset,
 		ListFunc:   dataset.ListLikedArticleIDs,
 		ListEntity: "liked",
-	})).Methods(http.MethodGet, http.MethodOptions)
+	}))).Methods(http.MethodGet, http.MethodOptions)
 
-	r.Handle("/v1/articles/disliked", requireAuthMiddleware(controller.UserArticlesList{
+	r.Handle("/v1/articles/disliked", articlesRead(requireAuthMiddleware(controller.UserArticlesList{
 		Fetcher:    dataset,
 		ListFunc:   dataset.ListDislikedArticleIDs,
 		ListEntity: "disliked",
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/articles/trending", articlesRead(controller.TrendingArticlesList{
+		Lister:      dataset,
+		Fetcher:     dataset,
+		CacheMaxAge: latestCacheMaxAge,
 	})).Methods(http.MethodGet, http.MethodOptions)
 
-	r.Handle("/v1/articles/semantic-search", controller.SemanticSearch{
+	r.Handle("/v1/articles/following", articlesRead(requireAuthMiddleware(controller.FollowingArticlesList{
+		ListCmd: listFollowedArticleIDsCmd,
+		Fetcher: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/articles/semantic-search", articlesRead(controller.SemanticSearch{
 		Embedder:   embedder,
 		Similarity: similarity,
 		Fetcher:    dataset,
-	}).Methods(http.MethodPost, http.MethodOptions)
+	})).Methods(http.MethodPost, http.MethodOptions)
 
-	r.Handle("/v1/articles/{article_id}", controller.ArticleGet{
+	r.Handle("/v1/articles/{article_id}", articlesRead(controller.ArticleGet{
 		Fetcher:     dataset,
+		NoteLister:  dataset,
 		CacheMaxAge: latestCacheMaxAge,
-	}).Methods(http.MethodGet, http.MethodOptions)
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/notes", articlesRead(requireAuthMiddleware(controller.ArticleNotesList{
+		Lister: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/notes", interactionsWrite(requireAuthMiddleware(controller.ArticleNoteCreate{
+		Fetcher:   dataset,
+		CreateCmd: createArticleNoteCmd,
+	}))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/tags", articlesRead(requireAuthMiddleware(controller.ArticleTagsList{
+		Lister: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/tags/{tag}", interactionsWrite(requireAuthMiddleware(controller.ArticleTagAdd{
+		Fetcher: dataset,
+		Tagger:  dataset,
+	}))).Methods(http.MethodPut, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/tags/{tag}", interactionsWrite(requireAuthMiddleware(
+		controller.ArticleTagRemove{
+			Untagger: dataset,
+		}))).Methods(http.MethodDelete, http.MethodOptions)
 
-	r.Handle("/v1/articles/{article_id}/similar", controller.SimilarArticlesList{
+	r.Handle("/v1/articles/{article_id}/similar", articlesRead(controller.SimilarArticlesList{
 		Fetcher:     dataset,
 		Similarity:  similarity,
+		Duplicates:  dataset,
 		CacheMaxAge: 0,
-	}).Methods(http.MethodGet, http.MethodOptions)
+	})).Methods(http.MethodGet, http.MethodOptions)
 
-	r.Handle("/v1/articles/{article_id}/read/{read}", requireAuthMiddleware(controller.ArticleReadSet{
+	r.Handle("/v1/articles/{article_id}/read/{read}", interactionsWrite(requireAuthMiddleware(controller.ArticleReadSet{
 		Fetcher:    dataset,
 		ReadSetter: dataset,
-	})).Methods(http.MethodPost, http.MethodOptions)
+	}))).Methods(http.MethodPost, http.MethodOptions)
 
-	r.Handle("/v1/articles/{article_id}/thumbs_up/{thumbs_up}", requireAuthMiddleware(controller.ArticleRatingSet{
-		Fetcher:      dataset,
-		SetRatingCmd: setRatingCmd,
-		RatingType:   domain.RatingTypeThumbsUp,
-	})).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/v1/articles/{article_id}/dismissed/{dismissed}", interactionsWrite(requireAuthMiddleware(
+		controller.ArticleDismissedSet{
+			Fetcher:         dataset,
+			DismissedSetter: dataset,
+		}))).Methods(http.MethodPost, http.MethodOptions)
 
-	r.Handle("/v1/articles/{article_id}/thumbs_down/{thumbs_down}", requireAuthMiddleware(controller.ArticleRatingSet{
-		Fetcher:      dataset,
-		SetRatingCmd: setRatingCmd,
-		RatingType:   domain.RatingTypeThumbsDown,
-	})).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/v1/articles/{article_id}/snooze", interactionsWrite(requireAuthMiddleware(controller.ArticleSnoozeSet{
+		Fetcher: dataset,
+		Snoozer: dataset,
+	}))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/snooze", interactionsWrite(requireAuthMiddleware(controller.ArticleSnoozeClear{
+		Snoozer: dataset,
+	}))).Methods(http.MethodDelete, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/thumbs_up/{thumbs_up}", interactionsWrite(requireAuthMiddleware(
+		controller.ArticleRatingSet{
+			Fetcher:      dataset,
+			SetRatingCmd: setRatingCmd,
+			RatingType:   domain.RatingTypeThumbsUp,
+		}))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/thumbs_down/{thumbs_down}", interactionsWrite(requireAuthMiddleware(
+		controller.ArticleRatingSet{
+			Fetcher:      dataset,
+			SetRatingCmd: setRatingCmd,
+			RatingType:   domain.RatingTypeThumbsDown,
+		}))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/rating/{rating}", interactionsWrite(requireAuthMiddleware(
+		controller.ArticleGradedRatingSet{
+			Fetcher:      dataset,
+			SetRatingCmd: setRatingCmd,
+		}))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/rating", interactionsWrite(requireAuthMiddleware(
+		controller.ArticleGradedRatingClear{
+			SetRatingCmd: setRatingCmd,
+		}))).Methods(http.MethodDelete, http.MethodOptions)
+
+	r.Handle("/v1/articles/{article_id}/signals", interactionsWrite(requireAuthMiddleware(
+		controller.ArticleSignalRecord{
+			Fetcher:   dataset,
+			RecordCmd: recordSignalCmd,
+		}))).Methods(http.MethodPost, http.MethodOptions)
 
 	rssFeeds := []controller.RSS{
 		{
@@ -101,9 +210,38 @@ func MakeRouter(
 	}
 
 	for _, feed := range rssFeeds {
-		r.Handle(feed.FeedPath, feed)
+		r.Handle(feed.FeedPath, feedsRead(feed))
 	}
 
+	r.Handle("/rss/saved-searches/{feed_token}", feedsRead(controller.SavedSearchRSS{
+		FeedHostname:    rssFeedBaseURL,
+		FeedAuthorName:  rssFeedAuthorName,
+		FeedAuthorEmail: rssFeedAuthorEmail,
+		Getter:          dataset,
+		RunCmd:          runSavedSearchCmd,
+		CacheMaxAge:     latestCacheMaxAge,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/rss/following/{feed_token}", feedsRead(controller.FollowingRSS{
+		FeedHostname:    rssFeedBaseURL,
+		FeedAuthorName:  rssFeedAuthorName,
+		FeedAuthorEmail: rssFeedAuthorEmail,
+		Getter:          dataset,
+		ListCmd:         listFollowedArticleIDsCmd,
+		Fetcher:         dataset,
+		CacheMaxAge:     latestCacheMaxAge,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/rss/collections/{share_token}", feedsRead(controller.CollectionRSS{
+		FeedHostname:    rssFeedBaseURL,
+		FeedAuthorName:  rssFeedAuthorName,
+		FeedAuthorEmail: rssFeedAuthorEmail,
+		Getter:          dataset,
+		Lister:          dataset,
+		Fetcher:         dataset,
+		CacheMaxAge:     latestCacheMaxAge,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
 	// API Token management endpoints (no API token auth allowed)
 	r.Handle("/v1/tokens", requireNonAPITokenAuthMiddleware(controller.APITokenCreate{
 		CreateCmd: createAPITokenCmd,
@@ -115,7 +253,253 @@ func MakeRouter(
 
 	r.Handle("/v1/tokens/{token_id}", requireNonAPITokenAuthMiddleware(controller.APITokenRevoke{
 		TokenRevoker: dataset,
+		AuditWriter:  dataset,
 	})).Methods(http.MethodDelete, http.MethodOptions)
 
+	r.Handle("/v1/tokens/{token_id}", requireNonAPITokenAuthMiddleware(controller.APITokenUpdate{
+		TokenGetter:  dataset,
+		TokenRenamer: dataset,
+	})).Methods(http.MethodPatch, http.MethodOptions)
+
+	r.Handle("/v1/tokens/{token_id}/rotate", requireNonAPITokenAuthMiddleware(controller.APITokenRotate{
+		RotateCmd: rotateAPITokenCmd,
+	})).Methods(http.MethodPost, http.MethodOptions)
+
+	// Audit log endpoint (no API token auth allowed)
+	r.Handle("/v1/me/audit-events", requireNonAPITokenAuthMiddleware(controller.AuditEventsList{
+		Lister: dataset,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	// Account data export and deletion (no API token auth allowed)
+	r.Handle("/v1/me/export", requireNonAPITokenAuthMiddleware(controller.UserDataExport{
+		Exporter: dataset,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/me", requireNonAPITokenAuthMiddleware(controller.UserDelete{
+		Deleter: dataset,
+	})).Methods(http.MethodDelete, http.MethodOptions)
+
+	// Reading history import
+	r.Handle("/v1/me/import", interactionsWrite(requireAuthMiddleware(controller.ReadingImport{
+		ImportCmd: importReadingHistoryCmd,
+	}))).Methods(http.MethodPost, http.MethodOptions)
+
+	// Onboarding endpoints
+	r.Handle("/v1/categories", articlesRead(controller.ArticleCategoriesList{
+		Lister: dataset,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/me/onboarding", articlesRead(requireAuthMiddleware(controller.OnboardingInterestsGet{
+		InterestsGetter: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/me/onboarding", interactionsWrite(requireAuthMiddleware(controller.OnboardingInterestsSet{
+		SetCmd: setOnboardingInterestsCmd,
+	}))).Methods(http.MethodPut, http.MethodOptions)
+
+	// Email digest endpoints
+	r.Handle("/v1/me/digest", articlesRead(requireAuthMiddleware(controller.DigestPreferencesGet{
+		PreferencesGetter: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/me/digest", interactionsWrite(requireAuthMiddleware(controller.DigestPreferencesSet{
+		SetCmd: setDigestPreferencesCmd,
+	}))).Methods(http.MethodPut, http.MethodOptions)
+
+	r.Handle("/v1/digest/unsubscribe", controller.DigestUnsubscribe{
+		Unsubscriber: dataset,
+	}).Methods(http.MethodGet, http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/digest/confirm", controller.DigestEmailConfirm{
+		Confirmer: dataset,
+	}).Methods(http.MethodGet, http.MethodPost, http.MethodOptions)
+
+	// Saved search endpoints
+	r.Handle("/v1/saved-searches", articlesRead(requireAuthMiddleware(controller.SavedSearchList{
+		Lister:      dataset,
+		FeedBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/saved-searches", interactionsWrite(requireAuthMiddleware(controller.SavedSearchCreate{
+		CreateCmd:   createSavedSearchCmd,
+		FeedBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/saved-searches/{saved_search_id}", articlesRead(requireAuthMiddleware(controller.SavedSearchGet{
+		Getter:      dataset,
+		FeedBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/saved-searches/{saved_search_id}", interactionsWrite(requireAuthMiddleware(
+		controller.SavedSearchUpdate{
+			UpdateCmd:   updateSavedSearchCmd,
+			FeedBaseURL: rssFeedBaseURL,
+		}))).Methods(http.MethodPatch, http.MethodOptions)
+
+	r.Handle("/v1/saved-searches/{saved_search_id}", interactionsWrite(requireAuthMiddleware(
+		controller.SavedSearchDelete{
+			Deleter: dataset,
+		}))).Methods(http.MethodDelete, http.MethodOptions)
+
+	r.Handle("/v1/saved-searches/{saved_search_id}/articles", articlesRead(requireAuthMiddleware(
+		controller.SavedSearchArticles{
+			Getter: dataset,
+			RunCmd: runSavedSearchCmd,
+		}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/saved-searches/{saved_search_id}/new", articlesRead(requireAuthMiddleware(
+		controller.SavedSearchArticles{
+			Getter:  dataset,
+			RunCmd:  runSavedSearchCmd,
+			NewOnly: true,
+		}))).Methods(http.MethodGet, http.MethodOptions)
+
+	// Author endpoints
+	r.Handle("/v1/authors/{author_id}", articlesRead(controller.AuthorGet{
+		Getter: dataset,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/authors/{author_id}/articles", articlesRead(controller.AuthorArticlesList{
+		Getter:  dataset,
+		Lister:  dataset,
+		Fetcher: dataset,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/authors/{author_id}/coauthors", articlesRead(controller.AuthorCoAuthorsList{
+		Getter: dataset,
+		Lister: dataset,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/authors/{author_id}/similar", articlesRead(controller.AuthorSimilarList{
+		Getter:  dataset,
+		Command: similarAuthorsCmd,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
+	// Follow endpoints
+	r.Handle("/v1/follows", articlesRead(requireAuthMiddleware(controller.FollowsList{
+		Lister: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/follows/{follow_type}/{target}", interactionsWrite(requireAuthMiddleware(controller.FollowAdd{
+		FollowCmd: followItemCmd,
+	}))).Methods(http.MethodPut, http.MethodOptions)
+
+	r.Handle("/v1/follows/{follow_type}/{target}", interactionsWrite(requireAuthMiddleware(controller.FollowRemove{
+		Unfollower: dataset,
+	}))).Methods(http.MethodDelete, http.MethodOptions)
+
+	r.Handle("/v1/me/following", articlesRead(requireAuthMiddleware(controller.FollowPreferencesGet{
+		PreferencesGetter: dataset,
+		FeedBaseURL:       rssFeedBaseURL,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/me/following", interactionsWrite(requireAuthMiddleware(controller.FollowPreferencesSet{
+		SetCmd:      setFollowPreferencesCmd,
+		FeedBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodPut, http.MethodOptions)
+
+	// Tag endpoints
+	r.Handle("/v1/tags", articlesRead(requireAuthMiddleware(controller.UserTagsList{
+		Lister: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/tags/{tag}/articles", articlesRead(requireAuthMiddleware(controller.TaggedArticlesList{
+		Lister:  dataset,
+		Fetcher: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/me/tags", articlesRead(requireAuthMiddleware(controller.TagPreferencesGet{
+		PreferencesGetter: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/me/tags", interactionsWrite(requireAuthMiddleware(controller.TagPreferencesSet{
+		PreferencesUpserter: dataset,
+	}))).Methods(http.MethodPut, http.MethodOptions)
+
+	// Article note endpoints
+	r.Handle("/v1/notes", articlesRead(requireAuthMiddleware(controller.UserArticleNotesList{
+		Lister:   dataset,
+		Searcher: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/notes/{note_id}", articlesRead(requireAuthMiddleware(controller.ArticleNoteGet{
+		Getter: dataset,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/notes/{note_id}", interactionsWrite(requireAuthMiddleware(controller.ArticleNoteUpdate{
+		UpdateCmd: updateArticleNoteCmd,
+	}))).Methods(http.MethodPatch, http.MethodOptions)
+
+	r.Handle("/v1/notes/{note_id}", interactionsWrite(requireAuthMiddleware(controller.ArticleNoteDelete{
+		Deleter: dataset,
+	}))).Methods(http.MethodDelete, http.MethodOptions)
+
+	// Collection endpoints
+	r.Handle("/v1/collections", articlesRead(requireAuthMiddleware(controller.CollectionList{
+		Lister:       dataset,
+		ShareBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/collections", interactionsWrite(requireAuthMiddleware(controller.CollectionCreate{
+		CreateCmd:    createCollectionCmd,
+		ShareBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}", articlesRead(requireAuthMiddleware(controller.CollectionGet{
+		Getter:       dataset,
+		ShareBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}", interactionsWrite(requireAuthMiddleware(controller.CollectionUpdate{
+		Store:        dataset,
+		ShareBaseURL: rssFeedBaseURL,
+	}))).Methods(http.MethodPatch, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}", interactionsWrite(requireAuthMiddleware(controller.CollectionDelete{
+		Deleter: dataset,
+	}))).Methods(http.MethodDelete, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}/articles", articlesRead(requireAuthMiddleware(
+		controller.CollectionArticlesList{
+			Getter:  dataset,
+			Lister:  dataset,
+			Fetcher: dataset,
+		}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}/articles", interactionsWrite(requireAuthMiddleware(
+		controller.CollectionReorder{
+			Getter:     dataset,
+			ReorderCmd: reorderCollectionCmd,
+		}))).Methods(http.MethodPut, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}/articles/{article_id}", interactionsWrite(requireAuthMiddleware(
+		controller.CollectionArticleAdd{
+			Getter:    dataset,
+			Fetcher:   dataset,
+			Adder:     dataset,
+			SignalCmd: recordSignalCmd,
+		}))).Methods(http.MethodPut, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}/articles/{article_id}", interactionsWrite(requireAuthMiddleware(
+		controller.CollectionArticleRemove{
+			Getter:  dataset,
+			Remover: dataset,
+		}))).Methods(http.MethodDelete, http.MethodOptions)
+
+	r.Handle("/v1/collections/{collection_id}/similar", articlesRead(requireAuthMiddleware(
+		controller.CollectionSimilarArticles{
+			Getter:     dataset,
+			Lister:     dataset,
+			Similarity: similarity,
+			Fetcher:    dataset,
+		}))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/v1/shared/collections/{share_token}", articlesRead(controller.SharedCollectionGet{
+		Getter:       dataset,
+		Lister:       dataset,
+		Fetcher:      dataset,
+		ShareBaseURL: rssFeedBaseURL,
+	})).Methods(http.MethodGet, http.MethodOptions)
+
 	return r, nil
 }
@@ -0,0 +1,15 @@
+-- Store per-user email digest preferences
+-- The unsubscribe token is embedded in every digest email and allows one-click unsubscribe without auth
+CREATE TABLE IF NOT EXISTS `user_digest_preferences` (
+    `user_id` VARCHAR(256) NOT NULL PRIMARY KEY,
+    `email` VARCHAR(320) NOT NULL,
+    `frequency` VARCHAR(16) NOT NULL,
+    `categories` TEXT DEFAULT NULL,
+    `enabled` BOOLEAN NOT NULL DEFAULT TRUE,
+    `unsubscribe_token` CHAR(64) NOT NULL,
+    `last_sent_at` DATETIME DEFAULT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    `updated_at` DATETIME NOT NULL DEFAULT NOW(),
+    UNIQUE INDEX `idx_unsubscribe_token` (`unsubscribe_token`),
+    INDEX `idx_enabled` (`enabled`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
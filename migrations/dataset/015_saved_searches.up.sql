@@ -0,0 +1,19 @@
+-- Store named searches a user can re-run, either as serialized article filters
+-- or as semantic query text with its embedding.
+-- The feed token allows RSS readers to fetch results without an Authorization header
+CREATE TABLE IF NOT EXISTS `saved_searches` (
+    `id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(256) NOT NULL,
+    `name` VARCHAR(128) NOT NULL,
+    `kind` VARCHAR(16) NOT NULL,
+    `filters` TEXT DEFAULT NULL,
+    `query_text` TEXT DEFAULT NULL,
+    `query_vector` LONGBLOB DEFAULT NULL,
+    `feed_token` CHAR(64) NOT NULL,
+    `last_viewed_at` DATETIME DEFAULT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    `updated_at` DATETIME NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_feed_token` (`feed_token`),
+    INDEX `idx_user_created` (`user_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
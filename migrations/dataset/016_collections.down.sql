@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `collection_articles`;
+DROP TABLE IF EXISTS `collections`;
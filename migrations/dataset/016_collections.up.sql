@@ -0,0 +1,27 @@
+-- Named, ordered reading lists of articles per user
+-- The share token identifies a collection in its public share link and RSS feed; both only work while it is public
+CREATE TABLE IF NOT EXISTS `collections` (
+    `id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(256) NOT NULL,
+    `name` VARCHAR(128) NOT NULL,
+    `is_public` BOOLEAN NOT NULL DEFAULT FALSE,
+    `share_token` CHAR(64) NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    `updated_at` DATETIME NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_share_token` (`share_token`),
+    INDEX `idx_user_created` (`user_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE IF NOT EXISTS `collection_articles` (
+    `collection_id` VARCHAR(36) NOT NULL,
+    `article_hash_id` VARCHAR(32) NOT NULL,
+    `position` INT NOT NULL,
+    `added_at` DATETIME NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (`collection_id`, `article_hash_id`),
+    INDEX `idx_collection_position` (`collection_id`, `position`),
+    CONSTRAINT `collection_articles_ibfk_1` FOREIGN KEY (`collection_id`)
+        REFERENCES `collections` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `collection_articles_ibfk_2` FOREIGN KEY (`article_hash_id`)
+        REFERENCES `articles` (`hash_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
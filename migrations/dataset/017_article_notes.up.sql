@@ -0,0 +1,17 @@
+-- Private per-user markdown notes on articles
+-- A note with a quote is a highlight of that passage; the body may then be empty
+CREATE TABLE IF NOT EXISTS `article_notes` (
+    `id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(256) NOT NULL,
+    `article_hash_id` VARCHAR(32) NOT NULL,
+    `body` TEXT NOT NULL,
+    `quote` TEXT DEFAULT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    `updated_at` DATETIME NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (`id`),
+    INDEX `idx_user_article` (`user_id`, `article_hash_id`),
+    INDEX `idx_user_updated` (`user_id`, `updated_at`),
+    FULLTEXT INDEX `notes_fulltext_idx` (`body`, `quote`),
+    CONSTRAINT `article_notes_ibfk_1` FOREIGN KEY (`article_hash_id`)
+        REFERENCES `articles` (`hash_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,13 @@
+-- Free-form, user-scoped tags on articles
+-- Tags are stored normalized (trimmed, lower-cased, single-spaced)
+CREATE TABLE IF NOT EXISTS `user_article_tags` (
+    `user_id` VARCHAR(256) NOT NULL,
+    `article_hash_id` VARCHAR(32) NOT NULL,
+    `tag` VARCHAR(64) NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (`user_id`, `article_hash_id`, `tag`),
+    INDEX `idx_user_tag` (`user_id`, `tag`),
+    INDEX `idx_tag_article` (`tag`, `article_hash_id`),
+    CONSTRAINT `user_article_tags_ibfk_1` FOREIGN KEY (`article_hash_id`)
+        REFERENCES `articles` (`hash_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,5 @@
+-- Comma-separated scopes limiting what a token may do
+-- Tokens created before scopes existed keep full access
+ALTER TABLE api_tokens ADD COLUMN scopes VARCHAR(255) NOT NULL
+    DEFAULT 'articles:read,interactions:write,recommendations:read,feeds:read';
+ALTER TABLE api_tokens ALTER COLUMN scopes DROP DEFAULT;
@@ -0,0 +1,2 @@
+ALTER TABLE api_tokens DROP INDEX idx_active_expiry;
+ALTER TABLE api_tokens DROP COLUMN replaced_by_id;
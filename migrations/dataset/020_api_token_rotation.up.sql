@@ -0,0 +1,3 @@
+-- Set on a rotated token to the ID of the token that replaced it
+ALTER TABLE api_tokens ADD COLUMN replaced_by_id VARCHAR(36) DEFAULT NULL;
+ALTER TABLE api_tokens ADD INDEX idx_active_expiry (revoked_at, expires_at);
@@ -0,0 +1,15 @@
+-- Append-only log of security-relevant account events
+-- user_id is NULL for failed authentication that can't be attributed to an account
+CREATE TABLE IF NOT EXISTS `audit_events` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT,
+    `user_id` VARCHAR(256) DEFAULT NULL,
+    `event_type` VARCHAR(64) NOT NULL,
+    `outcome` VARCHAR(16) NOT NULL,
+    `token_id` VARCHAR(36) DEFAULT NULL,
+    `ip_address` VARCHAR(45) NOT NULL,
+    `user_agent` VARCHAR(512) NOT NULL,
+    `detail` VARCHAR(255) NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (`id`),
+    INDEX `idx_user_created` (`user_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
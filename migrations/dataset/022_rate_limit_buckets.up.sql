@@ -0,0 +1,10 @@
+-- Token buckets for rate limiting shared between API instances
+-- A bucket idle for longer than its limit's window is full, the same as a missing row,
+-- so stale rows may be deleted at any time
+CREATE TABLE IF NOT EXISTS `rate_limit_buckets` (
+    `bucket_key` VARCHAR(320) NOT NULL,
+    `tokens` DOUBLE NOT NULL,
+    `updated_at` DATETIME(6) NOT NULL,
+    PRIMARY KEY (`bucket_key`),
+    INDEX `idx_updated_at` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1 @@
+ALTER TABLE articles DROP INDEX idx_url;
@@ -0,0 +1,3 @@
+-- Index URLs so imported reading history can be matched to articles
+-- URLs can exceed the maximum key length, so only a prefix is indexed
+ALTER TABLE articles ADD INDEX idx_url (url(255));
@@ -0,0 +1,2 @@
+ALTER TABLE user_interest_clusters DROP COLUMN provisional;
+DROP TABLE IF EXISTS `user_onboarding_interests`;
@@ -0,0 +1,14 @@
+-- Interests a new user picks before they have rated anything
+-- categories and interests are JSON arrays of strings
+CREATE TABLE IF NOT EXISTS `user_onboarding_interests` (
+    `user_id` VARCHAR(256) NOT NULL,
+    `categories` TEXT DEFAULT NULL,
+    `interests` TEXT DEFAULT NULL,
+    `created_at` DATETIME NOT NULL,
+    `updated_at` DATETIME NOT NULL,
+    PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- Provisional clusters are seeded from onboarding interests rather than computed from ratings,
+-- and are replaced once the user has rated enough articles to cluster
+ALTER TABLE user_interest_clusters ADD COLUMN provisional BOOLEAN NOT NULL DEFAULT FALSE;
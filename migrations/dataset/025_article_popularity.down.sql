@@ -0,0 +1,4 @@
+ALTER TABLE user_article_interactions
+    DROP INDEX idx_date_rated,
+    DROP INDEX idx_date_read;
+DROP TABLE IF EXISTS `article_popularity`;
@@ -0,0 +1,21 @@
+-- Per-article interaction counts over sliding windows, recomputed by the aggregate-popularity job
+-- Only articles with interactions from enough distinct users are stored
+CREATE TABLE IF NOT EXISTS `article_popularity` (
+    `article_hash_id` VARCHAR(32) NOT NULL,
+    `window_days` INT NOT NULL,
+    `like_count` BIGINT NOT NULL,
+    `dislike_count` BIGINT NOT NULL,
+    `read_count` BIGINT NOT NULL,
+    `user_count` BIGINT NOT NULL,
+    `score` DOUBLE NOT NULL,
+    `computed_at` DATETIME NOT NULL,
+    PRIMARY KEY (`window_days`, `article_hash_id`),
+    INDEX idx_window_score (`window_days`, `score`),
+    CONSTRAINT `article_popularity_ibfk_1` FOREIGN KEY (`article_hash_id`)
+        REFERENCES `articles` (`hash_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- Aggregation scans interactions by date across all users
+ALTER TABLE user_article_interactions
+    ADD INDEX idx_date_rated (`date_rated`),
+    ADD INDEX idx_date_read (`date_read`);
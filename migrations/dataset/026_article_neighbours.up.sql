@@ -0,0 +1,14 @@
+-- Item-item collaborative filtering neighbours: articles liked by the same users,
+-- recomputed by the compute-article-neighbours job
+CREATE TABLE IF NOT EXISTS `article_neighbours` (
+    `article_hash_id` VARCHAR(32) NOT NULL,
+    `neighbour_hash_id` VARCHAR(32) NOT NULL,
+    `co_like_count` BIGINT NOT NULL,
+    `score` DOUBLE NOT NULL,
+    `computed_at` DATETIME NOT NULL,
+    PRIMARY KEY (`article_hash_id`, `neighbour_hash_id`),
+    CONSTRAINT `article_neighbours_ibfk_1` FOREIGN KEY (`article_hash_id`)
+        REFERENCES `articles` (`hash_id`),
+    CONSTRAINT `article_neighbours_ibfk_2` FOREIGN KEY (`neighbour_hash_id`)
+        REFERENCES `articles` (`hash_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `recommendation_impressions`;
+
+ALTER TABLE user_precomputed_recommendations
+    DROP COLUMN `experiment_arm`;
@@ -0,0 +1,16 @@
+-- Record which experiment arm's config generated each precomputed recommendation
+ALTER TABLE user_precomputed_recommendations
+    ADD COLUMN `experiment_arm` VARCHAR(64) NOT NULL DEFAULT '' AFTER `source`;
+
+-- Recommendations shown to users, for comparing experiment arms by what was read or liked afterwards
+CREATE TABLE IF NOT EXISTS `recommendation_impressions` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    `user_id` VARCHAR(256) NOT NULL,
+    `article_hash_id` VARCHAR(32) NOT NULL,
+    `experiment_arm` VARCHAR(64) NOT NULL,
+    `source` VARCHAR(32) NOT NULL,
+    `position` INT NOT NULL,
+    `served_at` DATETIME NOT NULL,
+    INDEX idx_user_article (`user_id`, `article_hash_id`),
+    INDEX idx_served_at (`served_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,2 @@
+ALTER TABLE recommendation_impressions
+    DROP INDEX idx_user_served_at;
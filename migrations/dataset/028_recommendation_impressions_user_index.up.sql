@@ -0,0 +1,3 @@
+-- Recommendation generation looks up the impressions shown to a user recently
+ALTER TABLE recommendation_impressions
+    ADD INDEX idx_user_served_at (`user_id`, `served_at`);
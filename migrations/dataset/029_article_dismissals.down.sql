@@ -0,0 +1,4 @@
+ALTER TABLE user_article_interactions
+    DROP COLUMN `snoozed_until`,
+    DROP COLUMN `date_dismissed`,
+    DROP COLUMN `dismissed`;
@@ -0,0 +1,5 @@
+-- Articles a user has dismissed, or snoozed until a time, are left out of their recommendations
+ALTER TABLE user_article_interactions
+    ADD COLUMN `dismissed` BOOLEAN NOT NULL DEFAULT FALSE AFTER `thumbs_down`,
+    ADD COLUMN `date_dismissed` DATETIME DEFAULT NULL AFTER `date_rated`,
+    ADD COLUMN `snoozed_until` DATETIME DEFAULT NULL AFTER `date_dismissed`;
@@ -0,0 +1,6 @@
+ALTER TABLE user_article_interactions
+    DROP COLUMN `date_engaged`,
+    DROP COLUMN `saved_to_collection`,
+    DROP COLUMN `time_on_article_seconds`,
+    DROP COLUMN `opened_link`,
+    DROP COLUMN `rating`;
@@ -0,0 +1,8 @@
+-- Optional 1-5 ratings, kept consistent with thumbs up (4-5) and thumbs down (1-2),
+-- and implicit signals of interest, all used to weight a user's article vectors
+ALTER TABLE user_article_interactions
+    ADD COLUMN `rating` INT DEFAULT NULL AFTER `thumbs_down`,
+    ADD COLUMN `opened_link` BOOLEAN NOT NULL DEFAULT FALSE AFTER `rating`,
+    ADD COLUMN `time_on_article_seconds` INT NOT NULL DEFAULT 0 AFTER `opened_link`,
+    ADD COLUMN `saved_to_collection` BOOLEAN NOT NULL DEFAULT FALSE AFTER `time_on_article_seconds`,
+    ADD COLUMN `date_engaged` DATETIME DEFAULT NULL AFTER `date_rated`;
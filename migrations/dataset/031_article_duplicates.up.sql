@@ -0,0 +1,13 @@
+-- Variants of articles, such as crossposts, grouped under the canonical article shown
+-- in their place; recomputed by the dedupe-articles job
+CREATE TABLE IF NOT EXISTS `article_duplicates` (
+    `variant_hash_id` VARCHAR(32) NOT NULL,
+    `canonical_hash_id` VARCHAR(32) NOT NULL,
+    `detected_at` DATETIME NOT NULL,
+    PRIMARY KEY (`variant_hash_id`),
+    KEY `article_duplicates_canonical_idx` (`canonical_hash_id`),
+    CONSTRAINT `article_duplicates_ibfk_1` FOREIGN KEY (`variant_hash_id`)
+        REFERENCES `articles` (`hash_id`),
+    CONSTRAINT `article_duplicates_ibfk_2` FOREIGN KEY (`canonical_hash_id`)
+        REFERENCES `articles` (`hash_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
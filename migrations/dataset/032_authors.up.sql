@@ -0,0 +1,28 @@
+-- Authors extracted from articles' free-text author lists, with the other spellings of
+-- their names, and the articles each is credited on; recomputed by the extract-authors job.
+-- IDs and aliases compare exactly, so names differing only by accents stay distinct.
+CREATE TABLE IF NOT EXISTS `authors` (
+    `id` VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    `name` VARCHAR(255) NOT NULL,
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE IF NOT EXISTS `author_aliases` (
+    `author_id` VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    `alias` VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    PRIMARY KEY (`author_id`, `alias`),
+    CONSTRAINT `author_aliases_ibfk_1` FOREIGN KEY (`author_id`)
+        REFERENCES `authors` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE IF NOT EXISTS `article_authors` (
+    `article_hash_id` VARCHAR(32) NOT NULL,
+    `author_id` VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    `position` INT NOT NULL,
+    PRIMARY KEY (`article_hash_id`, `author_id`),
+    KEY `article_authors_author_idx` (`author_id`),
+    CONSTRAINT `article_authors_ibfk_1` FOREIGN KEY (`article_hash_id`)
+        REFERENCES `articles` (`hash_id`) ON DELETE CASCADE,
+    CONSTRAINT `article_authors_ibfk_2` FOREIGN KEY (`author_id`)
+        REFERENCES `authors` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `user_follow_preferences`;
+DROP TABLE IF EXISTS `user_follows`;
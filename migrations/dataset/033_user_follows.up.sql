@@ -0,0 +1,20 @@
+-- Authors, sources and categories a user follows; articles from any of them make up
+-- their following feed. Targets compare exactly, like author IDs.
+CREATE TABLE IF NOT EXISTS `user_follows` (
+    `user_id` VARCHAR(256) NOT NULL,
+    `follow_type` VARCHAR(16) NOT NULL,
+    `target` VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (`user_id`, `follow_type`, `target`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- Per-user following settings
+-- The feed token is the URL of the user's RSS feed of followed articles, since feed readers can't authenticate
+CREATE TABLE IF NOT EXISTS `user_follow_preferences` (
+    `user_id` VARCHAR(256) NOT NULL PRIMARY KEY,
+    `include_in_recommendations` BOOLEAN NOT NULL DEFAULT FALSE,
+    `feed_token` CHAR(64) NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    `updated_at` DATETIME NOT NULL DEFAULT NOW(),
+    UNIQUE INDEX `idx_feed_token` (`feed_token`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,4 @@
+ALTER TABLE user_digest_preferences
+    DROP INDEX `idx_email_confirmation_token`,
+    DROP COLUMN `email_confirmation_token`,
+    DROP COLUMN `pending_email`;
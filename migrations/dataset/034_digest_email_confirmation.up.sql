@@ -0,0 +1,6 @@
+-- A newly entered digest address is kept pending until confirmed through the link emailed to it,
+-- so digests are only sent to addresses whose owner asked for them
+ALTER TABLE user_digest_preferences
+    ADD COLUMN `pending_email` VARCHAR(320) DEFAULT NULL AFTER `email`,
+    ADD COLUMN `email_confirmation_token` CHAR(64) DEFAULT NULL AFTER `pending_email`,
+    ADD UNIQUE INDEX `idx_email_confirmation_token` (`email_confirmation_token`);
@@ -0,0 +1,9 @@
+-- Per-user tag settings
+-- Tags are private unless the user opts in to sharing them, letting them feed other users'
+-- tag co-occurrence recommendations
+CREATE TABLE IF NOT EXISTS `user_tag_preferences` (
+    `user_id` VARCHAR(256) NOT NULL PRIMARY KEY,
+    `share_for_recommendations` BOOLEAN NOT NULL DEFAULT FALSE,
+    `created_at` DATETIME NOT NULL DEFAULT NOW(),
+    `updated_at` DATETIME NOT NULL DEFAULT NOW()
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
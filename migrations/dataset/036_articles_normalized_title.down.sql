@@ -0,0 +1 @@
+ALTER TABLE articles DROP INDEX idx_normalized_title, DROP COLUMN normalized_title;
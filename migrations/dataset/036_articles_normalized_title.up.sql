@@ -0,0 +1,7 @@
+-- Titles reduced to lower-cased words separated by single spaces, like domain.NormalizeTitle,
+-- so imported reading history can be matched to articles by title in batches
+-- Titles can exceed the maximum key length, so only a prefix is indexed
+ALTER TABLE articles
+    ADD COLUMN `normalized_title` VARCHAR(1028)
+        AS (TRIM(REGEXP_REPLACE(LOWER(title), '[^[:alnum:]]+', ' '))) STORED,
+    ADD INDEX idx_normalized_title (normalized_title(255));